@@ -2,8 +2,13 @@ package sdk
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strings"
+	"time"
 )
 
 type Cache interface {
@@ -32,3 +37,68 @@ func CopyResponse(resp *http.Response) *http.Response {
 
 	return &c
 }
+
+// PrefixDeleter is implemented by caches able to drop every entry whose key
+// starts with a prefix, which the client uses to invalidate the cached
+// responses of a resource collection after a write. Other caches only lose
+// the entry of the written URL.
+type PrefixDeleter interface {
+	DeletePrefix(prefix string)
+}
+
+// cachePrincipal identifies the credentials of cfg in cache keys, so the
+// clients of different principals, like the profiles of the CLI, never
+// share cached responses.
+func cachePrincipal(cfg *Configuration) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%v\n%v\n%v\n%v", cfg.Okta.Client.AuthorizationMode, cfg.Okta.Client.ClientId, cfg.Okta.Client.Token, strings.Join(cfg.Okta.Client.Scopes, " "))
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+// resourceCollection returns the collection a path belongs to, the path up
+// to the segment following the API version, like /api/v1/groups for
+// /api/v1/groups/{groupId}/users/{userId}.
+func resourceCollection(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i := 0; i+1 < len(segments); i++ {
+		if len(segments[i]) > 1 && segments[i][0] == 'v' && strings.Trim(segments[i][1:], "0123456789") == "" {
+			return "/" + strings.Join(segments[:i+2], "/")
+		}
+	}
+	return path
+}
+
+// cacheFresh reports whether a cached response can be served without asking
+// the org. Responses with Cache-Control no-cache never are, and those with a
+// max-age or an Expires header only until then. Others are revalidated with
+// their ETag when they have one, since revalidating is cheap, or stay fresh
+// as long as the cache keeps them.
+func cacheFresh(resp *http.Response) bool {
+	cacheControl := parseCacheControl(resp.Header)
+	if _, ok := cacheControl["no-cache"]; ok {
+		return false
+	}
+	if _, ok := cacheControl["max-age"]; !ok && resp.Header.Get("Expires") == "" {
+		return resp.Header.Get("ETag") == ""
+	}
+	return time.Now().Before(CacheExpires(resp))
+}
+
+// cacheable reports whether a response may be stored, which Cache-Control
+// no-store forbids.
+func cacheable(resp *http.Response) bool {
+	_, noStore := parseCacheControl(resp.Header)["no-store"]
+	return !noStore
+}
+
+// revalidated refreshes a cached response with the headers of the 304 Not
+// Modified answer to its revalidation.
+func revalidated(cached, notModified *http.Response) *http.Response {
+	for _, header := range []string{"Date", "Expires", "Cache-Control", "ETag"} {
+		if value := notModified.Header.Get(header); value != "" {
+			cached.Header.Set(header, value)
+		}
+	}
+	cached.Request = notModified.Request
+	return cached
+}
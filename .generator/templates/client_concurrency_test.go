@@ -0,0 +1,134 @@
+package sdk
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// tokenServer issues access tokens for PrivateKey auth, slowly enough for
+// concurrent requests to overlap, and counts the tokens and API calls.
+func tokenServer(t *testing.T) (*httptest.Server, *int32, *int32) {
+	var tokens, calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/oauth2/v1/token" {
+			n := atomic.AddInt32(&tokens, 1)
+			time.Sleep(50 * time.Millisecond)
+			fmt.Fprintf(w, `{"token_type":"Bearer","access_token":"token-%d","expires_in":3600}`, n)
+			return
+		}
+		atomic.AddInt32(&calls, 1)
+		if r.Header.Get("Authorization") != "Bearer token-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprintf(w, `[{"id":"%d"}]`, atomic.LoadInt32(&calls))
+	}))
+	t.Cleanup(server.Close)
+	return server, &tokens, &calls
+}
+
+func privateKeyTestClient(t *testing.T, orgURL string, setters ...ConfigSetter) *APIClient {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	privateKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	setters = append([]ConfigSetter{
+		WithAuthorizationMode("PrivateKey"), WithClientId("client"), WithScopes([]string{"okta.users.read"}), WithPrivateKey(string(privateKey)),
+	}, setters...)
+	client, err := NewTestClient(orgURL, "", setters...)
+	require.NoError(t, err)
+	return client
+}
+
+func TestConcurrentRequestsShareOneAccessToken(t *testing.T) {
+	server, tokens, calls := tokenServer(t)
+	client := privateKeyTestClient(t, server.URL)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.UserAPI.ListUsers(context.Background()).Execute()
+			require.NoError(t, err)
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, int32(1), *tokens, "concurrent requests should wait for a single token request")
+	require.Equal(t, int32(20), *calls)
+
+	tokenType, token, err := client.AccessToken()
+	require.NoError(t, err)
+	require.Equal(t, "Bearer", tokenType)
+	require.Equal(t, "token-1", token)
+}
+
+func TestConcurrentSkipCache(t *testing.T) {
+	server, _, calls := tokenServer(t)
+	client := privateKeyTestClient(t, server.URL, WithCache(true))
+	get := func(ctx context.Context) {
+		req, err := http.NewRequest(http.MethodGet, server.URL+"/api/v1/users", nil)
+		require.NoError(t, err)
+		_, bearer, err := client.AccessToken()
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer "+bearer)
+		resp, err := client.Do(ctx, req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+	get(context.Background())
+	require.Equal(t, int32(1), *calls)
+
+	skipCache := context.WithValue(context.Background(), ContextSkipCache, true)
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			get(context.Background())
+		}()
+		go func() {
+			defer wg.Done()
+			get(skipCache)
+		}()
+	}
+	wg.Wait()
+	// only the requests bypassing the cache reach the server, the others
+	// are served from the cache, refreshed or not
+	require.Equal(t, int32(11), *calls)
+}
+
+func TestRefreshNext(t *testing.T) {
+	server, _, calls := tokenServer(t)
+	client := privateKeyTestClient(t, server.URL, WithCache(true))
+	get := func() {
+		req, err := http.NewRequest(http.MethodGet, server.URL+"/api/v1/users", nil)
+		require.NoError(t, err)
+		_, bearer, err := client.AccessToken()
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer "+bearer)
+		_, err = client.Do(context.Background(), req)
+		require.NoError(t, err)
+	}
+	get()
+	get()
+	require.Equal(t, int32(1), *calls)
+	client.RefreshNext()
+	get()
+	get()
+	// only the request following RefreshNext bypasses the cache
+	require.Equal(t, int32(2), *calls)
+}
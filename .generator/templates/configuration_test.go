@@ -1,6 +1,8 @@
 package sdk
 
 import (
+	"os"
+	"path/filepath"
 	"runtime"
 	"testing"
 
@@ -22,3 +24,19 @@ func TestUserAgentWithExtra(t *testing.T) {
 	userAgent := "okta-sdk-golang/" + VERSION + " golang/" + runtime.Version() + " " + runtime.GOOS + "/" + runtime.GOARCH + " extra/info"
 	require.Equal(t, userAgent, configuration.UserAgent)
 }
+
+func TestWithConfigFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "okta.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("okta:\n  client:\n    orgUrl: https://profile.example.com\n"), 0o600))
+	configuration, err := NewConfiguration(WithConfigFile(path))
+	require.NoError(t, err)
+	require.Equal(t, "https://profile.example.com", configuration.Okta.Client.OrgUrl)
+
+	_, err = NewConfiguration(WithConfigFile(filepath.Join(dir, "missing.yaml")))
+	require.ErrorContains(t, err, "missing.yaml")
+
+	require.NoError(t, os.WriteFile(path, []byte("okta: [not a mapping"), 0o600))
+	_, err = NewConfiguration(WithConfigFile(path))
+	require.ErrorContains(t, err, "failed to read configuration file")
+}
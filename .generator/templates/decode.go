@@ -0,0 +1,41 @@
+package sdk
+
+import (
+	"bytes"
+	"errors"
+	"io"
+)
+
+// Decode reads the body of resp into a T, like []Group or User. oneOf
+// models like ListApplications200ResponseInner are decoded into the
+// variant their discriminator names, e.g. BookmarkApplication for an app
+// with the BOOKMARK signOnMode. The body stays readable afterwards.
+func Decode[T any](resp *APIResponse) (T, error) {
+	var v T
+	if resp == nil || resp.Response == nil || resp.Body == nil {
+		return v, errors.New("no response to decode")
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewBuffer(body))
+	if err != nil {
+		return v, err
+	}
+	cli := resp.cli
+	if cli == nil {
+		cli = &APIClient{}
+	}
+	err = cli.decode(&v, body, resp.Header.Get("Content-Type"))
+	return v, err
+}
+
+// decodeResponse decodes the response of an Execute call, it backs the
+// ExecuteTyped methods of the request builders.
+func decodeResponse[T any](resp *APIResponse, err error) (T, *APIResponse, error) {
+	if err != nil {
+		var v T
+		return v, resp, err
+	}
+	v, err := Decode[T](resp)
+	return v, resp, err
+}
@@ -0,0 +1,49 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/okta/okta-cli-client/mockorg"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDryRun(t *testing.T) {
+	org := mockorg.New()
+	server := httptest.NewServer(org)
+	defer server.Close()
+	var dryRun []*http.Request
+	var body []byte
+	client, err := NewTestClient(server.URL, org.Token(), WithDryRun(func(req *http.Request) error {
+		dryRun = append(dryRun, req)
+		if req.Body == nil {
+			return nil
+		}
+		var readErr error
+		body, readErr = io.ReadAll(req.Body)
+		return readErr
+	}))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = client.GroupAPI.CreateGroup(ctx).Data(`{"profile": {"name": "Dry"}}`).Execute()
+	require.True(t, errors.Is(err, ErrDryRun))
+	require.Len(t, dryRun, 1)
+	require.Equal(t, http.MethodPost, dryRun[0].Method)
+	require.Equal(t, server.URL+"/api/v1/groups", dryRun[0].URL.String())
+	require.Equal(t, "SSWS "+org.Token(), dryRun[0].Header.Get("Authorization"))
+	require.JSONEq(t, `{"profile": {"name": "Dry"}}`, string(body))
+
+	groups, _, err := decodeResponse[[]Group](client.GroupAPI.ListGroups(ctx).Execute())
+	require.NoError(t, err)
+	require.Empty(t, groups, "the group was not created")
+	require.Len(t, dryRun, 1, "GET requests are sent")
+
+	_, err = client.GroupAPI.DeleteGroup(ctx, "00g1").Execute()
+	require.True(t, errors.Is(err, ErrDryRun))
+	require.Len(t, dryRun, 2)
+}
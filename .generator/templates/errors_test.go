@@ -0,0 +1,66 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/okta/okta-cli-client/mockorg"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOktaError(t *testing.T) {
+	client := mockOrgClient(t, 0)
+	ctx := context.Background()
+
+	_, err := client.GroupAPI.GetGroup(ctx, "00gmissing").Execute()
+	require.True(t, IsNotFound(err))
+	require.False(t, IsValidationError(err))
+	oktaErr, ok := AsOktaError(err)
+	require.True(t, ok)
+	require.Equal(t, http.StatusNotFound, oktaErr.StatusCode)
+	require.Equal(t, ErrorCodeNotFound, oktaErr.ErrorCode)
+	require.NotEmpty(t, oktaErr.RequestId)
+	require.Equal(t, "404 Not Found", err.Error(), "the message of the API errors is unchanged")
+
+	_, err = client.GroupAPI.CreateGroup(ctx).Data(map[string]interface{}{"profile": map[string]interface{}{"description": "no name"}}).Execute()
+	require.True(t, IsValidationError(err))
+	oktaErr, _ = AsOktaError(err)
+	require.Equal(t, []string{"name: The field cannot be left blank"}, oktaErr.ErrorCauses)
+
+	// statuses without a model in the spec are decoded as well
+	_, err = client.GroupAPI.CreateGroup(ctx).Data("{").Execute()
+	oktaErr, ok = AsOktaError(err)
+	require.True(t, ok)
+	require.Equal(t, "E0000003", oktaErr.ErrorCode)
+}
+
+func TestOktaErrorRateLimited(t *testing.T) {
+	org := mockorg.New(mockorg.WithRateLimit(1, time.Minute))
+	server := httptest.NewServer(org)
+	defer server.Close()
+	client, err := NewTestClient(server.URL, org.Token(), WithRateLimitMaxRetries(0))
+	require.NoError(t, err)
+
+	_, err = client.GroupAPI.ListGroups(context.Background()).Execute()
+	require.NoError(t, err)
+	_, err = client.GroupAPI.ListGroups(context.Background()).Execute()
+	require.True(t, IsRateLimited(err))
+	oktaErr, _ := AsOktaError(err)
+	require.Equal(t, ErrorCodeRateLimited, oktaErr.ErrorCode)
+	require.Greater(t, oktaErr.RetryAfter, time.Duration(0))
+	require.LessOrEqual(t, oktaErr.RetryAfter, time.Minute+time.Second)
+}
+
+func TestOktaErrorRequestFailure(t *testing.T) {
+	client := mockOrgClient(t, 0)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := client.GroupAPI.ListGroups(ctx).Execute()
+	require.True(t, errors.Is(err, context.Canceled))
+	require.False(t, IsNotFound(err))
+}
@@ -0,0 +1,166 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileCache(t *testing.T) {
+	dir := t.TempDir()
+	cache, err := NewFileCache(dir, 0)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, r.URL.Path)
+	}))
+	defer server.Close()
+	for _, path := range []string{"/api/v1/groups", "/api/v1/groups/00g1", "/api/v1/users"} {
+		resp, err := http.Get(server.URL + path)
+		require.NoError(t, err)
+		cache.Set(server.URL+path, resp)
+	}
+	cache.SetString("token", "value")
+
+	// entries outlive the instance
+	cache, err = NewFileCache(dir, 0)
+	require.NoError(t, err)
+	require.True(t, cache.Has(server.URL+"/api/v1/groups/00g1"))
+	body, err := io.ReadAll(cache.Get(server.URL + "/api/v1/groups/00g1").Body)
+	require.NoError(t, err)
+	require.Equal(t, "/api/v1/groups/00g1", string(body))
+	require.Equal(t, "value", cache.GetString("token"))
+	require.Nil(t, cache.Get("missing"))
+
+	cache.DeletePrefix(server.URL + "/api/v1/groups")
+	require.False(t, cache.Has(server.URL+"/api/v1/groups"))
+	require.False(t, cache.Has(server.URL+"/api/v1/groups/00g1"))
+	require.True(t, cache.Has(server.URL+"/api/v1/users"))
+	cache.Delete(server.URL + "/api/v1/users")
+	require.False(t, cache.Has(server.URL+"/api/v1/users"))
+	cache.Clear()
+	require.Equal(t, "", cache.GetString("token"))
+
+	expiring := &FileCache{dir: dir, ttl: time.Nanosecond}
+	expiring.SetString("token", "value")
+	require.False(t, expiring.Has("token"))
+	require.Empty(t, expiring.entries())
+}
+
+// cachingServer answers the paths of headers with the path in the body and
+// the headers, 304 Not Modified when the request matches the ETag.
+func cachingServer(t *testing.T, headers map[string]http.Header) (*httptest.Server, map[string]int) {
+	var mu sync.Mutex
+	calls := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		calls[r.Method+" "+r.URL.Path]++
+		mu.Unlock()
+		for key, values := range headers[r.URL.Path] {
+			w.Header()[key] = values
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if etag := w.Header().Get("ETag"); etag != "" && r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		fmt.Fprintf(w, `{"path":%q}`, r.URL.Path)
+	}))
+	t.Cleanup(server.Close)
+	return server, calls
+}
+
+func cachingClient(t *testing.T, orgURL, dir, token string) *APIClient {
+	client, err := NewTestClient(orgURL, token, WithCache(true), WithCacheDir(dir), WithCacheTtl(300))
+	require.NoError(t, err)
+	return client
+}
+
+func TestCacheRevalidation(t *testing.T) {
+	server, calls := cachingServer(t, map[string]http.Header{
+		"/api/v1/groups/00g1": {"Etag": {`"v1"`}, "Cache-Control": {"no-cache"}},
+		"/api/v1/groups/00g2": {"Cache-Control": {"max-age=3600"}},
+		"/api/v1/groups/00g3": {"Cache-Control": {"no-store"}},
+		"/api/v1/groups/00g4": {"Etag": {`"v1"`}, "Expires": {"0"}},
+		"/api/v1/groups/00g5": {"Etag": {`"v1"`}},
+		"/api/v1/groups/00g6": {},
+	})
+	dir := t.TempDir()
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		// every client stands for an invocation of the CLI
+		client := cachingClient(t, server.URL, dir, "token")
+		for _, id := range []string{"00g1", "00g2", "00g3", "00g4", "00g5", "00g6"} {
+			resp, err := client.GroupAPI.GetGroup(ctx, id).Execute()
+			require.NoError(t, err)
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.JSONEq(t, fmt.Sprintf(`{"path":"/api/v1/groups/%v"}`, id), string(body))
+		}
+	}
+	require.Equal(t, map[string]int{
+		"GET /api/v1/groups/00g1": 2, // revalidated
+		"GET /api/v1/groups/00g2": 1, // fresh
+		"GET /api/v1/groups/00g3": 2, // not stored
+		"GET /api/v1/groups/00g4": 2, // expired and revalidated
+		"GET /api/v1/groups/00g5": 2, // revalidated
+		"GET /api/v1/groups/00g6": 1, // fresh until the TTL
+	}, calls)
+}
+
+func TestCacheInvalidation(t *testing.T) {
+	maxAge := http.Header{"Cache-Control": {"max-age=3600"}}
+	server, calls := cachingServer(t, map[string]http.Header{
+		"/api/v1/groups": maxAge, "/api/v1/groups/00g1": maxAge, "/api/v1/users": maxAge,
+		"/api/v1/groupsettings": maxAge,
+	})
+	dir := t.TempDir()
+	ctx := context.Background()
+	client := cachingClient(t, server.URL, dir, "token")
+	other := cachingClient(t, server.URL, dir, "other token")
+	read := func(client *APIClient) {
+		_, err := client.GroupAPI.ListGroups(ctx).Execute()
+		require.NoError(t, err)
+		_, err = client.GroupAPI.GetGroup(ctx, "00g1").Execute()
+		require.NoError(t, err)
+		_, err = client.UserAPI.ListUsers(ctx).Execute()
+		require.NoError(t, err)
+		_, err = client.RawRequest(ctx, http.MethodGet, "/api/v1/groupsettings").Execute()
+		require.NoError(t, err)
+	}
+
+	read(client)
+	read(client)
+	require.Equal(t, 1, calls["GET /api/v1/groups"])
+	read(other)
+	require.Equal(t, 2, calls["GET /api/v1/groups"], "principals never share entries")
+
+	_, err := client.GroupAPI.ReplaceGroup(ctx, "00g1").Data(map[string]interface{}{"profile": map[string]interface{}{"name": "Renamed"}}).Execute()
+	require.NoError(t, err)
+	read(client)
+	read(other)
+	require.Equal(t, 4, calls["GET /api/v1/groups"], "the write invalidates the collection for every principal")
+	require.Equal(t, 4, calls["GET /api/v1/groups/00g1"])
+	require.Equal(t, 2, calls["GET /api/v1/users"])
+	require.Equal(t, 2, calls["GET /api/v1/groupsettings"], "the collection ends at a path segment")
+}
+
+func TestResourceCollection(t *testing.T) {
+	for path, collection := range map[string]string{
+		"/api/v1/groups":                                 "/api/v1/groups",
+		"/api/v1/groups/00g1/users/00u1":                 "/api/v1/groups",
+		"/oauth2/v1/clients/0oa1/roles":                  "/oauth2/v1/clients",
+		"/integrations/api/v1/api-services/0oa1/secrets": "/integrations/api/v1/api-services",
+		"/.well-known/okta-organization":                 "/.well-known/okta-organization",
+	} {
+		require.Equal(t, collection, resourceCollection(path), path)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"bytes"
 	"net/http"
 	"net/http/httputil"
+	"strings"
 	"time"
 
 	patrickmnGoCache "github.com/patrickmn/go-cache"
@@ -62,6 +63,14 @@ func (c GoCache) Delete(key string) {
 	c.rootLibrary.Delete(key)
 }
 
+func (c GoCache) DeletePrefix(prefix string) {
+	for key := range c.rootLibrary.Items() {
+		if strings.HasPrefix(key, prefix) {
+			c.rootLibrary.Delete(key)
+		}
+	}
+}
+
 func (c GoCache) Clear() {
 	c.rootLibrary.Flush()
 }
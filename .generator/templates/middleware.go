@@ -0,0 +1,30 @@
+package sdk
+
+import "net/http"
+
+// Doer sends an HTTP request and returns its response, like *http.Client.
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// DoerFunc adapts a function to a Doer.
+type DoerFunc func(req *http.Request) (*http.Response, error)
+
+func (f DoerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Middleware wraps the Doer sending the requests of the client, to inspect
+// or change requests and responses, or to answer them without calling next.
+// It sees every attempt of the API requests, retries included, and the
+// requests for access tokens.
+type Middleware func(next Doer) Doer
+
+// chainMiddlewares wraps doer so that the first middleware sees the requests
+// first and the responses last.
+func chainMiddlewares(doer Doer, middlewares []Middleware) Doer {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		doer = middlewares[i](doer)
+	}
+	return doer
+}
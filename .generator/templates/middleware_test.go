@@ -0,0 +1,97 @@
+package sdk
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMiddleware(t *testing.T) {
+	server, tokens, calls := tokenServer(t)
+
+	var mu sync.Mutex
+	var seen []string
+	record := func(name string) Middleware {
+		return func(next Doer) Doer {
+			return DoerFunc(func(req *http.Request) (*http.Response, error) {
+				mu.Lock()
+				seen = append(seen, name+" "+req.URL.Path)
+				mu.Unlock()
+				req.Header.Set("X-Middleware", name)
+				return next.Do(req)
+			})
+		}
+	}
+	client := privateKeyTestClient(t, server.URL, WithMiddleware(record("first"), record("second")))
+
+	resp, err := client.UserAPI.ListUsers(context.Background()).Execute()
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, []string{
+		"first /oauth2/v1/token", "second /oauth2/v1/token",
+		"first /api/v1/users", "second /api/v1/users",
+	}, seen)
+	require.Equal(t, int32(1), *tokens)
+	require.Equal(t, int32(1), *calls)
+}
+
+func TestMiddlewareAnswersRequests(t *testing.T) {
+	server, _, calls := tokenServer(t)
+	fake := func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			if req.URL.Path == "/oauth2/v1/token" {
+				return next.Do(req)
+			}
+			return &http.Response{
+				StatusCode: http.StatusServiceUnavailable,
+				Header:     http.Header{"Content-Type": {"application/json"}},
+				Body:       io.NopCloser(bytes.NewBufferString(`{}`)),
+				Request:    req,
+			}, nil
+		})
+	}
+	client := privateKeyTestClient(t, server.URL, WithMiddleware(fake), WithRetryBackoff(0, 0))
+
+	resp, err := client.UserAPI.ListUsers(context.Background()).Execute()
+	require.Error(t, err)
+	require.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	require.Equal(t, int32(0), *calls)
+}
+
+func TestCallMiddleware(t *testing.T) {
+	server, calls, _ := flakyServer(t, 2, http.StatusServiceUnavailable)
+	var seen []string
+	record := func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			resp, err := next.Do(req)
+			require.NoError(t, err)
+			seen = append(seen, fmt.Sprintf("%v %v %v", req.Method, req.URL.Path, resp.StatusCode))
+			return resp, err
+		})
+	}
+	client, err := NewTestClient(server.URL, "token", WithRetryBackoff(0, 0), WithCallMiddleware(record),
+		WithDryRun(func(req *http.Request) error { return nil }))
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodPut, server.URL+"/api/v1/groups/00g1", nil)
+	require.NoError(t, err)
+	_, err = client.Do(context.Background(), req)
+	require.ErrorIs(t, err, ErrDryRun)
+	require.Equal(t, int32(0), *calls, "dry runs are not sent")
+	require.Empty(t, seen)
+
+	client.cfg.DryRun = nil
+	req, err = http.NewRequest(http.MethodPut, server.URL+"/api/v1/groups/00g1", nil)
+	require.NoError(t, err)
+	resp, err := client.Do(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, int32(3), *calls)
+	require.Equal(t, []string{"PUT /api/v1/groups/00g1 200"}, seen, "the call is seen once, retries included")
+}
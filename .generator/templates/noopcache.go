@@ -25,6 +25,9 @@ func (c NoOpCache) SetString(key string, value string) {
 func (c NoOpCache) Delete(key string) {
 }
 
+func (c NoOpCache) DeletePrefix(prefix string) {
+}
+
 func (c NoOpCache) Clear() {
 }
 
@@ -0,0 +1,156 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/okta/okta-cli-client/mockorg"
+	"github.com/stretchr/testify/require"
+)
+
+func mockOrgClient(t *testing.T, users int) *APIClient {
+	org := mockorg.New()
+	org.Seed(users, 0)
+	server := httptest.NewServer(org)
+	t.Cleanup(server.Close)
+	client, err := NewTestClient(server.URL, org.Token())
+	require.NoError(t, err)
+	return client
+}
+
+func TestAll(t *testing.T) {
+	client := mockOrgClient(t, 5)
+	ctx := context.Background()
+
+	pages := 0
+	countPages := func(resp *APIResponse) error {
+		pages++
+		return nil
+	}
+	var logins []string
+	for user, err := range All[User](ctx, client.UserAPI.ListUsers(ctx).Limit(2), countPages) {
+		require.NoError(t, err)
+		logins = append(logins, user.Profile.GetLogin())
+	}
+	require.Equal(t, []string{"user1@example.com", "user2@example.com", "user3@example.com", "user4@example.com", "user5@example.com"}, logins)
+	require.Equal(t, 3, pages)
+
+	// pages are fetched lazily
+	pages = 0
+	for page, err := range Pages[User](ctx, client.UserAPI.ListUsers(ctx).Limit(2), countPages) {
+		require.NoError(t, err)
+		require.Len(t, page, 2)
+		break
+	}
+	require.Equal(t, 1, pages)
+}
+
+func TestAllStops(t *testing.T) {
+	client := mockOrgClient(t, 5)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var errs []error
+	items := 0
+	for _, err := range All[User](ctx, client.UserAPI.ListUsers(ctx).Limit(2), func(*APIResponse) error {
+		cancel()
+		return nil
+	}) {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		items++
+	}
+	require.Equal(t, 2, items, "the first page is yielded before the cancellation is noticed")
+	require.Equal(t, []error{context.Canceled}, errs)
+
+	stop := errors.New("stop")
+	var err error
+	for _, err = range All[User](context.Background(), client.UserAPI.ListUsers(context.Background()), func(*APIResponse) error {
+		return stop
+	}) {
+	}
+	require.ErrorIs(t, err, stop)
+
+	err = nil
+	for _, err = range All[User](context.Background(), client.UserAPI.GetUser(context.Background(), "missing")) {
+	}
+	require.Error(t, err)
+}
+
+func TestPaginationInBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		role := `{"id":"%v","label":"%v","description":"Role %v"}`
+		switch r.URL.Query().Get("after") {
+		case "":
+			fmt.Fprintf(w, `{"roles":[`+role+`,`+role+`],"_links":{"next":{"href":"http://%v/api/v1/iam/roles?after=cr2"}}}`,
+				"cr1", "first", 1, "cr2", "second", 2, r.Host)
+		case "cr2":
+			fmt.Fprintf(w, `{"roles":[`+role+`],"_links":{}}`, "cr3", "third", 3)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+	client, err := NewTestClient(server.URL, "token")
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	var labels []string
+	for role, err := range All[IamRole](ctx, client.RoleAPI.ListRoles(ctx)) {
+		require.NoError(t, err)
+		labels = append(labels, role.Label)
+	}
+	require.Equal(t, []string{"first", "second", "third"}, labels)
+
+	resp, err := client.RoleAPI.ListRoles(ctx).Execute()
+	require.NoError(t, err)
+	require.True(t, resp.HasNextPage())
+	require.Equal(t, "/api/v1/iam/roles?after=cr2", resp.NextPage())
+	var roles IamRoles
+	resp, err = resp.Next(&roles)
+	require.NoError(t, err)
+	require.Len(t, roles.Roles, 1)
+	require.False(t, resp.HasNextPage())
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Contains(t, string(body), "third", "reading the links leaves the body readable")
+}
+
+func TestPaginationInBodyNextPage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("after") {
+		case "":
+			fmt.Fprint(w, `{"roles":[{"id":"cr1","label":"first"}],"nextPage":"cr2"}`)
+		case "cr2":
+			fmt.Fprintf(w, `{"roles":[{"id":"cr2","label":"second"}],"nextPage":"http://%v/api/v1/iam/roles?after=cr3&limit=1"}`, r.Host)
+		case "cr3":
+			fmt.Fprint(w, `{"roles":[{"id":"cr3","label":"third"}]}`)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+	client, err := NewTestClient(server.URL, "token")
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	resp, err := client.RoleAPI.ListRoles(ctx).Execute()
+	require.NoError(t, err)
+	require.Equal(t, "/api/v1/iam/roles?after=cr2", resp.NextPage(), "a cursor is sent back as the after parameter")
+
+	var labels []string
+	for role, err := range All[IamRole](ctx, client.RoleAPI.ListRoles(ctx)) {
+		require.NoError(t, err)
+		labels = append(labels, role.Label)
+	}
+	require.Equal(t, []string{"first", "second", "third"}, labels)
+}
@@ -0,0 +1,142 @@
+package sdk
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"testing"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/lestrrat-go/jwx/jwk"
+	"github.com/stretchr/testify/require"
+	"github.com/youmark/pkcs8"
+)
+
+func signAndVerify(t *testing.T, signer jose.Signer, public crypto.PublicKey) *jose.JSONWebSignature {
+	t.Helper()
+	signed, err := signer.Sign([]byte("payload"))
+	require.NoError(t, err)
+	serialized, err := signed.CompactSerialize()
+	require.NoError(t, err)
+	parsed, err := jose.ParseSigned(serialized)
+	require.NoError(t, err)
+	_, err = parsed.Verify(public)
+	require.NoError(t, err)
+	return parsed
+}
+
+func TestCreateKeySignerPKCS1(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	privatePEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	signer, err := createKeySigner(string(privatePEM), "kid1", "", nil)
+	require.NoError(t, err)
+	parsed := signAndVerify(t, signer, &key.PublicKey)
+	require.Equal(t, "RS256", parsed.Signatures[0].Header.Algorithm)
+	require.Equal(t, "kid1", parsed.Signatures[0].Header.KeyID)
+
+	signer, err = createKeySigner(string(privatePEM), "", "PS384", nil)
+	require.NoError(t, err)
+	parsed = signAndVerify(t, signer, &key.PublicKey)
+	require.Equal(t, "PS384", parsed.Signatures[0].Header.Algorithm)
+
+	_, err = createKeySigner(string(privatePEM), "", "ES256", nil)
+	require.Error(t, err)
+}
+
+func TestCreateKeySignerEC(t *testing.T) {
+	for curve, alg := range map[elliptic.Curve]string{
+		elliptic.P256(): "ES256",
+		elliptic.P384(): "ES384",
+		elliptic.P521(): "ES512",
+	} {
+		key, err := ecdsa.GenerateKey(curve, rand.Reader)
+		require.NoError(t, err)
+		der, err := x509.MarshalECPrivateKey(key)
+		require.NoError(t, err)
+		privatePEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})
+
+		signer, err := createKeySigner(string(privatePEM), "", "", nil)
+		require.NoError(t, err)
+		parsed := signAndVerify(t, signer, &key.PublicKey)
+		require.Equal(t, alg, parsed.Signatures[0].Header.Algorithm)
+
+		_, err = createKeySigner(string(privatePEM), "", "RS256", nil)
+		require.Error(t, err)
+	}
+}
+
+func TestCreateKeySignerEncryptedPKCS8(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+	der, err := pkcs8.MarshalPrivateKey(key, []byte("secret"), nil)
+	require.NoError(t, err)
+	privatePEM := pem.EncodeToMemory(&pem.Block{Type: "ENCRYPTED PRIVATE KEY", Bytes: der})
+
+	_, err = createKeySigner(string(privatePEM), "", "", nil)
+	require.Error(t, err)
+	_, err = createKeySigner(string(privatePEM), "", "", func() ([]byte, error) { return nil, errors.New("no terminal") })
+	require.Error(t, err)
+	_, err = createKeySigner(string(privatePEM), "", "", func() ([]byte, error) { return []byte("wrong"), nil })
+	require.Error(t, err)
+
+	signer, err := createKeySigner(string(privatePEM), "", "", func() ([]byte, error) { return []byte("secret"), nil })
+	require.NoError(t, err)
+	parsed := signAndVerify(t, signer, &key.PublicKey)
+	require.Equal(t, "ES384", parsed.Signatures[0].Header.Algorithm)
+}
+
+func TestCreateKeySignerJWK(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	privateJWK, err := jwk.New(key)
+	require.NoError(t, err)
+	require.NoError(t, privateJWK.Set(jwk.KeyIDKey, "jwk-kid"))
+	require.NoError(t, privateJWK.Set(jwk.AlgorithmKey, "RS512"))
+	otherJWK, err := jwk.New(key)
+	require.NoError(t, err)
+	require.NoError(t, otherJWK.Set(jwk.KeyIDKey, "other-kid"))
+
+	single, err := json.Marshal(privateJWK)
+	require.NoError(t, err)
+	signer, err := createKeySigner(string(single), "", "", nil)
+	require.NoError(t, err)
+	parsed := signAndVerify(t, signer, &key.PublicKey)
+	require.Equal(t, "RS512", parsed.Signatures[0].Header.Algorithm)
+	require.Equal(t, "jwk-kid", parsed.Signatures[0].Header.KeyID)
+
+	set, err := json.Marshal(map[string]interface{}{"keys": []jwk.Key{otherJWK, privateJWK}})
+	require.NoError(t, err)
+	_, err = createKeySigner(string(set), "", "", nil)
+	require.Error(t, err)
+	signer, err = createKeySigner(string(set), "jwk-kid", "", nil)
+	require.NoError(t, err)
+	parsed = signAndVerify(t, signer, &key.PublicKey)
+	require.Equal(t, "jwk-kid", parsed.Signatures[0].Header.KeyID)
+
+	publicJWK, err := jwk.New(&key.PublicKey)
+	require.NoError(t, err)
+	public, err := json.Marshal(publicJWK)
+	require.NoError(t, err)
+	_, err = createKeySigner(string(public), "", "", nil)
+	require.Error(t, err)
+}
+
+func TestCreateKeySignerEd25519(t *testing.T) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	privatePEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+
+	_, err = createKeySigner(string(privatePEM), "", "", nil)
+	require.ErrorContains(t, err, "EdDSA")
+}
@@ -0,0 +1,127 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// rateGovernor spaces out requests before Okta answers with 429s. It
+// tracks the X-Rate-Limit-Limit, X-Rate-Limit-Remaining and
+// X-Rate-Limit-Reset headers per endpoint bucket and, once the remaining
+// capacity of a bucket falls to headroom percent of its limit, holds
+// requests to the bucket until it resets. It is safe for concurrent use.
+type rateGovernor struct {
+	headroom int32
+	mu       sync.Mutex
+	buckets  map[string]*rateBucket
+	now      func() time.Time
+}
+
+type rateBucket struct {
+	limit     int
+	remaining int
+	reset     time.Time
+	// resetEpoch is the X-Rate-Limit-Reset header reset is computed from,
+	// telling the responses of the same window apart.
+	resetEpoch int64
+}
+
+func newRateGovernor(headroom int32) *rateGovernor {
+	return &rateGovernor{headroom: headroom, buckets: map[string]*rateBucket{}, now: time.Now}
+}
+
+// bucketWord matches the segments of paths that are not IDs, words like
+// users, api-tokens or .well-known, and versioned ones like v1 or oauth2.
+var bucketWord = regexp.MustCompile(`^\.?[A-Za-z][A-Za-z_-]*[0-9]?$`)
+
+// rateBucketKey approximates Okta's rate limit buckets with the method and
+// the path, IDs replaced by a placeholder.
+func rateBucketKey(req *http.Request) string {
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	for i, segment := range segments {
+		if !bucketWord.MatchString(segment) {
+			segments[i] = "{id}"
+		}
+	}
+	return req.Method + " /" + strings.Join(segments, "/")
+}
+
+// wait blocks until the bucket of req has capacity above the headroom and
+// accounts the request against it.
+func (g *rateGovernor) wait(ctx context.Context, req *http.Request) error {
+	if g == nil || g.headroom <= 0 {
+		return nil
+	}
+	key := rateBucketKey(req)
+	for {
+		g.mu.Lock()
+		bucket, ok := g.buckets[key]
+		if !ok {
+			g.mu.Unlock()
+			return nil
+		}
+		now := g.now()
+		if !now.Before(bucket.reset) {
+			delete(g.buckets, key)
+			g.mu.Unlock()
+			return nil
+		}
+		reserve := bucket.limit * int(g.headroom) / 100
+		if bucket.remaining > reserve {
+			bucket.remaining--
+			g.mu.Unlock()
+			return nil
+		}
+		delay := bucket.reset.Sub(now)
+		g.mu.Unlock()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// update records the rate limit headers of resp for the bucket of req.
+func (g *rateGovernor) update(req *http.Request, resp *http.Response) {
+	if g == nil || g.headroom <= 0 || resp == nil {
+		return
+	}
+	limit, err := strconv.Atoi(resp.Header.Get("X-Rate-Limit-Limit"))
+	if err != nil {
+		return
+	}
+	remaining, err := strconv.Atoi(resp.Header.Get("X-Rate-Limit-Remaining"))
+	if err != nil {
+		return
+	}
+	reset, err := strconv.ParseInt(resp.Header.Get("X-Rate-Limit-Reset"), 10, 64)
+	if err != nil {
+		return
+	}
+	now := g.now()
+	// measure the reset against the server's clock
+	serverNow := now
+	if date, err := http.ParseTime(resp.Header.Get("Date")); err == nil {
+		serverNow = date
+	}
+	resetAt := now.Add(time.Unix(reset, 0).Sub(serverNow))
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	key := rateBucketKey(req)
+	bucket, ok := g.buckets[key]
+	if ok && bucket.resetEpoch == reset && bucket.remaining < remaining {
+		// responses of concurrent requests can arrive out of order
+		return
+	}
+	g.buckets[key] = &rateBucket{limit: limit, remaining: remaining, reset: resetAt, resetEpoch: reset}
+}
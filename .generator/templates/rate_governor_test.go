@@ -0,0 +1,121 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateBucketKey(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, "https://example.okta.com/api/v1/users/00u1abcdEFGH234/groups?limit=2", nil)
+	require.NoError(t, err)
+	require.Equal(t, "GET /api/v1/users/{id}/groups", rateBucketKey(req))
+	req, err = http.NewRequest(http.MethodGet, "https://example.okta.com/api/v1/users/jane@example.com/groups", nil)
+	require.NoError(t, err)
+	require.Equal(t, "GET /api/v1/users/{id}/groups", rateBucketKey(req))
+	req, err = http.NewRequest(http.MethodPost, "https://example.okta.com/oauth2/aus1a2b3c4d5e6f7g8h9/v1/token", nil)
+	require.NoError(t, err)
+	require.Equal(t, "POST /oauth2/{id}/v1/token", rateBucketKey(req))
+	req, err = http.NewRequest(http.MethodGet, "https://example.okta.com/api/v1/api-tokens/00T1abc", nil)
+	require.NoError(t, err)
+	require.Equal(t, "GET /api/v1/api-tokens/{id}", rateBucketKey(req))
+}
+
+func TestRateGovernor(t *testing.T) {
+	var mu sync.Mutex
+	remaining := 10
+	var reset time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		now := time.Now()
+		if !now.Before(reset) {
+			reset = now.Truncate(time.Second).Add(time.Second)
+			remaining = 10
+		}
+		remaining--
+		w.Header().Set("Date", now.UTC().Format(http.TimeFormat))
+		w.Header().Set("X-Rate-Limit-Limit", "10")
+		w.Header().Set("X-Rate-Limit-Remaining", fmt.Sprint(remaining))
+		w.Header().Set("X-Rate-Limit-Reset", fmt.Sprint(reset.Unix()))
+		if remaining < 0 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, err := NewTestClient(server.URL, "token", WithRateLimitHeadroom(30))
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	statuses := make(chan int, 20)
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 5; j++ {
+				// require must not be called outside of the test goroutine
+				req, err := http.NewRequest(http.MethodGet, server.URL+"/api/v1/users", nil)
+				if !assert.NoError(t, err) {
+					return
+				}
+				resp, err := client.Do(context.Background(), req)
+				if !assert.NoError(t, err) {
+					return
+				}
+				statuses <- resp.StatusCode
+			}
+		}()
+	}
+	wg.Wait()
+	close(statuses)
+	for status := range statuses {
+		require.Equal(t, http.StatusOK, status, "the governor should keep requests under the limit")
+	}
+}
+
+func TestRateGovernorHonorsContext(t *testing.T) {
+	g := newRateGovernor(50)
+	req, err := http.NewRequest(http.MethodGet, "https://example.okta.com/api/v1/groups", nil)
+	require.NoError(t, err)
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set("X-Rate-Limit-Limit", "100")
+	resp.Header.Set("X-Rate-Limit-Remaining", "10")
+	resp.Header.Set("X-Rate-Limit-Reset", fmt.Sprint(time.Now().Add(time.Minute).Unix()))
+	g.update(req, resp)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, g.wait(ctx, req), context.DeadlineExceeded)
+
+	other, err := http.NewRequest(http.MethodGet, "https://example.okta.com/api/v1/users", nil)
+	require.NoError(t, err)
+	require.NoError(t, g.wait(ctx, other))
+}
+
+func TestRateGovernorOutOfOrderResponses(t *testing.T) {
+	g := newRateGovernor(50)
+	g.now = func() time.Time { return time.Unix(1000, 500_000_000) }
+	req, err := http.NewRequest(http.MethodGet, "https://example.okta.com/api/v1/groups", nil)
+	require.NoError(t, err)
+	respond := func(remaining int) {
+		resp := &http.Response{Header: http.Header{}}
+		resp.Header.Set("Date", time.Unix(1000, 0).UTC().Format(http.TimeFormat))
+		resp.Header.Set("X-Rate-Limit-Limit", "100")
+		resp.Header.Set("X-Rate-Limit-Remaining", fmt.Sprint(remaining))
+		resp.Header.Set("X-Rate-Limit-Reset", "1060")
+		g.update(req, resp)
+	}
+	respond(40)
+	respond(45)
+	require.Equal(t, 40, g.buckets[rateBucketKey(req)].remaining, "a stale response of the same window is ignored")
+}
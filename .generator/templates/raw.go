@@ -0,0 +1,145 @@
+package sdk
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ApiRawRequest is the request builder of an arbitrary request to the org,
+// for the endpoints the SDK has no operation for. It is authorized, retried,
+// cached and traced like the operations, and is Pageable for the lists.
+type ApiRawRequest struct {
+	ctx     context.Context
+	client  *APIClient
+	method  string
+	path    string
+	body    interface{}
+	query   url.Values
+	headers map[string]string
+}
+
+// RawRequest returns the builder of a request with method to path, like
+// /api/v1/users, which may hold a query. path can also be a URL of the org,
+// like the next link of a page.
+func (c *APIClient) RawRequest(ctx context.Context, method, path string) ApiRawRequest {
+	return ApiRawRequest{
+		ctx:     ctx,
+		client:  c,
+		method:  strings.ToUpper(method),
+		path:    path,
+		query:   url.Values{},
+		headers: map[string]string{},
+	}
+}
+
+// Body sets the body of the request, sent as is when it is a string or a
+// []byte and encoded to JSON otherwise.
+func (r ApiRawRequest) Body(body interface{}) ApiRawRequest {
+	r.body = body
+	return r
+}
+
+// Query adds a parameter to the query of the request.
+func (r ApiRawRequest) Query(key, value string) ApiRawRequest {
+	query := url.Values{}
+	for k, v := range r.query {
+		query[k] = append([]string(nil), v...)
+	}
+	query.Add(key, value)
+	r.query = query
+	return r
+}
+
+// Header sets a header of the request, like Content-Type for a body that is
+// not JSON.
+func (r ApiRawRequest) Header(key, value string) ApiRawRequest {
+	headers := map[string]string{}
+	for k, v := range r.headers {
+		headers[k] = v
+	}
+	headers[key] = value
+	r.headers = headers
+	return r
+}
+
+func (r ApiRawRequest) Execute() (*APIResponse, error) {
+	var (
+		localVarHTTPResponse *http.Response
+		localAPIResponse     *APIResponse
+		err                  error
+	)
+
+	if r.client.cfg.Okta.Client.RequestTimeout > 0 {
+		localctx, cancel := context.WithTimeout(r.ctx, time.Second*time.Duration(r.client.cfg.Okta.Client.RequestTimeout))
+		r.ctx = localctx
+		defer cancel()
+	}
+	localVarPath, localVarQueryParams, err := r.url()
+	if err != nil {
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
+	}
+
+	localVarHeaderParams := map[string]string{"Accept": "application/json"}
+	if r.body != nil {
+		localVarHeaderParams["Content-Type"] = "application/json"
+	}
+	for k, v := range r.headers {
+		localVarHeaderParams[k] = v
+	}
+	req, err := r.client.PrepareRequest(r.ctx, localVarPath, r.method, r.body, localVarHeaderParams, localVarQueryParams, url.Values{}, nil)
+	if err != nil {
+		return nil, err
+	}
+	localVarHTTPResponse, err = r.client.Do(r.ctx, req)
+	if err != nil {
+		localAPIResponse = newAPIResponse(localVarHTTPResponse, r.client, nil)
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	localAPIResponse = newAPIResponse(localVarHTTPResponse, r.client, nil)
+	if err != nil {
+		return localAPIResponse, err
+	}
+	if localVarHTTPResponse.StatusCode >= 300 {
+		return localAPIResponse, &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
+		}
+	}
+	return localAPIResponse, nil
+}
+
+// url returns the URL of the request without its query, and the query. The
+// credentials of the client are only ever sent to the org.
+func (r ApiRawRequest) url() (string, url.Values, error) {
+	localBasePath, err := r.client.cfg.ServerURLWithContext(r.ctx, "")
+	if err != nil {
+		return "", nil, err
+	}
+	target, err := url.Parse(r.path)
+	if err != nil {
+		return "", nil, err
+	}
+	if target.IsAbs() && (target.Scheme != r.client.cfg.Scheme || target.Host != r.client.cfg.Host) {
+		return "", nil, fmt.Errorf("%v is not a URL of the org %v", r.path, r.client.cfg.Okta.Client.OrgUrl)
+	}
+	query := target.Query()
+	for k, v := range r.query {
+		query[k] = append(query[k], v...)
+	}
+	path := target.EscapedPath()
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	return strings.TrimSuffix(localBasePath, "/") + path, query, nil
+}
@@ -0,0 +1,41 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRawRequest(t *testing.T) {
+	client := mockOrgClient(t, 5)
+	ctx := context.Background()
+
+	count := 0
+	for user, err := range All[User](ctx, client.RawRequest(ctx, "get", "/api/v1/users?limit=2")) {
+		require.NoError(t, err)
+		require.NotEmpty(t, user.GetId())
+		count++
+	}
+	require.Equal(t, 5, count)
+
+	group, resp, err := decodeResponse[Group](client.RawRequest(ctx, http.MethodPost, "api/v1/groups").
+		Body(`{"profile": {"name": "Raw"}}`).
+		Execute())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "Raw", group.Profile.GetName())
+
+	groups, _, err := decodeResponse[[]Group](client.RawRequest(ctx, http.MethodGet, client.GetConfig().Okta.Client.OrgUrl+"/api/v1/groups").
+		Query("q", "Raw").
+		Execute())
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+
+	_, err = client.RawRequest(ctx, http.MethodGet, "/api/v1/groups/00gmissing").Execute()
+	require.True(t, IsNotFound(err))
+
+	_, err = client.RawRequest(ctx, http.MethodGet, "https://example.com/api/v1/users").Execute()
+	require.ErrorContains(t, err, "is not a URL of the org")
+}
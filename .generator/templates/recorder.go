@@ -0,0 +1,307 @@
+package sdk
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// ErrFixtureNotFound is returned in replay mode for requests that were not
+// recorded, or not as many times.
+var ErrFixtureNotFound = errors.New("no recorded response")
+
+// fixtureOrigin stands for the scheme and host of the org in fixtures, so
+// that a session recorded against one org replays against any org URL.
+const fixtureOrigin = "{orgUrl}"
+
+// redacted replaces credentials in fixtures.
+const redacted = "REDACTED"
+
+// secretFields are JSON and form fields holding credentials, their values
+// are replaced altogether.
+var secretFields = map[string]bool{
+	"access_token": true, "id_token": true, "refresh_token": true, "client_secret": true,
+	"client_assertion": true, "password": true, "answer": true, "secret": true,
+	"sharedSecret": true, "token": true, "tokenValue": true, "privateKey": true,
+	"d": true, "p": true, "q": true, "dp": true, "dq": true, "qi": true,
+}
+
+// piiFields are JSON fields holding personal data, their values are replaced
+// by pseudonyms keeping them distinct and consistent across fixtures.
+var piiFields = map[string]bool{
+	"login": true, "email": true, "secondEmail": true, "firstName": true, "lastName": true,
+	"middleName": true, "displayName": true, "nickName": true, "mobilePhone": true,
+	"primaryPhone": true, "streetAddress": true, "postalAddress": true, "zipCode": true,
+	"employeeNumber": true,
+}
+
+// fixtureHeaders are the response headers kept in fixtures.
+var fixtureHeaders = []string{
+	"Content-Type", "Date", "Etag", "Link", "Location", "Retry-After",
+	"X-Okta-Request-Id", "X-Rate-Limit-Limit", "X-Rate-Limit-Remaining", "X-Rate-Limit-Reset",
+}
+
+var (
+	emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+	slugPattern  = regexp.MustCompile(`[^A-Za-z0-9]+`)
+)
+
+// fixture is a recorded request and its response. Bodies are stored as JSON
+// when they are, as text otherwise.
+type fixture struct {
+	Request struct {
+		Method string          `json:"method"`
+		URL    string          `json:"url"`
+		JSON   json.RawMessage `json:"json,omitempty"`
+		Body   string          `json:"body,omitempty"`
+	} `json:"request"`
+	Response struct {
+		StatusCode int               `json:"statusCode"`
+		Header     map[string]string `json:"header,omitempty"`
+		JSON       json.RawMessage   `json:"json,omitempty"`
+		Body       string            `json:"body,omitempty"`
+	} `json:"response"`
+}
+
+// recorder stores request and response pairs as fixture files in dir, or
+// answers requests from them in replay mode. Identical requests are numbered
+// in the order they are sent, a replay past the last one fails.
+type recorder struct {
+	dir    string
+	replay bool
+	mu     sync.Mutex
+	counts map[string]int
+}
+
+func newRecorder(dir string, replay bool) *recorder {
+	return &recorder{dir: dir, replay: replay, counts: map[string]int{}}
+}
+
+// WithRecord stores the requests of the client and their responses as
+// fixtures in dir, with credentials and personal data scrubbed.
+func WithRecord(dir string) ConfigSetter {
+	return WithMiddleware(newRecorder(dir, false).middleware)
+}
+
+// WithReplay answers the requests of the client with the fixtures recorded
+// in dir, without contacting the org. Requests that were not recorded, or
+// sent more times than they were, fail with ErrFixtureNotFound.
+func WithReplay(dir string) ConfigSetter {
+	return WithMiddleware(newRecorder(dir, true).middleware)
+}
+
+func (r *recorder) middleware(next Doer) Doer {
+	return DoerFunc(func(req *http.Request) (*http.Response, error) {
+		var body []byte
+		if req.Body != nil {
+			var err error
+			if body, err = io.ReadAll(req.Body); err != nil {
+				return nil, err
+			}
+			req.Body.Close()
+			req.Body = io.NopCloser(bytes.NewReader(body))
+		}
+		f := &fixture{}
+		f.Request.Method = req.Method
+		f.Request.URL = scrubURL(req.URL)
+		f.Request.JSON, f.Request.Body = scrubBody(req.Header.Get("Content-Type"), body, origin(req.URL))
+		name := r.fixtureName(f)
+		if r.replay {
+			return r.load(req, name)
+		}
+
+		resp, err := next.Do(req)
+		if err != nil {
+			return resp, err
+		}
+		respBody, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(respBody))
+		if err != nil {
+			return resp, err
+		}
+		f.Response.StatusCode = resp.StatusCode
+		f.Response.Header = map[string]string{}
+		for _, key := range fixtureHeaders {
+			if value := resp.Header.Get(key); value != "" {
+				f.Response.Header[key] = scrubString(value, origin(req.URL))
+			}
+		}
+		f.Response.JSON, f.Response.Body = scrubBody(resp.Header.Get("Content-Type"), respBody, origin(req.URL))
+		if err = r.save(name, f); err != nil {
+			return resp, fmt.Errorf("failed to record %s %s: %w", req.Method, f.Request.URL, err)
+		}
+		return resp, nil
+	})
+}
+
+// fixtureName returns the file name of the next occurrence of the request of
+// f, keyed by the method, the path, the query and a hash of the body.
+func (r *recorder) fixtureName(f *fixture) string {
+	hash := sha256.New()
+	fmt.Fprintf(hash, "%s\n%s\n%s\n%s", f.Request.Method, f.Request.URL, f.Request.JSON, f.Request.Body)
+	key := hex.EncodeToString(hash.Sum(nil))[:12]
+	path, _, _ := strings.Cut(f.Request.URL, "?")
+	slug := strings.Trim(slugPattern.ReplaceAllString(path, "_"), "_")
+	if len(slug) > 60 {
+		slug = slug[:60]
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.counts[key]++
+	return fmt.Sprintf("%s_%s_%s_%d.json", f.Request.Method, slug, key, r.counts[key])
+}
+
+func (r *recorder) save(name string, f *fixture) error {
+	content, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(r.dir, 0o700); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(r.dir, name), append(content, '\n'), 0o600)
+}
+
+func (r *recorder) load(req *http.Request, name string) (*http.Response, error) {
+	content, err := os.ReadFile(filepath.Join(r.dir, name))
+	if errors.Is(err, os.ErrNotExist) {
+		// the suffix of the name is the occurrence of the request
+		occurrence := strings.TrimSuffix(name[strings.LastIndex(name, "_")+1:], ".json")
+		return nil, fmt.Errorf("%w for occurrence %s of %s %s in %s", ErrFixtureNotFound, occurrence, req.Method, scrubURL(req.URL), r.dir)
+	}
+	if err != nil {
+		return nil, err
+	}
+	f := &fixture{}
+	if err = json.Unmarshal(content, f); err != nil {
+		return nil, fmt.Errorf("invalid fixture %s: %w", name, err)
+	}
+
+	resp := &http.Response{
+		Status:     fmt.Sprintf("%d %s", f.Response.StatusCode, http.StatusText(f.Response.StatusCode)),
+		StatusCode: f.Response.StatusCode,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{},
+		Request:    req,
+	}
+	for key, value := range f.Response.Header {
+		resp.Header.Set(key, strings.ReplaceAll(value, fixtureOrigin, origin(req.URL)))
+	}
+	body := []byte(f.Response.Body)
+	if len(f.Response.JSON) > 0 {
+		body = f.Response.JSON
+	}
+	body = bytes.ReplaceAll(body, []byte(fixtureOrigin), []byte(origin(req.URL)))
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+	return resp, nil
+}
+
+func origin(u *url.URL) string {
+	return u.Scheme + "://" + u.Host
+}
+
+// scrubURL returns the path and the sorted query of u without personal data.
+func scrubURL(u *url.URL) string {
+	path := scrubString(u.Path, origin(u))
+	query := u.Query()
+	if len(query) == 0 {
+		return path
+	}
+	for key, values := range query {
+		for i, value := range values {
+			values[i] = scrubString(value, origin(u))
+		}
+		query[key] = values
+	}
+	return path + "?" + query.Encode()
+}
+
+// scrubBody returns body without credentials and personal data, as JSON
+// when it is, as text otherwise.
+func scrubBody(contentType string, body []byte, origin string) (json.RawMessage, string) {
+	if len(body) == 0 {
+		return nil, ""
+	}
+	if strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		if form, err := url.ParseQuery(string(body)); err == nil {
+			for key, values := range form {
+				for i, value := range values {
+					if secretFields[key] {
+						values[i] = redacted
+					} else {
+						values[i] = scrubString(value, origin)
+					}
+				}
+			}
+			return nil, form.Encode()
+		}
+	}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var v interface{}
+	if err := decoder.Decode(&v); err == nil && !decoder.More() {
+		if scrubbed, err := json.Marshal(scrubJSON(v, origin)); err == nil {
+			return scrubbed, ""
+		}
+	}
+	return nil, scrubString(string(body), origin)
+}
+
+func scrubJSON(v interface{}, origin string) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			switch s, isString := value.(string); {
+			case secretFields[key]:
+				v[key] = redacted
+			case piiFields[key] && isString:
+				v[key] = pseudonym(s)
+			default:
+				v[key] = scrubJSON(value, origin)
+			}
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = scrubJSON(value, origin)
+		}
+	case string:
+		return scrubString(v, origin)
+	}
+	return v
+}
+
+// scrubString replaces the org origin and email addresses in s.
+func scrubString(s, origin string) string {
+	s = strings.ReplaceAll(s, origin, fixtureOrigin)
+	return emailPattern.ReplaceAllStringFunc(s, pseudonym)
+}
+
+// pseudonym replaces s by a value derived from its hash, an email address
+// for email addresses.
+func pseudonym(s string) string {
+	if s == "" {
+		return s
+	}
+	hash := sha256.Sum256([]byte(s))
+	id := hex.EncodeToString(hash[:])[:8]
+	if emailPattern.FindString(s) == s {
+		return "user-" + id + "@example.com"
+	}
+	return "redacted-" + id
+}
@@ -0,0 +1,94 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&calls, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "sid=secret")
+		w.Header().Set("Link", fmt.Sprintf(`<http://%s/api/v1/users?after=1>; rel="next"`, r.Host))
+		fmt.Fprintf(w, `[{"id":"00u1","profile":{"login":"jane@example.org","firstName":"Jane"},"credentials":{"password":{"value":"hunter2"}},"call":%d}]`, n)
+	}))
+	defer server.Close()
+	dir := t.TempDir()
+	get := func(client *APIClient, orgURL, path string) (*http.Response, string, error) {
+		req, err := http.NewRequest(http.MethodGet, orgURL+path, nil)
+		require.NoError(t, err)
+		resp, err := client.Do(context.Background(), req)
+		if err != nil {
+			return nil, "", err
+		}
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp, string(body), nil
+	}
+
+	client, err := NewTestClient(server.URL, "token", WithRecord(dir))
+	require.NoError(t, err)
+	_, recorded, err := get(client, server.URL, "/api/v1/users?search=profile.login+eq+%22jane%40example.org%22")
+	require.NoError(t, err)
+	require.Contains(t, recorded, "hunter2", "the caller gets the response as is")
+	_, _, err = get(client, server.URL, "/api/v1/users?search=profile.login+eq+%22jane%40example.org%22")
+	require.NoError(t, err)
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+	content, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+	for _, secret := range []string{"hunter2", "jane@example.org", "Jane", "sid=secret", strings.TrimPrefix(server.URL, "http://")} {
+		require.NotContains(t, string(content), secret)
+	}
+
+	// the replay does not need the org, nor the same org URL
+	server.Close()
+	orgURL := "http://localhost:1"
+	client, err = NewTestClient(orgURL, "", WithReplay(dir))
+	require.NoError(t, err)
+	for _, call := range []string{`"call": 1`, `"call": 2`} {
+		resp, replayed, err := get(client, orgURL, "/api/v1/users?search=profile.login+eq+%22jane%40example.org%22")
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Contains(t, replayed, call)
+		require.Contains(t, replayed, "REDACTED")
+		require.Equal(t, `<http://localhost:1/api/v1/users?after=1>; rel="next"`, resp.Header.Get("Link"))
+	}
+	// a request sent more times than recorded is not answered with a stale
+	// response
+	_, _, err = get(client, orgURL, "/api/v1/users?search=profile.login+eq+%22jane%40example.org%22")
+	require.ErrorIs(t, err, ErrFixtureNotFound)
+	require.ErrorContains(t, err, "occurrence 3")
+
+	_, _, err = get(client, orgURL, "/api/v1/groups")
+	require.ErrorIs(t, err, ErrFixtureNotFound)
+}
+
+func TestRecordTokenRequests(t *testing.T) {
+	server, tokens, _ := tokenServer(t)
+	dir := t.TempDir()
+	client := privateKeyTestClient(t, server.URL, WithRecord(dir))
+	_, err := client.UserAPI.ListUsers(context.Background()).Execute()
+	require.NoError(t, err)
+	require.Equal(t, int32(1), *tokens)
+
+	// client assertions differ on each run but match the recorded request
+	client = privateKeyTestClient(t, "http://localhost:1", WithReplay(dir))
+	resp, err := client.UserAPI.ListUsers(context.Background()).Execute()
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
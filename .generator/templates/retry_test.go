@@ -0,0 +1,159 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// flakyServer fails the first failures requests with status, or by closing
+// the connection when status is 0, and records the retry headers.
+func flakyServer(t *testing.T, failures int32, status int) (*httptest.Server, *int32, *[]string) {
+	var calls int32
+	var retryCounts []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&calls, 1)
+		retryCounts = append(retryCounts, r.Header.Get("X-Okta-Retry-Count"))
+		if n > failures {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if status == 0 {
+			conn, _, err := w.(http.Hijacker).Hijack()
+			require.NoError(t, err)
+			conn.Close()
+			return
+		}
+		w.Header().Set("X-Okta-Request-Id", "req-1")
+		if status == http.StatusTooManyRequests {
+			now := time.Now().UTC()
+			w.Header().Set("Date", now.Format(http.TimeFormat))
+			w.Header().Set("X-Rate-Limit-Reset", strconv.FormatInt(now.Unix(), 10))
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls, &retryCounts
+}
+
+func retryTestClient(t *testing.T, orgURL string) *APIClient {
+	client, err := NewTestClient(orgURL, "token", WithRetryBackoff(0, 0))
+	require.NoError(t, err)
+	return client
+}
+
+func TestRetryServerErrors(t *testing.T) {
+	server, calls, retryCounts := flakyServer(t, 2, http.StatusServiceUnavailable)
+	client := retryTestClient(t, server.URL)
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/api/v1/users", nil)
+	require.NoError(t, err)
+	resp, err := client.Do(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, int32(3), *calls)
+	require.Equal(t, []string{"", "1", "2"}, *retryCounts)
+	require.Equal(t, "req-1", req.Header.Get("X-Okta-Retry-For"))
+}
+
+func TestRetryGivesUpAfterMaxRetries(t *testing.T) {
+	server, calls, _ := flakyServer(t, 10, http.StatusBadGateway)
+	client := retryTestClient(t, server.URL)
+
+	req, err := http.NewRequest(http.MethodPut, server.URL+"/api/v1/groups/1", strings.NewReader(`{}`))
+	require.NoError(t, err)
+	resp, err := client.Do(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	require.Equal(t, int32(DefaultRetryMaxRetries+1), *calls)
+}
+
+func TestRetryTooManyRequests(t *testing.T) {
+	server, calls, retryCounts := flakyServer(t, 1, http.StatusTooManyRequests)
+	client, err := NewTestClient(server.URL, "token", WithRateLimitMaxRetries(2), WithRateLimitMaxBackOff(0))
+	require.NoError(t, err)
+
+	// 429 responses are retried for any method, Okta did not process them
+	req, err := http.NewRequest(http.MethodPost, server.URL+"/api/v1/groups", strings.NewReader(`{}`))
+	require.NoError(t, err)
+	resp, err := client.Do(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, int32(2), *calls)
+	require.Equal(t, []string{"", "1"}, *retryCounts)
+}
+
+func TestRetryConnectionReset(t *testing.T) {
+	server, calls, _ := flakyServer(t, 1, 0)
+	client := retryTestClient(t, server.URL)
+
+	req, err := http.NewRequest(http.MethodPut, server.URL+"/api/v1/groups/1", strings.NewReader(`{}`))
+	require.NoError(t, err)
+	resp, err := client.Do(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, int32(2), *calls)
+}
+
+func TestRetryPost(t *testing.T) {
+	server, calls, _ := flakyServer(t, 1, http.StatusServiceUnavailable)
+	client := retryTestClient(t, server.URL)
+
+	req, err := http.NewRequest(http.MethodPost, server.URL+"/api/v1/groups", strings.NewReader(`{}`))
+	require.NoError(t, err)
+	resp, err := client.Do(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusServiceUnavailable, resp.StatusCode, "POST is not idempotent")
+	require.Equal(t, int32(1), *calls)
+
+	req, err = http.NewRequest(http.MethodPost, server.URL+"/api/v1/groups", strings.NewReader(`{}`))
+	require.NoError(t, err)
+	ctx := context.WithValue(context.Background(), ContextRetryNonIdempotent, true)
+	resp, err = client.Do(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	server, calls, _ = flakyServer(t, 1, http.StatusServiceUnavailable)
+	client = retryTestClient(t, server.URL)
+	req, err = http.NewRequest(http.MethodPost, server.URL+"/api/v1/users/1/lifecycle/deactivate", nil)
+	require.NoError(t, err)
+	resp, err = client.Do(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, int32(2), *calls)
+
+	server, calls, _ = flakyServer(t, 1, http.StatusServiceUnavailable)
+	client = retryTestClient(t, server.URL)
+	req, err = http.NewRequest(http.MethodPost, server.URL+"/api/v1/users/1/lifecycle/activate", nil)
+	require.NoError(t, err)
+	resp, err = client.Do(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusServiceUnavailable, resp.StatusCode, "activating twice may send two emails")
+	require.Equal(t, int32(1), *calls)
+}
+
+func TestRetryDelete(t *testing.T) {
+	server, calls, _ := flakyServer(t, 1, http.StatusServiceUnavailable)
+	client := retryTestClient(t, server.URL)
+
+	req, err := http.NewRequest(http.MethodDelete, server.URL+"/api/v1/users/1", nil)
+	require.NoError(t, err)
+	resp, err := client.Do(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusServiceUnavailable, resp.StatusCode, "a second DELETE of a user deletes it for good")
+	require.Equal(t, int32(1), *calls)
+
+	req, err = http.NewRequest(http.MethodDelete, server.URL+"/api/v1/users/1", nil)
+	require.NoError(t, err)
+	ctx := context.WithValue(context.Background(), ContextRetryNonIdempotent, true)
+	resp, err = client.Do(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
@@ -0,0 +1,127 @@
+package sdk
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultSecretTimeout bounds an exec: secret reference when no
+// secretTimeout is configured.
+const DefaultSecretTimeout = 30 * time.Second
+
+// Secret references can be used in place of the credential values of the
+// configuration (token, privateKey, privateKeyPassphrase, clientAssertion and
+// the proxy password):
+//
+//	env:OKTA_PROD_TOKEN            the value of an environment variable
+//	file:/run/secrets/okta         the content of a file
+//	exec:vault read -field=token   the standard output of a shell command
+//
+// References are resolved when the client first needs the value and the
+// result is kept for the lifetime of the client. Any other value is used as is.
+const (
+	secretEnvPrefix  = "env:"
+	secretFilePrefix = "file:"
+	secretExecPrefix = "exec:"
+)
+
+type secretResolver struct {
+	timeout time.Duration
+	mu      sync.Mutex
+	values  map[string]string
+}
+
+func newSecretResolver(timeout time.Duration) *secretResolver {
+	if timeout <= 0 {
+		timeout = DefaultSecretTimeout
+	}
+	return &secretResolver{timeout: timeout, values: map[string]string{}}
+}
+
+// isSecretReference reports whether value is an env:, file: or exec: reference.
+func isSecretReference(value string) bool {
+	return strings.HasPrefix(value, secretEnvPrefix) || strings.HasPrefix(value, secretFilePrefix) || strings.HasPrefix(value, secretExecPrefix)
+}
+
+// resolve returns the secret value references, name is the configuration
+// key used in errors.
+func (r *secretResolver) resolve(name, value string) (string, error) {
+	if !isSecretReference(value) {
+		return value, nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if secret, ok := r.values[value]; ok {
+		return secret, nil
+	}
+	secret, err := r.lookup(value)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve %v: %w", name, err)
+	}
+	r.values[value] = secret
+	return secret, nil
+}
+
+func (r *secretResolver) lookup(value string) (string, error) {
+	switch {
+	case strings.HasPrefix(value, secretEnvPrefix):
+		key := strings.TrimPrefix(value, secretEnvPrefix)
+		secret, ok := os.LookupEnv(key)
+		if !ok {
+			return "", fmt.Errorf("environment variable %v is not set", key)
+		}
+		return secret, nil
+	case strings.HasPrefix(value, secretFilePrefix):
+		content, err := os.ReadFile(strings.TrimPrefix(value, secretFilePrefix))
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimRight(string(content), "\r\n"), nil
+	default:
+		return r.run(strings.TrimPrefix(value, secretExecPrefix))
+	}
+}
+
+// run executes command with the platform shell, like git and docker
+// credential helpers, and returns its standard output.
+func (r *secretResolver) run(command string) (string, error) {
+	if strings.TrimSpace(command) == "" {
+		return "", errors.New("empty exec: command")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+	// don't wait for children of the shell still holding stdout after a timeout
+	cmd.WaitDelay = time.Second
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	err := cmd.Run()
+	if ctx.Err() == context.DeadlineExceeded {
+		return "", fmt.Errorf("command timed out after %v", r.timeout)
+	}
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("%w: %v", err, msg)
+		}
+		return "", err
+	}
+	secret := strings.TrimRight(stdout.String(), "\r\n")
+	if secret == "" {
+		return "", errors.New("command printed nothing")
+	}
+	return secret, nil
+}
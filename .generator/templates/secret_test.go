@@ -0,0 +1,60 @@
+package sdk
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretResolverEnvAndFile(t *testing.T) {
+	t.Setenv("OKTA_TEST_SECRET", "from-env")
+	path := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(path, []byte("from-file\n"), 0o600))
+	r := newSecretResolver(0)
+
+	secret, err := r.resolve("token", "env:OKTA_TEST_SECRET")
+	require.NoError(t, err)
+	require.Equal(t, "from-env", secret)
+	secret, err = r.resolve("token", "file:"+path)
+	require.NoError(t, err)
+	require.Equal(t, "from-file", secret)
+	secret, err = r.resolve("token", "plain-value")
+	require.NoError(t, err)
+	require.Equal(t, "plain-value", secret)
+
+	_, err = r.resolve("token", "env:OKTA_TEST_SECRET_MISSING")
+	require.ErrorContains(t, err, "failed to resolve token")
+	_, err = r.resolve("token", "file:"+path+".missing")
+	require.Error(t, err)
+}
+
+func TestSecretResolverExec(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses sh")
+	}
+	calls := filepath.Join(t.TempDir(), "calls")
+	r := newSecretResolver(time.Second)
+
+	for i := 0; i < 2; i++ {
+		secret, err := r.resolve("token", "exec:echo call >> "+calls+"; echo from-exec")
+		require.NoError(t, err)
+		require.Equal(t, "from-exec", secret)
+	}
+	content, err := os.ReadFile(calls)
+	require.NoError(t, err)
+	require.Equal(t, 1, strings.Count(string(content), "call"), "the command should only run once")
+
+	_, err = r.resolve("token", "exec:echo denied >&2; exit 3")
+	require.ErrorContains(t, err, "denied")
+	_, err = r.resolve("token", "exec:true")
+	require.ErrorContains(t, err, "printed nothing")
+
+	r = newSecretResolver(100 * time.Millisecond)
+	_, err = r.resolve("token", "exec:sleep 5")
+	require.ErrorContains(t, err, "timed out")
+}
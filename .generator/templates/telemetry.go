@@ -0,0 +1,139 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName names the tracer and meter of the client.
+const instrumentationName = "github.com/okta/okta-cli-client/sdk"
+
+// tokenRoute is the route of the access token requests, which are not
+// operations of the Management API.
+const tokenRoute = "/oauth2/v1/token"
+
+// telemetry traces and measures the requests of a client with the providers
+// of its configuration, the global ones by default, which record nothing
+// until the application installs an OpenTelemetry SDK.
+type telemetry struct {
+	tracer      trace.Tracer
+	duration    metric.Float64Histogram
+	rateLimited metric.Int64Counter
+}
+
+func newTelemetry(cfg *Configuration) *telemetry {
+	tracerProvider := cfg.TracerProvider
+	if tracerProvider == nil {
+		tracerProvider = otel.GetTracerProvider()
+	}
+	meterProvider := cfg.MeterProvider
+	if meterProvider == nil {
+		meterProvider = otel.GetMeterProvider()
+	}
+	meter := meterProvider.Meter(instrumentationName)
+	t := &telemetry{tracer: tracerProvider.Tracer(instrumentationName)}
+	// the instruments are no-ops when the meter cannot create them
+	t.duration, _ = meter.Float64Histogram("okta.client.request.duration",
+		metric.WithDescription("Duration of the requests to the org, retries included."),
+		metric.WithUnit("s"))
+	t.rateLimited, _ = meter.Int64Counter("okta.client.rate_limited",
+		metric.WithDescription("Responses of the org with the 429 Too Many Requests status."),
+		metric.WithUnit("{response}"))
+	return t
+}
+
+// requestTelemetry is the span and measurements of a request in flight.
+type requestTelemetry struct {
+	t     *telemetry
+	span  trace.Span
+	start time.Time
+	attrs []attribute.KeyValue
+}
+
+// start starts the span of req and returns its context.
+func (t *telemetry) start(ctx context.Context, req *http.Request) (context.Context, *requestTelemetry) {
+	route, attrs := routeAttributes(req)
+	name := req.Method
+	if route != "" {
+		name += " " + route
+	}
+	ctx, span := t.tracer.Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+		trace.WithAttributes(attribute.String("server.address", req.URL.Hostname())))
+	return ctx, &requestTelemetry{t: t, span: span, start: time.Now(), attrs: attrs}
+}
+
+// end records the outcome of the request on its span and in the metrics.
+func (r *requestTelemetry) end(ctx context.Context, resp *http.Response, err error) {
+	attrs := r.attrs
+	if resp != nil {
+		attrs = append(attrs, attribute.Int("http.response.status_code", resp.StatusCode))
+		if remaining, parseErr := strconv.Atoi(resp.Header.Get("X-Rate-Limit-Remaining")); parseErr == nil {
+			r.span.SetAttributes(attribute.Int("okta.rate_limit.remaining", remaining))
+		}
+		if requestID := resp.Header.Get("X-Okta-Request-Id"); requestID != "" {
+			r.span.SetAttributes(attribute.String("okta.request.id", requestID))
+		}
+		if resp.StatusCode >= 400 {
+			r.span.SetStatus(codes.Error, resp.Status)
+		}
+	}
+	if err != nil {
+		r.span.RecordError(err)
+		r.span.SetStatus(codes.Error, err.Error())
+	}
+	r.span.SetAttributes(attrs...)
+	r.span.End()
+	r.t.duration.Record(ctx, time.Since(r.start).Seconds(), metric.WithAttributes(attrs...))
+}
+
+// retry records on the span in ctx that the request is sent again, count
+// being the retries so far.
+func (t *telemetry) retry(ctx context.Context, count int32, reason error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.Int("http.request.resend_count", int(count)))
+	span.AddEvent("retry", trace.WithAttributes(attribute.String("okta.retry.reason", reason.Error())))
+}
+
+// tooManyRequests counts a 429 response to req.
+func (t *telemetry) tooManyRequests(ctx context.Context, req *http.Request) {
+	_, attrs := routeAttributes(req)
+	t.rateLimited.Add(ctx, 1, metric.WithAttributes(attrs...))
+}
+
+// tokenDoer wraps the Doer requesting access tokens, tracing every attempt
+// in ctx, the context of the API request needing the token.
+func (t *telemetry) tokenDoer(ctx context.Context, next Doer) Doer {
+	return DoerFunc(func(req *http.Request) (*http.Response, error) {
+		ctx, rt := t.start(ctx, req)
+		resp, err := next.Do(req.WithContext(ctx))
+		if resp != nil && tooManyRequests(resp) {
+			t.tooManyRequests(ctx, req)
+		}
+		rt.end(ctx, resp, err)
+		return resp, err
+	})
+}
+
+// routeAttributes returns the route template of req, empty when it is not
+// an operation of the spec, and the attributes identifying the route, which
+// unlike the URL do not multiply the metric series.
+func routeAttributes(req *http.Request) (string, []attribute.KeyValue) {
+	attrs := []attribute.KeyValue{attribute.String("http.request.method", req.Method)}
+	if route, ok := findOperation(req.Method, req.URL.Path); ok {
+		return route.path, append(attrs, attribute.String("http.route", route.path), attribute.String("okta.operation.id", route.operationID))
+	}
+	if req.URL.Path == tokenRoute {
+		return tokenRoute, append(attrs, attribute.String("http.route", tokenRoute))
+	}
+	return "", attrs
+}
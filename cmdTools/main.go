@@ -5,11 +5,13 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"slices"
 	"strings"
 
 	"github.com/okta/okta-cli-client/utils"
 
 	"github.com/pb33f/libopenapi"
+	"github.com/pb33f/libopenapi/datamodel/high/base"
 	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
 	"github.com/pb33f/libopenapi/orderedmap"
 	"golang.org/x/text/cases"
@@ -40,10 +42,24 @@ func main() {
 	if err != nil {
 		fmt.Println(err.Error())
 	}
+	err = generateMockRoutes(ctx, docModel)
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	err = generateBodyPaginatedRoutes(ctx, docModel)
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	err = generateOperationRoutes(ctx, docModel)
+	if err != nil {
+		fmt.Println(err.Error())
+	}
 }
 
 const (
-	packageName = "okta"
+	packageName     = "okta"
+	mockPackageName = "mockorg"
+	sdkPackageName  = "sdk"
 )
 
 func generateCmd(ctx context.Context, docModel *libopenapi.DocumentModel[v3high.Document]) (err error) {
@@ -154,10 +170,17 @@ func buildCmdForHTTPMethod(ops *v3high.Operation, endpoint, httpMethod string, p
 		"requiredFlags": requiredFlags,
 		"subCommand":    subCommand,
 		"summary":       ops.Summary,
+		"scopes":        strings.Join(getOAuth2Scopes(ops), " "),
 	}
 	if checkRequestBodyExist(ops) {
 		templateData["data"] = true
 	}
+	if httpMethod == http.MethodGet && isListOperation(ops) {
+		templateData["list"] = true
+	}
+	if isDestructive(ops, httpMethod) {
+		templateData["destructive"] = destructiveTarget(endpoint)
+	}
 
 	err = utils.WriteFile(f, "cmdTools", "lowLevelCmd.tmpl", templateData)
 	if err != nil {
@@ -166,6 +189,198 @@ func buildCmdForHTTPMethod(ops *v3high.Operation, endpoint, httpMethod string, p
 	return nil
 }
 
+type route struct {
+	Method      string
+	Path        string
+	OperationID string
+}
+
+// specRoutes returns the operations of the spec in its order.
+func specRoutes(ctx context.Context, docModel *libopenapi.DocumentModel[v3high.Document]) []route {
+	routes := make([]route, 0)
+	for pair := range orderedmap.Iterate(ctx, docModel.Model.Paths.PathItems) {
+		node := pair.Value()
+		operations := []struct {
+			method string
+			ops    *v3high.Operation
+		}{
+			{http.MethodGet, node.Get},
+			{http.MethodPost, node.Post},
+			{http.MethodPut, node.Put},
+			{http.MethodPatch, node.Patch},
+			{http.MethodDelete, node.Delete},
+		}
+		for _, operation := range operations {
+			if operation.ops != nil {
+				routes = append(routes, route{Method: operation.method, Path: pair.Key(), OperationID: operation.ops.OperationId})
+			}
+		}
+	}
+	return routes
+}
+
+// generateMockRoutes writes the operations of the spec for the mock org
+// server to tell unimplemented operations from unknown paths.
+func generateMockRoutes(ctx context.Context, docModel *libopenapi.DocumentModel[v3high.Document]) error {
+	f, err := os.Create(fmt.Sprintf("%v/routes.go", mockPackageName))
+	if err != nil {
+		return err
+	}
+	return utils.WriteFile(f, "cmdTools", "mockRoutes.tmpl", map[string]interface{}{
+		"packageName": mockPackageName,
+		"routes":      specRoutes(ctx, docModel),
+	})
+}
+
+// generateOperationRoutes writes the operations of the spec for the SDK to
+// name the operation and route template of its requests in telemetry.
+func generateOperationRoutes(ctx context.Context, docModel *libopenapi.DocumentModel[v3high.Document]) error {
+	f, err := os.Create(fmt.Sprintf("%v/operation_routes.go", sdkPackageName))
+	if err != nil {
+		return err
+	}
+	return utils.WriteFile(f, "cmdTools", "operationRoutes.tmpl", map[string]interface{}{
+		"packageName": sdkPackageName,
+		"routes":      specRoutes(ctx, docModel),
+	})
+}
+
+type bodyPaginatedRoute struct {
+	Path  string
+	Items string
+}
+
+// generateBodyPaginatedRoutes writes the list operations paginated in the
+// body for the SDK to tell their pagination style from Link headers.
+func generateBodyPaginatedRoutes(ctx context.Context, docModel *libopenapi.DocumentModel[v3high.Document]) error {
+	routes := make([]bodyPaginatedRoute, 0)
+	for pair := range orderedmap.Iterate(ctx, docModel.Model.Paths.PathItems) {
+		if ops := pair.Value().Get; ops != nil {
+			if items, ok := bodyPaginationItems(ops); ok {
+				routes = append(routes, bodyPaginatedRoute{Path: pair.Key(), Items: items})
+			}
+		}
+	}
+	f, err := os.Create(fmt.Sprintf("%v/pagination_routes.go", sdkPackageName))
+	if err != nil {
+		return err
+	}
+	return utils.WriteFile(f, "cmdTools", "paginationRoutes.tmpl", map[string]interface{}{
+		"packageName": sdkPackageName,
+		"routes":      routes,
+	})
+}
+
+// destructivePrefixes start the operationIds of the operations that, like
+// DELETE ones, take away what they act on.
+var destructivePrefixes = []string{"deactivate", "revoke", "reset", "expire", "rotate", "unassign"}
+
+// isDestructive reports whether the command of the operation asks for a
+// confirmation before running.
+func isDestructive(ops *v3high.Operation, httpMethod string) bool {
+	if httpMethod == http.MethodDelete {
+		return true
+	}
+	for _, prefix := range destructivePrefixes {
+		if strings.HasPrefix(ops.OperationId, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// destructiveTarget returns the path of the object a destructive operation
+// acts on, the endpoint up to its last path parameter: the application of
+// /api/v1/apps/{appId}/lifecycle/deactivate.
+func destructiveTarget(endpoint string) string {
+	if i := strings.LastIndex(endpoint, "}"); i >= 0 {
+		return endpoint[:i+1]
+	}
+	return endpoint
+}
+
 func checkRequestBodyExist(ops *v3high.Operation) bool {
 	return ops.RequestBody != nil
 }
+
+// isListOperation reports whether the operation answers a JSON array or
+// items paginated in the body, whose pages the command can follow with --all.
+func isListOperation(ops *v3high.Operation) bool {
+	schema := responseSchema(ops)
+	if schema != nil && slices.Contains(schema.Type, "array") {
+		return true
+	}
+	_, ok := bodyPaginationItems(ops)
+	return ok
+}
+
+// bodyPaginationItems returns the property holding the items of an
+// operation paginated in the body, whose response links the next page in
+// _links.next instead of a Link header.
+func bodyPaginationItems(ops *v3high.Operation) (string, bool) {
+	schema := responseSchema(ops)
+	if schema == nil || schema.Properties == nil {
+		return "", false
+	}
+	links := schema.Properties.GetOrZero("_links")
+	if links == nil || !hasProperty(links.Schema(), "next") {
+		return "", false
+	}
+	items := make([]string, 0)
+	for pair := schema.Properties.First(); pair != nil; pair = pair.Next() {
+		if property := pair.Value().Schema(); property != nil && slices.Contains(property.Type, "array") {
+			items = append(items, pair.Key())
+		}
+	}
+	if len(items) != 1 {
+		return "", false
+	}
+	return items[0], true
+}
+
+// hasProperty reports whether schema, or one of the schemas it combines
+// with allOf, declares the property name.
+func hasProperty(schema *base.Schema, name string) bool {
+	if schema == nil {
+		return false
+	}
+	if schema.Properties != nil && schema.Properties.GetOrZero(name) != nil {
+		return true
+	}
+	for _, proxy := range schema.AllOf {
+		if hasProperty(proxy.Schema(), name) {
+			return true
+		}
+	}
+	return false
+}
+
+// responseSchema returns the schema of the JSON body of the operation's 200
+// response.
+func responseSchema(ops *v3high.Operation) *base.Schema {
+	if ops.Responses == nil || ops.Responses.Codes == nil {
+		return nil
+	}
+	response := ops.Responses.Codes.GetOrZero("200")
+	if response == nil || response.Content == nil {
+		return nil
+	}
+	mediaType := response.Content.GetOrZero("application/json")
+	if mediaType == nil || mediaType.Schema == nil {
+		return nil
+	}
+	return mediaType.Schema.Schema()
+}
+
+// getOAuth2Scopes returns the oauth2 scopes the operation's security requirement lists.
+func getOAuth2Scopes(ops *v3high.Operation) []string {
+	for _, requirement := range ops.Security {
+		if requirement.Requirements == nil {
+			continue
+		}
+		if scopes := requirement.Requirements.GetOrZero("oauth2"); len(scopes) > 0 {
+			return scopes
+		}
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"net/http"
+	"testing"
+
+	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsDestructive(t *testing.T) {
+	for name, test := range map[string]struct {
+		method      string
+		operationID string
+		destructive bool
+	}{
+		"delete":     {method: http.MethodDelete, operationID: "deleteGroup", destructive: true},
+		"deactivate": {method: http.MethodPost, operationID: "deactivateApplication", destructive: true},
+		"revoke":     {method: http.MethodPost, operationID: "revokeUserSessions", destructive: true},
+		"reset":      {method: http.MethodPost, operationID: "resetFactors", destructive: true},
+		"expire":     {method: http.MethodPost, operationID: "expirePassword", destructive: true},
+		"rotate":     {method: http.MethodPost, operationID: "rotateApplicationKey", destructive: true},
+		"unassign":   {method: http.MethodPost, operationID: "unassignRoleFromUser", destructive: true},
+		"activate":   {method: http.MethodPost, operationID: "activateApplication"},
+		"assign":     {method: http.MethodPut, operationID: "assignUserToGroup"},
+		"replace":    {method: http.MethodPut, operationID: "replaceGroup"},
+		"list":       {method: http.MethodGet, operationID: "listGroups"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ops := &v3high.Operation{OperationId: test.operationID}
+			require.Equal(t, test.destructive, isDestructive(ops, test.method))
+		})
+	}
+}
+
+func TestDestructiveTarget(t *testing.T) {
+	for endpoint, target := range map[string]string{
+		"/api/v1/groups/{groupId}":                       "/api/v1/groups/{groupId}",
+		"/api/v1/apps/{appId}/lifecycle/deactivate":      "/api/v1/apps/{appId}",
+		"/api/v1/groups/{groupId}/users/{userId}":        "/api/v1/groups/{groupId}/users/{userId}",
+		"/api/v1/users/{userId}/lifecycle/reset_factors": "/api/v1/users/{userId}",
+		"/api/v1/api-tokens/current":                     "/api/v1/api-tokens/current",
+	} {
+		require.Equal(t, target, destructiveTarget(endpoint), endpoint)
+	}
+}
@@ -0,0 +1,220 @@
+package mockorg
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// parseFilter compiles the subset of Okta's filter and search expressions
+// the mock org supports: eq, ne, sw, co, gt, ge, lt, le and pr comparisons
+// of dotted attributes, combined with and, or and parentheses, like
+//
+//	status eq "ACTIVE" and (profile.lastName sw "Sm" or profile.department pr)
+func parseFilter(expr string) (func(map[string]interface{}) bool, error) {
+	tokens, err := tokenize(expr)
+	if err != nil {
+		return nil, err
+	}
+	p := &filterParser{tokens: tokens}
+	match, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("unexpected %q", p.tokens[p.pos].text)
+	}
+	return match, nil
+}
+
+type filterToken struct {
+	text   string
+	quoted bool
+}
+
+func tokenize(expr string) ([]filterToken, error) {
+	var tokens []filterToken
+	for i := 0; i < len(expr); {
+		switch c := expr[i]; {
+		case c == ' ' || c == '\t':
+			i++
+		case c == '(' || c == ')':
+			tokens = append(tokens, filterToken{text: string(c)})
+			i++
+		case c == '"':
+			var b strings.Builder
+			i++
+			for ; i < len(expr) && expr[i] != '"'; i++ {
+				if expr[i] == '\\' && i+1 < len(expr) {
+					i++
+				}
+				b.WriteByte(expr[i])
+			}
+			if i == len(expr) {
+				return nil, fmt.Errorf("unterminated string in %q", expr)
+			}
+			tokens = append(tokens, filterToken{text: b.String(), quoted: true})
+			i++
+		default:
+			start := i
+			for i < len(expr) && !strings.ContainsRune(" \t()\"", rune(expr[i])) {
+				i++
+			}
+			tokens = append(tokens, filterToken{text: expr[start:i]})
+		}
+	}
+	return tokens, nil
+}
+
+type filterParser struct {
+	tokens []filterToken
+	pos    int
+}
+
+func (p *filterParser) keyword(word string) bool {
+	if p.pos < len(p.tokens) && !p.tokens[p.pos].quoted && strings.EqualFold(p.tokens[p.pos].text, word) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *filterParser) or() (func(map[string]interface{}) bool, error) {
+	left, err := p.and()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword("or") {
+		right, err := p.and()
+		if err != nil {
+			return nil, err
+		}
+		l := left
+		left = func(item map[string]interface{}) bool { return l(item) || right(item) }
+	}
+	return left, nil
+}
+
+func (p *filterParser) and() (func(map[string]interface{}) bool, error) {
+	left, err := p.comparison()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword("and") {
+		right, err := p.comparison()
+		if err != nil {
+			return nil, err
+		}
+		l := left
+		left = func(item map[string]interface{}) bool { return l(item) && right(item) }
+	}
+	return left, nil
+}
+
+func (p *filterParser) comparison() (func(map[string]interface{}) bool, error) {
+	if p.keyword("(") {
+		match, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		if !p.keyword(")") {
+			return nil, fmt.Errorf("missing )")
+		}
+		return match, nil
+	}
+	if p.pos+1 >= len(p.tokens) {
+		return nil, fmt.Errorf("incomplete expression")
+	}
+	attribute, operator := p.tokens[p.pos].text, strings.ToLower(p.tokens[p.pos+1].text)
+	p.pos += 2
+	if operator == "pr" {
+		return func(item map[string]interface{}) bool { return lookup(item, attribute) != nil }, nil
+	}
+	if p.pos >= len(p.tokens) {
+		return nil, fmt.Errorf("missing value after %s %s", attribute, operator)
+	}
+	value := p.tokens[p.pos]
+	p.pos++
+	var expected interface{} = value.text
+	if !value.quoted {
+		switch v := strings.ToLower(value.text); {
+		case v == "true" || v == "false":
+			expected = v == "true"
+		case v == "null":
+			expected = nil
+		default:
+			n, err := strconv.ParseFloat(value.text, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value %q", value.text)
+			}
+			expected = n
+		}
+	}
+	compare, ok := operators[operator]
+	if !ok {
+		return nil, fmt.Errorf("unsupported operator %q", operator)
+	}
+	return func(item map[string]interface{}) bool { return compare(lookup(item, attribute), expected) }, nil
+}
+
+var operators = map[string]func(actual, expected interface{}) bool{
+	"eq": func(actual, expected interface{}) bool { return order(actual, expected) == 0 },
+	"ne": func(actual, expected interface{}) bool { return order(actual, expected) != 0 },
+	"gt": func(actual, expected interface{}) bool { return actual != nil && order(actual, expected) > 0 },
+	"ge": func(actual, expected interface{}) bool { return actual != nil && order(actual, expected) >= 0 },
+	"lt": func(actual, expected interface{}) bool { return actual != nil && order(actual, expected) < 0 },
+	"le": func(actual, expected interface{}) bool { return actual != nil && order(actual, expected) <= 0 },
+	"sw": func(actual, expected interface{}) bool {
+		a, ok := actual.(string)
+		e, _ := expected.(string)
+		return ok && strings.HasPrefix(strings.ToLower(a), strings.ToLower(e))
+	},
+	"co": func(actual, expected interface{}) bool {
+		a, ok := actual.(string)
+		e, _ := expected.(string)
+		return ok && strings.Contains(strings.ToLower(a), strings.ToLower(e))
+	},
+}
+
+// order compares attribute values, strings case insensitively, which also
+// orders ISO 8601 timestamps.
+func order(actual, expected interface{}) int {
+	switch e := expected.(type) {
+	case string:
+		a, _ := actual.(string)
+		return strings.Compare(strings.ToLower(a), strings.ToLower(e))
+	case float64:
+		a, ok := actual.(float64)
+		switch {
+		case !ok || a < e:
+			return -1
+		case a > e:
+			return 1
+		}
+		return 0
+	case bool:
+		if a, ok := actual.(bool); ok && a == e {
+			return 0
+		}
+		return 1
+	case nil:
+		if actual == nil {
+			return 0
+		}
+		return 1
+	}
+	return 1
+}
+
+// lookup returns the value of a dotted attribute like profile.login.
+func lookup(item map[string]interface{}, attribute string) interface{} {
+	var value interface{} = item
+	for _, key := range strings.Split(attribute, ".") {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = object[key]
+	}
+	return value
+}
@@ -0,0 +1,284 @@
+// Package mockorg serves an in-memory imitation of an Okta org for hermetic
+// tests of the SDK and the CLI.
+//
+// The users, groups, group rules, apps, group memberships, policies, network
+// zones, trusted origins, authorization servers with their scopes, claims,
+// policies and rules, and system log of the org are kept in memory,
+// /api/v1/users/me answers the super administrator owning the API token. Lists are paginated with Link
+// headers and support the filter, search and q parameters. Other operations of the
+// Management API answer 501 Not Implemented, unknown paths 404 Not Found.
+package mockorg
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultToken is the API token accepted when none is configured.
+const DefaultToken = "mock-api-token"
+
+// AdminID and AdminLogin identify the super administrator owning the API
+// token, answered for /api/v1/users/me. It is not one of the users of the
+// org.
+const (
+	AdminID    = "00umockadmin"
+	AdminLogin = "admin@example.org"
+)
+
+// DefaultPageSize is the page size of lists without a limit parameter.
+const DefaultPageSize = 200
+
+type route struct {
+	method      string
+	path        string
+	operationID string
+}
+
+// Server is an http.Handler imitating an Okta org. It is safe for
+// concurrent use.
+type Server struct {
+	mu         sync.Mutex
+	token      string
+	tokens     map[string]bool
+	pageSize   int
+	rateLimit  int
+	rateWindow time.Duration
+	buckets    map[string]*rateBucket
+	now        func() time.Time
+	sequence   int
+
+	users      *collection
+	groups     *collection
+	apps       *collection
+	policies   *collection
+	groupRules *collection
+	// objects holds the network zones, trusted origins and authorization
+	// servers, and the scopes, claims, policies and policy rules of the
+	// latter, by the path of their collection.
+	objects map[string]*collection
+	members map[string]map[string]bool
+	logs    []map[string]interface{}
+}
+
+type rateBucket struct {
+	remaining int
+	reset     time.Time
+}
+
+// Option configures a Server.
+type Option func(s *Server)
+
+// WithToken sets the API token accepted in SSWS authorization headers.
+func WithToken(token string) Option {
+	return func(s *Server) {
+		s.token = token
+	}
+}
+
+// WithPageSize sets the page size of lists without a limit parameter.
+func WithPageSize(size int) Option {
+	return func(s *Server) {
+		s.pageSize = size
+	}
+}
+
+// WithRateLimit answers 429 Too Many Requests once an endpoint received
+// limit requests within window, and sets the X-Rate-Limit headers.
+func WithRateLimit(limit int, window time.Duration) Option {
+	return func(s *Server) {
+		s.rateLimit = limit
+		s.rateWindow = window
+	}
+}
+
+// New returns an empty org.
+func New(options ...Option) *Server {
+	s := &Server{
+		token:      DefaultToken,
+		tokens:     map[string]bool{},
+		pageSize:   DefaultPageSize,
+		buckets:    map[string]*rateBucket{},
+		now:        time.Now,
+		users:      newCollection("00u"),
+		groups:     newCollection("00g"),
+		apps:       newCollection("0oa"),
+		policies:   newCollection("00p"),
+		groupRules: newCollection("0pr"),
+		objects:    map[string]*collection{},
+		members:    map[string]map[string]bool{},
+	}
+	for _, option := range options {
+		option(s)
+	}
+	return s
+}
+
+// Token returns the API token accepted by the org.
+func (s *Server) Token() string {
+	return s.token
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("X-Okta-Request-Id", uuid.New().String())
+	if r.Method == http.MethodPost && r.URL.Path == "/oauth2/v1/token" {
+		s.issueToken(w, r)
+		return
+	}
+
+	h, params, pattern := findHandler(r.Method, r.URL.Path)
+	if pattern == "" {
+		if spec, exists := findRoute(r.Method, r.URL.Path); spec != nil {
+			pattern = spec.path
+		} else if exists {
+			writeError(w, http.StatusMethodNotAllowed, "E0000022", "The endpoint does not support the provided HTTP method")
+			return
+		} else {
+			writeError(w, http.StatusNotFound, "E0000022", "The endpoint does not exist.")
+			return
+		}
+	}
+	if !s.authorized(r) {
+		writeError(w, http.StatusUnauthorized, "E0000011", "Invalid token provided")
+		return
+	}
+	if !s.allow(w, r.Method+" "+pattern) {
+		writeError(w, http.StatusTooManyRequests, "E0000047", "API call exceeded rate limit due to too many requests.")
+		return
+	}
+	if h == nil {
+		writeError(w, http.StatusNotImplemented, "E0000060", fmt.Sprintf("Unsupported operation: the mock org does not implement %s %s.", r.Method, pattern))
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	h(s, w, r, params)
+}
+
+// authorized checks the SSWS API token or a Bearer access token issued by
+// the token endpoint.
+func (s *Server) authorized(r *http.Request) bool {
+	scheme, credential, _ := strings.Cut(r.Header.Get("Authorization"), " ")
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch scheme {
+	case "SSWS":
+		return credential == s.token
+	case "Bearer", "DPoP":
+		return s.tokens[credential]
+	}
+	return false
+}
+
+// issueToken answers client credentials token requests with an opaque
+// access token, the client assertion is not verified.
+func (s *Server) issueToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil || r.PostForm.Get("grant_type") != "client_credentials" {
+		writeJSON(w, http.StatusBadRequest, map[string]interface{}{
+			"error":             "unsupported_grant_type",
+			"error_description": "The authorization grant type is not supported by the authorization server.",
+		})
+		return
+	}
+	token := "mock-access-token-" + uuid.New().String()
+	s.mu.Lock()
+	s.tokens[token] = true
+	s.mu.Unlock()
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+		"access_token": token,
+		"scope":        r.PostForm.Get("scope"),
+	})
+}
+
+// allow accounts a request against the rate limit of bucket.
+func (s *Server) allow(w http.ResponseWriter, bucket string) bool {
+	if s.rateLimit <= 0 {
+		return true
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	b, ok := s.buckets[bucket]
+	if !ok || !now.Before(b.reset) {
+		b = &rateBucket{remaining: s.rateLimit, reset: now.Add(s.rateWindow)}
+		s.buckets[bucket] = b
+	}
+	allowed := b.remaining > 0
+	if allowed {
+		b.remaining--
+	}
+	w.Header().Set("Date", now.UTC().Format(http.TimeFormat))
+	w.Header().Set("X-Rate-Limit-Limit", strconv.Itoa(s.rateLimit))
+	w.Header().Set("X-Rate-Limit-Remaining", strconv.Itoa(b.remaining))
+	w.Header().Set("X-Rate-Limit-Reset", strconv.FormatInt(b.reset.Unix(), 10))
+	return allowed
+}
+
+// findRoute returns the operation of the spec matching method and path, and
+// whether the path exists for another method.
+func findRoute(method, path string) (*route, bool) {
+	exists := false
+	for i := range routes {
+		if _, ok := matchPath(routes[i].path, path); ok {
+			if routes[i].method == method {
+				return &routes[i], true
+			}
+			exists = true
+		}
+	}
+	return nil, exists
+}
+
+// matchPath returns the parameters of path for pattern, like
+// /api/v1/users/{userId}.
+func matchPath(pattern, path string) (map[string]string, bool) {
+	patternSegments := strings.Split(strings.Trim(pattern, "/"), "/")
+	pathSegments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(patternSegments) != len(pathSegments) {
+		return nil, false
+	}
+	params := map[string]string{}
+	for i, segment := range patternSegments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			if pathSegments[i] == "" {
+				return nil, false
+			}
+			params[strings.Trim(segment, "{}")] = pathSegments[i]
+		} else if segment != pathSegments[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if v != nil {
+		json.NewEncoder(w).Encode(v)
+	}
+}
+
+// writeError answers with an Okta error object.
+func writeError(w http.ResponseWriter, status int, code, summary string, causes ...string) {
+	errorCauses := make([]map[string]string, 0, len(causes))
+	for _, cause := range causes {
+		errorCauses = append(errorCauses, map[string]string{"errorSummary": cause})
+	}
+	writeJSON(w, status, map[string]interface{}{
+		"errorCode":    code,
+		"errorSummary": summary,
+		"errorLink":    code,
+		"errorId":      "oae" + strings.ReplaceAll(uuid.New().String(), "-", "")[:19],
+		"errorCauses":  errorCauses,
+	})
+}
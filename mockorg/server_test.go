@@ -0,0 +1,347 @@
+package mockorg
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/okta/okta-cli-client/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClient(t *testing.T, org *Server, setters ...sdk.ConfigSetter) (*sdk.APIClient, string) {
+	server := httptest.NewServer(org)
+	t.Cleanup(server.Close)
+	client, err := sdk.NewTestClient(server.URL, org.Token(), setters...)
+	require.NoError(t, err)
+	return client, server.URL
+}
+
+func decode(t *testing.T, resp *sdk.APIResponse, v interface{}) {
+	t.Helper()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(body, v))
+}
+
+func TestUsersAndGroups(t *testing.T) {
+	client, _ := newTestClient(t, New())
+	ctx := context.Background()
+
+	resp, err := client.UserAPI.CreateUser(ctx).Data(map[string]interface{}{
+		"profile": map[string]interface{}{"login": "jane@example.com", "firstName": "Jane", "lastName": "Doe"},
+	}).Execute()
+	require.NoError(t, err)
+	var user map[string]interface{}
+	decode(t, resp, &user)
+	require.Equal(t, "ACTIVE", user["status"])
+	userID := user["id"].(string)
+
+	_, err = client.UserAPI.CreateUser(ctx).Data(map[string]interface{}{
+		"profile": map[string]interface{}{"login": "jane@example.com"},
+	}).Execute()
+	require.Error(t, err, "logins are unique")
+
+	resp, err = client.UserAPI.GetUser(ctx, "jane@example.com").Execute()
+	require.NoError(t, err)
+	decode(t, resp, &user)
+	require.Equal(t, userID, user["id"])
+
+	resp, err = client.GroupAPI.CreateGroup(ctx).Data(map[string]interface{}{
+		"profile": map[string]interface{}{"name": "Engineering"},
+	}).Execute()
+	require.NoError(t, err)
+	var group map[string]interface{}
+	decode(t, resp, &group)
+	groupID := group["id"].(string)
+
+	resp, err = client.GroupAPI.AssignUserToGroup(ctx, groupID, userID).Execute()
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+	resp, err = client.GroupAPI.ListGroupUsers(ctx, groupID).Execute()
+	require.NoError(t, err)
+	var members []map[string]interface{}
+	decode(t, resp, &members)
+	require.Len(t, members, 1)
+	require.Equal(t, userID, members[0]["id"])
+
+	// deleting an active user deactivates it, the second call deletes it
+	_, err = client.UserAPI.DeleteUser(ctx, userID).Execute()
+	require.NoError(t, err)
+	resp, err = client.UserAPI.GetUser(ctx, userID).Execute()
+	require.NoError(t, err)
+	decode(t, resp, &user)
+	require.Equal(t, "DEPROVISIONED", user["status"])
+	_, err = client.UserAPI.DeleteUser(ctx, userID).Execute()
+	require.NoError(t, err)
+	resp, err = client.UserAPI.GetUser(ctx, userID).Execute()
+	require.Error(t, err)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	resp, err = client.SystemLogAPI.ListLogEvents(ctx).Filter(`eventType sw "group.user_membership"`).Execute()
+	require.NoError(t, err)
+	var events []map[string]interface{}
+	decode(t, resp, &events)
+	require.Len(t, events, 1)
+	require.Equal(t, "group.user_membership.add", events[0]["eventType"])
+}
+
+func TestMe(t *testing.T) {
+	client, _ := newTestClient(t, New())
+	ctx := context.Background()
+
+	resp, err := client.UserAPI.GetUser(ctx, "me").Execute()
+	require.NoError(t, err)
+	var me map[string]interface{}
+	decode(t, resp, &me)
+	require.Equal(t, AdminID, me["id"])
+	require.Equal(t, AdminLogin, me["profile"].(map[string]interface{})["login"])
+
+	resp, err = client.RoleAssignmentAPI.ListAssignedRolesForUser(ctx, AdminID).Execute()
+	require.NoError(t, err)
+	var roles []map[string]interface{}
+	decode(t, resp, &roles)
+	require.Len(t, roles, 1)
+	require.Equal(t, "SUPER_ADMIN", roles[0]["type"])
+
+	resp, err = client.UserAPI.ListUsers(ctx).Execute()
+	require.NoError(t, err)
+	var users []map[string]interface{}
+	decode(t, resp, &users)
+	require.Empty(t, users, "the admin is not one of the users")
+}
+
+func TestGroupRules(t *testing.T) {
+	client, _ := newTestClient(t, New())
+	ctx := context.Background()
+	rule := map[string]interface{}{
+		"name": "Engineers",
+		"type": "group_rule",
+		"actions": map[string]interface{}{
+			"assignUserToGroups": map[string]interface{}{"groupIds": []interface{}{"00g1"}},
+		},
+	}
+
+	resp, err := client.GroupAPI.CreateGroupRule(ctx).Data(rule).Execute()
+	require.NoError(t, err)
+	var created map[string]interface{}
+	decode(t, resp, &created)
+	require.Equal(t, "INACTIVE", created["status"], "rules are created inactive")
+	ruleID := created["id"].(string)
+
+	_, err = client.GroupAPI.ActivateGroupRule(ctx, ruleID).Execute()
+	require.NoError(t, err)
+	_, err = client.GroupAPI.ReplaceGroupRule(ctx, ruleID).Data(rule).Execute()
+	require.Error(t, err, "active rules cannot be replaced")
+	_, err = client.GroupAPI.DeleteGroupRule(ctx, ruleID).Execute()
+	require.Error(t, err, "active rules cannot be deleted")
+
+	_, err = client.GroupAPI.DeactivateGroupRule(ctx, ruleID).Execute()
+	require.NoError(t, err)
+	rule["name"] = "Engineering members"
+	_, err = client.GroupAPI.ReplaceGroupRule(ctx, ruleID).Data(rule).Execute()
+	require.NoError(t, err)
+	resp, err = client.GroupAPI.ListGroupRules(ctx).Execute()
+	require.NoError(t, err)
+	var rules []map[string]interface{}
+	decode(t, resp, &rules)
+	require.Len(t, rules, 1)
+	require.Equal(t, "Engineering members", rules[0]["name"])
+	require.Equal(t, ruleID, rules[0]["id"])
+	for keyword, count := range map[string]int{"members": 1, "Sales": 0} {
+		resp, err = client.GroupAPI.ListGroupRules(ctx).Search(keyword).Execute()
+		require.NoError(t, err)
+		decode(t, resp, &rules)
+		require.Len(t, rules, count, keyword)
+	}
+
+	_, err = client.GroupAPI.DeleteGroupRule(ctx, ruleID).Execute()
+	require.NoError(t, err)
+	resp, err = client.GroupAPI.ListGroups(ctx).Execute()
+	require.NoError(t, err)
+	var groups []map[string]interface{}
+	decode(t, resp, &groups)
+	require.Empty(t, groups, "rules are not groups")
+}
+
+func TestNetworkZones(t *testing.T) {
+	client, _ := newTestClient(t, New())
+	ctx := context.Background()
+
+	resp, err := client.NetworkZoneAPI.CreateNetworkZone(ctx).Data(map[string]interface{}{
+		"type": "IP", "name": "Office", "gateways": []interface{}{map[string]interface{}{"type": "CIDR", "value": "10.0.0.0/8"}},
+	}).Execute()
+	require.NoError(t, err)
+	var zone map[string]interface{}
+	decode(t, resp, &zone)
+	require.Equal(t, "ACTIVE", zone["status"])
+	zoneID := zone["id"].(string)
+
+	_, err = client.NetworkZoneAPI.DeleteNetworkZone(ctx, zoneID).Execute()
+	require.Error(t, err, "active zones cannot be deleted")
+	_, err = client.NetworkZoneAPI.DeactivateNetworkZone(ctx, zoneID).Execute()
+	require.NoError(t, err)
+	_, err = client.NetworkZoneAPI.DeleteNetworkZone(ctx, zoneID).Execute()
+	require.NoError(t, err)
+	resp, err = client.NetworkZoneAPI.ListNetworkZones(ctx).Execute()
+	require.NoError(t, err)
+	var zones []map[string]interface{}
+	decode(t, resp, &zones)
+	require.Empty(t, zones)
+}
+
+func TestAuthorizationServers(t *testing.T) {
+	client, _ := newTestClient(t, New())
+	ctx := context.Background()
+
+	resp, err := client.AuthorizationServerAPI.CreateAuthorizationServer(ctx).Data(map[string]interface{}{
+		"name": "api", "audiences": []interface{}{"api://default"},
+	}).Execute()
+	require.NoError(t, err)
+	var server map[string]interface{}
+	decode(t, resp, &server)
+	serverID := server["id"].(string)
+
+	for _, name := range []string{"orders.read", "orders.write"} {
+		_, err = client.AuthorizationServerScopesAPI.CreateOAuth2Scope(ctx, serverID).Data(map[string]interface{}{"name": name}).Execute()
+		require.NoError(t, err)
+	}
+	resp, err = client.AuthorizationServerScopesAPI.ListOAuth2Scopes(ctx, serverID).Q("orders.w").Execute()
+	require.NoError(t, err)
+	var scopes []map[string]interface{}
+	decode(t, resp, &scopes)
+	require.Len(t, scopes, 1)
+	require.Equal(t, "orders.write", scopes[0]["name"])
+
+	resp, err = client.AuthorizationServerPoliciesAPI.CreateAuthorizationServerPolicy(ctx, serverID).Data(map[string]interface{}{
+		"type": "OAUTH_AUTHORIZATION_POLICY", "name": "Default",
+	}).Execute()
+	require.NoError(t, err)
+	var policy map[string]interface{}
+	decode(t, resp, &policy)
+	policyID := policy["id"].(string)
+	_, err = client.AuthorizationServerRulesAPI.CreateAuthorizationServerPolicyRule(ctx, serverID, policyID).Data(map[string]interface{}{
+		"type": "RESOURCE_ACCESS", "name": "Everyone",
+	}).Execute()
+	require.NoError(t, err)
+	resp, err = client.AuthorizationServerRulesAPI.ListAuthorizationServerPolicyRules(ctx, serverID, policyID).Execute()
+	require.NoError(t, err)
+	var rules []map[string]interface{}
+	decode(t, resp, &rules)
+	require.Len(t, rules, 1)
+	require.Equal(t, "Everyone", rules[0]["name"])
+
+	_, err = client.AuthorizationServerScopesAPI.CreateOAuth2Scope(ctx, "ausmissing").Data(map[string]interface{}{"name": "orders.read"}).Execute()
+	require.Error(t, err, "the scopes belong to an authorization server")
+
+	// deleting the server deletes the objects nested in it
+	_, err = client.AuthorizationServerAPI.DeleteAuthorizationServer(ctx, serverID).Execute()
+	require.NoError(t, err)
+	resp, err = client.AuthorizationServerScopesAPI.ListOAuth2Scopes(ctx, serverID).Execute()
+	require.Error(t, err)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestPaginationAndFilters(t *testing.T) {
+	org := New()
+	org.Seed(5, 2)
+	client, _ := newTestClient(t, org)
+	ctx := context.Background()
+
+	resp, err := client.UserAPI.ListUsers(ctx).Limit(2).Execute()
+	require.NoError(t, err)
+	var logins []string
+	for {
+		var page []map[string]interface{}
+		decode(t, resp, &page)
+		require.LessOrEqual(t, len(page), 2)
+		for _, user := range page {
+			logins = append(logins, user["profile"].(map[string]interface{})["login"].(string))
+		}
+		if !resp.HasNextPage() {
+			break
+		}
+		resp, err = resp.Next(&[]map[string]interface{}{})
+		require.NoError(t, err)
+	}
+	require.Equal(t, []string{"user1@example.com", "user2@example.com", "user3@example.com", "user4@example.com", "user5@example.com"}, logins)
+
+	for expr, count := range map[string]int{
+		`profile.login eq "user3@example.com"`:                                        1,
+		`status eq "ACTIVE" and (profile.lastName sw "1" or profile.lastName eq "5")`: 2,
+		`profile.department pr`:                                                       0,
+	} {
+		resp, err = client.UserAPI.ListUsers(ctx).Search(expr).Execute()
+		require.NoError(t, err, expr)
+		var users []map[string]interface{}
+		decode(t, resp, &users)
+		require.Len(t, users, count, expr)
+	}
+	resp, err = client.UserAPI.ListUsers(ctx).Filter(`status eq`).Execute()
+	require.Error(t, err)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp, err = client.GroupAPI.ListGroups(ctx).Q("group").Execute()
+	require.NoError(t, err)
+	var groups []map[string]interface{}
+	decode(t, resp, &groups)
+	require.Len(t, groups, 2)
+}
+
+func TestAuthAndUnimplementedOperations(t *testing.T) {
+	client, orgURL := newTestClient(t, New(WithToken("secret")), sdk.WithToken("wrong"))
+	resp, err := client.UserAPI.ListUsers(context.Background()).Execute()
+	require.Error(t, err)
+	require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	client, orgURL = newTestClient(t, New())
+	resp, err = client.UserTypeAPI.ListUserTypes(context.Background()).Execute()
+	require.Error(t, err)
+	require.Equal(t, http.StatusNotImplemented, resp.StatusCode)
+
+	r, err := http.Get(orgURL + "/api/v1/unknown")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNotFound, r.StatusCode)
+}
+
+func TestBearerTokens(t *testing.T) {
+	org := New()
+	server := httptest.NewServer(org)
+	defer server.Close()
+
+	r, err := http.PostForm(server.URL+"/oauth2/v1/token", map[string][]string{
+		"grant_type": {"client_credentials"}, "scope": {"okta.users.read"}, "client_assertion": {"ignored"},
+	})
+	require.NoError(t, err)
+	var token struct {
+		AccessToken string `json:"access_token"`
+	}
+	require.NoError(t, json.NewDecoder(r.Body).Decode(&token))
+
+	client, err := sdk.NewTestClient(server.URL, token.AccessToken, sdk.WithAuthorizationMode("Bearer"))
+	require.NoError(t, err)
+	resp, err := client.UserAPI.ListUsers(context.Background()).Execute()
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestRateLimit(t *testing.T) {
+	org := New(WithRateLimit(2, time.Minute))
+	client, _ := newTestClient(t, org, sdk.WithRateLimitMaxRetries(0))
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		resp, err := client.GroupAPI.ListGroups(ctx).Execute()
+		require.NoError(t, err)
+		require.Equal(t, "2", resp.Header.Get("X-Rate-Limit-Limit"))
+	}
+	_, err := client.GroupAPI.ListGroups(ctx).Execute()
+	require.ErrorContains(t, err, "too many requests")
+
+	// buckets are per endpoint
+	_, err = client.UserAPI.ListUsers(ctx).Execute()
+	require.NoError(t, err)
+}
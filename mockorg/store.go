@@ -0,0 +1,1130 @@
+package mockorg
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// timeFormat is the format of the timestamps of Okta resources.
+const timeFormat = "2006-01-02T15:04:05.000Z"
+
+// collection keeps resources in creation order.
+type collection struct {
+	prefix string
+	ids    []string
+	items  map[string]map[string]interface{}
+}
+
+func newCollection(prefix string) *collection {
+	return &collection{prefix: prefix, items: map[string]map[string]interface{}{}}
+}
+
+func (c *collection) add(id string, item map[string]interface{}) {
+	c.ids = append(c.ids, id)
+	c.items[id] = item
+}
+
+func (c *collection) remove(id string) {
+	delete(c.items, id)
+	for i := range c.ids {
+		if c.ids[i] == id {
+			c.ids = append(c.ids[:i], c.ids[i+1:]...)
+			break
+		}
+	}
+}
+
+func (c *collection) list() []map[string]interface{} {
+	items := make([]map[string]interface{}, 0, len(c.ids))
+	for _, id := range c.ids {
+		items = append(items, c.items[id])
+	}
+	return items
+}
+
+type handler func(s *Server, w http.ResponseWriter, r *http.Request, params map[string]string)
+
+// handlers are the operations implemented by the mock org, their paths are
+// the ones of template.yaml.
+var handlers = []struct {
+	method string
+	path   string
+	handle handler
+}{
+	{http.MethodGet, "/api/v1/users", (*Server).listUsers},
+	{http.MethodPost, "/api/v1/users", (*Server).createUser},
+	{http.MethodGet, "/api/v1/users/{userId}", (*Server).getUser},
+	{http.MethodPost, "/api/v1/users/{userId}", (*Server).updateUser},
+	{http.MethodPut, "/api/v1/users/{userId}", (*Server).replaceUser},
+	{http.MethodDelete, "/api/v1/users/{userId}", (*Server).deleteUser},
+	{http.MethodGet, "/api/v1/users/{userId}/groups", (*Server).listUserGroups},
+	{http.MethodGet, "/api/v1/users/{userId}/roles", (*Server).listUserRoles},
+	{http.MethodPost, "/api/v1/users/{userId}/lifecycle/activate", userTransition("ACTIVE", "user.lifecycle.activate", "STAGED", "PROVISIONED", "DEPROVISIONED")},
+	{http.MethodPost, "/api/v1/users/{userId}/lifecycle/reactivate", userTransition("ACTIVE", "user.lifecycle.reactivate", "PROVISIONED")},
+	{http.MethodPost, "/api/v1/users/{userId}/lifecycle/deactivate", userTransition("DEPROVISIONED", "user.lifecycle.deactivate")},
+	{http.MethodPost, "/api/v1/users/{userId}/lifecycle/suspend", userTransition("SUSPENDED", "user.lifecycle.suspend", "ACTIVE")},
+	{http.MethodPost, "/api/v1/users/{userId}/lifecycle/unsuspend", userTransition("ACTIVE", "user.lifecycle.unsuspend", "SUSPENDED")},
+	{http.MethodPost, "/api/v1/users/{userId}/lifecycle/unlock", userTransition("ACTIVE", "user.account.unlock", "LOCKED_OUT")},
+	{http.MethodGet, "/api/v1/groups", (*Server).listGroups},
+	{http.MethodPost, "/api/v1/groups", (*Server).createGroup},
+	// the group rules come first, /api/v1/groups/rules is not a group
+	{http.MethodGet, "/api/v1/groups/rules", (*Server).listGroupRules},
+	{http.MethodPost, "/api/v1/groups/rules", (*Server).createGroupRule},
+	{http.MethodGet, "/api/v1/groups/rules/{groupRuleId}", (*Server).getGroupRule},
+	{http.MethodPut, "/api/v1/groups/rules/{groupRuleId}", (*Server).replaceGroupRule},
+	{http.MethodDelete, "/api/v1/groups/rules/{groupRuleId}", (*Server).deleteGroupRule},
+	{http.MethodPost, "/api/v1/groups/rules/{groupRuleId}/lifecycle/activate", resourceTransition("groupRules", "groupRuleId", "ACTIVE", "group.user_membership.rule.activate")},
+	{http.MethodPost, "/api/v1/groups/rules/{groupRuleId}/lifecycle/deactivate", resourceTransition("groupRules", "groupRuleId", "INACTIVE", "group.user_membership.rule.deactivate")},
+	{http.MethodGet, "/api/v1/groups/{groupId}", (*Server).getGroup},
+	{http.MethodPut, "/api/v1/groups/{groupId}", (*Server).replaceGroup},
+	{http.MethodDelete, "/api/v1/groups/{groupId}", (*Server).deleteGroup},
+	{http.MethodGet, "/api/v1/groups/{groupId}/users", (*Server).listGroupUsers},
+	{http.MethodPut, "/api/v1/groups/{groupId}/users/{userId}", (*Server).assignUserToGroup},
+	{http.MethodDelete, "/api/v1/groups/{groupId}/users/{userId}", (*Server).unassignUserFromGroup},
+	{http.MethodGet, "/api/v1/apps", (*Server).listApps},
+	{http.MethodPost, "/api/v1/apps", (*Server).createApp},
+	{http.MethodGet, "/api/v1/apps/{appId}", (*Server).getApp},
+	{http.MethodPut, "/api/v1/apps/{appId}", (*Server).replaceApp},
+	{http.MethodDelete, "/api/v1/apps/{appId}", (*Server).deleteApp},
+	{http.MethodPost, "/api/v1/apps/{appId}/lifecycle/activate", resourceTransition("apps", "appId", "ACTIVE", "application.lifecycle.activate")},
+	{http.MethodPost, "/api/v1/apps/{appId}/lifecycle/deactivate", resourceTransition("apps", "appId", "INACTIVE", "application.lifecycle.deactivate")},
+	{http.MethodGet, "/api/v1/policies", (*Server).listPolicies},
+	{http.MethodPost, "/api/v1/policies", (*Server).createPolicy},
+	{http.MethodGet, "/api/v1/policies/{policyId}", (*Server).getPolicy},
+	{http.MethodPut, "/api/v1/policies/{policyId}", (*Server).replacePolicy},
+	{http.MethodDelete, "/api/v1/policies/{policyId}", (*Server).deletePolicy},
+	{http.MethodPost, "/api/v1/policies/{policyId}/lifecycle/activate", resourceTransition("policies", "policyId", "ACTIVE", "policy.lifecycle.activate")},
+	{http.MethodPost, "/api/v1/policies/{policyId}/lifecycle/deactivate", resourceTransition("policies", "policyId", "INACTIVE", "policy.lifecycle.deactivate")},
+	{http.MethodGet, "/api/v1/zones", listObjects()},
+	{http.MethodPost, "/api/v1/zones", createObject("nzo", "NetworkZone", "ACTIVE", "zone.create")},
+	{http.MethodGet, "/api/v1/zones/{zoneId}", getObject("NetworkZone")},
+	{http.MethodPut, "/api/v1/zones/{zoneId}", replaceObject("NetworkZone", "zone.update")},
+	{http.MethodDelete, "/api/v1/zones/{zoneId}", deleteObject("NetworkZone", "zone.delete")},
+	{http.MethodPost, "/api/v1/zones/{zoneId}/lifecycle/activate", objectTransition("NetworkZone", "ACTIVE", "zone.activate")},
+	{http.MethodPost, "/api/v1/zones/{zoneId}/lifecycle/deactivate", objectTransition("NetworkZone", "INACTIVE", "zone.deactivate")},
+	{http.MethodGet, "/api/v1/trustedOrigins", listObjects("name", "origin")},
+	{http.MethodPost, "/api/v1/trustedOrigins", createObject("tos", "TrustedOrigin", "ACTIVE", "trusted_origin.create")},
+	{http.MethodGet, "/api/v1/trustedOrigins/{trustedOriginId}", getObject("TrustedOrigin")},
+	{http.MethodPut, "/api/v1/trustedOrigins/{trustedOriginId}", replaceObject("TrustedOrigin", "trusted_origin.update")},
+	{http.MethodDelete, "/api/v1/trustedOrigins/{trustedOriginId}", removeObject("TrustedOrigin", "trusted_origin.delete")},
+	{http.MethodPost, "/api/v1/trustedOrigins/{trustedOriginId}/lifecycle/activate", objectTransition("TrustedOrigin", "ACTIVE", "trusted_origin.activate")},
+	{http.MethodPost, "/api/v1/trustedOrigins/{trustedOriginId}/lifecycle/deactivate", objectTransition("TrustedOrigin", "INACTIVE", "trusted_origin.deactivate")},
+	{http.MethodGet, "/api/v1/authorizationServers", listObjects("name")},
+	{http.MethodPost, "/api/v1/authorizationServers", createObject("aus", "AuthorizationServer", "ACTIVE", "oauth2.as.create")},
+	{http.MethodGet, "/api/v1/authorizationServers/{authServerId}", getObject("AuthorizationServer")},
+	{http.MethodPut, "/api/v1/authorizationServers/{authServerId}", replaceObject("AuthorizationServer", "oauth2.as.update")},
+	{http.MethodDelete, "/api/v1/authorizationServers/{authServerId}", removeObject("AuthorizationServer", "oauth2.as.delete")},
+	{http.MethodPost, "/api/v1/authorizationServers/{authServerId}/lifecycle/activate", objectTransition("AuthorizationServer", "ACTIVE", "oauth2.as.activate")},
+	{http.MethodPost, "/api/v1/authorizationServers/{authServerId}/lifecycle/deactivate", objectTransition("AuthorizationServer", "INACTIVE", "oauth2.as.deactivate")},
+	{http.MethodGet, "/api/v1/authorizationServers/{authServerId}/scopes", listObjects("name")},
+	{http.MethodPost, "/api/v1/authorizationServers/{authServerId}/scopes", createObject("scp", "OAuth2Scope", "", "oauth2.as.scope.create")},
+	{http.MethodGet, "/api/v1/authorizationServers/{authServerId}/scopes/{scopeId}", getObject("OAuth2Scope")},
+	{http.MethodPut, "/api/v1/authorizationServers/{authServerId}/scopes/{scopeId}", replaceObject("OAuth2Scope", "oauth2.as.scope.update")},
+	{http.MethodDelete, "/api/v1/authorizationServers/{authServerId}/scopes/{scopeId}", removeObject("OAuth2Scope", "oauth2.as.scope.delete")},
+	{http.MethodGet, "/api/v1/authorizationServers/{authServerId}/claims", listObjects("name")},
+	{http.MethodPost, "/api/v1/authorizationServers/{authServerId}/claims", createObject("ocl", "OAuth2Claim", "ACTIVE", "oauth2.as.claim.create")},
+	{http.MethodGet, "/api/v1/authorizationServers/{authServerId}/claims/{claimId}", getObject("OAuth2Claim")},
+	{http.MethodPut, "/api/v1/authorizationServers/{authServerId}/claims/{claimId}", replaceObject("OAuth2Claim", "oauth2.as.claim.update")},
+	{http.MethodDelete, "/api/v1/authorizationServers/{authServerId}/claims/{claimId}", removeObject("OAuth2Claim", "oauth2.as.claim.delete")},
+	{http.MethodGet, "/api/v1/authorizationServers/{authServerId}/policies", listObjects("name")},
+	{http.MethodPost, "/api/v1/authorizationServers/{authServerId}/policies", createObject("00p", "AuthorizationServerPolicy", "ACTIVE", "oauth2.as.policy.create")},
+	{http.MethodGet, "/api/v1/authorizationServers/{authServerId}/policies/{policyId}", getObject("AuthorizationServerPolicy")},
+	{http.MethodPut, "/api/v1/authorizationServers/{authServerId}/policies/{policyId}", replaceObject("AuthorizationServerPolicy", "oauth2.as.policy.update")},
+	{http.MethodDelete, "/api/v1/authorizationServers/{authServerId}/policies/{policyId}", removeObject("AuthorizationServerPolicy", "oauth2.as.policy.delete")},
+	{http.MethodPost, "/api/v1/authorizationServers/{authServerId}/policies/{policyId}/lifecycle/activate", objectTransition("AuthorizationServerPolicy", "ACTIVE", "oauth2.as.policy.activate")},
+	{http.MethodPost, "/api/v1/authorizationServers/{authServerId}/policies/{policyId}/lifecycle/deactivate", objectTransition("AuthorizationServerPolicy", "INACTIVE", "oauth2.as.policy.deactivate")},
+	{http.MethodGet, "/api/v1/authorizationServers/{authServerId}/policies/{policyId}/rules", listObjects("name")},
+	{http.MethodPost, "/api/v1/authorizationServers/{authServerId}/policies/{policyId}/rules", createObject("0pr", "AuthorizationServerPolicyRule", "ACTIVE", "oauth2.as.rule.create")},
+	{http.MethodGet, "/api/v1/authorizationServers/{authServerId}/policies/{policyId}/rules/{ruleId}", getObject("AuthorizationServerPolicyRule")},
+	{http.MethodPut, "/api/v1/authorizationServers/{authServerId}/policies/{policyId}/rules/{ruleId}", replaceObject("AuthorizationServerPolicyRule", "oauth2.as.rule.update")},
+	{http.MethodDelete, "/api/v1/authorizationServers/{authServerId}/policies/{policyId}/rules/{ruleId}", removeObject("AuthorizationServerPolicyRule", "oauth2.as.rule.delete")},
+	{http.MethodPost, "/api/v1/authorizationServers/{authServerId}/policies/{policyId}/rules/{ruleId}/lifecycle/activate", objectTransition("AuthorizationServerPolicyRule", "ACTIVE", "oauth2.as.rule.activate")},
+	{http.MethodPost, "/api/v1/authorizationServers/{authServerId}/policies/{policyId}/rules/{ruleId}/lifecycle/deactivate", objectTransition("AuthorizationServerPolicyRule", "INACTIVE", "oauth2.as.rule.deactivate")},
+	{http.MethodGet, "/api/v1/logs", (*Server).listLogs},
+}
+
+// findHandler returns the implemented operation for method and path with
+// the parameters of the path and its pattern.
+func findHandler(method, path string) (handler, map[string]string, string) {
+	for _, h := range handlers {
+		if params, ok := matchPath(h.path, path); ok && h.method == method {
+			return h.handle, params, h.path
+		}
+	}
+	return nil, nil, ""
+}
+
+func (s *Server) timestamp() string {
+	return s.now().UTC().Format(timeFormat)
+}
+
+func (s *Server) newID(c *collection) string {
+	s.sequence++
+	return fmt.Sprintf("%smock%013d", c.prefix, s.sequence)
+}
+
+// readObject decodes the JSON object of the request body, answering 400
+// when it is not one.
+func readObject(w http.ResponseWriter, r *http.Request) (map[string]interface{}, bool) {
+	var body map[string]interface{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body == nil {
+		writeError(w, http.StatusBadRequest, "E0000003", "The request body was not well-formed.")
+		return nil, false
+	}
+	return body, true
+}
+
+func notFound(w http.ResponseWriter, kind, id string) {
+	writeError(w, http.StatusNotFound, "E0000007", fmt.Sprintf("Not found: Resource not found: %s (%s)", id, kind))
+}
+
+func links(r *http.Request, path string) map[string]interface{} {
+	return map[string]interface{}{"self": map[string]string{"href": origin(r) + path}}
+}
+
+func origin(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host
+}
+
+// log appends a system log event about target.
+func (s *Server) log(eventType, message, targetType string, target map[string]interface{}) {
+	displayName := target["id"]
+	if profile, ok := target["profile"].(map[string]interface{}); ok {
+		for _, key := range []string{"login", "name"} {
+			if name, ok := profile[key]; ok {
+				displayName = name
+				break
+			}
+		}
+	} else if label, ok := target["label"]; ok {
+		displayName = label
+	} else if name, ok := target["name"]; ok {
+		displayName = name
+	}
+	s.logs = append(s.logs, map[string]interface{}{
+		"uuid":           uuid.New().String(),
+		"published":      s.timestamp(),
+		"eventType":      eventType,
+		"displayMessage": message,
+		"severity":       "INFO",
+		"actor":          map[string]interface{}{"id": "mock-admin", "type": "User", "alternateId": "admin@example.com"},
+		"outcome":        map[string]interface{}{"result": "SUCCESS"},
+		"target": []interface{}{map[string]interface{}{
+			"id":          target["id"],
+			"type":        targetType,
+			"displayName": displayName,
+		}},
+	})
+}
+
+// writePage answers with the page of items selected by the limit and after
+// parameters and a Link header to the next page.
+func (s *Server) writePage(w http.ResponseWriter, r *http.Request, items []map[string]interface{}, cursor func(map[string]interface{}) string) {
+	query := r.URL.Query()
+	limit := s.pageSize
+	if value := query.Get("limit"); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil || n <= 0 {
+			writeError(w, http.StatusBadRequest, "E0000001", "Api validation failed: limit", "limit: The limit must be a positive number.")
+			return
+		}
+		limit = n
+	}
+	start := 0
+	if after := query.Get("after"); after != "" {
+		start = len(items)
+		for i, item := range items {
+			if cursor(item) == after {
+				start = i + 1
+				break
+			}
+		}
+	}
+	end := start + limit
+	if end > len(items) {
+		end = len(items)
+	}
+	page := items[start:end]
+
+	self := *r.URL
+	w.Header().Add("Link", fmt.Sprintf(`<%s%s>; rel="self"`, origin(r), self.RequestURI()))
+	if end < len(items) {
+		next := *r.URL
+		query.Set("limit", strconv.Itoa(limit))
+		query.Set("after", cursor(page[len(page)-1]))
+		next.RawQuery = query.Encode()
+		w.Header().Add("Link", fmt.Sprintf(`<%s%s>; rel="next"`, origin(r), next.RequestURI()))
+	}
+	writeJSON(w, http.StatusOK, page)
+}
+
+func byID(item map[string]interface{}) string {
+	id, _ := item["id"].(string)
+	return id
+}
+
+// selectItems keeps the items matching the filter and search parameters,
+// and whose q attributes start with the q parameter.
+func selectItems(w http.ResponseWriter, r *http.Request, items []map[string]interface{}, qAttributes ...string) ([]map[string]interface{}, bool) {
+	query := r.URL.Query()
+	for _, param := range []string{"filter", "search"} {
+		if expr := query.Get(param); expr != "" {
+			match, err := parseFilter(expr)
+			if err != nil {
+				writeError(w, http.StatusBadRequest, "E0000031", "Invalid search criteria.", err.Error())
+				return nil, false
+			}
+			items = keep(items, match)
+		}
+	}
+	if q := strings.ToLower(query.Get("q")); q != "" {
+		items = keep(items, func(item map[string]interface{}) bool {
+			for _, attribute := range qAttributes {
+				if value, ok := lookup(item, attribute).(string); ok && strings.HasPrefix(strings.ToLower(value), q) {
+					return true
+				}
+			}
+			return false
+		})
+	}
+	return items, true
+}
+
+func keep(items []map[string]interface{}, match func(map[string]interface{}) bool) []map[string]interface{} {
+	kept := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		if match(item) {
+			kept = append(kept, item)
+		}
+	}
+	return kept
+}
+
+func profileOf(body map[string]interface{}) map[string]interface{} {
+	profile, _ := body["profile"].(map[string]interface{})
+	if profile == nil {
+		profile = map[string]interface{}{}
+	}
+	return profile
+}
+
+// findUser returns the user with the ID, or the login, of userId.
+func (s *Server) findUser(userId string) map[string]interface{} {
+	if user, ok := s.users.items[userId]; ok {
+		return user
+	}
+	for _, user := range s.users.list() {
+		if login, ok := profileOf(user)["login"].(string); ok && strings.EqualFold(login, userId) {
+			return user
+		}
+	}
+	return nil
+}
+
+func (s *Server) listUsers(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	users, ok := selectItems(w, r, s.users.list(), "profile.login", "profile.email", "profile.firstName", "profile.lastName")
+	if !ok {
+		return
+	}
+	if r.URL.Query().Get("filter") == "" && r.URL.Query().Get("search") == "" {
+		// Okta leaves deprovisioned users out unless asked for
+		users = keep(users, func(user map[string]interface{}) bool { return user["status"] != "DEPROVISIONED" })
+	}
+	s.writePage(w, r, users, byID)
+}
+
+func (s *Server) createUser(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	body, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	profile := profileOf(body)
+	login, _ := profile["login"].(string)
+	if login == "" {
+		writeError(w, http.StatusBadRequest, "E0000001", "Api validation failed: login", "login: The field cannot be left blank")
+		return
+	}
+	if s.findUser(login) != nil {
+		writeError(w, http.StatusBadRequest, "E0000001", "Api validation failed: login", "login: An object with this field already exists in the current organization")
+		return
+	}
+	status := "ACTIVE"
+	if activate := r.URL.Query().Get("activate"); activate == "false" {
+		status = "STAGED"
+	}
+	now := s.timestamp()
+	id := s.newID(s.users)
+	user := map[string]interface{}{
+		"id":              id,
+		"status":          status,
+		"created":         now,
+		"activated":       nil,
+		"statusChanged":   nil,
+		"lastLogin":       nil,
+		"lastUpdated":     now,
+		"passwordChanged": nil,
+		"type":            map[string]interface{}{"id": "oty" + id[3:]},
+		"profile":         profile,
+		"credentials":     map[string]interface{}{"provider": map[string]interface{}{"type": "OKTA", "name": "OKTA"}},
+		"_links":          links(r, "/api/v1/users/"+id),
+	}
+	if status == "ACTIVE" {
+		user["activated"] = now
+		user["statusChanged"] = now
+	}
+	if credentials, ok := body["credentials"].(map[string]interface{}); ok && credentials["password"] != nil {
+		user["passwordChanged"] = now
+	}
+	s.users.add(id, user)
+	s.log("user.lifecycle.create", "Create Okta user", "User", user)
+	writeJSON(w, http.StatusOK, user)
+}
+
+func (s *Server) getUser(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if userId := params["userId"]; userId == "me" || userId == AdminID {
+		writeJSON(w, http.StatusOK, admin(r))
+		return
+	}
+	user := s.findUser(params["userId"])
+	if user == nil {
+		notFound(w, "User", params["userId"])
+		return
+	}
+	writeJSON(w, http.StatusOK, user)
+}
+
+// admin returns the super administrator owning the API token.
+func admin(r *http.Request) map[string]interface{} {
+	return map[string]interface{}{
+		"id":      AdminID,
+		"status":  "ACTIVE",
+		"created": "2024-01-01T00:00:00.000Z",
+		"type":    map[string]interface{}{"id": "otymockadmin"},
+		"profile": map[string]interface{}{
+			"login": AdminLogin, "email": AdminLogin, "firstName": "Mock", "lastName": "Admin",
+		},
+		"credentials": map[string]interface{}{"provider": map[string]interface{}{"type": "OKTA", "name": "OKTA"}},
+		"_links":      links(r, "/api/v1/users/"+AdminID),
+	}
+}
+
+// updateUser merges the profile of the request into the user's.
+func (s *Server) updateUser(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.changeUser(w, r, params, true)
+}
+
+func (s *Server) replaceUser(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.changeUser(w, r, params, false)
+}
+
+func (s *Server) changeUser(w http.ResponseWriter, r *http.Request, params map[string]string, merge bool) {
+	user := s.findUser(params["userId"])
+	if user == nil {
+		notFound(w, "User", params["userId"])
+		return
+	}
+	body, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	profile := profileOf(body)
+	if merge {
+		merged := profileOf(user)
+		for key, value := range profile {
+			merged[key] = value
+		}
+		profile = merged
+	}
+	if login, _ := profile["login"].(string); login == "" {
+		writeError(w, http.StatusBadRequest, "E0000001", "Api validation failed: login", "login: The field cannot be left blank")
+		return
+	}
+	user["profile"] = profile
+	user["lastUpdated"] = s.timestamp()
+	s.log("user.account.update_profile", "Update user profile for Okta", "User", user)
+	writeJSON(w, http.StatusOK, user)
+}
+
+// deleteUser deactivates an active user, and deletes a deactivated one,
+// like Okta does.
+func (s *Server) deleteUser(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	user := s.findUser(params["userId"])
+	if user == nil {
+		notFound(w, "User", params["userId"])
+		return
+	}
+	id := byID(user)
+	if user["status"] != "DEPROVISIONED" {
+		s.setStatus(user, "DEPROVISIONED")
+		s.log("user.lifecycle.deactivate", "Deactivate Okta user", "User", user)
+	} else {
+		s.users.remove(id)
+		for _, members := range s.members {
+			delete(members, id)
+		}
+		s.log("user.lifecycle.delete.completed", "Delete Okta user completed", "User", user)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) setStatus(item map[string]interface{}, status string) {
+	now := s.timestamp()
+	item["status"] = status
+	item["lastUpdated"] = now
+	if _, ok := item["statusChanged"]; ok {
+		item["statusChanged"] = now
+	}
+	if _, ok := item["activated"]; ok && status == "ACTIVE" {
+		item["activated"] = now
+	}
+}
+
+// userTransition changes the status of a user to status, from one of the
+// statuses of from when given.
+func userTransition(status, eventType string, from ...string) handler {
+	return func(s *Server, w http.ResponseWriter, r *http.Request, params map[string]string) {
+		user := s.findUser(params["userId"])
+		if user == nil {
+			notFound(w, "User", params["userId"])
+			return
+		}
+		if len(from) > 0 {
+			current, _ := user["status"].(string)
+			allowed := false
+			for _, f := range from {
+				allowed = allowed || f == current
+			}
+			if !allowed {
+				writeError(w, http.StatusForbidden, "E0000038", "This operation is not allowed in the user's current status.")
+				return
+			}
+		}
+		s.setStatus(user, status)
+		s.log(eventType, "Okta user lifecycle transition", "User", user)
+		writeJSON(w, http.StatusOK, map[string]interface{}{})
+	}
+}
+
+func (s *Server) listUserGroups(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	user := s.findUser(params["userId"])
+	if user == nil {
+		notFound(w, "User", params["userId"])
+		return
+	}
+	groups := keep(s.groups.list(), func(group map[string]interface{}) bool {
+		return s.members[byID(group)][byID(user)]
+	})
+	s.writePage(w, r, groups, byID)
+}
+
+// listUserRoles answers the super administrator role of the admin, and no
+// role for the users of the org.
+func (s *Server) listUserRoles(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if userId := params["userId"]; userId == "me" || userId == AdminID {
+		writeJSON(w, http.StatusOK, []map[string]interface{}{{
+			"id": "ramockadmin", "label": "Super Organization Administrator", "type": "SUPER_ADMIN",
+			"status": "ACTIVE", "assignmentType": "USER",
+		}})
+		return
+	}
+	if s.findUser(params["userId"]) == nil {
+		notFound(w, "User", params["userId"])
+		return
+	}
+	writeJSON(w, http.StatusOK, []map[string]interface{}{})
+}
+
+func (s *Server) listGroups(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	groups, ok := selectItems(w, r, s.groups.list(), "profile.name")
+	if !ok {
+		return
+	}
+	s.writePage(w, r, groups, byID)
+}
+
+func (s *Server) createGroup(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	body, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	profile := profileOf(body)
+	if name, _ := profile["name"].(string); name == "" {
+		writeError(w, http.StatusBadRequest, "E0000001", "Api validation failed: name", "name: The field cannot be left blank")
+		return
+	}
+	now := s.timestamp()
+	id := s.newID(s.groups)
+	group := map[string]interface{}{
+		"id":                    id,
+		"created":               now,
+		"lastUpdated":           now,
+		"lastMembershipUpdated": now,
+		"objectClass":           []interface{}{"okta:user_group"},
+		"type":                  "OKTA_GROUP",
+		"profile":               profile,
+		"_links":                links(r, "/api/v1/groups/"+id),
+	}
+	s.groups.add(id, group)
+	s.members[id] = map[string]bool{}
+	s.log("group.lifecycle.create", "Create Okta group", "UserGroup", group)
+	writeJSON(w, http.StatusOK, group)
+}
+
+func (s *Server) getGroup(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	group, ok := s.groups.items[params["groupId"]]
+	if !ok {
+		notFound(w, "UserGroup", params["groupId"])
+		return
+	}
+	writeJSON(w, http.StatusOK, group)
+}
+
+func (s *Server) replaceGroup(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	group, ok := s.groups.items[params["groupId"]]
+	if !ok {
+		notFound(w, "UserGroup", params["groupId"])
+		return
+	}
+	body, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	profile := profileOf(body)
+	if name, _ := profile["name"].(string); name == "" {
+		writeError(w, http.StatusBadRequest, "E0000001", "Api validation failed: name", "name: The field cannot be left blank")
+		return
+	}
+	group["profile"] = profile
+	group["lastUpdated"] = s.timestamp()
+	s.log("group.profile.update", "Update Okta group profile", "UserGroup", group)
+	writeJSON(w, http.StatusOK, group)
+}
+
+func (s *Server) deleteGroup(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	group, ok := s.groups.items[params["groupId"]]
+	if !ok {
+		notFound(w, "UserGroup", params["groupId"])
+		return
+	}
+	s.groups.remove(params["groupId"])
+	delete(s.members, params["groupId"])
+	s.log("group.lifecycle.delete", "Delete Okta group", "UserGroup", group)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listGroupUsers(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if _, ok := s.groups.items[params["groupId"]]; !ok {
+		notFound(w, "UserGroup", params["groupId"])
+		return
+	}
+	users := keep(s.users.list(), func(user map[string]interface{}) bool {
+		return s.members[params["groupId"]][byID(user)]
+	})
+	s.writePage(w, r, users, byID)
+}
+
+func (s *Server) assignUserToGroup(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.changeMembership(w, params, true)
+}
+
+func (s *Server) unassignUserFromGroup(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.changeMembership(w, params, false)
+}
+
+func (s *Server) changeMembership(w http.ResponseWriter, params map[string]string, member bool) {
+	group, ok := s.groups.items[params["groupId"]]
+	if !ok {
+		notFound(w, "UserGroup", params["groupId"])
+		return
+	}
+	user := s.findUser(params["userId"])
+	if user == nil {
+		notFound(w, "User", params["userId"])
+		return
+	}
+	if member {
+		s.members[byID(group)][byID(user)] = true
+		s.log("group.user_membership.add", "Add user to group membership", "User", user)
+	} else {
+		delete(s.members[byID(group)], byID(user))
+		s.log("group.user_membership.remove", "Remove user from group membership", "User", user)
+	}
+	group["lastMembershipUpdated"] = s.timestamp()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// listGroupRules answers the rules whose name holds the search keyword, the
+// search parameter of group rules is not an expression.
+func (s *Server) listGroupRules(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	rules := s.groupRules.list()
+	if keyword := strings.ToLower(r.URL.Query().Get("search")); keyword != "" {
+		rules = keep(rules, func(rule map[string]interface{}) bool {
+			name, _ := rule["name"].(string)
+			return strings.Contains(strings.ToLower(name), keyword)
+		})
+	}
+	s.writePage(w, r, rules, byID)
+}
+
+// createGroupRule creates an inactive group rule, like the org.
+func (s *Server) createGroupRule(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	body, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	if name, _ := body["name"].(string); name == "" {
+		writeError(w, http.StatusBadRequest, "E0000001", "Api validation failed: name", "name: The field cannot be left blank")
+		return
+	}
+	now := s.timestamp()
+	id := s.newID(s.groupRules)
+	body["id"] = id
+	body["created"] = now
+	body["lastUpdated"] = now
+	body["status"] = "INACTIVE"
+	body["type"] = "group_rule"
+	body["_links"] = links(r, "/api/v1/groups/rules/"+id)
+	s.groupRules.add(id, body)
+	s.log("group.user_membership.rule.add", "Add group rule", "GroupRule", body)
+	writeJSON(w, http.StatusOK, body)
+}
+
+func (s *Server) getGroupRule(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.getResource(w, s.groupRules, "GroupRule", params["groupRuleId"])
+}
+
+// replaceGroupRule replaces an inactive group rule, the org refuses to
+// replace active ones.
+func (s *Server) replaceGroupRule(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if rule, ok := s.groupRules.items[params["groupRuleId"]]; ok && rule["status"] == "ACTIVE" {
+		writeError(w, http.StatusBadRequest, "E0000001", "Api validation failed: status", "Only inactive group rules can be updated.")
+		return
+	}
+	s.replaceResource(w, r, s.groupRules, "GroupRule", params["groupRuleId"], "group.user_membership.rule.update")
+}
+
+func (s *Server) deleteGroupRule(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.deleteResource(w, s.groupRules, "GroupRule", params["groupRuleId"], "group.user_membership.rule.delete")
+}
+
+func (s *Server) listApps(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	apps, ok := selectItems(w, r, s.apps.list(), "label", "name")
+	if !ok {
+		return
+	}
+	s.writePage(w, r, apps, byID)
+}
+
+func (s *Server) createApp(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	body, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	if label, _ := body["label"].(string); label == "" {
+		writeError(w, http.StatusBadRequest, "E0000001", "Api validation failed: label", "label: The field cannot be left blank")
+		return
+	}
+	now := s.timestamp()
+	id := s.newID(s.apps)
+	body["id"] = id
+	body["created"] = now
+	body["lastUpdated"] = now
+	body["status"] = "ACTIVE"
+	if r.URL.Query().Get("activate") == "false" {
+		body["status"] = "INACTIVE"
+	}
+	if _, ok := body["name"]; !ok {
+		body["name"] = strings.ToLower(strings.ReplaceAll(body["label"].(string), " ", "_"))
+	}
+	body["_links"] = links(r, "/api/v1/apps/"+id)
+	s.apps.add(id, body)
+	s.log("application.lifecycle.create", "Add application", "AppInstance", body)
+	writeJSON(w, http.StatusOK, body)
+}
+
+func (s *Server) getApp(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.getResource(w, s.apps, "AppInstance", params["appId"])
+}
+
+func (s *Server) replaceApp(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.replaceResource(w, r, s.apps, "AppInstance", params["appId"], "application.lifecycle.update")
+}
+
+func (s *Server) deleteApp(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.deleteResource(w, s.apps, "AppInstance", params["appId"], "application.lifecycle.delete")
+}
+
+func (s *Server) listPolicies(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	policyType := r.URL.Query().Get("type")
+	if policyType == "" {
+		writeError(w, http.StatusBadRequest, "E0000001", "Api validation failed: type", "type: The policy type is required.")
+		return
+	}
+	policies := keep(s.policies.list(), func(policy map[string]interface{}) bool { return policy["type"] == policyType })
+	if status := r.URL.Query().Get("status"); status != "" {
+		policies = keep(policies, func(policy map[string]interface{}) bool { return policy["status"] == status })
+	}
+	policies, ok := selectItems(w, r, policies, "name")
+	if !ok {
+		return
+	}
+	sort.SliceStable(policies, func(i, j int) bool {
+		pi, _ := policies[i]["priority"].(float64)
+		pj, _ := policies[j]["priority"].(float64)
+		return pi < pj
+	})
+	s.writePage(w, r, policies, byID)
+}
+
+func (s *Server) createPolicy(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	body, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	policyType, _ := body["type"].(string)
+	name, _ := body["name"].(string)
+	if policyType == "" || name == "" {
+		writeError(w, http.StatusBadRequest, "E0000001", "Api validation failed: policy", "type and name: The fields cannot be left blank")
+		return
+	}
+	now := s.timestamp()
+	id := s.newID(s.policies)
+	body["id"] = id
+	body["created"] = now
+	body["lastUpdated"] = now
+	body["system"] = false
+	body["status"] = "ACTIVE"
+	if r.URL.Query().Get("activate") == "false" {
+		body["status"] = "INACTIVE"
+	}
+	if _, ok := body["priority"].(float64); !ok {
+		priority := 1
+		for _, policy := range s.policies.list() {
+			if policy["type"] == policyType {
+				priority++
+			}
+		}
+		body["priority"] = float64(priority)
+	}
+	body["_links"] = links(r, "/api/v1/policies/"+id)
+	s.policies.add(id, body)
+	s.log("policy.lifecycle.create", "Create policy", "Policy", body)
+	writeJSON(w, http.StatusOK, body)
+}
+
+func (s *Server) getPolicy(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.getResource(w, s.policies, "Policy", params["policyId"])
+}
+
+func (s *Server) replacePolicy(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.replaceResource(w, r, s.policies, "Policy", params["policyId"], "policy.lifecycle.update")
+}
+
+func (s *Server) deletePolicy(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.deleteResource(w, s.policies, "Policy", params["policyId"], "policy.lifecycle.delete")
+}
+
+// parentExists tells whether the object owning the collection at path
+// exists, answering 404 when it does not. The collections of the org have
+// no parent.
+func (s *Server) parentExists(w http.ResponseWriter, collectionPath string) bool {
+	parent := path.Dir(collectionPath)
+	if parent == "/api/v1" {
+		return true
+	}
+	if c, ok := s.objects[path.Dir(parent)]; ok {
+		if _, ok := c.items[path.Base(parent)]; ok {
+			return true
+		}
+	}
+	notFound(w, path.Base(path.Dir(parent)), path.Base(parent))
+	return false
+}
+
+// objectCollection returns the collection of the object at the path of the
+// request, the path less its last segments when trim is set, like
+// /lifecycle/activate, and the ID of the object.
+func (s *Server) objectCollection(r *http.Request, trim int) (*collection, string) {
+	objectPath := path.Clean(r.URL.Path)
+	for i := 0; i < trim; i++ {
+		objectPath = path.Dir(objectPath)
+	}
+	c, ok := s.objects[path.Dir(objectPath)]
+	if !ok {
+		c = newCollection("")
+	}
+	return c, path.Base(objectPath)
+}
+
+// listObjects lists the zones, trusted origins, authorization servers or
+// the objects nested in the latter, the q parameter matching qAttributes.
+func listObjects(qAttributes ...string) handler {
+	return func(s *Server, w http.ResponseWriter, r *http.Request, params map[string]string) {
+		collectionPath := path.Clean(r.URL.Path)
+		if !s.parentExists(w, collectionPath) {
+			return
+		}
+		var objects []map[string]interface{}
+		if c, ok := s.objects[collectionPath]; ok {
+			objects = c.list()
+		}
+		objects, ok := selectItems(w, r, objects, qAttributes...)
+		if !ok {
+			return
+		}
+		s.writePage(w, r, objects, byID)
+	}
+}
+
+// createObject creates a named object with status, if any, in the
+// collection at the path of the request.
+func createObject(prefix, kind, status, eventType string) handler {
+	return func(s *Server, w http.ResponseWriter, r *http.Request, params map[string]string) {
+		collectionPath := path.Clean(r.URL.Path)
+		if !s.parentExists(w, collectionPath) {
+			return
+		}
+		body, ok := readObject(w, r)
+		if !ok {
+			return
+		}
+		if name, _ := body["name"].(string); name == "" {
+			writeError(w, http.StatusBadRequest, "E0000001", "Api validation failed: name", "name: The field cannot be left blank")
+			return
+		}
+		c, ok := s.objects[collectionPath]
+		if !ok {
+			c = newCollection(prefix)
+			s.objects[collectionPath] = c
+		}
+		now := s.timestamp()
+		id := s.newID(c)
+		body["id"] = id
+		body["created"] = now
+		body["lastUpdated"] = now
+		if status != "" {
+			body["status"] = status
+		}
+		body["_links"] = links(r, collectionPath+"/"+id)
+		c.add(id, body)
+		s.log(eventType, "Create "+strings.ToLower(kind), kind, body)
+		writeJSON(w, http.StatusOK, body)
+	}
+}
+
+func getObject(kind string) handler {
+	return func(s *Server, w http.ResponseWriter, r *http.Request, params map[string]string) {
+		c, id := s.objectCollection(r, 0)
+		s.getResource(w, c, kind, id)
+	}
+}
+
+func replaceObject(kind, eventType string) handler {
+	return func(s *Server, w http.ResponseWriter, r *http.Request, params map[string]string) {
+		c, id := s.objectCollection(r, 0)
+		s.replaceResource(w, r, c, kind, id, eventType)
+	}
+}
+
+// deleteObject deletes an inactive object, like network zones.
+func deleteObject(kind, eventType string) handler {
+	return func(s *Server, w http.ResponseWriter, r *http.Request, params map[string]string) {
+		c, id := s.objectCollection(r, 0)
+		s.deleteResource(w, c, kind, id, eventType)
+	}
+}
+
+// removeObject deletes an object whatever its status, and the objects
+// nested in it.
+func removeObject(kind, eventType string) handler {
+	return func(s *Server, w http.ResponseWriter, r *http.Request, params map[string]string) {
+		c, id := s.objectCollection(r, 0)
+		s.removeResource(w, c, kind, id, eventType)
+		objectPath := path.Clean(r.URL.Path) + "/"
+		for collectionPath := range s.objects {
+			if strings.HasPrefix(collectionPath, objectPath) {
+				delete(s.objects, collectionPath)
+			}
+		}
+	}
+}
+
+// objectTransition changes the status of the object of a lifecycle
+// operation.
+func objectTransition(kind, status, eventType string) handler {
+	return func(s *Server, w http.ResponseWriter, r *http.Request, params map[string]string) {
+		c, id := s.objectCollection(r, 2)
+		item, ok := c.items[id]
+		if !ok {
+			notFound(w, kind, id)
+			return
+		}
+		s.setStatus(item, status)
+		s.log(eventType, "Change "+strings.ToLower(kind)+" status", kind, item)
+		writeJSON(w, http.StatusOK, map[string]interface{}{})
+	}
+}
+
+func (s *Server) getResource(w http.ResponseWriter, c *collection, kind, id string) {
+	item, ok := c.items[id]
+	if !ok {
+		notFound(w, kind, id)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
+
+// replaceResource replaces the attributes of an object, keeping the ones
+// managed by the org.
+func (s *Server) replaceResource(w http.ResponseWriter, r *http.Request, c *collection, kind, id, eventType string) {
+	item, ok := c.items[id]
+	if !ok {
+		notFound(w, kind, id)
+		return
+	}
+	body, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	for _, key := range []string{"id", "created", "status", "system", "_links"} {
+		if value, ok := item[key]; ok {
+			body[key] = value
+		}
+	}
+	body["lastUpdated"] = s.timestamp()
+	c.items[id] = body
+	s.log(eventType, "Update "+strings.ToLower(kind), kind, body)
+	writeJSON(w, http.StatusOK, body)
+}
+
+// deleteResource deletes an inactive app, policy, group rule or network
+// zone.
+func (s *Server) deleteResource(w http.ResponseWriter, c *collection, kind, id, eventType string) {
+	if item, ok := c.items[id]; ok && item["status"] == "ACTIVE" {
+		writeError(w, http.StatusForbidden, "E0000056", "Delete application forbidden.", fmt.Sprintf("%s %s must be deactivated before deletion.", kind, id))
+		return
+	}
+	s.removeResource(w, c, kind, id, eventType)
+}
+
+func (s *Server) removeResource(w http.ResponseWriter, c *collection, kind, id, eventType string) {
+	item, ok := c.items[id]
+	if !ok {
+		notFound(w, kind, id)
+		return
+	}
+	c.remove(id)
+	s.log(eventType, "Delete "+strings.ToLower(kind), kind, item)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// resourceTransition changes the status of an app, a policy or a group rule.
+func resourceTransition(name, param, status, eventType string) handler {
+	return func(s *Server, w http.ResponseWriter, r *http.Request, params map[string]string) {
+		c := map[string]*collection{"apps": s.apps, "policies": s.policies, "groupRules": s.groupRules}[name]
+		item, ok := c.items[params[param]]
+		if !ok {
+			notFound(w, name, params[param])
+			return
+		}
+		s.setStatus(item, status)
+		s.log(eventType, "Change "+name+" status", name, item)
+		writeJSON(w, http.StatusOK, map[string]interface{}{})
+	}
+}
+
+// listLogs answers the events published within the since and until
+// parameters, oldest first unless sortOrder is DESCENDING.
+func (s *Server) listLogs(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	query := r.URL.Query()
+	events := make([]map[string]interface{}, len(s.logs))
+	copy(events, s.logs)
+	for param, after := range map[string]bool{"since": true, "until": false} {
+		value := query.Get(param)
+		if value == "" {
+			continue
+		}
+		bound, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "E0000001", "Api validation failed: "+param, param+": Invalid date format, use ISO 8601.")
+			return
+		}
+		events = keep(events, func(event map[string]interface{}) bool {
+			published, _ := time.Parse(timeFormat, event["published"].(string))
+			if after {
+				return !published.Before(bound)
+			}
+			return published.Before(bound)
+		})
+	}
+	events, ok := selectItems(w, r, events, "eventType", "displayMessage")
+	if !ok {
+		return
+	}
+	if query.Get("sortOrder") == "DESCENDING" {
+		for i, j := 0, len(events)-1; i < j; i, j = i+1, j-1 {
+			events[i], events[j] = events[j], events[i]
+		}
+	}
+	s.writePage(w, r, events, func(event map[string]interface{}) string {
+		id, _ := event["uuid"].(string)
+		return id
+	})
+}
+
+// Seed adds users and groups with generated profiles, each user a member
+// of one group.
+func (s *Server) Seed(users, groups int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.timestamp()
+	var groupIDs []string
+	for i := 1; i <= groups; i++ {
+		id := s.newID(s.groups)
+		group := map[string]interface{}{
+			"id":                    id,
+			"created":               now,
+			"lastUpdated":           now,
+			"lastMembershipUpdated": now,
+			"objectClass":           []interface{}{"okta:user_group"},
+			"type":                  "OKTA_GROUP",
+			"profile":               map[string]interface{}{"name": fmt.Sprintf("Group %d", i), "description": "Seeded by the mock org"},
+		}
+		s.groups.add(id, group)
+		s.members[id] = map[string]bool{}
+		groupIDs = append(groupIDs, id)
+	}
+	for i := 1; i <= users; i++ {
+		id := s.newID(s.users)
+		login := fmt.Sprintf("user%d@example.com", i)
+		s.users.add(id, map[string]interface{}{
+			"id":            id,
+			"status":        "ACTIVE",
+			"created":       now,
+			"activated":     now,
+			"statusChanged": now,
+			"lastUpdated":   now,
+			"profile": map[string]interface{}{
+				"login":     login,
+				"email":     login,
+				"firstName": "User",
+				"lastName":  strconv.Itoa(i),
+			},
+		})
+		if len(groupIDs) > 0 {
+			s.members[groupIDs[(i-1)%len(groupIDs)]][id] = true
+		}
+	}
+}
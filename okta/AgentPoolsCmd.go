@@ -16,16 +16,25 @@ func init() {
 	rootCmd.AddCommand(AgentPoolsCmd)
 }
 
+var ListAgentPoolsall bool
+
 func NewListAgentPoolsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "list",
 		Long: "List all Agent Pools",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.agentPools.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AgentPoolsAPI.ListAgentPools(apiClient.GetConfig().Context)
+			req := apiClient.AgentPoolsAPI.ListAgentPools(cmd.Context())
+
+			if ListAgentPoolsall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -43,6 +52,8 @@ func NewListAgentPoolsCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &ListAgentPoolsall, false)
+
 	return cmd
 }
 
@@ -61,8 +72,11 @@ func NewCreateAgentPoolsUpdateCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "createUpdate",
 		Long: "Create an Agent Pool update",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.agentPools.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AgentPoolsAPI.CreateAgentPoolsUpdate(apiClient.GetConfig().Context, CreateAgentPoolsUpdatepoolId)
+			req := apiClient.AgentPoolsAPI.CreateAgentPoolsUpdate(cmd.Context(), CreateAgentPoolsUpdatepoolId)
 
 			if CreateAgentPoolsUpdatedata != "" {
 				req = req.Data(CreateAgentPoolsUpdatedata)
@@ -70,7 +84,7 @@ func NewCreateAgentPoolsUpdateCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -104,16 +118,25 @@ func init() {
 
 var ListAgentPoolsUpdatespoolId string
 
+var ListAgentPoolsUpdatesall bool
+
 func NewListAgentPoolsUpdatesCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listUpdates",
 		Long: "List all Agent Pool updates",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.agentPools.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AgentPoolsAPI.ListAgentPoolsUpdates(apiClient.GetConfig().Context, ListAgentPoolsUpdatespoolId)
+			req := apiClient.AgentPoolsAPI.ListAgentPoolsUpdates(cmd.Context(), ListAgentPoolsUpdatespoolId)
+
+			if ListAgentPoolsUpdatesall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -134,6 +157,8 @@ func NewListAgentPoolsUpdatesCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListAgentPoolsUpdatespoolId, "poolId", "", "", "")
 	cmd.MarkFlagRequired("poolId")
 
+	allFlag.RegisterBool(cmd, &ListAgentPoolsUpdatesall, false)
+
 	return cmd
 }
 
@@ -152,8 +177,11 @@ func NewUpdateAgentPoolsUpdateSettingsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "updateUpdateSettings",
 		Long: "Update an Agent Pool update settings",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.agentPools.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AgentPoolsAPI.UpdateAgentPoolsUpdateSettings(apiClient.GetConfig().Context, UpdateAgentPoolsUpdateSettingspoolId)
+			req := apiClient.AgentPoolsAPI.UpdateAgentPoolsUpdateSettings(cmd.Context(), UpdateAgentPoolsUpdateSettingspoolId)
 
 			if UpdateAgentPoolsUpdateSettingsdata != "" {
 				req = req.Data(UpdateAgentPoolsUpdateSettingsdata)
@@ -161,7 +189,7 @@ func NewUpdateAgentPoolsUpdateSettingsCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -199,12 +227,15 @@ func NewGetAgentPoolsUpdateSettingsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getUpdateSettings",
 		Long: "Retrieve an Agent Pool update's settings",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.agentPools.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AgentPoolsAPI.GetAgentPoolsUpdateSettings(apiClient.GetConfig().Context, GetAgentPoolsUpdateSettingspoolId)
+			req := apiClient.AgentPoolsAPI.GetAgentPoolsUpdateSettings(cmd.Context(), GetAgentPoolsUpdateSettingspoolId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -245,8 +276,11 @@ func NewUpdateAgentPoolsUpdateCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "updateUpdate",
 		Long: "Update an Agent Pool update by id",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.agentPools.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AgentPoolsAPI.UpdateAgentPoolsUpdate(apiClient.GetConfig().Context, UpdateAgentPoolsUpdatepoolId, UpdateAgentPoolsUpdateupdateId)
+			req := apiClient.AgentPoolsAPI.UpdateAgentPoolsUpdate(cmd.Context(), UpdateAgentPoolsUpdatepoolId, UpdateAgentPoolsUpdateupdateId)
 
 			if UpdateAgentPoolsUpdatedata != "" {
 				req = req.Data(UpdateAgentPoolsUpdatedata)
@@ -254,7 +288,7 @@ func NewUpdateAgentPoolsUpdateCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -299,12 +333,15 @@ func NewGetAgentPoolsUpdateInstanceCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getUpdateInstance",
 		Long: "Retrieve an Agent Pool update by id",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.agentPools.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AgentPoolsAPI.GetAgentPoolsUpdateInstance(apiClient.GetConfig().Context, GetAgentPoolsUpdateInstancepoolId, GetAgentPoolsUpdateInstanceupdateId)
+			req := apiClient.AgentPoolsAPI.GetAgentPoolsUpdateInstance(cmd.Context(), GetAgentPoolsUpdateInstancepoolId, GetAgentPoolsUpdateInstanceupdateId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -346,12 +383,16 @@ func NewDeleteAgentPoolsUpdateCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deleteUpdate",
 		Long: "Delete an Agent Pool update",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.agentPools.manage",
+			destructiveAnnotation: "/api/v1/agentPools/{poolId}/updates/{updateId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AgentPoolsAPI.DeleteAgentPoolsUpdate(apiClient.GetConfig().Context, DeleteAgentPoolsUpdatepoolId, DeleteAgentPoolsUpdateupdateId)
+			req := apiClient.AgentPoolsAPI.DeleteAgentPoolsUpdate(cmd.Context(), DeleteAgentPoolsUpdatepoolId, DeleteAgentPoolsUpdateupdateId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -393,12 +434,15 @@ func NewActivateAgentPoolsUpdateCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "activateUpdate",
 		Long: "Activate an Agent Pool update",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.agentPools.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AgentPoolsAPI.ActivateAgentPoolsUpdate(apiClient.GetConfig().Context, ActivateAgentPoolsUpdatepoolId, ActivateAgentPoolsUpdateupdateId)
+			req := apiClient.AgentPoolsAPI.ActivateAgentPoolsUpdate(cmd.Context(), ActivateAgentPoolsUpdatepoolId, ActivateAgentPoolsUpdateupdateId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -440,12 +484,16 @@ func NewDeactivateAgentPoolsUpdateCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deactivateUpdate",
 		Long: "Deactivate an Agent Pool update",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.agentPools.manage",
+			destructiveAnnotation: "/api/v1/agentPools/{poolId}/updates/{updateId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AgentPoolsAPI.DeactivateAgentPoolsUpdate(apiClient.GetConfig().Context, DeactivateAgentPoolsUpdatepoolId, DeactivateAgentPoolsUpdateupdateId)
+			req := apiClient.AgentPoolsAPI.DeactivateAgentPoolsUpdate(cmd.Context(), DeactivateAgentPoolsUpdatepoolId, DeactivateAgentPoolsUpdateupdateId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -487,12 +535,15 @@ func NewPauseAgentPoolsUpdateCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "pauseUpdate",
 		Long: "Pause an Agent Pool update",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.agentPools.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AgentPoolsAPI.PauseAgentPoolsUpdate(apiClient.GetConfig().Context, PauseAgentPoolsUpdatepoolId, PauseAgentPoolsUpdateupdateId)
+			req := apiClient.AgentPoolsAPI.PauseAgentPoolsUpdate(cmd.Context(), PauseAgentPoolsUpdatepoolId, PauseAgentPoolsUpdateupdateId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -534,12 +585,15 @@ func NewResumeAgentPoolsUpdateCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "resumeUpdate",
 		Long: "Resume an Agent Pool update",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.agentPools.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AgentPoolsAPI.ResumeAgentPoolsUpdate(apiClient.GetConfig().Context, ResumeAgentPoolsUpdatepoolId, ResumeAgentPoolsUpdateupdateId)
+			req := apiClient.AgentPoolsAPI.ResumeAgentPoolsUpdate(cmd.Context(), ResumeAgentPoolsUpdatepoolId, ResumeAgentPoolsUpdateupdateId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -581,12 +635,15 @@ func NewRetryAgentPoolsUpdateCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "retryUpdate",
 		Long: "Retry an Agent Pool update",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.agentPools.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AgentPoolsAPI.RetryAgentPoolsUpdate(apiClient.GetConfig().Context, RetryAgentPoolsUpdatepoolId, RetryAgentPoolsUpdateupdateId)
+			req := apiClient.AgentPoolsAPI.RetryAgentPoolsUpdate(cmd.Context(), RetryAgentPoolsUpdatepoolId, RetryAgentPoolsUpdateupdateId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -628,12 +685,15 @@ func NewStopAgentPoolsUpdateCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "stopUpdate",
 		Long: "Stop an Agent Pool update",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.agentPools.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AgentPoolsAPI.StopAgentPoolsUpdate(apiClient.GetConfig().Context, StopAgentPoolsUpdatepoolId, StopAgentPoolsUpdateupdateId)
+			req := apiClient.AgentPoolsAPI.StopAgentPoolsUpdate(cmd.Context(), StopAgentPoolsUpdatepoolId, StopAgentPoolsUpdateupdateId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
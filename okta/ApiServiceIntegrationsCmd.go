@@ -23,7 +23,7 @@ func NewCreateApiServiceIntegrationInstanceCmd() *cobra.Command {
 		Use:  "createApiServiceIntegrationInstance",
 		Long: "Create an API Service Integration instance",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApiServiceIntegrationsAPI.CreateApiServiceIntegrationInstance(apiClient.GetConfig().Context)
+			req := apiClient.ApiServiceIntegrationsAPI.CreateApiServiceIntegrationInstance(cmd.Context())
 
 			if CreateApiServiceIntegrationInstancedata != "" {
 				req = req.Data(CreateApiServiceIntegrationInstancedata)
@@ -31,7 +31,7 @@ func NewCreateApiServiceIntegrationInstanceCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -60,16 +60,25 @@ func init() {
 	ApiServiceIntegrationsCmd.AddCommand(CreateApiServiceIntegrationInstanceCmd)
 }
 
+var ListApiServiceIntegrationInstancesall bool
+
 func NewListApiServiceIntegrationInstancesCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listApiServiceIntegrationInstances",
 		Long: "List all API Service Integration instances",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.oauthIntegrations.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApiServiceIntegrationsAPI.ListApiServiceIntegrationInstances(apiClient.GetConfig().Context)
+			req := apiClient.ApiServiceIntegrationsAPI.ListApiServiceIntegrationInstances(cmd.Context())
+
+			if ListApiServiceIntegrationInstancesall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -87,6 +96,8 @@ func NewListApiServiceIntegrationInstancesCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &ListApiServiceIntegrationInstancesall, false)
+
 	return cmd
 }
 
@@ -101,12 +112,15 @@ func NewGetApiServiceIntegrationInstanceCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getApiServiceIntegrationInstance",
 		Long: "Retrieve an API Service Integration instance",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.oauthIntegrations.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApiServiceIntegrationsAPI.GetApiServiceIntegrationInstance(apiClient.GetConfig().Context, GetApiServiceIntegrationInstanceapiServiceId)
+			req := apiClient.ApiServiceIntegrationsAPI.GetApiServiceIntegrationInstance(cmd.Context(), GetApiServiceIntegrationInstanceapiServiceId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -141,12 +155,16 @@ func NewDeleteApiServiceIntegrationInstanceCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deleteApiServiceIntegrationInstance",
 		Long: "Delete an API Service Integration instance",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.oauthIntegrations.manage",
+			destructiveAnnotation: "/integrations/api/v1/api-services/{apiServiceId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApiServiceIntegrationsAPI.DeleteApiServiceIntegrationInstance(apiClient.GetConfig().Context, DeleteApiServiceIntegrationInstanceapiServiceId)
+			req := apiClient.ApiServiceIntegrationsAPI.DeleteApiServiceIntegrationInstance(cmd.Context(), DeleteApiServiceIntegrationInstanceapiServiceId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -181,12 +199,15 @@ func NewCreateApiServiceIntegrationInstanceSecretCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "createApiServiceIntegrationInstanceSecret",
 		Long: "Create an API Service Integration instance Secret",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.oauthIntegrations.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApiServiceIntegrationsAPI.CreateApiServiceIntegrationInstanceSecret(apiClient.GetConfig().Context, CreateApiServiceIntegrationInstanceSecretapiServiceId)
+			req := apiClient.ApiServiceIntegrationsAPI.CreateApiServiceIntegrationInstanceSecret(cmd.Context(), CreateApiServiceIntegrationInstanceSecretapiServiceId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -217,16 +238,25 @@ func init() {
 
 var ListApiServiceIntegrationInstanceSecretsapiServiceId string
 
+var ListApiServiceIntegrationInstanceSecretsall bool
+
 func NewListApiServiceIntegrationInstanceSecretsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listApiServiceIntegrationInstanceSecrets",
 		Long: "List all API Service Integration instance Secrets",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.oauthIntegrations.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApiServiceIntegrationsAPI.ListApiServiceIntegrationInstanceSecrets(apiClient.GetConfig().Context, ListApiServiceIntegrationInstanceSecretsapiServiceId)
+			req := apiClient.ApiServiceIntegrationsAPI.ListApiServiceIntegrationInstanceSecrets(cmd.Context(), ListApiServiceIntegrationInstanceSecretsapiServiceId)
+
+			if ListApiServiceIntegrationInstanceSecretsall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -247,6 +277,8 @@ func NewListApiServiceIntegrationInstanceSecretsCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListApiServiceIntegrationInstanceSecretsapiServiceId, "apiServiceId", "", "", "")
 	cmd.MarkFlagRequired("apiServiceId")
 
+	allFlag.RegisterBool(cmd, &ListApiServiceIntegrationInstanceSecretsall, false)
+
 	return cmd
 }
 
@@ -265,12 +297,16 @@ func NewDeleteApiServiceIntegrationInstanceSecretCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deleteApiServiceIntegrationInstanceSecret",
 		Long: "Delete an API Service Integration instance Secret",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.oauthIntegrations.manage",
+			destructiveAnnotation: "/integrations/api/v1/api-services/{apiServiceId}/credentials/secrets/{secretId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApiServiceIntegrationsAPI.DeleteApiServiceIntegrationInstanceSecret(apiClient.GetConfig().Context, DeleteApiServiceIntegrationInstanceSecretapiServiceId, DeleteApiServiceIntegrationInstanceSecretsecretId)
+			req := apiClient.ApiServiceIntegrationsAPI.DeleteApiServiceIntegrationInstanceSecret(cmd.Context(), DeleteApiServiceIntegrationInstanceSecretapiServiceId, DeleteApiServiceIntegrationInstanceSecretsecretId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -312,12 +348,15 @@ func NewActivateApiServiceIntegrationInstanceSecretCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "activateApiServiceIntegrationInstanceSecret",
 		Long: "Activate an API Service Integration instance Secret",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.oauthIntegrations.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApiServiceIntegrationsAPI.ActivateApiServiceIntegrationInstanceSecret(apiClient.GetConfig().Context, ActivateApiServiceIntegrationInstanceSecretapiServiceId, ActivateApiServiceIntegrationInstanceSecretsecretId)
+			req := apiClient.ApiServiceIntegrationsAPI.ActivateApiServiceIntegrationInstanceSecret(cmd.Context(), ActivateApiServiceIntegrationInstanceSecretapiServiceId, ActivateApiServiceIntegrationInstanceSecretsecretId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -359,12 +398,16 @@ func NewDeactivateApiServiceIntegrationInstanceSecretCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deactivateApiServiceIntegrationInstanceSecret",
 		Long: "Deactivate an API Service Integration instance Secret",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.oauthIntegrations.manage",
+			destructiveAnnotation: "/integrations/api/v1/api-services/{apiServiceId}/credentials/secrets/{secretId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApiServiceIntegrationsAPI.DeactivateApiServiceIntegrationInstanceSecret(apiClient.GetConfig().Context, DeactivateApiServiceIntegrationInstanceSecretapiServiceId, DeactivateApiServiceIntegrationInstanceSecretsecretId)
+			req := apiClient.ApiServiceIntegrationsAPI.DeactivateApiServiceIntegrationInstanceSecret(cmd.Context(), DeactivateApiServiceIntegrationInstanceSecretapiServiceId, DeactivateApiServiceIntegrationInstanceSecretsecretId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -16,16 +16,25 @@ func init() {
 	rootCmd.AddCommand(ApiTokenCmd)
 }
 
+var ListApiTokensall bool
+
 func NewListApiTokensCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "lists",
 		Long: "List all API Token Metadata",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.apiTokens.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApiTokenAPI.ListApiTokens(apiClient.GetConfig().Context)
+			req := apiClient.ApiTokenAPI.ListApiTokens(cmd.Context())
+
+			if ListApiTokensall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -43,6 +52,8 @@ func NewListApiTokensCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &ListApiTokensall, false)
+
 	return cmd
 }
 
@@ -55,12 +66,15 @@ func NewRevokeCurrentApiTokenCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "revokeCurrent",
 		Long: "Revoke the Current API Token",
+		Annotations: map[string]string{
+			destructiveAnnotation: "/api/v1/api-tokens/current",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApiTokenAPI.RevokeCurrentApiToken(apiClient.GetConfig().Context)
+			req := apiClient.ApiTokenAPI.RevokeCurrentApiToken(cmd.Context())
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -92,12 +106,15 @@ func NewGetApiTokenCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "get",
 		Long: "Retrieve an API Token's Metadata",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.apiTokens.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApiTokenAPI.GetApiToken(apiClient.GetConfig().Context, GetApiTokenapiTokenId)
+			req := apiClient.ApiTokenAPI.GetApiToken(cmd.Context(), GetApiTokenapiTokenId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -132,12 +149,16 @@ func NewRevokeApiTokenCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "revoke",
 		Long: "Revoke an API Token",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.apiTokens.manage",
+			destructiveAnnotation: "/api/v1/api-tokens/{apiTokenId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApiTokenAPI.RevokeApiToken(apiClient.GetConfig().Context, RevokeApiTokenapiTokenId)
+			req := apiClient.ApiTokenAPI.RevokeApiToken(cmd.Context(), RevokeApiTokenapiTokenId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
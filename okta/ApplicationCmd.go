@@ -22,8 +22,11 @@ func NewCreateApplicationCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "create",
 		Long: "Create an Application",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.apps.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationAPI.CreateApplication(apiClient.GetConfig().Context)
+			req := apiClient.ApplicationAPI.CreateApplication(cmd.Context())
 
 			if CreateApplicationdata != "" {
 				req = req.Data(CreateApplicationdata)
@@ -31,7 +34,7 @@ func NewCreateApplicationCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -60,16 +63,25 @@ func init() {
 	ApplicationCmd.AddCommand(CreateApplicationCmd)
 }
 
+var ListApplicationsall bool
+
 func NewListApplicationsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "lists",
 		Long: "List all Applications",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.apps.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationAPI.ListApplications(apiClient.GetConfig().Context)
+			req := apiClient.ApplicationAPI.ListApplications(cmd.Context())
+
+			if ListApplicationsall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -87,6 +99,8 @@ func NewListApplicationsCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &ListApplicationsall, false)
+
 	return cmd
 }
 
@@ -101,12 +115,15 @@ func NewGetApplicationCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "get",
 		Long: "Retrieve an Application",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.apps.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationAPI.GetApplication(apiClient.GetConfig().Context, GetApplicationappId)
+			req := apiClient.ApplicationAPI.GetApplication(cmd.Context(), GetApplicationappId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -145,8 +162,11 @@ func NewReplaceApplicationCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replace",
 		Long: "Replace an Application",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.apps.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationAPI.ReplaceApplication(apiClient.GetConfig().Context, ReplaceApplicationappId)
+			req := apiClient.ApplicationAPI.ReplaceApplication(cmd.Context(), ReplaceApplicationappId)
 
 			if ReplaceApplicationdata != "" {
 				req = req.Data(ReplaceApplicationdata)
@@ -154,7 +174,7 @@ func NewReplaceApplicationCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -192,12 +212,16 @@ func NewDeleteApplicationCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "delete",
 		Long: "Delete an Application",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.apps.manage",
+			destructiveAnnotation: "/api/v1/apps/{appId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationAPI.DeleteApplication(apiClient.GetConfig().Context, DeleteApplicationappId)
+			req := apiClient.ApplicationAPI.DeleteApplication(cmd.Context(), DeleteApplicationappId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -232,12 +256,15 @@ func NewActivateApplicationCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "activate",
 		Long: "Activate an Application",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.apps.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationAPI.ActivateApplication(apiClient.GetConfig().Context, ActivateApplicationappId)
+			req := apiClient.ApplicationAPI.ActivateApplication(cmd.Context(), ActivateApplicationappId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -272,12 +299,16 @@ func NewDeactivateApplicationCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deactivate",
 		Long: "Deactivate an Application",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.apps.manage",
+			destructiveAnnotation: "/api/v1/apps/{appId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationAPI.DeactivateApplication(apiClient.GetConfig().Context, DeactivateApplicationappId)
+			req := apiClient.ApplicationAPI.DeactivateApplication(cmd.Context(), DeactivateApplicationappId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -26,8 +26,11 @@ func NewUpdateDefaultProvisioningConnectionForApplicationCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "updateDefaultProvisioningConnectionForApplication",
 		Long: "Update the default Provisioning Connection",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.apps.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationConnectionsAPI.UpdateDefaultProvisioningConnectionForApplication(apiClient.GetConfig().Context, UpdateDefaultProvisioningConnectionForApplicationappId)
+			req := apiClient.ApplicationConnectionsAPI.UpdateDefaultProvisioningConnectionForApplication(cmd.Context(), UpdateDefaultProvisioningConnectionForApplicationappId)
 
 			if UpdateDefaultProvisioningConnectionForApplicationdata != "" {
 				req = req.Data(UpdateDefaultProvisioningConnectionForApplicationdata)
@@ -35,7 +38,7 @@ func NewUpdateDefaultProvisioningConnectionForApplicationCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -73,12 +76,15 @@ func NewGetDefaultProvisioningConnectionForApplicationCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getDefaultProvisioningConnectionForApplication",
 		Long: "Retrieve the default Provisioning Connection",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.apps.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationConnectionsAPI.GetDefaultProvisioningConnectionForApplication(apiClient.GetConfig().Context, GetDefaultProvisioningConnectionForApplicationappId)
+			req := apiClient.ApplicationConnectionsAPI.GetDefaultProvisioningConnectionForApplication(cmd.Context(), GetDefaultProvisioningConnectionForApplicationappId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -113,12 +119,15 @@ func NewActivateDefaultProvisioningConnectionForApplicationCmd() *cobra.Command
 	cmd := &cobra.Command{
 		Use:  "activateDefaultProvisioningConnectionForApplication",
 		Long: "Activate the default Provisioning Connection",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.apps.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationConnectionsAPI.ActivateDefaultProvisioningConnectionForApplication(apiClient.GetConfig().Context, ActivateDefaultProvisioningConnectionForApplicationappId)
+			req := apiClient.ApplicationConnectionsAPI.ActivateDefaultProvisioningConnectionForApplication(cmd.Context(), ActivateDefaultProvisioningConnectionForApplicationappId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -153,12 +162,16 @@ func NewDeactivateDefaultProvisioningConnectionForApplicationCmd() *cobra.Comman
 	cmd := &cobra.Command{
 		Use:  "deactivateDefaultProvisioningConnectionForApplication",
 		Long: "Deactivate the default Provisioning Connection",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.apps.manage",
+			destructiveAnnotation: "/api/v1/apps/{appId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationConnectionsAPI.DeactivateDefaultProvisioningConnectionForApplication(apiClient.GetConfig().Context, DeactivateDefaultProvisioningConnectionForApplicationappId)
+			req := apiClient.ApplicationConnectionsAPI.DeactivateDefaultProvisioningConnectionForApplication(cmd.Context(), DeactivateDefaultProvisioningConnectionForApplicationappId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -197,12 +210,15 @@ func NewVerifyProvisioningConnectionForApplicationCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "verifyProvisioningConnectionForApplication",
 		Long: "Verify the Provisioning Connection",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.apps.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationConnectionsAPI.VerifyProvisioningConnectionForApplication(apiClient.GetConfig().Context, VerifyProvisioningConnectionForApplicationappName, VerifyProvisioningConnectionForApplicationappId)
+			req := apiClient.ApplicationConnectionsAPI.VerifyProvisioningConnectionForApplication(cmd.Context(), VerifyProvisioningConnectionForApplicationappName, VerifyProvisioningConnectionForApplicationappId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -26,8 +26,11 @@ func NewGenerateCsrForApplicationCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "generateCsrForApplication",
 		Long: "Generate a Certificate Signing Request",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.apps.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationCredentialsAPI.GenerateCsrForApplication(apiClient.GetConfig().Context, GenerateCsrForApplicationappId)
+			req := apiClient.ApplicationCredentialsAPI.GenerateCsrForApplication(cmd.Context(), GenerateCsrForApplicationappId)
 
 			if GenerateCsrForApplicationdata != "" {
 				req = req.Data(GenerateCsrForApplicationdata)
@@ -35,7 +38,7 @@ func NewGenerateCsrForApplicationCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -69,16 +72,25 @@ func init() {
 
 var ListCsrsForApplicationappId string
 
+var ListCsrsForApplicationall bool
+
 func NewListCsrsForApplicationCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listCsrsForApplication",
 		Long: "List all Certificate Signing Requests",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.apps.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationCredentialsAPI.ListCsrsForApplication(apiClient.GetConfig().Context, ListCsrsForApplicationappId)
+			req := apiClient.ApplicationCredentialsAPI.ListCsrsForApplication(cmd.Context(), ListCsrsForApplicationappId)
+
+			if ListCsrsForApplicationall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -99,6 +111,8 @@ func NewListCsrsForApplicationCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListCsrsForApplicationappId, "appId", "", "", "")
 	cmd.MarkFlagRequired("appId")
 
+	allFlag.RegisterBool(cmd, &ListCsrsForApplicationall, false)
+
 	return cmd
 }
 
@@ -117,12 +131,15 @@ func NewGetCsrForApplicationCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getCsrForApplication",
 		Long: "Retrieve a Certificate Signing Request",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.apps.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationCredentialsAPI.GetCsrForApplication(apiClient.GetConfig().Context, GetCsrForApplicationappId, GetCsrForApplicationcsrId)
+			req := apiClient.ApplicationCredentialsAPI.GetCsrForApplication(cmd.Context(), GetCsrForApplicationappId, GetCsrForApplicationcsrId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -164,12 +181,16 @@ func NewRevokeCsrFromApplicationCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "revokeCsrFromApplication",
 		Long: "Revoke a Certificate Signing Request",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.apps.manage",
+			destructiveAnnotation: "/api/v1/apps/{appId}/credentials/csrs/{csrId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationCredentialsAPI.RevokeCsrFromApplication(apiClient.GetConfig().Context, RevokeCsrFromApplicationappId, RevokeCsrFromApplicationcsrId)
+			req := apiClient.ApplicationCredentialsAPI.RevokeCsrFromApplication(cmd.Context(), RevokeCsrFromApplicationappId, RevokeCsrFromApplicationcsrId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -213,8 +234,11 @@ func NewPublishCsrFromApplicationCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "publishCsrFromApplication",
 		Long: "Publish a Certificate Signing Request",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.apps.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationCredentialsAPI.PublishCsrFromApplication(apiClient.GetConfig().Context, PublishCsrFromApplicationappId, PublishCsrFromApplicationcsrId)
+			req := apiClient.ApplicationCredentialsAPI.PublishCsrFromApplication(cmd.Context(), PublishCsrFromApplicationappId, PublishCsrFromApplicationcsrId)
 
 			if PublishCsrFromApplicationdata != "" {
 				req = req.Data(PublishCsrFromApplicationdata)
@@ -222,7 +246,7 @@ func NewPublishCsrFromApplicationCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -259,16 +283,25 @@ func init() {
 
 var ListApplicationKeysappId string
 
+var ListApplicationKeysall bool
+
 func NewListApplicationKeysCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listApplicationKeys",
 		Long: "List all Key Credentials",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.apps.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationCredentialsAPI.ListApplicationKeys(apiClient.GetConfig().Context, ListApplicationKeysappId)
+			req := apiClient.ApplicationCredentialsAPI.ListApplicationKeys(cmd.Context(), ListApplicationKeysappId)
+
+			if ListApplicationKeysall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -289,6 +322,8 @@ func NewListApplicationKeysCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListApplicationKeysappId, "appId", "", "", "")
 	cmd.MarkFlagRequired("appId")
 
+	allFlag.RegisterBool(cmd, &ListApplicationKeysall, false)
+
 	return cmd
 }
 
@@ -303,12 +338,15 @@ func NewGenerateApplicationKeyCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "generateApplicationKey",
 		Long: "Generate a Key Credential",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.apps.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationCredentialsAPI.GenerateApplicationKey(apiClient.GetConfig().Context, GenerateApplicationKeyappId)
+			req := apiClient.ApplicationCredentialsAPI.GenerateApplicationKey(cmd.Context(), GenerateApplicationKeyappId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -347,12 +385,15 @@ func NewGetApplicationKeyCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getApplicationKey",
 		Long: "Retrieve a Key Credential",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.apps.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationCredentialsAPI.GetApplicationKey(apiClient.GetConfig().Context, GetApplicationKeyappId, GetApplicationKeykeyId)
+			req := apiClient.ApplicationCredentialsAPI.GetApplicationKey(cmd.Context(), GetApplicationKeyappId, GetApplicationKeykeyId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -394,12 +435,15 @@ func NewCloneApplicationKeyCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "cloneApplicationKey",
 		Long: "Clone a Key Credential",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.apps.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationCredentialsAPI.CloneApplicationKey(apiClient.GetConfig().Context, CloneApplicationKeyappId, CloneApplicationKeykeyId)
+			req := apiClient.ApplicationCredentialsAPI.CloneApplicationKey(cmd.Context(), CloneApplicationKeyappId, CloneApplicationKeykeyId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
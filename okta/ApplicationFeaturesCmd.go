@@ -18,16 +18,25 @@ func init() {
 
 var ListFeaturesForApplicationappId string
 
+var ListFeaturesForApplicationall bool
+
 func NewListFeaturesForApplicationCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listFeaturesForApplication",
 		Long: "List all Features",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.apps.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationFeaturesAPI.ListFeaturesForApplication(apiClient.GetConfig().Context, ListFeaturesForApplicationappId)
+			req := apiClient.ApplicationFeaturesAPI.ListFeaturesForApplication(cmd.Context(), ListFeaturesForApplicationappId)
+
+			if ListFeaturesForApplicationall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -48,6 +57,8 @@ func NewListFeaturesForApplicationCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListFeaturesForApplicationappId, "appId", "", "", "")
 	cmd.MarkFlagRequired("appId")
 
+	allFlag.RegisterBool(cmd, &ListFeaturesForApplicationall, false)
+
 	return cmd
 }
 
@@ -66,12 +77,15 @@ func NewGetFeatureForApplicationCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getFeatureForApplication",
 		Long: "Retrieve a Feature",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.apps.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationFeaturesAPI.GetFeatureForApplication(apiClient.GetConfig().Context, GetFeatureForApplicationappId, GetFeatureForApplicationfeatureName)
+			req := apiClient.ApplicationFeaturesAPI.GetFeatureForApplication(cmd.Context(), GetFeatureForApplicationappId, GetFeatureForApplicationfeatureName)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -115,8 +129,11 @@ func NewUpdateFeatureForApplicationCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "updateFeatureForApplication",
 		Long: "Update a Feature",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.apps.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationFeaturesAPI.UpdateFeatureForApplication(apiClient.GetConfig().Context, UpdateFeatureForApplicationappId, UpdateFeatureForApplicationfeatureName)
+			req := apiClient.ApplicationFeaturesAPI.UpdateFeatureForApplication(cmd.Context(), UpdateFeatureForApplicationappId, UpdateFeatureForApplicationfeatureName)
 
 			if UpdateFeatureForApplicationdata != "" {
 				req = req.Data(UpdateFeatureForApplicationdata)
@@ -124,7 +141,7 @@ func NewUpdateFeatureForApplicationCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -26,8 +26,11 @@ func NewGrantConsentToScopeCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "grantConsentToScope",
 		Long: "Grant consent to scope",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.appGrants.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationGrantsAPI.GrantConsentToScope(apiClient.GetConfig().Context, GrantConsentToScopeappId)
+			req := apiClient.ApplicationGrantsAPI.GrantConsentToScope(cmd.Context(), GrantConsentToScopeappId)
 
 			if GrantConsentToScopedata != "" {
 				req = req.Data(GrantConsentToScopedata)
@@ -35,7 +38,7 @@ func NewGrantConsentToScopeCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -69,16 +72,25 @@ func init() {
 
 var ListScopeConsentGrantsappId string
 
+var ListScopeConsentGrantsall bool
+
 func NewListScopeConsentGrantsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listScopeConsentGrants",
 		Long: "List all app Grants",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.appGrants.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationGrantsAPI.ListScopeConsentGrants(apiClient.GetConfig().Context, ListScopeConsentGrantsappId)
+			req := apiClient.ApplicationGrantsAPI.ListScopeConsentGrants(cmd.Context(), ListScopeConsentGrantsappId)
+
+			if ListScopeConsentGrantsall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -99,6 +111,8 @@ func NewListScopeConsentGrantsCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListScopeConsentGrantsappId, "appId", "", "", "")
 	cmd.MarkFlagRequired("appId")
 
+	allFlag.RegisterBool(cmd, &ListScopeConsentGrantsall, false)
+
 	return cmd
 }
 
@@ -117,12 +131,15 @@ func NewGetScopeConsentGrantCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getScopeConsentGrant",
 		Long: "Retrieve an app Grant",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.appGrants.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationGrantsAPI.GetScopeConsentGrant(apiClient.GetConfig().Context, GetScopeConsentGrantappId, GetScopeConsentGrantgrantId)
+			req := apiClient.ApplicationGrantsAPI.GetScopeConsentGrant(cmd.Context(), GetScopeConsentGrantappId, GetScopeConsentGrantgrantId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -164,12 +181,16 @@ func NewRevokeScopeConsentGrantCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "revokeScopeConsentGrant",
 		Long: "Revoke an app Grant",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.appGrants.manage",
+			destructiveAnnotation: "/api/v1/apps/{appId}/grants/{grantId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationGrantsAPI.RevokeScopeConsentGrant(apiClient.GetConfig().Context, RevokeScopeConsentGrantappId, RevokeScopeConsentGrantgrantId)
+			req := apiClient.ApplicationGrantsAPI.RevokeScopeConsentGrant(cmd.Context(), RevokeScopeConsentGrantappId, RevokeScopeConsentGrantgrantId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
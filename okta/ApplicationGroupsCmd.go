@@ -18,16 +18,25 @@ func init() {
 
 var ListApplicationGroupAssignmentsappId string
 
+var ListApplicationGroupAssignmentsall bool
+
 func NewListApplicationGroupAssignmentsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listApplicationGroupAssignments",
 		Long: "List all Assigned Groups",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.apps.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationGroupsAPI.ListApplicationGroupAssignments(apiClient.GetConfig().Context, ListApplicationGroupAssignmentsappId)
+			req := apiClient.ApplicationGroupsAPI.ListApplicationGroupAssignments(cmd.Context(), ListApplicationGroupAssignmentsappId)
+
+			if ListApplicationGroupAssignmentsall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -48,6 +57,8 @@ func NewListApplicationGroupAssignmentsCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListApplicationGroupAssignmentsappId, "appId", "", "", "")
 	cmd.MarkFlagRequired("appId")
 
+	allFlag.RegisterBool(cmd, &ListApplicationGroupAssignmentsall, false)
+
 	return cmd
 }
 
@@ -66,12 +77,15 @@ func NewGetApplicationGroupAssignmentCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getApplicationGroupAssignment",
 		Long: "Retrieve an Assigned Group",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.apps.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationGroupsAPI.GetApplicationGroupAssignment(apiClient.GetConfig().Context, GetApplicationGroupAssignmentappId, GetApplicationGroupAssignmentgroupId)
+			req := apiClient.ApplicationGroupsAPI.GetApplicationGroupAssignment(cmd.Context(), GetApplicationGroupAssignmentappId, GetApplicationGroupAssignmentgroupId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -115,8 +129,11 @@ func NewAssignGroupToApplicationCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "assignGroupToApplication",
 		Long: "Assign a Group",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.apps.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationGroupsAPI.AssignGroupToApplication(apiClient.GetConfig().Context, AssignGroupToApplicationappId, AssignGroupToApplicationgroupId)
+			req := apiClient.ApplicationGroupsAPI.AssignGroupToApplication(cmd.Context(), AssignGroupToApplicationappId, AssignGroupToApplicationgroupId)
 
 			if AssignGroupToApplicationdata != "" {
 				req = req.Data(AssignGroupToApplicationdata)
@@ -124,7 +141,7 @@ func NewAssignGroupToApplicationCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -169,12 +186,16 @@ func NewUnassignApplicationFromGroupCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "unassignApplicationFromGroup",
 		Long: "Unassign a Group",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.apps.manage",
+			destructiveAnnotation: "/api/v1/apps/{appId}/groups/{groupId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationGroupsAPI.UnassignApplicationFromGroup(apiClient.GetConfig().Context, UnassignApplicationFromGroupappId, UnassignApplicationFromGroupgroupId)
+			req := apiClient.ApplicationGroupsAPI.UnassignApplicationFromGroup(cmd.Context(), UnassignApplicationFromGroupappId, UnassignApplicationFromGroupgroupId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
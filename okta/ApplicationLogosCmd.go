@@ -26,8 +26,11 @@ func NewUploadApplicationLogoCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "uploadApplicationLogo",
 		Long: "Upload an application Logo",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.apps.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationLogosAPI.UploadApplicationLogo(apiClient.GetConfig().Context, UploadApplicationLogoappId)
+			req := apiClient.ApplicationLogosAPI.UploadApplicationLogo(cmd.Context(), UploadApplicationLogoappId)
 
 			if UploadApplicationLogodata != "" {
 				req = req.Data(UploadApplicationLogodata)
@@ -35,7 +38,7 @@ func NewUploadApplicationLogoCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -22,12 +22,15 @@ func NewGetFirstPartyAppSettingsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getFirstPartyAppSettings",
 		Long: "Retrieve the Okta app settings",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.apps.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationOktaApplicationSettingsAPI.GetFirstPartyAppSettings(apiClient.GetConfig().Context, GetFirstPartyAppSettingsappName)
+			req := apiClient.ApplicationOktaApplicationSettingsAPI.GetFirstPartyAppSettings(cmd.Context(), GetFirstPartyAppSettingsappName)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -66,8 +69,11 @@ func NewReplaceFirstPartyAppSettingsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replaceFirstPartyAppSettings",
 		Long: "Replace the Okta app settings",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.apps.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationOktaApplicationSettingsAPI.ReplaceFirstPartyAppSettings(apiClient.GetConfig().Context, ReplaceFirstPartyAppSettingsappName)
+			req := apiClient.ApplicationOktaApplicationSettingsAPI.ReplaceFirstPartyAppSettings(cmd.Context(), ReplaceFirstPartyAppSettingsappName)
 
 			if ReplaceFirstPartyAppSettingsdata != "" {
 				req = req.Data(ReplaceFirstPartyAppSettingsdata)
@@ -75,7 +81,7 @@ func NewReplaceFirstPartyAppSettingsCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
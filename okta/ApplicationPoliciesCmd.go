@@ -26,12 +26,15 @@ func NewAssignApplicationPolicyCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "assignApplicationPolicy",
 		Long: "Assign an application to a Policy",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.apps.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationPoliciesAPI.AssignApplicationPolicy(apiClient.GetConfig().Context, AssignApplicationPolicyappId, AssignApplicationPolicypolicyId)
+			req := apiClient.ApplicationPoliciesAPI.AssignApplicationPolicy(cmd.Context(), AssignApplicationPolicyappId, AssignApplicationPolicypolicyId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -22,12 +22,15 @@ func NewPreviewSAMLmetadataForApplicationCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "previewSAMLmetadataForApplication",
 		Long: "Preview the application SAML metadata",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.apps.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationSSOAPI.PreviewSAMLmetadataForApplication(apiClient.GetConfig().Context, PreviewSAMLmetadataForApplicationappId)
+			req := apiClient.ApplicationSSOAPI.PreviewSAMLmetadataForApplication(cmd.Context(), PreviewSAMLmetadataForApplicationappId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
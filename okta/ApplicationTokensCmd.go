@@ -18,16 +18,25 @@ func init() {
 
 var ListOAuth2TokensForApplicationappId string
 
+var ListOAuth2TokensForApplicationall bool
+
 func NewListOAuth2TokensForApplicationCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listOAuth2TokensForApplication",
 		Long: "List all application refresh Tokens",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.apps.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationTokensAPI.ListOAuth2TokensForApplication(apiClient.GetConfig().Context, ListOAuth2TokensForApplicationappId)
+			req := apiClient.ApplicationTokensAPI.ListOAuth2TokensForApplication(cmd.Context(), ListOAuth2TokensForApplicationappId)
+
+			if ListOAuth2TokensForApplicationall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -48,6 +57,8 @@ func NewListOAuth2TokensForApplicationCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListOAuth2TokensForApplicationappId, "appId", "", "", "")
 	cmd.MarkFlagRequired("appId")
 
+	allFlag.RegisterBool(cmd, &ListOAuth2TokensForApplicationall, false)
+
 	return cmd
 }
 
@@ -62,12 +73,16 @@ func NewRevokeOAuth2TokensForApplicationCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "revokeOAuth2TokensForApplication",
 		Long: "Revoke all application Tokens",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.apps.manage",
+			destructiveAnnotation: "/api/v1/apps/{appId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationTokensAPI.RevokeOAuth2TokensForApplication(apiClient.GetConfig().Context, RevokeOAuth2TokensForApplicationappId)
+			req := apiClient.ApplicationTokensAPI.RevokeOAuth2TokensForApplication(cmd.Context(), RevokeOAuth2TokensForApplicationappId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -106,12 +121,15 @@ func NewGetOAuth2TokenForApplicationCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getOAuth2TokenForApplication",
 		Long: "Retrieve an application Token",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.apps.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationTokensAPI.GetOAuth2TokenForApplication(apiClient.GetConfig().Context, GetOAuth2TokenForApplicationappId, GetOAuth2TokenForApplicationtokenId)
+			req := apiClient.ApplicationTokensAPI.GetOAuth2TokenForApplication(cmd.Context(), GetOAuth2TokenForApplicationappId, GetOAuth2TokenForApplicationtokenId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -153,12 +171,16 @@ func NewRevokeOAuth2TokenForApplicationCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "revokeOAuth2TokenForApplication",
 		Long: "Revoke an application Token",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.apps.manage",
+			destructiveAnnotation: "/api/v1/apps/{appId}/tokens/{tokenId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationTokensAPI.RevokeOAuth2TokenForApplication(apiClient.GetConfig().Context, RevokeOAuth2TokenForApplicationappId, RevokeOAuth2TokenForApplicationtokenId)
+			req := apiClient.ApplicationTokensAPI.RevokeOAuth2TokenForApplication(cmd.Context(), RevokeOAuth2TokenForApplicationappId, RevokeOAuth2TokenForApplicationtokenId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -26,8 +26,11 @@ func NewAssignUserToApplicationCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "assignUserToApplication",
 		Long: "Assign an Application User",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.apps.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationUsersAPI.AssignUserToApplication(apiClient.GetConfig().Context, AssignUserToApplicationappId)
+			req := apiClient.ApplicationUsersAPI.AssignUserToApplication(cmd.Context(), AssignUserToApplicationappId)
 
 			if AssignUserToApplicationdata != "" {
 				req = req.Data(AssignUserToApplicationdata)
@@ -35,7 +38,7 @@ func NewAssignUserToApplicationCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -69,16 +72,25 @@ func init() {
 
 var ListApplicationUsersappId string
 
+var ListApplicationUsersall bool
+
 func NewListApplicationUsersCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "list",
 		Long: "List all Application Users",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.apps.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationUsersAPI.ListApplicationUsers(apiClient.GetConfig().Context, ListApplicationUsersappId)
+			req := apiClient.ApplicationUsersAPI.ListApplicationUsers(cmd.Context(), ListApplicationUsersappId)
+
+			if ListApplicationUsersall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -99,6 +111,8 @@ func NewListApplicationUsersCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListApplicationUsersappId, "appId", "", "", "")
 	cmd.MarkFlagRequired("appId")
 
+	allFlag.RegisterBool(cmd, &ListApplicationUsersall, false)
+
 	return cmd
 }
 
@@ -119,8 +133,11 @@ func NewUpdateApplicationUserCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "updateApplicationUser",
 		Long: "Update an Application User",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.apps.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationUsersAPI.UpdateApplicationUser(apiClient.GetConfig().Context, UpdateApplicationUserappId, UpdateApplicationUseruserId)
+			req := apiClient.ApplicationUsersAPI.UpdateApplicationUser(cmd.Context(), UpdateApplicationUserappId, UpdateApplicationUseruserId)
 
 			if UpdateApplicationUserdata != "" {
 				req = req.Data(UpdateApplicationUserdata)
@@ -128,7 +145,7 @@ func NewUpdateApplicationUserCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -173,12 +190,15 @@ func NewGetApplicationUserCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getApplicationUser",
 		Long: "Retrieve an Application User",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.apps.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationUsersAPI.GetApplicationUser(apiClient.GetConfig().Context, GetApplicationUserappId, GetApplicationUseruserId)
+			req := apiClient.ApplicationUsersAPI.GetApplicationUser(cmd.Context(), GetApplicationUserappId, GetApplicationUseruserId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -220,12 +240,16 @@ func NewUnassignUserFromApplicationCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "unassignUserFromApplication",
 		Long: "Unassign an Application User",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.apps.manage",
+			destructiveAnnotation: "/api/v1/apps/{appId}/users/{userId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ApplicationUsersAPI.UnassignUserFromApplication(apiClient.GetConfig().Context, UnassignUserFromApplicationappId, UnassignUserFromApplicationuserId)
+			req := apiClient.ApplicationUsersAPI.UnassignUserFromApplication(cmd.Context(), UnassignUserFromApplicationappId, UnassignUserFromApplicationuserId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -16,16 +16,25 @@ func init() {
 	rootCmd.AddCommand(AttackProtectionCmd)
 }
 
+var GetAuthenticatorSettingsall bool
+
 func NewGetAuthenticatorSettingsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getAuthenticatorSettings",
 		Long: "Retrieve the Authenticator Settings",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.orgs.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AttackProtectionAPI.GetAuthenticatorSettings(apiClient.GetConfig().Context)
+			req := apiClient.AttackProtectionAPI.GetAuthenticatorSettings(cmd.Context())
+
+			if GetAuthenticatorSettingsall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -43,6 +52,8 @@ func NewGetAuthenticatorSettingsCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &GetAuthenticatorSettingsall, false)
+
 	return cmd
 }
 
@@ -57,8 +68,11 @@ func NewReplaceAuthenticatorSettingsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replaceAuthenticatorSettings",
 		Long: "Replace the Authenticator Settings",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.orgs.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AttackProtectionAPI.ReplaceAuthenticatorSettings(apiClient.GetConfig().Context)
+			req := apiClient.AttackProtectionAPI.ReplaceAuthenticatorSettings(cmd.Context())
 
 			if ReplaceAuthenticatorSettingsdata != "" {
 				req = req.Data(ReplaceAuthenticatorSettingsdata)
@@ -66,7 +80,7 @@ func NewReplaceAuthenticatorSettingsCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -95,16 +109,25 @@ func init() {
 	AttackProtectionCmd.AddCommand(ReplaceAuthenticatorSettingsCmd)
 }
 
+var GetUserLockoutSettingsall bool
+
 func NewGetUserLockoutSettingsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getUserLockoutSettings",
 		Long: "Retrieve the User Lockout Settings",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.orgs.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AttackProtectionAPI.GetUserLockoutSettings(apiClient.GetConfig().Context)
+			req := apiClient.AttackProtectionAPI.GetUserLockoutSettings(cmd.Context())
+
+			if GetUserLockoutSettingsall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -122,6 +145,8 @@ func NewGetUserLockoutSettingsCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &GetUserLockoutSettingsall, false)
+
 	return cmd
 }
 
@@ -136,8 +161,11 @@ func NewReplaceUserLockoutSettingsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replaceUserLockoutSettings",
 		Long: "Replace the User Lockout Settings",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.orgs.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AttackProtectionAPI.ReplaceUserLockoutSettings(apiClient.GetConfig().Context)
+			req := apiClient.AttackProtectionAPI.ReplaceUserLockoutSettings(cmd.Context())
 
 			if ReplaceUserLockoutSettingsdata != "" {
 				req = req.Data(ReplaceUserLockoutSettingsdata)
@@ -145,7 +173,7 @@ func NewReplaceUserLockoutSettingsCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
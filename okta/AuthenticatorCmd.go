@@ -16,16 +16,22 @@ func init() {
 	rootCmd.AddCommand(AuthenticatorCmd)
 }
 
+var GetWellKnownAppAuthenticatorConfigurationall bool
+
 func NewGetWellKnownAppAuthenticatorConfigurationCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getWellKnownAppConfiguration",
 		Long: "Retrieve the Well-Known App Authenticator Configuration",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthenticatorAPI.GetWellKnownAppAuthenticatorConfiguration(apiClient.GetConfig().Context)
+			req := apiClient.AuthenticatorAPI.GetWellKnownAppAuthenticatorConfiguration(cmd.Context())
+
+			if GetWellKnownAppAuthenticatorConfigurationall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -43,6 +49,8 @@ func NewGetWellKnownAppAuthenticatorConfigurationCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &GetWellKnownAppAuthenticatorConfigurationall, false)
+
 	return cmd
 }
 
@@ -57,8 +65,11 @@ func NewCreateAuthenticatorCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "create",
 		Long: "Create an Authenticator",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.authenticators.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthenticatorAPI.CreateAuthenticator(apiClient.GetConfig().Context)
+			req := apiClient.AuthenticatorAPI.CreateAuthenticator(cmd.Context())
 
 			if CreateAuthenticatordata != "" {
 				req = req.Data(CreateAuthenticatordata)
@@ -66,7 +77,7 @@ func NewCreateAuthenticatorCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -95,16 +106,25 @@ func init() {
 	AuthenticatorCmd.AddCommand(CreateAuthenticatorCmd)
 }
 
+var ListAuthenticatorsall bool
+
 func NewListAuthenticatorsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "lists",
 		Long: "List all Authenticators",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.authenticators.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthenticatorAPI.ListAuthenticators(apiClient.GetConfig().Context)
+			req := apiClient.AuthenticatorAPI.ListAuthenticators(cmd.Context())
+
+			if ListAuthenticatorsall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -122,6 +142,8 @@ func NewListAuthenticatorsCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &ListAuthenticatorsall, false)
+
 	return cmd
 }
 
@@ -136,12 +158,15 @@ func NewGetAuthenticatorCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "get",
 		Long: "Retrieve an Authenticator",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.authenticators.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthenticatorAPI.GetAuthenticator(apiClient.GetConfig().Context, GetAuthenticatorauthenticatorId)
+			req := apiClient.AuthenticatorAPI.GetAuthenticator(cmd.Context(), GetAuthenticatorauthenticatorId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -180,8 +205,11 @@ func NewReplaceAuthenticatorCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replace",
 		Long: "Replace an Authenticator",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.authenticators.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthenticatorAPI.ReplaceAuthenticator(apiClient.GetConfig().Context, ReplaceAuthenticatorauthenticatorId)
+			req := apiClient.AuthenticatorAPI.ReplaceAuthenticator(cmd.Context(), ReplaceAuthenticatorauthenticatorId)
 
 			if ReplaceAuthenticatordata != "" {
 				req = req.Data(ReplaceAuthenticatordata)
@@ -189,7 +217,7 @@ func NewReplaceAuthenticatorCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -227,12 +255,15 @@ func NewActivateAuthenticatorCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "activate",
 		Long: "Activate an Authenticator",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.authenticators.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthenticatorAPI.ActivateAuthenticator(apiClient.GetConfig().Context, ActivateAuthenticatorauthenticatorId)
+			req := apiClient.AuthenticatorAPI.ActivateAuthenticator(cmd.Context(), ActivateAuthenticatorauthenticatorId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -267,12 +298,16 @@ func NewDeactivateAuthenticatorCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deactivate",
 		Long: "Deactivate an Authenticator",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.authenticators.manage",
+			destructiveAnnotation: "/api/v1/authenticators/{authenticatorId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthenticatorAPI.DeactivateAuthenticator(apiClient.GetConfig().Context, DeactivateAuthenticatorauthenticatorId)
+			req := apiClient.AuthenticatorAPI.DeactivateAuthenticator(cmd.Context(), DeactivateAuthenticatorauthenticatorId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -303,16 +338,25 @@ func init() {
 
 var ListAuthenticatorMethodsauthenticatorId string
 
+var ListAuthenticatorMethodsall bool
+
 func NewListAuthenticatorMethodsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listMethods",
 		Long: "List all Methods of an Authenticator",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.authenticators.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthenticatorAPI.ListAuthenticatorMethods(apiClient.GetConfig().Context, ListAuthenticatorMethodsauthenticatorId)
+			req := apiClient.AuthenticatorAPI.ListAuthenticatorMethods(cmd.Context(), ListAuthenticatorMethodsauthenticatorId)
+
+			if ListAuthenticatorMethodsall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -333,6 +377,8 @@ func NewListAuthenticatorMethodsCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListAuthenticatorMethodsauthenticatorId, "authenticatorId", "", "", "")
 	cmd.MarkFlagRequired("authenticatorId")
 
+	allFlag.RegisterBool(cmd, &ListAuthenticatorMethodsall, false)
+
 	return cmd
 }
 
@@ -351,12 +397,15 @@ func NewGetAuthenticatorMethodCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getMethod",
 		Long: "Retrieve a Method",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.authenticators.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthenticatorAPI.GetAuthenticatorMethod(apiClient.GetConfig().Context, GetAuthenticatorMethodauthenticatorId, GetAuthenticatorMethodmethodType)
+			req := apiClient.AuthenticatorAPI.GetAuthenticatorMethod(cmd.Context(), GetAuthenticatorMethodauthenticatorId, GetAuthenticatorMethodmethodType)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -400,8 +449,11 @@ func NewReplaceAuthenticatorMethodCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replaceMethod",
 		Long: "Replace a Method",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.authenticators.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthenticatorAPI.ReplaceAuthenticatorMethod(apiClient.GetConfig().Context, ReplaceAuthenticatorMethodauthenticatorId, ReplaceAuthenticatorMethodmethodType)
+			req := apiClient.AuthenticatorAPI.ReplaceAuthenticatorMethod(cmd.Context(), ReplaceAuthenticatorMethodauthenticatorId, ReplaceAuthenticatorMethodmethodType)
 
 			if ReplaceAuthenticatorMethoddata != "" {
 				req = req.Data(ReplaceAuthenticatorMethoddata)
@@ -409,7 +461,7 @@ func NewReplaceAuthenticatorMethodCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -454,12 +506,15 @@ func NewActivateAuthenticatorMethodCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "activateMethod",
 		Long: "Activate an Authenticator Method",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.authenticators.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthenticatorAPI.ActivateAuthenticatorMethod(apiClient.GetConfig().Context, ActivateAuthenticatorMethodauthenticatorId, ActivateAuthenticatorMethodmethodType)
+			req := apiClient.AuthenticatorAPI.ActivateAuthenticatorMethod(cmd.Context(), ActivateAuthenticatorMethodauthenticatorId, ActivateAuthenticatorMethodmethodType)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -501,12 +556,16 @@ func NewDeactivateAuthenticatorMethodCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deactivateMethod",
 		Long: "Deactivate an Authenticator Method",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.authenticators.manage",
+			destructiveAnnotation: "/api/v1/authenticators/{authenticatorId}/methods/{methodType}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthenticatorAPI.DeactivateAuthenticatorMethod(apiClient.GetConfig().Context, DeactivateAuthenticatorMethodauthenticatorId, DeactivateAuthenticatorMethodmethodType)
+			req := apiClient.AuthenticatorAPI.DeactivateAuthenticatorMethod(cmd.Context(), DeactivateAuthenticatorMethodauthenticatorId, DeactivateAuthenticatorMethodmethodType)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -26,8 +26,11 @@ func NewCreateAssociatedServersCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "createAssociatedServers",
 		Long: "Create an associated Authorization Server",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.authorizationServers.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthorizationServerAssocAPI.CreateAssociatedServers(apiClient.GetConfig().Context, CreateAssociatedServersauthServerId)
+			req := apiClient.AuthorizationServerAssocAPI.CreateAssociatedServers(cmd.Context(), CreateAssociatedServersauthServerId)
 
 			if CreateAssociatedServersdata != "" {
 				req = req.Data(CreateAssociatedServersdata)
@@ -35,7 +38,7 @@ func NewCreateAssociatedServersCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -69,16 +72,25 @@ func init() {
 
 var ListAssociatedServersByTrustedTypeauthServerId string
 
+var ListAssociatedServersByTrustedTypeall bool
+
 func NewListAssociatedServersByTrustedTypeCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listAssociatedServersByTrustedType",
 		Long: "List all associated Authorization Servers",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.authorizationServers.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthorizationServerAssocAPI.ListAssociatedServersByTrustedType(apiClient.GetConfig().Context, ListAssociatedServersByTrustedTypeauthServerId)
+			req := apiClient.AuthorizationServerAssocAPI.ListAssociatedServersByTrustedType(cmd.Context(), ListAssociatedServersByTrustedTypeauthServerId)
+
+			if ListAssociatedServersByTrustedTypeall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -99,6 +111,8 @@ func NewListAssociatedServersByTrustedTypeCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListAssociatedServersByTrustedTypeauthServerId, "authServerId", "", "", "")
 	cmd.MarkFlagRequired("authServerId")
 
+	allFlag.RegisterBool(cmd, &ListAssociatedServersByTrustedTypeall, false)
+
 	return cmd
 }
 
@@ -117,12 +131,16 @@ func NewDeleteAssociatedServerCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deleteAssociatedServer",
 		Long: "Delete an associated Authorization Server",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.authorizationServers.manage",
+			destructiveAnnotation: "/api/v1/authorizationServers/{authServerId}/associatedServers/{associatedServerId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthorizationServerAssocAPI.DeleteAssociatedServer(apiClient.GetConfig().Context, DeleteAssociatedServerauthServerId, DeleteAssociatedServerassociatedServerId)
+			req := apiClient.AuthorizationServerAssocAPI.DeleteAssociatedServer(cmd.Context(), DeleteAssociatedServerauthServerId, DeleteAssociatedServerassociatedServerId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -26,8 +26,11 @@ func NewCreateOAuth2ClaimCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "createOAuth2Claim",
 		Long: "Create a custom token Claim",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.authorizationServers.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthorizationServerClaimsAPI.CreateOAuth2Claim(apiClient.GetConfig().Context, CreateOAuth2ClaimauthServerId)
+			req := apiClient.AuthorizationServerClaimsAPI.CreateOAuth2Claim(cmd.Context(), CreateOAuth2ClaimauthServerId)
 
 			if CreateOAuth2Claimdata != "" {
 				req = req.Data(CreateOAuth2Claimdata)
@@ -35,7 +38,7 @@ func NewCreateOAuth2ClaimCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -69,16 +72,25 @@ func init() {
 
 var ListOAuth2ClaimsauthServerId string
 
+var ListOAuth2Claimsall bool
+
 func NewListOAuth2ClaimsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listOAuth2Claims",
 		Long: "List all custom token Claims",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.authorizationServers.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthorizationServerClaimsAPI.ListOAuth2Claims(apiClient.GetConfig().Context, ListOAuth2ClaimsauthServerId)
+			req := apiClient.AuthorizationServerClaimsAPI.ListOAuth2Claims(cmd.Context(), ListOAuth2ClaimsauthServerId)
+
+			if ListOAuth2Claimsall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -99,6 +111,8 @@ func NewListOAuth2ClaimsCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListOAuth2ClaimsauthServerId, "authServerId", "", "", "")
 	cmd.MarkFlagRequired("authServerId")
 
+	allFlag.RegisterBool(cmd, &ListOAuth2Claimsall, false)
+
 	return cmd
 }
 
@@ -117,12 +131,15 @@ func NewGetOAuth2ClaimCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getOAuth2Claim",
 		Long: "Retrieve a custom token Claim",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.authorizationServers.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthorizationServerClaimsAPI.GetOAuth2Claim(apiClient.GetConfig().Context, GetOAuth2ClaimauthServerId, GetOAuth2ClaimclaimId)
+			req := apiClient.AuthorizationServerClaimsAPI.GetOAuth2Claim(cmd.Context(), GetOAuth2ClaimauthServerId, GetOAuth2ClaimclaimId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -166,8 +183,11 @@ func NewReplaceOAuth2ClaimCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replaceOAuth2Claim",
 		Long: "Replace a custom token Claim",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.authorizationServers.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthorizationServerClaimsAPI.ReplaceOAuth2Claim(apiClient.GetConfig().Context, ReplaceOAuth2ClaimauthServerId, ReplaceOAuth2ClaimclaimId)
+			req := apiClient.AuthorizationServerClaimsAPI.ReplaceOAuth2Claim(cmd.Context(), ReplaceOAuth2ClaimauthServerId, ReplaceOAuth2ClaimclaimId)
 
 			if ReplaceOAuth2Claimdata != "" {
 				req = req.Data(ReplaceOAuth2Claimdata)
@@ -175,7 +195,7 @@ func NewReplaceOAuth2ClaimCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -220,12 +240,16 @@ func NewDeleteOAuth2ClaimCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deleteOAuth2Claim",
 		Long: "Delete a custom token Claim",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.authorizationServers.manage",
+			destructiveAnnotation: "/api/v1/authorizationServers/{authServerId}/claims/{claimId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthorizationServerClaimsAPI.DeleteOAuth2Claim(apiClient.GetConfig().Context, DeleteOAuth2ClaimauthServerId, DeleteOAuth2ClaimclaimId)
+			req := apiClient.AuthorizationServerClaimsAPI.DeleteOAuth2Claim(cmd.Context(), DeleteOAuth2ClaimauthServerId, DeleteOAuth2ClaimclaimId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
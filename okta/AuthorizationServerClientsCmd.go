@@ -18,16 +18,25 @@ func init() {
 
 var ListOAuth2ClientsForAuthorizationServerauthServerId string
 
+var ListOAuth2ClientsForAuthorizationServerall bool
+
 func NewListOAuth2ClientsForAuthorizationServerCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listOAuth2ClientsForAuthorizationServer",
 		Long: "List all Client resources for an authorization server",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.authorizationServers.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthorizationServerClientsAPI.ListOAuth2ClientsForAuthorizationServer(apiClient.GetConfig().Context, ListOAuth2ClientsForAuthorizationServerauthServerId)
+			req := apiClient.AuthorizationServerClientsAPI.ListOAuth2ClientsForAuthorizationServer(cmd.Context(), ListOAuth2ClientsForAuthorizationServerauthServerId)
+
+			if ListOAuth2ClientsForAuthorizationServerall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -48,6 +57,8 @@ func NewListOAuth2ClientsForAuthorizationServerCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListOAuth2ClientsForAuthorizationServerauthServerId, "authServerId", "", "", "")
 	cmd.MarkFlagRequired("authServerId")
 
+	allFlag.RegisterBool(cmd, &ListOAuth2ClientsForAuthorizationServerall, false)
+
 	return cmd
 }
 
@@ -62,16 +73,25 @@ var (
 	ListRefreshTokensForAuthorizationServerAndClientclientId string
 )
 
+var ListRefreshTokensForAuthorizationServerAndClientall bool
+
 func NewListRefreshTokensForAuthorizationServerAndClientCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listRefreshTokensForAuthorizationServerAndClient",
 		Long: "List all refresh tokens for a Client",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.authorizationServers.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthorizationServerClientsAPI.ListRefreshTokensForAuthorizationServerAndClient(apiClient.GetConfig().Context, ListRefreshTokensForAuthorizationServerAndClientauthServerId, ListRefreshTokensForAuthorizationServerAndClientclientId)
+			req := apiClient.AuthorizationServerClientsAPI.ListRefreshTokensForAuthorizationServerAndClient(cmd.Context(), ListRefreshTokensForAuthorizationServerAndClientauthServerId, ListRefreshTokensForAuthorizationServerAndClientclientId)
+
+			if ListRefreshTokensForAuthorizationServerAndClientall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -95,6 +115,8 @@ func NewListRefreshTokensForAuthorizationServerAndClientCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListRefreshTokensForAuthorizationServerAndClientclientId, "clientId", "", "", "")
 	cmd.MarkFlagRequired("clientId")
 
+	allFlag.RegisterBool(cmd, &ListRefreshTokensForAuthorizationServerAndClientall, false)
+
 	return cmd
 }
 
@@ -113,12 +135,16 @@ func NewRevokeRefreshTokensForAuthorizationServerAndClientCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "revokeRefreshTokensForAuthorizationServerAndClient",
 		Long: "Revoke all refresh tokens for a Client",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.authorizationServers.manage",
+			destructiveAnnotation: "/api/v1/authorizationServers/{authServerId}/clients/{clientId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthorizationServerClientsAPI.RevokeRefreshTokensForAuthorizationServerAndClient(apiClient.GetConfig().Context, RevokeRefreshTokensForAuthorizationServerAndClientauthServerId, RevokeRefreshTokensForAuthorizationServerAndClientclientId)
+			req := apiClient.AuthorizationServerClientsAPI.RevokeRefreshTokensForAuthorizationServerAndClient(cmd.Context(), RevokeRefreshTokensForAuthorizationServerAndClientauthServerId, RevokeRefreshTokensForAuthorizationServerAndClientclientId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -162,12 +188,15 @@ func NewGetRefreshTokenForAuthorizationServerAndClientCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getRefreshTokenForAuthorizationServerAndClient",
 		Long: "Retrieve a refresh token for a Client",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.authorizationServers.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthorizationServerClientsAPI.GetRefreshTokenForAuthorizationServerAndClient(apiClient.GetConfig().Context, GetRefreshTokenForAuthorizationServerAndClientauthServerId, GetRefreshTokenForAuthorizationServerAndClientclientId, GetRefreshTokenForAuthorizationServerAndClienttokenId)
+			req := apiClient.AuthorizationServerClientsAPI.GetRefreshTokenForAuthorizationServerAndClient(cmd.Context(), GetRefreshTokenForAuthorizationServerAndClientauthServerId, GetRefreshTokenForAuthorizationServerAndClientclientId, GetRefreshTokenForAuthorizationServerAndClienttokenId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -214,12 +243,16 @@ func NewRevokeRefreshTokenForAuthorizationServerAndClientCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "revokeRefreshTokenForAuthorizationServerAndClient",
 		Long: "Revoke a refresh token for a Client",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.authorizationServers.manage",
+			destructiveAnnotation: "/api/v1/authorizationServers/{authServerId}/clients/{clientId}/tokens/{tokenId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthorizationServerClientsAPI.RevokeRefreshTokenForAuthorizationServerAndClient(apiClient.GetConfig().Context, RevokeRefreshTokenForAuthorizationServerAndClientauthServerId, RevokeRefreshTokenForAuthorizationServerAndClientclientId, RevokeRefreshTokenForAuthorizationServerAndClienttokenId)
+			req := apiClient.AuthorizationServerClientsAPI.RevokeRefreshTokenForAuthorizationServerAndClient(cmd.Context(), RevokeRefreshTokenForAuthorizationServerAndClientauthServerId, RevokeRefreshTokenForAuthorizationServerAndClientclientId, RevokeRefreshTokenForAuthorizationServerAndClienttokenId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
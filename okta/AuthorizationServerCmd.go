@@ -22,8 +22,11 @@ func NewCreateAuthorizationServerCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "create",
 		Long: "Create an Authorization Server",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.authorizationServers.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthorizationServerAPI.CreateAuthorizationServer(apiClient.GetConfig().Context)
+			req := apiClient.AuthorizationServerAPI.CreateAuthorizationServer(cmd.Context())
 
 			if CreateAuthorizationServerdata != "" {
 				req = req.Data(CreateAuthorizationServerdata)
@@ -31,7 +34,7 @@ func NewCreateAuthorizationServerCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -60,16 +63,25 @@ func init() {
 	AuthorizationServerCmd.AddCommand(CreateAuthorizationServerCmd)
 }
 
+var ListAuthorizationServersall bool
+
 func NewListAuthorizationServersCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "lists",
 		Long: "List all Authorization Servers",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.authorizationServers.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthorizationServerAPI.ListAuthorizationServers(apiClient.GetConfig().Context)
+			req := apiClient.AuthorizationServerAPI.ListAuthorizationServers(cmd.Context())
+
+			if ListAuthorizationServersall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -87,6 +99,8 @@ func NewListAuthorizationServersCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &ListAuthorizationServersall, false)
+
 	return cmd
 }
 
@@ -101,12 +115,15 @@ func NewGetAuthorizationServerCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "get",
 		Long: "Retrieve an Authorization Server",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.authorizationServers.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthorizationServerAPI.GetAuthorizationServer(apiClient.GetConfig().Context, GetAuthorizationServerauthServerId)
+			req := apiClient.AuthorizationServerAPI.GetAuthorizationServer(cmd.Context(), GetAuthorizationServerauthServerId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -145,8 +162,11 @@ func NewReplaceAuthorizationServerCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replace",
 		Long: "Replace an Authorization Server",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.authorizationServers.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthorizationServerAPI.ReplaceAuthorizationServer(apiClient.GetConfig().Context, ReplaceAuthorizationServerauthServerId)
+			req := apiClient.AuthorizationServerAPI.ReplaceAuthorizationServer(cmd.Context(), ReplaceAuthorizationServerauthServerId)
 
 			if ReplaceAuthorizationServerdata != "" {
 				req = req.Data(ReplaceAuthorizationServerdata)
@@ -154,7 +174,7 @@ func NewReplaceAuthorizationServerCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -192,12 +212,16 @@ func NewDeleteAuthorizationServerCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "delete",
 		Long: "Delete an Authorization Server",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.authorizationServers.manage",
+			destructiveAnnotation: "/api/v1/authorizationServers/{authServerId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthorizationServerAPI.DeleteAuthorizationServer(apiClient.GetConfig().Context, DeleteAuthorizationServerauthServerId)
+			req := apiClient.AuthorizationServerAPI.DeleteAuthorizationServer(cmd.Context(), DeleteAuthorizationServerauthServerId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -232,12 +256,15 @@ func NewActivateAuthorizationServerCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "activate",
 		Long: "Activate an Authorization Server",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.authorizationServers.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthorizationServerAPI.ActivateAuthorizationServer(apiClient.GetConfig().Context, ActivateAuthorizationServerauthServerId)
+			req := apiClient.AuthorizationServerAPI.ActivateAuthorizationServer(cmd.Context(), ActivateAuthorizationServerauthServerId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -272,12 +299,16 @@ func NewDeactivateAuthorizationServerCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deactivate",
 		Long: "Deactivate an Authorization Server",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.authorizationServers.manage",
+			destructiveAnnotation: "/api/v1/authorizationServers/{authServerId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthorizationServerAPI.DeactivateAuthorizationServer(apiClient.GetConfig().Context, DeactivateAuthorizationServerauthServerId)
+			req := apiClient.AuthorizationServerAPI.DeactivateAuthorizationServer(cmd.Context(), DeactivateAuthorizationServerauthServerId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
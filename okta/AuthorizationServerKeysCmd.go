@@ -18,16 +18,25 @@ func init() {
 
 var ListAuthorizationServerKeysauthServerId string
 
+var ListAuthorizationServerKeysall bool
+
 func NewListAuthorizationServerKeysCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "list",
 		Long: "List all Credential Keys",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.authorizationServers.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthorizationServerKeysAPI.ListAuthorizationServerKeys(apiClient.GetConfig().Context, ListAuthorizationServerKeysauthServerId)
+			req := apiClient.AuthorizationServerKeysAPI.ListAuthorizationServerKeys(cmd.Context(), ListAuthorizationServerKeysauthServerId)
+
+			if ListAuthorizationServerKeysall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -48,6 +57,8 @@ func NewListAuthorizationServerKeysCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListAuthorizationServerKeysauthServerId, "authServerId", "", "", "")
 	cmd.MarkFlagRequired("authServerId")
 
+	allFlag.RegisterBool(cmd, &ListAuthorizationServerKeysall, false)
+
 	return cmd
 }
 
@@ -66,8 +77,12 @@ func NewRotateAuthorizationServerKeysCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "rotate",
 		Long: "Rotate all Credential Keys",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.authorizationServers.manage",
+			destructiveAnnotation: "/api/v1/authorizationServers/{authServerId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthorizationServerKeysAPI.RotateAuthorizationServerKeys(apiClient.GetConfig().Context, RotateAuthorizationServerKeysauthServerId)
+			req := apiClient.AuthorizationServerKeysAPI.RotateAuthorizationServerKeys(cmd.Context(), RotateAuthorizationServerKeysauthServerId)
 
 			if RotateAuthorizationServerKeysdata != "" {
 				req = req.Data(RotateAuthorizationServerKeysdata)
@@ -75,7 +90,7 @@ func NewRotateAuthorizationServerKeysCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
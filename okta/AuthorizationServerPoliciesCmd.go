@@ -26,8 +26,11 @@ func NewCreateAuthorizationServerPolicyCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "createAuthorizationServerPolicy",
 		Long: "Create a Policy",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.authorizationServers.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthorizationServerPoliciesAPI.CreateAuthorizationServerPolicy(apiClient.GetConfig().Context, CreateAuthorizationServerPolicyauthServerId)
+			req := apiClient.AuthorizationServerPoliciesAPI.CreateAuthorizationServerPolicy(cmd.Context(), CreateAuthorizationServerPolicyauthServerId)
 
 			if CreateAuthorizationServerPolicydata != "" {
 				req = req.Data(CreateAuthorizationServerPolicydata)
@@ -35,7 +38,7 @@ func NewCreateAuthorizationServerPolicyCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -69,16 +72,25 @@ func init() {
 
 var ListAuthorizationServerPoliciesauthServerId string
 
+var ListAuthorizationServerPoliciesall bool
+
 func NewListAuthorizationServerPoliciesCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "list",
 		Long: "List all Policies",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.authorizationServers.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthorizationServerPoliciesAPI.ListAuthorizationServerPolicies(apiClient.GetConfig().Context, ListAuthorizationServerPoliciesauthServerId)
+			req := apiClient.AuthorizationServerPoliciesAPI.ListAuthorizationServerPolicies(cmd.Context(), ListAuthorizationServerPoliciesauthServerId)
+
+			if ListAuthorizationServerPoliciesall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -99,6 +111,8 @@ func NewListAuthorizationServerPoliciesCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListAuthorizationServerPoliciesauthServerId, "authServerId", "", "", "")
 	cmd.MarkFlagRequired("authServerId")
 
+	allFlag.RegisterBool(cmd, &ListAuthorizationServerPoliciesall, false)
+
 	return cmd
 }
 
@@ -117,12 +131,15 @@ func NewGetAuthorizationServerPolicyCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getAuthorizationServerPolicy",
 		Long: "Retrieve a Policy",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.authorizationServers.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthorizationServerPoliciesAPI.GetAuthorizationServerPolicy(apiClient.GetConfig().Context, GetAuthorizationServerPolicyauthServerId, GetAuthorizationServerPolicypolicyId)
+			req := apiClient.AuthorizationServerPoliciesAPI.GetAuthorizationServerPolicy(cmd.Context(), GetAuthorizationServerPolicyauthServerId, GetAuthorizationServerPolicypolicyId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -166,8 +183,11 @@ func NewReplaceAuthorizationServerPolicyCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replaceAuthorizationServerPolicy",
 		Long: "Replace a Policy",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.authorizationServers.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthorizationServerPoliciesAPI.ReplaceAuthorizationServerPolicy(apiClient.GetConfig().Context, ReplaceAuthorizationServerPolicyauthServerId, ReplaceAuthorizationServerPolicypolicyId)
+			req := apiClient.AuthorizationServerPoliciesAPI.ReplaceAuthorizationServerPolicy(cmd.Context(), ReplaceAuthorizationServerPolicyauthServerId, ReplaceAuthorizationServerPolicypolicyId)
 
 			if ReplaceAuthorizationServerPolicydata != "" {
 				req = req.Data(ReplaceAuthorizationServerPolicydata)
@@ -175,7 +195,7 @@ func NewReplaceAuthorizationServerPolicyCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -220,12 +240,16 @@ func NewDeleteAuthorizationServerPolicyCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deleteAuthorizationServerPolicy",
 		Long: "Delete a Policy",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.authorizationServers.manage",
+			destructiveAnnotation: "/api/v1/authorizationServers/{authServerId}/policies/{policyId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthorizationServerPoliciesAPI.DeleteAuthorizationServerPolicy(apiClient.GetConfig().Context, DeleteAuthorizationServerPolicyauthServerId, DeleteAuthorizationServerPolicypolicyId)
+			req := apiClient.AuthorizationServerPoliciesAPI.DeleteAuthorizationServerPolicy(cmd.Context(), DeleteAuthorizationServerPolicyauthServerId, DeleteAuthorizationServerPolicypolicyId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -267,12 +291,15 @@ func NewActivateAuthorizationServerPolicyCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "activateAuthorizationServerPolicy",
 		Long: "Activate a Policy",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.authorizationServers.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthorizationServerPoliciesAPI.ActivateAuthorizationServerPolicy(apiClient.GetConfig().Context, ActivateAuthorizationServerPolicyauthServerId, ActivateAuthorizationServerPolicypolicyId)
+			req := apiClient.AuthorizationServerPoliciesAPI.ActivateAuthorizationServerPolicy(cmd.Context(), ActivateAuthorizationServerPolicyauthServerId, ActivateAuthorizationServerPolicypolicyId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -314,12 +341,16 @@ func NewDeactivateAuthorizationServerPolicyCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deactivateAuthorizationServerPolicy",
 		Long: "Deactivate a Policy",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.authorizationServers.manage",
+			destructiveAnnotation: "/api/v1/authorizationServers/{authServerId}/policies/{policyId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthorizationServerPoliciesAPI.DeactivateAuthorizationServerPolicy(apiClient.GetConfig().Context, DeactivateAuthorizationServerPolicyauthServerId, DeactivateAuthorizationServerPolicypolicyId)
+			req := apiClient.AuthorizationServerPoliciesAPI.DeactivateAuthorizationServerPolicy(cmd.Context(), DeactivateAuthorizationServerPolicyauthServerId, DeactivateAuthorizationServerPolicypolicyId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -28,8 +28,11 @@ func NewCreateAuthorizationServerPolicyRuleCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "createAuthorizationServerPolicyRule",
 		Long: "Create a Policy Rule",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.authorizationServers.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthorizationServerRulesAPI.CreateAuthorizationServerPolicyRule(apiClient.GetConfig().Context, CreateAuthorizationServerPolicyRuleauthServerId, CreateAuthorizationServerPolicyRulepolicyId)
+			req := apiClient.AuthorizationServerRulesAPI.CreateAuthorizationServerPolicyRule(cmd.Context(), CreateAuthorizationServerPolicyRuleauthServerId, CreateAuthorizationServerPolicyRulepolicyId)
 
 			if CreateAuthorizationServerPolicyRuledata != "" {
 				req = req.Data(CreateAuthorizationServerPolicyRuledata)
@@ -37,7 +40,7 @@ func NewCreateAuthorizationServerPolicyRuleCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -78,16 +81,25 @@ var (
 	ListAuthorizationServerPolicyRulespolicyId string
 )
 
+var ListAuthorizationServerPolicyRulesall bool
+
 func NewListAuthorizationServerPolicyRulesCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listAuthorizationServerPolicyRules",
 		Long: "List all Policy Rules",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.authorizationServers.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthorizationServerRulesAPI.ListAuthorizationServerPolicyRules(apiClient.GetConfig().Context, ListAuthorizationServerPolicyRulesauthServerId, ListAuthorizationServerPolicyRulespolicyId)
+			req := apiClient.AuthorizationServerRulesAPI.ListAuthorizationServerPolicyRules(cmd.Context(), ListAuthorizationServerPolicyRulesauthServerId, ListAuthorizationServerPolicyRulespolicyId)
+
+			if ListAuthorizationServerPolicyRulesall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -111,6 +123,8 @@ func NewListAuthorizationServerPolicyRulesCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListAuthorizationServerPolicyRulespolicyId, "policyId", "", "", "")
 	cmd.MarkFlagRequired("policyId")
 
+	allFlag.RegisterBool(cmd, &ListAuthorizationServerPolicyRulesall, false)
+
 	return cmd
 }
 
@@ -131,12 +145,15 @@ func NewGetAuthorizationServerPolicyRuleCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getAuthorizationServerPolicyRule",
 		Long: "Retrieve a Policy Rule",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.authorizationServers.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthorizationServerRulesAPI.GetAuthorizationServerPolicyRule(apiClient.GetConfig().Context, GetAuthorizationServerPolicyRuleauthServerId, GetAuthorizationServerPolicyRulepolicyId, GetAuthorizationServerPolicyRuleruleId)
+			req := apiClient.AuthorizationServerRulesAPI.GetAuthorizationServerPolicyRule(cmd.Context(), GetAuthorizationServerPolicyRuleauthServerId, GetAuthorizationServerPolicyRulepolicyId, GetAuthorizationServerPolicyRuleruleId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -185,8 +202,11 @@ func NewReplaceAuthorizationServerPolicyRuleCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replaceAuthorizationServerPolicyRule",
 		Long: "Replace a Policy Rule",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.authorizationServers.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthorizationServerRulesAPI.ReplaceAuthorizationServerPolicyRule(apiClient.GetConfig().Context, ReplaceAuthorizationServerPolicyRuleauthServerId, ReplaceAuthorizationServerPolicyRulepolicyId, ReplaceAuthorizationServerPolicyRuleruleId)
+			req := apiClient.AuthorizationServerRulesAPI.ReplaceAuthorizationServerPolicyRule(cmd.Context(), ReplaceAuthorizationServerPolicyRuleauthServerId, ReplaceAuthorizationServerPolicyRulepolicyId, ReplaceAuthorizationServerPolicyRuleruleId)
 
 			if ReplaceAuthorizationServerPolicyRuledata != "" {
 				req = req.Data(ReplaceAuthorizationServerPolicyRuledata)
@@ -194,7 +214,7 @@ func NewReplaceAuthorizationServerPolicyRuleCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -244,12 +264,16 @@ func NewDeleteAuthorizationServerPolicyRuleCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deleteAuthorizationServerPolicyRule",
 		Long: "Delete a Policy Rule",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.authorizationServers.manage",
+			destructiveAnnotation: "/api/v1/authorizationServers/{authServerId}/policies/{policyId}/rules/{ruleId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthorizationServerRulesAPI.DeleteAuthorizationServerPolicyRule(apiClient.GetConfig().Context, DeleteAuthorizationServerPolicyRuleauthServerId, DeleteAuthorizationServerPolicyRulepolicyId, DeleteAuthorizationServerPolicyRuleruleId)
+			req := apiClient.AuthorizationServerRulesAPI.DeleteAuthorizationServerPolicyRule(cmd.Context(), DeleteAuthorizationServerPolicyRuleauthServerId, DeleteAuthorizationServerPolicyRulepolicyId, DeleteAuthorizationServerPolicyRuleruleId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -296,12 +320,15 @@ func NewActivateAuthorizationServerPolicyRuleCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "activateAuthorizationServerPolicyRule",
 		Long: "Activate a Policy Rule",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.authorizationServers.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthorizationServerRulesAPI.ActivateAuthorizationServerPolicyRule(apiClient.GetConfig().Context, ActivateAuthorizationServerPolicyRuleauthServerId, ActivateAuthorizationServerPolicyRulepolicyId, ActivateAuthorizationServerPolicyRuleruleId)
+			req := apiClient.AuthorizationServerRulesAPI.ActivateAuthorizationServerPolicyRule(cmd.Context(), ActivateAuthorizationServerPolicyRuleauthServerId, ActivateAuthorizationServerPolicyRulepolicyId, ActivateAuthorizationServerPolicyRuleruleId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -348,12 +375,16 @@ func NewDeactivateAuthorizationServerPolicyRuleCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deactivateAuthorizationServerPolicyRule",
 		Long: "Deactivate a Policy Rule",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.authorizationServers.manage",
+			destructiveAnnotation: "/api/v1/authorizationServers/{authServerId}/policies/{policyId}/rules/{ruleId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthorizationServerRulesAPI.DeactivateAuthorizationServerPolicyRule(apiClient.GetConfig().Context, DeactivateAuthorizationServerPolicyRuleauthServerId, DeactivateAuthorizationServerPolicyRulepolicyId, DeactivateAuthorizationServerPolicyRuleruleId)
+			req := apiClient.AuthorizationServerRulesAPI.DeactivateAuthorizationServerPolicyRule(cmd.Context(), DeactivateAuthorizationServerPolicyRuleauthServerId, DeactivateAuthorizationServerPolicyRulepolicyId, DeactivateAuthorizationServerPolicyRuleruleId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -26,8 +26,11 @@ func NewCreateOAuth2ScopeCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "createOAuth2Scope",
 		Long: "Create a Custom Token Scope",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.authorizationServers.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthorizationServerScopesAPI.CreateOAuth2Scope(apiClient.GetConfig().Context, CreateOAuth2ScopeauthServerId)
+			req := apiClient.AuthorizationServerScopesAPI.CreateOAuth2Scope(cmd.Context(), CreateOAuth2ScopeauthServerId)
 
 			if CreateOAuth2Scopedata != "" {
 				req = req.Data(CreateOAuth2Scopedata)
@@ -35,7 +38,7 @@ func NewCreateOAuth2ScopeCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -69,16 +72,25 @@ func init() {
 
 var ListOAuth2ScopesauthServerId string
 
+var ListOAuth2Scopesall bool
+
 func NewListOAuth2ScopesCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listOAuth2Scopes",
 		Long: "List all Custom Token Scopes",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.authorizationServers.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthorizationServerScopesAPI.ListOAuth2Scopes(apiClient.GetConfig().Context, ListOAuth2ScopesauthServerId)
+			req := apiClient.AuthorizationServerScopesAPI.ListOAuth2Scopes(cmd.Context(), ListOAuth2ScopesauthServerId)
+
+			if ListOAuth2Scopesall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -99,6 +111,8 @@ func NewListOAuth2ScopesCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListOAuth2ScopesauthServerId, "authServerId", "", "", "")
 	cmd.MarkFlagRequired("authServerId")
 
+	allFlag.RegisterBool(cmd, &ListOAuth2Scopesall, false)
+
 	return cmd
 }
 
@@ -117,12 +131,15 @@ func NewGetOAuth2ScopeCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getOAuth2Scope",
 		Long: "Retrieve a Custom Token Scope",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.authorizationServers.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthorizationServerScopesAPI.GetOAuth2Scope(apiClient.GetConfig().Context, GetOAuth2ScopeauthServerId, GetOAuth2ScopescopeId)
+			req := apiClient.AuthorizationServerScopesAPI.GetOAuth2Scope(cmd.Context(), GetOAuth2ScopeauthServerId, GetOAuth2ScopescopeId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -166,8 +183,11 @@ func NewReplaceOAuth2ScopeCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replaceOAuth2Scope",
 		Long: "Replace a Custom Token Scope",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.authorizationServers.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthorizationServerScopesAPI.ReplaceOAuth2Scope(apiClient.GetConfig().Context, ReplaceOAuth2ScopeauthServerId, ReplaceOAuth2ScopescopeId)
+			req := apiClient.AuthorizationServerScopesAPI.ReplaceOAuth2Scope(cmd.Context(), ReplaceOAuth2ScopeauthServerId, ReplaceOAuth2ScopescopeId)
 
 			if ReplaceOAuth2Scopedata != "" {
 				req = req.Data(ReplaceOAuth2Scopedata)
@@ -175,7 +195,7 @@ func NewReplaceOAuth2ScopeCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -220,12 +240,16 @@ func NewDeleteOAuth2ScopeCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deleteOAuth2Scope",
 		Long: "Delete a Custom Token Scope",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.authorizationServers.manage",
+			destructiveAnnotation: "/api/v1/authorizationServers/{authServerId}/scopes/{scopeId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.AuthorizationServerScopesAPI.DeleteOAuth2Scope(apiClient.GetConfig().Context, DeleteOAuth2ScopeauthServerId, DeleteOAuth2ScopescopeId)
+			req := apiClient.AuthorizationServerScopesAPI.DeleteOAuth2Scope(cmd.Context(), DeleteOAuth2ScopeauthServerId, DeleteOAuth2ScopescopeId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -22,8 +22,11 @@ func NewCreateBehaviorDetectionRuleCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "createDetectionRule",
 		Long: "Create a Behavior Detection Rule",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.behaviors.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.BehaviorAPI.CreateBehaviorDetectionRule(apiClient.GetConfig().Context)
+			req := apiClient.BehaviorAPI.CreateBehaviorDetectionRule(cmd.Context())
 
 			if CreateBehaviorDetectionRuledata != "" {
 				req = req.Data(CreateBehaviorDetectionRuledata)
@@ -31,7 +34,7 @@ func NewCreateBehaviorDetectionRuleCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -60,16 +63,25 @@ func init() {
 	BehaviorCmd.AddCommand(CreateBehaviorDetectionRuleCmd)
 }
 
+var ListBehaviorDetectionRulesall bool
+
 func NewListBehaviorDetectionRulesCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listDetectionRules",
 		Long: "List all Behavior Detection Rules",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.behaviors.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.BehaviorAPI.ListBehaviorDetectionRules(apiClient.GetConfig().Context)
+			req := apiClient.BehaviorAPI.ListBehaviorDetectionRules(cmd.Context())
+
+			if ListBehaviorDetectionRulesall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -87,6 +99,8 @@ func NewListBehaviorDetectionRulesCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &ListBehaviorDetectionRulesall, false)
+
 	return cmd
 }
 
@@ -101,12 +115,15 @@ func NewGetBehaviorDetectionRuleCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getDetectionRule",
 		Long: "Retrieve a Behavior Detection Rule",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.behaviors.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.BehaviorAPI.GetBehaviorDetectionRule(apiClient.GetConfig().Context, GetBehaviorDetectionRulebehaviorId)
+			req := apiClient.BehaviorAPI.GetBehaviorDetectionRule(cmd.Context(), GetBehaviorDetectionRulebehaviorId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -145,8 +162,11 @@ func NewReplaceBehaviorDetectionRuleCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replaceDetectionRule",
 		Long: "Replace a Behavior Detection Rule",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.behaviors.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.BehaviorAPI.ReplaceBehaviorDetectionRule(apiClient.GetConfig().Context, ReplaceBehaviorDetectionRulebehaviorId)
+			req := apiClient.BehaviorAPI.ReplaceBehaviorDetectionRule(cmd.Context(), ReplaceBehaviorDetectionRulebehaviorId)
 
 			if ReplaceBehaviorDetectionRuledata != "" {
 				req = req.Data(ReplaceBehaviorDetectionRuledata)
@@ -154,7 +174,7 @@ func NewReplaceBehaviorDetectionRuleCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -192,12 +212,16 @@ func NewDeleteBehaviorDetectionRuleCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deleteDetectionRule",
 		Long: "Delete a Behavior Detection Rule",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.behaviors.manage",
+			destructiveAnnotation: "/api/v1/behaviors/{behaviorId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.BehaviorAPI.DeleteBehaviorDetectionRule(apiClient.GetConfig().Context, DeleteBehaviorDetectionRulebehaviorId)
+			req := apiClient.BehaviorAPI.DeleteBehaviorDetectionRule(cmd.Context(), DeleteBehaviorDetectionRulebehaviorId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -232,12 +256,15 @@ func NewActivateBehaviorDetectionRuleCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "activateDetectionRule",
 		Long: "Activate a Behavior Detection Rule",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.behaviors.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.BehaviorAPI.ActivateBehaviorDetectionRule(apiClient.GetConfig().Context, ActivateBehaviorDetectionRulebehaviorId)
+			req := apiClient.BehaviorAPI.ActivateBehaviorDetectionRule(cmd.Context(), ActivateBehaviorDetectionRulebehaviorId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -272,12 +299,16 @@ func NewDeactivateBehaviorDetectionRuleCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deactivateDetectionRule",
 		Long: "Deactivate a Behavior Detection Rule",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.behaviors.manage",
+			destructiveAnnotation: "/api/v1/behaviors/{behaviorId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.BehaviorAPI.DeactivateBehaviorDetectionRule(apiClient.GetConfig().Context, DeactivateBehaviorDetectionRulebehaviorId)
+			req := apiClient.BehaviorAPI.DeactivateBehaviorDetectionRule(cmd.Context(), DeactivateBehaviorDetectionRulebehaviorId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -22,8 +22,11 @@ func NewCreateCaptchaInstanceCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "createCaptchaInstance",
 		Long: "Create a CAPTCHA instance",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.captchas.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CAPTCHAAPI.CreateCaptchaInstance(apiClient.GetConfig().Context)
+			req := apiClient.CAPTCHAAPI.CreateCaptchaInstance(cmd.Context())
 
 			if CreateCaptchaInstancedata != "" {
 				req = req.Data(CreateCaptchaInstancedata)
@@ -31,7 +34,7 @@ func NewCreateCaptchaInstanceCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -60,16 +63,25 @@ func init() {
 	CAPTCHACmd.AddCommand(CreateCaptchaInstanceCmd)
 }
 
+var ListCaptchaInstancesall bool
+
 func NewListCaptchaInstancesCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listCaptchaInstances",
 		Long: "List all CAPTCHA Instances",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.captchas.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CAPTCHAAPI.ListCaptchaInstances(apiClient.GetConfig().Context)
+			req := apiClient.CAPTCHAAPI.ListCaptchaInstances(cmd.Context())
+
+			if ListCaptchaInstancesall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -87,6 +99,8 @@ func NewListCaptchaInstancesCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &ListCaptchaInstancesall, false)
+
 	return cmd
 }
 
@@ -105,8 +119,11 @@ func NewUpdateCaptchaInstanceCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "updateCaptchaInstance",
 		Long: "Update a CAPTCHA Instance",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.captchas.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CAPTCHAAPI.UpdateCaptchaInstance(apiClient.GetConfig().Context, UpdateCaptchaInstancecaptchaId)
+			req := apiClient.CAPTCHAAPI.UpdateCaptchaInstance(cmd.Context(), UpdateCaptchaInstancecaptchaId)
 
 			if UpdateCaptchaInstancedata != "" {
 				req = req.Data(UpdateCaptchaInstancedata)
@@ -114,7 +131,7 @@ func NewUpdateCaptchaInstanceCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -152,12 +169,15 @@ func NewGetCaptchaInstanceCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getCaptchaInstance",
 		Long: "Retrieve a CAPTCHA Instance",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.captchas.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CAPTCHAAPI.GetCaptchaInstance(apiClient.GetConfig().Context, GetCaptchaInstancecaptchaId)
+			req := apiClient.CAPTCHAAPI.GetCaptchaInstance(cmd.Context(), GetCaptchaInstancecaptchaId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -196,8 +216,11 @@ func NewReplaceCaptchaInstanceCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replaceCaptchaInstance",
 		Long: "Replace a CAPTCHA Instance",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.captchas.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CAPTCHAAPI.ReplaceCaptchaInstance(apiClient.GetConfig().Context, ReplaceCaptchaInstancecaptchaId)
+			req := apiClient.CAPTCHAAPI.ReplaceCaptchaInstance(cmd.Context(), ReplaceCaptchaInstancecaptchaId)
 
 			if ReplaceCaptchaInstancedata != "" {
 				req = req.Data(ReplaceCaptchaInstancedata)
@@ -205,7 +228,7 @@ func NewReplaceCaptchaInstanceCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -243,12 +266,16 @@ func NewDeleteCaptchaInstanceCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deleteCaptchaInstance",
 		Long: "Delete a CAPTCHA Instance",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.captchas.manage",
+			destructiveAnnotation: "/api/v1/captchas/{captchaId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CAPTCHAAPI.DeleteCaptchaInstance(apiClient.GetConfig().Context, DeleteCaptchaInstancecaptchaId)
+			req := apiClient.CAPTCHAAPI.DeleteCaptchaInstance(cmd.Context(), DeleteCaptchaInstancecaptchaId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -281,12 +308,15 @@ func NewGetOrgCaptchaSettingsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getOrgCaptchaSettings",
 		Long: "Retrieve the Org-wide CAPTCHA Settings",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.captchas.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CAPTCHAAPI.GetOrgCaptchaSettings(apiClient.GetConfig().Context)
+			req := apiClient.CAPTCHAAPI.GetOrgCaptchaSettings(cmd.Context())
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -318,8 +348,11 @@ func NewReplacesOrgCaptchaSettingsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replacesOrgCaptchaSettings",
 		Long: "Replace the Org-wide CAPTCHA Settings",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.captchas.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CAPTCHAAPI.ReplacesOrgCaptchaSettings(apiClient.GetConfig().Context)
+			req := apiClient.CAPTCHAAPI.ReplacesOrgCaptchaSettings(cmd.Context())
 
 			if ReplacesOrgCaptchaSettingsdata != "" {
 				req = req.Data(ReplacesOrgCaptchaSettingsdata)
@@ -327,7 +360,7 @@ func NewReplacesOrgCaptchaSettingsCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -360,12 +393,16 @@ func NewDeleteOrgCaptchaSettingsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deleteOrgCaptchaSettings",
 		Long: "Delete the Org-wide CAPTCHA Settings",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.captchas.manage",
+			destructiveAnnotation: "/api/v1/org/captcha",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CAPTCHAAPI.DeleteOrgCaptchaSettings(apiClient.GetConfig().Context)
+			req := apiClient.CAPTCHAAPI.DeleteOrgCaptchaSettings(cmd.Context())
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
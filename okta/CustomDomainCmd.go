@@ -22,8 +22,11 @@ func NewCreateCustomDomainCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "create",
 		Long: "Create a Custom Domain",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.domains.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomDomainAPI.CreateCustomDomain(apiClient.GetConfig().Context)
+			req := apiClient.CustomDomainAPI.CreateCustomDomain(cmd.Context())
 
 			if CreateCustomDomaindata != "" {
 				req = req.Data(CreateCustomDomaindata)
@@ -31,7 +34,7 @@ func NewCreateCustomDomainCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -64,12 +67,15 @@ func NewListCustomDomainsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "lists",
 		Long: "List all Custom Domains",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.domains.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomDomainAPI.ListCustomDomains(apiClient.GetConfig().Context)
+			req := apiClient.CustomDomainAPI.ListCustomDomains(cmd.Context())
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -101,12 +107,15 @@ func NewGetCustomDomainCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "get",
 		Long: "Retrieve a Custom Domain",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.domains.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomDomainAPI.GetCustomDomain(apiClient.GetConfig().Context, GetCustomDomaindomainId)
+			req := apiClient.CustomDomainAPI.GetCustomDomain(cmd.Context(), GetCustomDomaindomainId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -145,8 +154,11 @@ func NewReplaceCustomDomainCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replace",
 		Long: "Replace a Custom Domain's Brand",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.domains.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomDomainAPI.ReplaceCustomDomain(apiClient.GetConfig().Context, ReplaceCustomDomaindomainId)
+			req := apiClient.CustomDomainAPI.ReplaceCustomDomain(cmd.Context(), ReplaceCustomDomaindomainId)
 
 			if ReplaceCustomDomaindata != "" {
 				req = req.Data(ReplaceCustomDomaindata)
@@ -154,7 +166,7 @@ func NewReplaceCustomDomainCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -192,12 +204,16 @@ func NewDeleteCustomDomainCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "delete",
 		Long: "Delete a Custom Domain",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.domains.manage",
+			destructiveAnnotation: "/api/v1/domains/{domainId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomDomainAPI.DeleteCustomDomain(apiClient.GetConfig().Context, DeleteCustomDomaindomainId)
+			req := apiClient.CustomDomainAPI.DeleteCustomDomain(cmd.Context(), DeleteCustomDomaindomainId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -236,8 +252,11 @@ func NewUpsertCertificateCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "upsertCertificate",
 		Long: "Upsert the Custom Domain's Certificate",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.domains.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomDomainAPI.UpsertCertificate(apiClient.GetConfig().Context, UpsertCertificatedomainId)
+			req := apiClient.CustomDomainAPI.UpsertCertificate(cmd.Context(), UpsertCertificatedomainId)
 
 			if UpsertCertificatedata != "" {
 				req = req.Data(UpsertCertificatedata)
@@ -245,7 +264,7 @@ func NewUpsertCertificateCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -283,12 +302,15 @@ func NewVerifyDomainCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "verifyDomain",
 		Long: "Verify a Custom Domain",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.domains.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomDomainAPI.VerifyDomain(apiClient.GetConfig().Context, VerifyDomaindomainId)
+			req := apiClient.CustomDomainAPI.VerifyDomain(cmd.Context(), VerifyDomaindomainId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
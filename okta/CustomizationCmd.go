@@ -22,8 +22,11 @@ func NewCreateBrandCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "createBrand",
 		Long: "Create a Brand",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.brands.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.CreateBrand(apiClient.GetConfig().Context)
+			req := apiClient.CustomizationAPI.CreateBrand(cmd.Context())
 
 			if CreateBranddata != "" {
 				req = req.Data(CreateBranddata)
@@ -31,7 +34,7 @@ func NewCreateBrandCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -60,16 +63,25 @@ func init() {
 	CustomizationCmd.AddCommand(CreateBrandCmd)
 }
 
+var ListBrandsall bool
+
 func NewListBrandsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listBrands",
 		Long: "List all Brands",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.brands.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.ListBrands(apiClient.GetConfig().Context)
+			req := apiClient.CustomizationAPI.ListBrands(cmd.Context())
+
+			if ListBrandsall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -87,6 +99,8 @@ func NewListBrandsCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &ListBrandsall, false)
+
 	return cmd
 }
 
@@ -101,12 +115,15 @@ func NewGetBrandCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getBrand",
 		Long: "Retrieve a Brand",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.brands.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.GetBrand(apiClient.GetConfig().Context, GetBrandbrandId)
+			req := apiClient.CustomizationAPI.GetBrand(cmd.Context(), GetBrandbrandId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -145,8 +162,11 @@ func NewReplaceBrandCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replaceBrand",
 		Long: "Replace a Brand",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.brands.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.ReplaceBrand(apiClient.GetConfig().Context, ReplaceBrandbrandId)
+			req := apiClient.CustomizationAPI.ReplaceBrand(cmd.Context(), ReplaceBrandbrandId)
 
 			if ReplaceBranddata != "" {
 				req = req.Data(ReplaceBranddata)
@@ -154,7 +174,7 @@ func NewReplaceBrandCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -192,12 +212,16 @@ func NewDeleteBrandCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deleteBrand",
 		Long: "Delete a brand",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.brands.manage",
+			destructiveAnnotation: "/api/v1/brands/{brandId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.DeleteBrand(apiClient.GetConfig().Context, DeleteBrandbrandId)
+			req := apiClient.CustomizationAPI.DeleteBrand(cmd.Context(), DeleteBrandbrandId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -228,16 +252,25 @@ func init() {
 
 var ListBrandDomainsbrandId string
 
+var ListBrandDomainsall bool
+
 func NewListBrandDomainsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listBrandDomains",
 		Long: "List all Domains associated with a Brand",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.brands.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.ListBrandDomains(apiClient.GetConfig().Context, ListBrandDomainsbrandId)
+			req := apiClient.CustomizationAPI.ListBrandDomains(cmd.Context(), ListBrandDomainsbrandId)
+
+			if ListBrandDomainsall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -258,6 +291,8 @@ func NewListBrandDomainsCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListBrandDomainsbrandId, "brandId", "", "", "")
 	cmd.MarkFlagRequired("brandId")
 
+	allFlag.RegisterBool(cmd, &ListBrandDomainsall, false)
+
 	return cmd
 }
 
@@ -272,12 +307,15 @@ func NewGetErrorPageCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getErrorPage",
 		Long: "Retrieve the Error Page Sub-Resources",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.brands.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.GetErrorPage(apiClient.GetConfig().Context, GetErrorPagebrandId)
+			req := apiClient.CustomizationAPI.GetErrorPage(cmd.Context(), GetErrorPagebrandId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -312,12 +350,15 @@ func NewGetCustomizedErrorPageCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getCustomizedErrorPage",
 		Long: "Retrieve the Customized Error Page",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.brands.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.GetCustomizedErrorPage(apiClient.GetConfig().Context, GetCustomizedErrorPagebrandId)
+			req := apiClient.CustomizationAPI.GetCustomizedErrorPage(cmd.Context(), GetCustomizedErrorPagebrandId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -356,8 +397,11 @@ func NewReplaceCustomizedErrorPageCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replaceCustomizedErrorPage",
 		Long: "Replace the Customized Error Page",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.brands.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.ReplaceCustomizedErrorPage(apiClient.GetConfig().Context, ReplaceCustomizedErrorPagebrandId)
+			req := apiClient.CustomizationAPI.ReplaceCustomizedErrorPage(cmd.Context(), ReplaceCustomizedErrorPagebrandId)
 
 			if ReplaceCustomizedErrorPagedata != "" {
 				req = req.Data(ReplaceCustomizedErrorPagedata)
@@ -365,7 +409,7 @@ func NewReplaceCustomizedErrorPageCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -403,12 +447,16 @@ func NewDeleteCustomizedErrorPageCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deleteCustomizedErrorPage",
 		Long: "Delete the Customized Error Page",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.brands.manage",
+			destructiveAnnotation: "/api/v1/brands/{brandId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.DeleteCustomizedErrorPage(apiClient.GetConfig().Context, DeleteCustomizedErrorPagebrandId)
+			req := apiClient.CustomizationAPI.DeleteCustomizedErrorPage(cmd.Context(), DeleteCustomizedErrorPagebrandId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -443,12 +491,15 @@ func NewGetDefaultErrorPageCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getDefaultErrorPage",
 		Long: "Retrieve the Default Error Page",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.brands.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.GetDefaultErrorPage(apiClient.GetConfig().Context, GetDefaultErrorPagebrandId)
+			req := apiClient.CustomizationAPI.GetDefaultErrorPage(cmd.Context(), GetDefaultErrorPagebrandId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -483,12 +534,15 @@ func NewGetPreviewErrorPageCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getPreviewErrorPage",
 		Long: "Retrieve the Preview Error Page Preview",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.brands.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.GetPreviewErrorPage(apiClient.GetConfig().Context, GetPreviewErrorPagebrandId)
+			req := apiClient.CustomizationAPI.GetPreviewErrorPage(cmd.Context(), GetPreviewErrorPagebrandId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -527,8 +581,11 @@ func NewReplacePreviewErrorPageCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replacePreviewErrorPage",
 		Long: "Replace the Preview Error Page",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.brands.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.ReplacePreviewErrorPage(apiClient.GetConfig().Context, ReplacePreviewErrorPagebrandId)
+			req := apiClient.CustomizationAPI.ReplacePreviewErrorPage(cmd.Context(), ReplacePreviewErrorPagebrandId)
 
 			if ReplacePreviewErrorPagedata != "" {
 				req = req.Data(ReplacePreviewErrorPagedata)
@@ -536,7 +593,7 @@ func NewReplacePreviewErrorPageCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -574,12 +631,16 @@ func NewDeletePreviewErrorPageCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deletePreviewErrorPage",
 		Long: "Delete the Preview Error Page",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.brands.manage",
+			destructiveAnnotation: "/api/v1/brands/{brandId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.DeletePreviewErrorPage(apiClient.GetConfig().Context, DeletePreviewErrorPagebrandId)
+			req := apiClient.CustomizationAPI.DeletePreviewErrorPage(cmd.Context(), DeletePreviewErrorPagebrandId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -614,12 +675,15 @@ func NewGetSignInPageCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getSignInPage",
 		Long: "Retrieve the Sign-in Page Sub-Resources",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.brands.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.GetSignInPage(apiClient.GetConfig().Context, GetSignInPagebrandId)
+			req := apiClient.CustomizationAPI.GetSignInPage(cmd.Context(), GetSignInPagebrandId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -654,12 +718,15 @@ func NewGetCustomizedSignInPageCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getCustomizedSignInPage",
 		Long: "Retrieve the Customized Sign-in Page",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.brands.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.GetCustomizedSignInPage(apiClient.GetConfig().Context, GetCustomizedSignInPagebrandId)
+			req := apiClient.CustomizationAPI.GetCustomizedSignInPage(cmd.Context(), GetCustomizedSignInPagebrandId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -698,8 +765,11 @@ func NewReplaceCustomizedSignInPageCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replaceCustomizedSignInPage",
 		Long: "Replace the Customized Sign-in Page",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.brands.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.ReplaceCustomizedSignInPage(apiClient.GetConfig().Context, ReplaceCustomizedSignInPagebrandId)
+			req := apiClient.CustomizationAPI.ReplaceCustomizedSignInPage(cmd.Context(), ReplaceCustomizedSignInPagebrandId)
 
 			if ReplaceCustomizedSignInPagedata != "" {
 				req = req.Data(ReplaceCustomizedSignInPagedata)
@@ -707,7 +777,7 @@ func NewReplaceCustomizedSignInPageCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -745,12 +815,16 @@ func NewDeleteCustomizedSignInPageCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deleteCustomizedSignInPage",
 		Long: "Delete the Customized Sign-in Page",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.brands.manage",
+			destructiveAnnotation: "/api/v1/brands/{brandId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.DeleteCustomizedSignInPage(apiClient.GetConfig().Context, DeleteCustomizedSignInPagebrandId)
+			req := apiClient.CustomizationAPI.DeleteCustomizedSignInPage(cmd.Context(), DeleteCustomizedSignInPagebrandId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -785,12 +859,15 @@ func NewGetDefaultSignInPageCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getDefaultSignInPage",
 		Long: "Retrieve the Default Sign-in Page",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.brands.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.GetDefaultSignInPage(apiClient.GetConfig().Context, GetDefaultSignInPagebrandId)
+			req := apiClient.CustomizationAPI.GetDefaultSignInPage(cmd.Context(), GetDefaultSignInPagebrandId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -825,12 +902,15 @@ func NewGetPreviewSignInPageCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getPreviewSignInPage",
 		Long: "Retrieve the Preview Sign-in Page Preview",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.brands.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.GetPreviewSignInPage(apiClient.GetConfig().Context, GetPreviewSignInPagebrandId)
+			req := apiClient.CustomizationAPI.GetPreviewSignInPage(cmd.Context(), GetPreviewSignInPagebrandId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -869,8 +949,11 @@ func NewReplacePreviewSignInPageCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replacePreviewSignInPage",
 		Long: "Replace the Preview Sign-in Page",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.brands.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.ReplacePreviewSignInPage(apiClient.GetConfig().Context, ReplacePreviewSignInPagebrandId)
+			req := apiClient.CustomizationAPI.ReplacePreviewSignInPage(cmd.Context(), ReplacePreviewSignInPagebrandId)
 
 			if ReplacePreviewSignInPagedata != "" {
 				req = req.Data(ReplacePreviewSignInPagedata)
@@ -878,7 +961,7 @@ func NewReplacePreviewSignInPageCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -916,12 +999,16 @@ func NewDeletePreviewSignInPageCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deletePreviewSignInPage",
 		Long: "Delete the Preview Sign-in Page",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.brands.manage",
+			destructiveAnnotation: "/api/v1/brands/{brandId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.DeletePreviewSignInPage(apiClient.GetConfig().Context, DeletePreviewSignInPagebrandId)
+			req := apiClient.CustomizationAPI.DeletePreviewSignInPage(cmd.Context(), DeletePreviewSignInPagebrandId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -952,16 +1039,25 @@ func init() {
 
 var ListAllSignInWidgetVersionsbrandId string
 
+var ListAllSignInWidgetVersionsall bool
+
 func NewListAllSignInWidgetVersionsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listAllSignInWidgetVersions",
 		Long: "List all Sign-in Widget Versions",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.brands.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.ListAllSignInWidgetVersions(apiClient.GetConfig().Context, ListAllSignInWidgetVersionsbrandId)
+			req := apiClient.CustomizationAPI.ListAllSignInWidgetVersions(cmd.Context(), ListAllSignInWidgetVersionsbrandId)
+
+			if ListAllSignInWidgetVersionsall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -982,6 +1078,8 @@ func NewListAllSignInWidgetVersionsCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListAllSignInWidgetVersionsbrandId, "brandId", "", "", "")
 	cmd.MarkFlagRequired("brandId")
 
+	allFlag.RegisterBool(cmd, &ListAllSignInWidgetVersionsall, false)
+
 	return cmd
 }
 
@@ -996,12 +1094,15 @@ func NewGetSignOutPageSettingsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getSignOutPageSettings",
 		Long: "Retrieve the Sign-out Page Settings",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.brands.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.GetSignOutPageSettings(apiClient.GetConfig().Context, GetSignOutPageSettingsbrandId)
+			req := apiClient.CustomizationAPI.GetSignOutPageSettings(cmd.Context(), GetSignOutPageSettingsbrandId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -1040,8 +1141,11 @@ func NewReplaceSignOutPageSettingsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replaceSignOutPageSettings",
 		Long: "Replace the Sign-out Page Settings",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.brands.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.ReplaceSignOutPageSettings(apiClient.GetConfig().Context, ReplaceSignOutPageSettingsbrandId)
+			req := apiClient.CustomizationAPI.ReplaceSignOutPageSettings(cmd.Context(), ReplaceSignOutPageSettingsbrandId)
 
 			if ReplaceSignOutPageSettingsdata != "" {
 				req = req.Data(ReplaceSignOutPageSettingsdata)
@@ -1049,7 +1153,7 @@ func NewReplaceSignOutPageSettingsCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -1083,16 +1187,25 @@ func init() {
 
 var ListEmailTemplatesbrandId string
 
+var ListEmailTemplatesall bool
+
 func NewListEmailTemplatesCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listEmailTemplates",
 		Long: "List all Email Templates",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.templates.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.ListEmailTemplates(apiClient.GetConfig().Context, ListEmailTemplatesbrandId)
+			req := apiClient.CustomizationAPI.ListEmailTemplates(cmd.Context(), ListEmailTemplatesbrandId)
+
+			if ListEmailTemplatesall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -1113,6 +1226,8 @@ func NewListEmailTemplatesCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListEmailTemplatesbrandId, "brandId", "", "", "")
 	cmd.MarkFlagRequired("brandId")
 
+	allFlag.RegisterBool(cmd, &ListEmailTemplatesall, false)
+
 	return cmd
 }
 
@@ -1131,12 +1246,15 @@ func NewGetEmailTemplateCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getEmailTemplate",
 		Long: "Retrieve an Email Template",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.templates.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.GetEmailTemplate(apiClient.GetConfig().Context, GetEmailTemplatebrandId, GetEmailTemplatetemplateName)
+			req := apiClient.CustomizationAPI.GetEmailTemplate(cmd.Context(), GetEmailTemplatebrandId, GetEmailTemplatetemplateName)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -1180,8 +1298,11 @@ func NewCreateEmailCustomizationCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "createEmail",
 		Long: "Create an Email Customization",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.templates.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.CreateEmailCustomization(apiClient.GetConfig().Context, CreateEmailCustomizationbrandId, CreateEmailCustomizationtemplateName)
+			req := apiClient.CustomizationAPI.CreateEmailCustomization(cmd.Context(), CreateEmailCustomizationbrandId, CreateEmailCustomizationtemplateName)
 
 			if CreateEmailCustomizationdata != "" {
 				req = req.Data(CreateEmailCustomizationdata)
@@ -1189,7 +1310,7 @@ func NewCreateEmailCustomizationCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -1230,16 +1351,25 @@ var (
 	ListEmailCustomizationstemplateName string
 )
 
+var ListEmailCustomizationsall bool
+
 func NewListEmailCustomizationsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listEmails",
 		Long: "List all Email Customizations",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.templates.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.ListEmailCustomizations(apiClient.GetConfig().Context, ListEmailCustomizationsbrandId, ListEmailCustomizationstemplateName)
+			req := apiClient.CustomizationAPI.ListEmailCustomizations(cmd.Context(), ListEmailCustomizationsbrandId, ListEmailCustomizationstemplateName)
+
+			if ListEmailCustomizationsall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -1263,6 +1393,8 @@ func NewListEmailCustomizationsCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListEmailCustomizationstemplateName, "templateName", "", "", "")
 	cmd.MarkFlagRequired("templateName")
 
+	allFlag.RegisterBool(cmd, &ListEmailCustomizationsall, false)
+
 	return cmd
 }
 
@@ -1281,12 +1413,16 @@ func NewDeleteAllCustomizationsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deleteAlls",
 		Long: "Delete all Email Customizations",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.templates.manage",
+			destructiveAnnotation: "/api/v1/brands/{brandId}/templates/email/{templateName}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.DeleteAllCustomizations(apiClient.GetConfig().Context, DeleteAllCustomizationsbrandId, DeleteAllCustomizationstemplateName)
+			req := apiClient.CustomizationAPI.DeleteAllCustomizations(cmd.Context(), DeleteAllCustomizationsbrandId, DeleteAllCustomizationstemplateName)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -1330,12 +1466,15 @@ func NewGetEmailCustomizationCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getEmail",
 		Long: "Retrieve an Email Customization",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.templates.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.GetEmailCustomization(apiClient.GetConfig().Context, GetEmailCustomizationbrandId, GetEmailCustomizationtemplateName, GetEmailCustomizationcustomizationId)
+			req := apiClient.CustomizationAPI.GetEmailCustomization(cmd.Context(), GetEmailCustomizationbrandId, GetEmailCustomizationtemplateName, GetEmailCustomizationcustomizationId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -1384,8 +1523,11 @@ func NewReplaceEmailCustomizationCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replaceEmail",
 		Long: "Replace an Email Customization",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.templates.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.ReplaceEmailCustomization(apiClient.GetConfig().Context, ReplaceEmailCustomizationbrandId, ReplaceEmailCustomizationtemplateName, ReplaceEmailCustomizationcustomizationId)
+			req := apiClient.CustomizationAPI.ReplaceEmailCustomization(cmd.Context(), ReplaceEmailCustomizationbrandId, ReplaceEmailCustomizationtemplateName, ReplaceEmailCustomizationcustomizationId)
 
 			if ReplaceEmailCustomizationdata != "" {
 				req = req.Data(ReplaceEmailCustomizationdata)
@@ -1393,7 +1535,7 @@ func NewReplaceEmailCustomizationCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -1443,12 +1585,16 @@ func NewDeleteEmailCustomizationCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deleteEmail",
 		Long: "Delete an Email Customization",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.templates.manage",
+			destructiveAnnotation: "/api/v1/brands/{brandId}/templates/email/{templateName}/customizations/{customizationId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.DeleteEmailCustomization(apiClient.GetConfig().Context, DeleteEmailCustomizationbrandId, DeleteEmailCustomizationtemplateName, DeleteEmailCustomizationcustomizationId)
+			req := apiClient.CustomizationAPI.DeleteEmailCustomization(cmd.Context(), DeleteEmailCustomizationbrandId, DeleteEmailCustomizationtemplateName, DeleteEmailCustomizationcustomizationId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -1495,12 +1641,15 @@ func NewGetCustomizationPreviewCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getPreview",
 		Long: "Retrieve a Preview of an Email Customization",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.templates.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.GetCustomizationPreview(apiClient.GetConfig().Context, GetCustomizationPreviewbrandId, GetCustomizationPreviewtemplateName, GetCustomizationPreviewcustomizationId)
+			req := apiClient.CustomizationAPI.GetCustomizationPreview(cmd.Context(), GetCustomizationPreviewbrandId, GetCustomizationPreviewtemplateName, GetCustomizationPreviewcustomizationId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -1545,12 +1694,15 @@ func NewGetEmailDefaultContentCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getEmailDefaultContent",
 		Long: "Retrieve an Email Template Default Content",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.templates.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.GetEmailDefaultContent(apiClient.GetConfig().Context, GetEmailDefaultContentbrandId, GetEmailDefaultContenttemplateName)
+			req := apiClient.CustomizationAPI.GetEmailDefaultContent(cmd.Context(), GetEmailDefaultContentbrandId, GetEmailDefaultContenttemplateName)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -1592,12 +1744,15 @@ func NewGetEmailDefaultPreviewCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getEmailDefaultPreview",
 		Long: "Retrieve a Preview of the Email Template default content",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.templates.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.GetEmailDefaultPreview(apiClient.GetConfig().Context, GetEmailDefaultPreviewbrandId, GetEmailDefaultPreviewtemplateName)
+			req := apiClient.CustomizationAPI.GetEmailDefaultPreview(cmd.Context(), GetEmailDefaultPreviewbrandId, GetEmailDefaultPreviewtemplateName)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -1639,12 +1794,15 @@ func NewGetEmailSettingsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getEmailSettings",
 		Long: "Retrieve the Email Template Settings",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.templates.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.GetEmailSettings(apiClient.GetConfig().Context, GetEmailSettingsbrandId, GetEmailSettingstemplateName)
+			req := apiClient.CustomizationAPI.GetEmailSettings(cmd.Context(), GetEmailSettingsbrandId, GetEmailSettingstemplateName)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -1688,8 +1846,11 @@ func NewReplaceEmailSettingsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replaceEmailSettings",
 		Long: "Replace the Email Template Settings",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.templates.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.ReplaceEmailSettings(apiClient.GetConfig().Context, ReplaceEmailSettingsbrandId, ReplaceEmailSettingstemplateName)
+			req := apiClient.CustomizationAPI.ReplaceEmailSettings(cmd.Context(), ReplaceEmailSettingsbrandId, ReplaceEmailSettingstemplateName)
 
 			if ReplaceEmailSettingsdata != "" {
 				req = req.Data(ReplaceEmailSettingsdata)
@@ -1697,7 +1858,7 @@ func NewReplaceEmailSettingsCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -1742,12 +1903,15 @@ func NewSendTestEmailCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "sendTestEmail",
 		Long: "Send a Test Email",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.templates.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.SendTestEmail(apiClient.GetConfig().Context, SendTestEmailbrandId, SendTestEmailtemplateName)
+			req := apiClient.CustomizationAPI.SendTestEmail(cmd.Context(), SendTestEmailbrandId, SendTestEmailtemplateName)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -1781,16 +1945,25 @@ func init() {
 
 var ListBrandThemesbrandId string
 
+var ListBrandThemesall bool
+
 func NewListBrandThemesCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listBrandThemes",
 		Long: "List all Themes",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.brands.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.ListBrandThemes(apiClient.GetConfig().Context, ListBrandThemesbrandId)
+			req := apiClient.CustomizationAPI.ListBrandThemes(cmd.Context(), ListBrandThemesbrandId)
+
+			if ListBrandThemesall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -1811,6 +1984,8 @@ func NewListBrandThemesCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListBrandThemesbrandId, "brandId", "", "", "")
 	cmd.MarkFlagRequired("brandId")
 
+	allFlag.RegisterBool(cmd, &ListBrandThemesall, false)
+
 	return cmd
 }
 
@@ -1829,12 +2004,15 @@ func NewGetBrandThemeCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getBrandTheme",
 		Long: "Retrieve a Theme",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.brands.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.GetBrandTheme(apiClient.GetConfig().Context, GetBrandThemebrandId, GetBrandThemethemeId)
+			req := apiClient.CustomizationAPI.GetBrandTheme(cmd.Context(), GetBrandThemebrandId, GetBrandThemethemeId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -1878,8 +2056,11 @@ func NewReplaceBrandThemeCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replaceBrandTheme",
 		Long: "Replace a Theme",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.brands.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.ReplaceBrandTheme(apiClient.GetConfig().Context, ReplaceBrandThemebrandId, ReplaceBrandThemethemeId)
+			req := apiClient.CustomizationAPI.ReplaceBrandTheme(cmd.Context(), ReplaceBrandThemebrandId, ReplaceBrandThemethemeId)
 
 			if ReplaceBrandThemedata != "" {
 				req = req.Data(ReplaceBrandThemedata)
@@ -1887,7 +2068,7 @@ func NewReplaceBrandThemeCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -1934,8 +2115,11 @@ func NewUploadBrandThemeBackgroundImageCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "uploadBrandThemeBackgroundImage",
 		Long: "Upload the Background Image",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.brands.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.UploadBrandThemeBackgroundImage(apiClient.GetConfig().Context, UploadBrandThemeBackgroundImagebrandId, UploadBrandThemeBackgroundImagethemeId)
+			req := apiClient.CustomizationAPI.UploadBrandThemeBackgroundImage(cmd.Context(), UploadBrandThemeBackgroundImagebrandId, UploadBrandThemeBackgroundImagethemeId)
 
 			if UploadBrandThemeBackgroundImagedata != "" {
 				req = req.Data(UploadBrandThemeBackgroundImagedata)
@@ -1943,7 +2127,7 @@ func NewUploadBrandThemeBackgroundImageCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -1988,12 +2172,16 @@ func NewDeleteBrandThemeBackgroundImageCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deleteBrandThemeBackgroundImage",
 		Long: "Delete the Background Image",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.brands.manage",
+			destructiveAnnotation: "/api/v1/brands/{brandId}/themes/{themeId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.DeleteBrandThemeBackgroundImage(apiClient.GetConfig().Context, DeleteBrandThemeBackgroundImagebrandId, DeleteBrandThemeBackgroundImagethemeId)
+			req := apiClient.CustomizationAPI.DeleteBrandThemeBackgroundImage(cmd.Context(), DeleteBrandThemeBackgroundImagebrandId, DeleteBrandThemeBackgroundImagethemeId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -2037,8 +2225,11 @@ func NewUploadBrandThemeFaviconCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "uploadBrandThemeFavicon",
 		Long: "Upload the Favicon",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.brands.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.UploadBrandThemeFavicon(apiClient.GetConfig().Context, UploadBrandThemeFaviconbrandId, UploadBrandThemeFaviconthemeId)
+			req := apiClient.CustomizationAPI.UploadBrandThemeFavicon(cmd.Context(), UploadBrandThemeFaviconbrandId, UploadBrandThemeFaviconthemeId)
 
 			if UploadBrandThemeFavicondata != "" {
 				req = req.Data(UploadBrandThemeFavicondata)
@@ -2046,7 +2237,7 @@ func NewUploadBrandThemeFaviconCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -2091,12 +2282,16 @@ func NewDeleteBrandThemeFaviconCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deleteBrandThemeFavicon",
 		Long: "Delete the Favicon",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.brands.manage",
+			destructiveAnnotation: "/api/v1/brands/{brandId}/themes/{themeId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.DeleteBrandThemeFavicon(apiClient.GetConfig().Context, DeleteBrandThemeFaviconbrandId, DeleteBrandThemeFaviconthemeId)
+			req := apiClient.CustomizationAPI.DeleteBrandThemeFavicon(cmd.Context(), DeleteBrandThemeFaviconbrandId, DeleteBrandThemeFaviconthemeId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -2140,8 +2335,11 @@ func NewUploadBrandThemeLogoCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "uploadBrandThemeLogo",
 		Long: "Upload the Logo",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.brands.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.UploadBrandThemeLogo(apiClient.GetConfig().Context, UploadBrandThemeLogobrandId, UploadBrandThemeLogothemeId)
+			req := apiClient.CustomizationAPI.UploadBrandThemeLogo(cmd.Context(), UploadBrandThemeLogobrandId, UploadBrandThemeLogothemeId)
 
 			if UploadBrandThemeLogodata != "" {
 				req = req.Data(UploadBrandThemeLogodata)
@@ -2149,7 +2347,7 @@ func NewUploadBrandThemeLogoCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -2194,12 +2392,16 @@ func NewDeleteBrandThemeLogoCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deleteBrandThemeLogo",
 		Long: "Delete the Logo",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.brands.manage",
+			destructiveAnnotation: "/api/v1/brands/{brandId}/themes/{themeId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.CustomizationAPI.DeleteBrandThemeLogo(apiClient.GetConfig().Context, DeleteBrandThemeLogobrandId, DeleteBrandThemeLogothemeId)
+			req := apiClient.CustomizationAPI.DeleteBrandThemeLogo(cmd.Context(), DeleteBrandThemeLogobrandId, DeleteBrandThemeLogothemeId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -22,8 +22,11 @@ func NewCreateDeviceAssurancePolicyCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "createPolicy",
 		Long: "Create a Device Assurance Policy",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.deviceAssurance.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.DeviceAssuranceAPI.CreateDeviceAssurancePolicy(apiClient.GetConfig().Context)
+			req := apiClient.DeviceAssuranceAPI.CreateDeviceAssurancePolicy(cmd.Context())
 
 			if CreateDeviceAssurancePolicydata != "" {
 				req = req.Data(CreateDeviceAssurancePolicydata)
@@ -31,7 +34,7 @@ func NewCreateDeviceAssurancePolicyCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -60,16 +63,25 @@ func init() {
 	DeviceAssuranceCmd.AddCommand(CreateDeviceAssurancePolicyCmd)
 }
 
+var ListDeviceAssurancePoliciesall bool
+
 func NewListDeviceAssurancePoliciesCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listPolicies",
 		Long: "List all Device Assurance Policies",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.deviceAssurance.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.DeviceAssuranceAPI.ListDeviceAssurancePolicies(apiClient.GetConfig().Context)
+			req := apiClient.DeviceAssuranceAPI.ListDeviceAssurancePolicies(cmd.Context())
+
+			if ListDeviceAssurancePoliciesall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -87,6 +99,8 @@ func NewListDeviceAssurancePoliciesCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &ListDeviceAssurancePoliciesall, false)
+
 	return cmd
 }
 
@@ -101,12 +115,15 @@ func NewGetDeviceAssurancePolicyCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getPolicy",
 		Long: "Retrieve a Device Assurance Policy",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.deviceAssurance.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.DeviceAssuranceAPI.GetDeviceAssurancePolicy(apiClient.GetConfig().Context, GetDeviceAssurancePolicydeviceAssuranceId)
+			req := apiClient.DeviceAssuranceAPI.GetDeviceAssurancePolicy(cmd.Context(), GetDeviceAssurancePolicydeviceAssuranceId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -145,8 +162,11 @@ func NewReplaceDeviceAssurancePolicyCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replacePolicy",
 		Long: "Replace a Device Assurance Policy",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.deviceAssurance.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.DeviceAssuranceAPI.ReplaceDeviceAssurancePolicy(apiClient.GetConfig().Context, ReplaceDeviceAssurancePolicydeviceAssuranceId)
+			req := apiClient.DeviceAssuranceAPI.ReplaceDeviceAssurancePolicy(cmd.Context(), ReplaceDeviceAssurancePolicydeviceAssuranceId)
 
 			if ReplaceDeviceAssurancePolicydata != "" {
 				req = req.Data(ReplaceDeviceAssurancePolicydata)
@@ -154,7 +174,7 @@ func NewReplaceDeviceAssurancePolicyCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -192,12 +212,16 @@ func NewDeleteDeviceAssurancePolicyCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deletePolicy",
 		Long: "Delete a Device Assurance Policy",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.deviceAssurance.manage",
+			destructiveAnnotation: "/api/v1/device-assurances/{deviceAssuranceId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.DeviceAssuranceAPI.DeleteDeviceAssurancePolicy(apiClient.GetConfig().Context, DeleteDeviceAssurancePolicydeviceAssuranceId)
+			req := apiClient.DeviceAssuranceAPI.DeleteDeviceAssurancePolicy(cmd.Context(), DeleteDeviceAssurancePolicydeviceAssuranceId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -16,16 +16,25 @@ func init() {
 	rootCmd.AddCommand(DeviceCmd)
 }
 
+var ListDevicesall bool
+
 func NewListDevicesCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "lists",
 		Long: "List all Devices",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.devices.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.DeviceAPI.ListDevices(apiClient.GetConfig().Context)
+			req := apiClient.DeviceAPI.ListDevices(cmd.Context())
+
+			if ListDevicesall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -43,6 +52,8 @@ func NewListDevicesCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &ListDevicesall, false)
+
 	return cmd
 }
 
@@ -57,12 +68,15 @@ func NewGetDeviceCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "get",
 		Long: "Retrieve a Device",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.devices.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.DeviceAPI.GetDevice(apiClient.GetConfig().Context, GetDevicedeviceId)
+			req := apiClient.DeviceAPI.GetDevice(cmd.Context(), GetDevicedeviceId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -97,12 +111,16 @@ func NewDeleteDeviceCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "delete",
 		Long: "Delete a Device",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.devices.manage",
+			destructiveAnnotation: "/api/v1/devices/{deviceId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.DeviceAPI.DeleteDevice(apiClient.GetConfig().Context, DeleteDevicedeviceId)
+			req := apiClient.DeviceAPI.DeleteDevice(cmd.Context(), DeleteDevicedeviceId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -137,12 +155,15 @@ func NewActivateDeviceCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "activate",
 		Long: "Activate a Device",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.devices.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.DeviceAPI.ActivateDevice(apiClient.GetConfig().Context, ActivateDevicedeviceId)
+			req := apiClient.DeviceAPI.ActivateDevice(cmd.Context(), ActivateDevicedeviceId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -177,12 +198,16 @@ func NewDeactivateDeviceCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deactivate",
 		Long: "Deactivate a Device",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.devices.manage",
+			destructiveAnnotation: "/api/v1/devices/{deviceId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.DeviceAPI.DeactivateDevice(apiClient.GetConfig().Context, DeactivateDevicedeviceId)
+			req := apiClient.DeviceAPI.DeactivateDevice(cmd.Context(), DeactivateDevicedeviceId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -217,12 +242,15 @@ func NewSuspendDeviceCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "suspend",
 		Long: "Suspend a Device",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.devices.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.DeviceAPI.SuspendDevice(apiClient.GetConfig().Context, SuspendDevicedeviceId)
+			req := apiClient.DeviceAPI.SuspendDevice(cmd.Context(), SuspendDevicedeviceId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -257,12 +285,15 @@ func NewUnsuspendDeviceCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "unsuspend",
 		Long: "Unsuspend a Device",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.devices.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.DeviceAPI.UnsuspendDevice(apiClient.GetConfig().Context, UnsuspendDevicedeviceId)
+			req := apiClient.DeviceAPI.UnsuspendDevice(cmd.Context(), UnsuspendDevicedeviceId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -293,16 +324,25 @@ func init() {
 
 var ListDeviceUsersdeviceId string
 
+var ListDeviceUsersall bool
+
 func NewListDeviceUsersCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listUsers",
 		Long: "List all Users for a Device",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.devices.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.DeviceAPI.ListDeviceUsers(apiClient.GetConfig().Context, ListDeviceUsersdeviceId)
+			req := apiClient.DeviceAPI.ListDeviceUsers(cmd.Context(), ListDeviceUsersdeviceId)
+
+			if ListDeviceUsersall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -323,6 +363,8 @@ func NewListDeviceUsersCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListDeviceUsersdeviceId, "deviceId", "", "", "")
 	cmd.MarkFlagRequired("deviceId")
 
+	allFlag.RegisterBool(cmd, &ListDeviceUsersall, false)
+
 	return cmd
 }
 
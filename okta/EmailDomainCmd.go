@@ -22,8 +22,11 @@ func NewCreateEmailDomainCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "create",
 		Long: "Create an Email Domain",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.emailDomains.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.EmailDomainAPI.CreateEmailDomain(apiClient.GetConfig().Context)
+			req := apiClient.EmailDomainAPI.CreateEmailDomain(cmd.Context())
 
 			if CreateEmailDomaindata != "" {
 				req = req.Data(CreateEmailDomaindata)
@@ -31,7 +34,7 @@ func NewCreateEmailDomainCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -60,16 +63,25 @@ func init() {
 	EmailDomainCmd.AddCommand(CreateEmailDomainCmd)
 }
 
+var ListEmailDomainsall bool
+
 func NewListEmailDomainsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "lists",
 		Long: "List all Email Domains",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.emailDomains.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.EmailDomainAPI.ListEmailDomains(apiClient.GetConfig().Context)
+			req := apiClient.EmailDomainAPI.ListEmailDomains(cmd.Context())
+
+			if ListEmailDomainsall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -87,6 +99,8 @@ func NewListEmailDomainsCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &ListEmailDomainsall, false)
+
 	return cmd
 }
 
@@ -101,12 +115,15 @@ func NewGetEmailDomainCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "get",
 		Long: "Retrieve an Email Domain",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.emailDomains.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.EmailDomainAPI.GetEmailDomain(apiClient.GetConfig().Context, GetEmailDomainemailDomainId)
+			req := apiClient.EmailDomainAPI.GetEmailDomain(cmd.Context(), GetEmailDomainemailDomainId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -145,8 +162,11 @@ func NewReplaceEmailDomainCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replace",
 		Long: "Replace an Email Domain",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.emailDomains.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.EmailDomainAPI.ReplaceEmailDomain(apiClient.GetConfig().Context, ReplaceEmailDomainemailDomainId)
+			req := apiClient.EmailDomainAPI.ReplaceEmailDomain(cmd.Context(), ReplaceEmailDomainemailDomainId)
 
 			if ReplaceEmailDomaindata != "" {
 				req = req.Data(ReplaceEmailDomaindata)
@@ -154,7 +174,7 @@ func NewReplaceEmailDomainCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -192,12 +212,16 @@ func NewDeleteEmailDomainCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "delete",
 		Long: "Delete an Email Domain",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.emailDomains.manage",
+			destructiveAnnotation: "/api/v1/email-domains/{emailDomainId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.EmailDomainAPI.DeleteEmailDomain(apiClient.GetConfig().Context, DeleteEmailDomainemailDomainId)
+			req := apiClient.EmailDomainAPI.DeleteEmailDomain(cmd.Context(), DeleteEmailDomainemailDomainId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -232,12 +256,15 @@ func NewVerifyEmailDomainCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "verify",
 		Long: "Verify an Email Domain",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.emailDomains.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.EmailDomainAPI.VerifyEmailDomain(apiClient.GetConfig().Context, VerifyEmailDomainemailDomainId)
+			req := apiClient.EmailDomainAPI.VerifyEmailDomain(cmd.Context(), VerifyEmailDomainemailDomainId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -22,8 +22,11 @@ func NewCreateEmailServerCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "create",
 		Long: "Create a custom SMTP server",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.emailServers.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.EmailServerAPI.CreateEmailServer(apiClient.GetConfig().Context)
+			req := apiClient.EmailServerAPI.CreateEmailServer(cmd.Context())
 
 			if CreateEmailServerdata != "" {
 				req = req.Data(CreateEmailServerdata)
@@ -31,7 +34,7 @@ func NewCreateEmailServerCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -64,12 +67,15 @@ func NewListEmailServersCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "lists",
 		Long: "List all enrolled SMTP servers",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.emailServers.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.EmailServerAPI.ListEmailServers(apiClient.GetConfig().Context)
+			req := apiClient.EmailServerAPI.ListEmailServers(cmd.Context())
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -101,12 +107,15 @@ func NewGetEmailServerCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "get",
 		Long: "Retrieve an SMTP Server configuration",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.emailServers.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.EmailServerAPI.GetEmailServer(apiClient.GetConfig().Context, GetEmailServeremailServerId)
+			req := apiClient.EmailServerAPI.GetEmailServer(cmd.Context(), GetEmailServeremailServerId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -141,12 +150,16 @@ func NewDeleteEmailServerCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "delete",
 		Long: "Delete an SMTP Server configuration",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.emailServers.manage",
+			destructiveAnnotation: "/api/v1/email-servers/{emailServerId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.EmailServerAPI.DeleteEmailServer(apiClient.GetConfig().Context, DeleteEmailServeremailServerId)
+			req := apiClient.EmailServerAPI.DeleteEmailServer(cmd.Context(), DeleteEmailServeremailServerId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -185,8 +198,11 @@ func NewUpdateEmailServerCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "update",
 		Long: "Update an SMTP Server configuration",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.emailServers.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.EmailServerAPI.UpdateEmailServer(apiClient.GetConfig().Context, UpdateEmailServeremailServerId)
+			req := apiClient.EmailServerAPI.UpdateEmailServer(cmd.Context(), UpdateEmailServeremailServerId)
 
 			if UpdateEmailServerdata != "" {
 				req = req.Data(UpdateEmailServerdata)
@@ -194,7 +210,7 @@ func NewUpdateEmailServerCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -236,8 +252,11 @@ func NewTestEmailServerCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "test",
 		Long: "Test an SMTP Server configuration",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.emailServers.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.EmailServerAPI.TestEmailServer(apiClient.GetConfig().Context, TestEmailServeremailServerId)
+			req := apiClient.EmailServerAPI.TestEmailServer(cmd.Context(), TestEmailServeremailServerId)
 
 			if TestEmailServerdata != "" {
 				req = req.Data(TestEmailServerdata)
@@ -245,7 +264,7 @@ func NewTestEmailServerCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
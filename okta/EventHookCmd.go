@@ -22,8 +22,11 @@ func NewCreateEventHookCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "create",
 		Long: "Create an Event Hook",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.eventHooks.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.EventHookAPI.CreateEventHook(apiClient.GetConfig().Context)
+			req := apiClient.EventHookAPI.CreateEventHook(cmd.Context())
 
 			if CreateEventHookdata != "" {
 				req = req.Data(CreateEventHookdata)
@@ -31,7 +34,7 @@ func NewCreateEventHookCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -60,16 +63,25 @@ func init() {
 	EventHookCmd.AddCommand(CreateEventHookCmd)
 }
 
+var ListEventHooksall bool
+
 func NewListEventHooksCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "lists",
 		Long: "List all Event Hooks",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.eventHooks.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.EventHookAPI.ListEventHooks(apiClient.GetConfig().Context)
+			req := apiClient.EventHookAPI.ListEventHooks(cmd.Context())
+
+			if ListEventHooksall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -87,6 +99,8 @@ func NewListEventHooksCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &ListEventHooksall, false)
+
 	return cmd
 }
 
@@ -101,12 +115,15 @@ func NewGetEventHookCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "get",
 		Long: "Retrieve an Event Hook",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.eventHooks.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.EventHookAPI.GetEventHook(apiClient.GetConfig().Context, GetEventHookeventHookId)
+			req := apiClient.EventHookAPI.GetEventHook(cmd.Context(), GetEventHookeventHookId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -145,8 +162,11 @@ func NewReplaceEventHookCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replace",
 		Long: "Replace an Event Hook",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.eventHooks.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.EventHookAPI.ReplaceEventHook(apiClient.GetConfig().Context, ReplaceEventHookeventHookId)
+			req := apiClient.EventHookAPI.ReplaceEventHook(cmd.Context(), ReplaceEventHookeventHookId)
 
 			if ReplaceEventHookdata != "" {
 				req = req.Data(ReplaceEventHookdata)
@@ -154,7 +174,7 @@ func NewReplaceEventHookCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -192,12 +212,16 @@ func NewDeleteEventHookCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "delete",
 		Long: "Delete an Event Hook",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.eventHooks.manage",
+			destructiveAnnotation: "/api/v1/eventHooks/{eventHookId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.EventHookAPI.DeleteEventHook(apiClient.GetConfig().Context, DeleteEventHookeventHookId)
+			req := apiClient.EventHookAPI.DeleteEventHook(cmd.Context(), DeleteEventHookeventHookId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -232,12 +256,15 @@ func NewActivateEventHookCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "activate",
 		Long: "Activate an Event Hook",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.eventHooks.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.EventHookAPI.ActivateEventHook(apiClient.GetConfig().Context, ActivateEventHookeventHookId)
+			req := apiClient.EventHookAPI.ActivateEventHook(cmd.Context(), ActivateEventHookeventHookId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -272,12 +299,16 @@ func NewDeactivateEventHookCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deactivate",
 		Long: "Deactivate an Event Hook",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.eventHooks.manage",
+			destructiveAnnotation: "/api/v1/eventHooks/{eventHookId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.EventHookAPI.DeactivateEventHook(apiClient.GetConfig().Context, DeactivateEventHookeventHookId)
+			req := apiClient.EventHookAPI.DeactivateEventHook(cmd.Context(), DeactivateEventHookeventHookId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -312,12 +343,15 @@ func NewVerifyEventHookCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "verify",
 		Long: "Verify an Event Hook",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.eventHooks.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.EventHookAPI.VerifyEventHook(apiClient.GetConfig().Context, VerifyEventHookeventHookId)
+			req := apiClient.EventHookAPI.VerifyEventHook(cmd.Context(), VerifyEventHookeventHookId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
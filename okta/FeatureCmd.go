@@ -16,16 +16,25 @@ func init() {
 	rootCmd.AddCommand(FeatureCmd)
 }
 
+var ListFeaturesall bool
+
 func NewListFeaturesCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "lists",
 		Long: "List all Features",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.features.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.FeatureAPI.ListFeatures(apiClient.GetConfig().Context)
+			req := apiClient.FeatureAPI.ListFeatures(cmd.Context())
+
+			if ListFeaturesall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -43,6 +52,8 @@ func NewListFeaturesCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &ListFeaturesall, false)
+
 	return cmd
 }
 
@@ -57,12 +68,15 @@ func NewGetFeatureCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "get",
 		Long: "Retrieve a Feature",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.features.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.FeatureAPI.GetFeature(apiClient.GetConfig().Context, GetFeaturefeatureId)
+			req := apiClient.FeatureAPI.GetFeature(cmd.Context(), GetFeaturefeatureId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -93,16 +107,25 @@ func init() {
 
 var ListFeatureDependenciesfeatureId string
 
+var ListFeatureDependenciesall bool
+
 func NewListFeatureDependenciesCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listDependencies",
 		Long: "List all dependencies",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.features.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.FeatureAPI.ListFeatureDependencies(apiClient.GetConfig().Context, ListFeatureDependenciesfeatureId)
+			req := apiClient.FeatureAPI.ListFeatureDependencies(cmd.Context(), ListFeatureDependenciesfeatureId)
+
+			if ListFeatureDependenciesall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -123,6 +146,8 @@ func NewListFeatureDependenciesCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListFeatureDependenciesfeatureId, "featureId", "", "", "")
 	cmd.MarkFlagRequired("featureId")
 
+	allFlag.RegisterBool(cmd, &ListFeatureDependenciesall, false)
+
 	return cmd
 }
 
@@ -133,16 +158,25 @@ func init() {
 
 var ListFeatureDependentsfeatureId string
 
+var ListFeatureDependentsall bool
+
 func NewListFeatureDependentsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listDependents",
 		Long: "List all dependents",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.features.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.FeatureAPI.ListFeatureDependents(apiClient.GetConfig().Context, ListFeatureDependentsfeatureId)
+			req := apiClient.FeatureAPI.ListFeatureDependents(cmd.Context(), ListFeatureDependentsfeatureId)
+
+			if ListFeatureDependentsall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -163,6 +197,8 @@ func NewListFeatureDependentsCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListFeatureDependentsfeatureId, "featureId", "", "", "")
 	cmd.MarkFlagRequired("featureId")
 
+	allFlag.RegisterBool(cmd, &ListFeatureDependentsall, false)
+
 	return cmd
 }
 
@@ -181,12 +217,15 @@ func NewUpdateFeatureLifecycleCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "updateLifecycle",
 		Long: "Update a Feature lifecycle",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.features.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.FeatureAPI.UpdateFeatureLifecycle(apiClient.GetConfig().Context, UpdateFeatureLifecyclefeatureId, UpdateFeatureLifecyclelifecycle)
+			req := apiClient.FeatureAPI.UpdateFeatureLifecycle(cmd.Context(), UpdateFeatureLifecyclefeatureId, UpdateFeatureLifecyclelifecycle)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
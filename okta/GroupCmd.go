@@ -22,8 +22,11 @@ func NewCreateGroupCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "create",
 		Long: "Create a Group",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.groups.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.GroupAPI.CreateGroup(apiClient.GetConfig().Context)
+			req := apiClient.GroupAPI.CreateGroup(cmd.Context())
 
 			if CreateGroupdata != "" {
 				req = req.Data(CreateGroupdata)
@@ -31,7 +34,7 @@ func NewCreateGroupCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -60,16 +63,25 @@ func init() {
 	GroupCmd.AddCommand(CreateGroupCmd)
 }
 
+var ListGroupsall bool
+
 func NewListGroupsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "lists",
 		Long: "List all Groups",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.groups.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.GroupAPI.ListGroups(apiClient.GetConfig().Context)
+			req := apiClient.GroupAPI.ListGroups(cmd.Context())
+
+			if ListGroupsall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -87,6 +99,8 @@ func NewListGroupsCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &ListGroupsall, false)
+
 	return cmd
 }
 
@@ -101,8 +115,11 @@ func NewCreateGroupRuleCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "createRule",
 		Long: "Create a Group Rule",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.groups.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.GroupAPI.CreateGroupRule(apiClient.GetConfig().Context)
+			req := apiClient.GroupAPI.CreateGroupRule(cmd.Context())
 
 			if CreateGroupRuledata != "" {
 				req = req.Data(CreateGroupRuledata)
@@ -110,7 +127,7 @@ func NewCreateGroupRuleCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -139,16 +156,25 @@ func init() {
 	GroupCmd.AddCommand(CreateGroupRuleCmd)
 }
 
+var ListGroupRulesall bool
+
 func NewListGroupRulesCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listRules",
 		Long: "List all Group Rules",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.groups.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.GroupAPI.ListGroupRules(apiClient.GetConfig().Context)
+			req := apiClient.GroupAPI.ListGroupRules(cmd.Context())
+
+			if ListGroupRulesall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -166,6 +192,8 @@ func NewListGroupRulesCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &ListGroupRulesall, false)
+
 	return cmd
 }
 
@@ -180,12 +208,15 @@ func NewGetGroupRuleCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getRule",
 		Long: "Retrieve a Group Rule",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.groups.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.GroupAPI.GetGroupRule(apiClient.GetConfig().Context, GetGroupRulegroupRuleId)
+			req := apiClient.GroupAPI.GetGroupRule(cmd.Context(), GetGroupRulegroupRuleId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -224,8 +255,11 @@ func NewReplaceGroupRuleCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replaceRule",
 		Long: "Replace a Group Rule",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.groups.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.GroupAPI.ReplaceGroupRule(apiClient.GetConfig().Context, ReplaceGroupRulegroupRuleId)
+			req := apiClient.GroupAPI.ReplaceGroupRule(cmd.Context(), ReplaceGroupRulegroupRuleId)
 
 			if ReplaceGroupRuledata != "" {
 				req = req.Data(ReplaceGroupRuledata)
@@ -233,7 +267,7 @@ func NewReplaceGroupRuleCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -271,12 +305,16 @@ func NewDeleteGroupRuleCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deleteRule",
 		Long: "Delete a group Rule",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.groups.manage",
+			destructiveAnnotation: "/api/v1/groups/rules/{groupRuleId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.GroupAPI.DeleteGroupRule(apiClient.GetConfig().Context, DeleteGroupRulegroupRuleId)
+			req := apiClient.GroupAPI.DeleteGroupRule(cmd.Context(), DeleteGroupRulegroupRuleId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -311,12 +349,15 @@ func NewActivateGroupRuleCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "activateRule",
 		Long: "Activate a Group Rule",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.groups.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.GroupAPI.ActivateGroupRule(apiClient.GetConfig().Context, ActivateGroupRulegroupRuleId)
+			req := apiClient.GroupAPI.ActivateGroupRule(cmd.Context(), ActivateGroupRulegroupRuleId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -351,12 +392,16 @@ func NewDeactivateGroupRuleCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deactivateRule",
 		Long: "Deactivate a Group Rule",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.groups.manage",
+			destructiveAnnotation: "/api/v1/groups/rules/{groupRuleId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.GroupAPI.DeactivateGroupRule(apiClient.GetConfig().Context, DeactivateGroupRulegroupRuleId)
+			req := apiClient.GroupAPI.DeactivateGroupRule(cmd.Context(), DeactivateGroupRulegroupRuleId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -391,12 +436,15 @@ func NewGetGroupCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "get",
 		Long: "Retrieve a Group",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.groups.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.GroupAPI.GetGroup(apiClient.GetConfig().Context, GetGroupgroupId)
+			req := apiClient.GroupAPI.GetGroup(cmd.Context(), GetGroupgroupId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -435,8 +483,11 @@ func NewReplaceGroupCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replace",
 		Long: "Replace a Group",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.groups.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.GroupAPI.ReplaceGroup(apiClient.GetConfig().Context, ReplaceGroupgroupId)
+			req := apiClient.GroupAPI.ReplaceGroup(cmd.Context(), ReplaceGroupgroupId)
 
 			if ReplaceGroupdata != "" {
 				req = req.Data(ReplaceGroupdata)
@@ -444,7 +495,7 @@ func NewReplaceGroupCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -482,12 +533,16 @@ func NewDeleteGroupCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "delete",
 		Long: "Delete a Group",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.groups.manage",
+			destructiveAnnotation: "/api/v1/groups/{groupId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.GroupAPI.DeleteGroup(apiClient.GetConfig().Context, DeleteGroupgroupId)
+			req := apiClient.GroupAPI.DeleteGroup(cmd.Context(), DeleteGroupgroupId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -518,16 +573,25 @@ func init() {
 
 var ListAssignedApplicationsForGroupgroupId string
 
+var ListAssignedApplicationsForGroupall bool
+
 func NewListAssignedApplicationsForGroupCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listAssignedApplicationsFor",
 		Long: "List all Assigned Applications",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.groups.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.GroupAPI.ListAssignedApplicationsForGroup(apiClient.GetConfig().Context, ListAssignedApplicationsForGroupgroupId)
+			req := apiClient.GroupAPI.ListAssignedApplicationsForGroup(cmd.Context(), ListAssignedApplicationsForGroupgroupId)
+
+			if ListAssignedApplicationsForGroupall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -548,6 +612,8 @@ func NewListAssignedApplicationsForGroupCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListAssignedApplicationsForGroupgroupId, "groupId", "", "", "")
 	cmd.MarkFlagRequired("groupId")
 
+	allFlag.RegisterBool(cmd, &ListAssignedApplicationsForGroupall, false)
+
 	return cmd
 }
 
@@ -558,16 +624,25 @@ func init() {
 
 var ListGroupUsersgroupId string
 
+var ListGroupUsersall bool
+
 func NewListGroupUsersCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listUsers",
 		Long: "List all Member Users",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.groups.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.GroupAPI.ListGroupUsers(apiClient.GetConfig().Context, ListGroupUsersgroupId)
+			req := apiClient.GroupAPI.ListGroupUsers(cmd.Context(), ListGroupUsersgroupId)
+
+			if ListGroupUsersall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -588,6 +663,8 @@ func NewListGroupUsersCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListGroupUsersgroupId, "groupId", "", "", "")
 	cmd.MarkFlagRequired("groupId")
 
+	allFlag.RegisterBool(cmd, &ListGroupUsersall, false)
+
 	return cmd
 }
 
@@ -606,12 +683,15 @@ func NewAssignUserToGroupCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "assignUserTo",
 		Long: "Assign a User",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.groups.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.GroupAPI.AssignUserToGroup(apiClient.GetConfig().Context, AssignUserToGroupgroupId, AssignUserToGroupuserId)
+			req := apiClient.GroupAPI.AssignUserToGroup(cmd.Context(), AssignUserToGroupgroupId, AssignUserToGroupuserId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -653,12 +733,16 @@ func NewUnassignUserFromGroupCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "unassignUserFrom",
 		Long: "Unassign a User",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.groups.manage",
+			destructiveAnnotation: "/api/v1/groups/{groupId}/users/{userId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.GroupAPI.UnassignUserFromGroup(apiClient.GetConfig().Context, UnassignUserFromGroupgroupId, UnassignUserFromGroupuserId)
+			req := apiClient.GroupAPI.UnassignUserFromGroup(cmd.Context(), UnassignUserFromGroupgroupId, UnassignUserFromGroupuserId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -26,8 +26,11 @@ func NewAssignGroupOwnerCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "assign",
 		Long: "Assign a Group Owner",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.groups.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.GroupOwnerAPI.AssignGroupOwner(apiClient.GetConfig().Context, AssignGroupOwnergroupId)
+			req := apiClient.GroupOwnerAPI.AssignGroupOwner(cmd.Context(), AssignGroupOwnergroupId)
 
 			if AssignGroupOwnerdata != "" {
 				req = req.Data(AssignGroupOwnerdata)
@@ -35,7 +38,7 @@ func NewAssignGroupOwnerCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -69,16 +72,25 @@ func init() {
 
 var ListGroupOwnersgroupId string
 
+var ListGroupOwnersall bool
+
 func NewListGroupOwnersCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "lists",
 		Long: "List all Group Owners",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.groups.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.GroupOwnerAPI.ListGroupOwners(apiClient.GetConfig().Context, ListGroupOwnersgroupId)
+			req := apiClient.GroupOwnerAPI.ListGroupOwners(cmd.Context(), ListGroupOwnersgroupId)
+
+			if ListGroupOwnersall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -99,6 +111,8 @@ func NewListGroupOwnersCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListGroupOwnersgroupId, "groupId", "", "", "")
 	cmd.MarkFlagRequired("groupId")
 
+	allFlag.RegisterBool(cmd, &ListGroupOwnersall, false)
+
 	return cmd
 }
 
@@ -117,12 +131,16 @@ func NewDeleteGroupOwnerCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "delete",
 		Long: "Delete a Group Owner",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.groups.manage",
+			destructiveAnnotation: "/api/v1/groups/{groupId}/owners/{ownerId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.GroupOwnerAPI.DeleteGroupOwner(apiClient.GetConfig().Context, DeleteGroupOwnergroupId, DeleteGroupOwnerownerId)
+			req := apiClient.GroupOwnerAPI.DeleteGroupOwner(cmd.Context(), DeleteGroupOwnergroupId, DeleteGroupOwnerownerId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -22,8 +22,11 @@ func NewCreateHookKeyCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "create",
 		Long: "Create a key",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.inlineHooks.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.HookKeyAPI.CreateHookKey(apiClient.GetConfig().Context)
+			req := apiClient.HookKeyAPI.CreateHookKey(cmd.Context())
 
 			if CreateHookKeydata != "" {
 				req = req.Data(CreateHookKeydata)
@@ -31,7 +34,7 @@ func NewCreateHookKeyCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -60,16 +63,25 @@ func init() {
 	HookKeyCmd.AddCommand(CreateHookKeyCmd)
 }
 
+var ListHookKeysall bool
+
 func NewListHookKeysCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "lists",
 		Long: "List all keys",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.inlineHooks.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.HookKeyAPI.ListHookKeys(apiClient.GetConfig().Context)
+			req := apiClient.HookKeyAPI.ListHookKeys(cmd.Context())
+
+			if ListHookKeysall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -87,6 +99,8 @@ func NewListHookKeysCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &ListHookKeysall, false)
+
 	return cmd
 }
 
@@ -101,12 +115,15 @@ func NewGetPublicKeyCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getPublicKey",
 		Long: "Retrieve a public key",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.inlineHooks.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.HookKeyAPI.GetPublicKey(apiClient.GetConfig().Context, GetPublicKeypublicKeyId)
+			req := apiClient.HookKeyAPI.GetPublicKey(cmd.Context(), GetPublicKeypublicKeyId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -141,12 +158,15 @@ func NewGetHookKeyCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "get",
 		Long: "Retrieve a key",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.inlineHooks.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.HookKeyAPI.GetHookKey(apiClient.GetConfig().Context, GetHookKeyhookKeyId)
+			req := apiClient.HookKeyAPI.GetHookKey(cmd.Context(), GetHookKeyhookKeyId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -185,8 +205,11 @@ func NewReplaceHookKeyCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replace",
 		Long: "Replace a key",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.inlineHooks.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.HookKeyAPI.ReplaceHookKey(apiClient.GetConfig().Context, ReplaceHookKeyhookKeyId)
+			req := apiClient.HookKeyAPI.ReplaceHookKey(cmd.Context(), ReplaceHookKeyhookKeyId)
 
 			if ReplaceHookKeydata != "" {
 				req = req.Data(ReplaceHookKeydata)
@@ -194,7 +217,7 @@ func NewReplaceHookKeyCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -232,12 +255,16 @@ func NewDeleteHookKeyCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "delete",
 		Long: "Delete a key",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.inlineHooks.manage",
+			destructiveAnnotation: "/api/v1/hook-keys/{hookKeyId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.HookKeyAPI.DeleteHookKey(apiClient.GetConfig().Context, DeleteHookKeyhookKeyId)
+			req := apiClient.HookKeyAPI.DeleteHookKey(cmd.Context(), DeleteHookKeyhookKeyId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
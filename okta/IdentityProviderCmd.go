@@ -22,8 +22,11 @@ func NewCreateIdentityProviderCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "create",
 		Long: "Create an Identity Provider",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.idps.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.IdentityProviderAPI.CreateIdentityProvider(apiClient.GetConfig().Context)
+			req := apiClient.IdentityProviderAPI.CreateIdentityProvider(cmd.Context())
 
 			if CreateIdentityProviderdata != "" {
 				req = req.Data(CreateIdentityProviderdata)
@@ -31,7 +34,7 @@ func NewCreateIdentityProviderCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -60,16 +63,25 @@ func init() {
 	IdentityProviderCmd.AddCommand(CreateIdentityProviderCmd)
 }
 
+var ListIdentityProvidersall bool
+
 func NewListIdentityProvidersCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "lists",
 		Long: "List all Identity Providers",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.idps.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.IdentityProviderAPI.ListIdentityProviders(apiClient.GetConfig().Context)
+			req := apiClient.IdentityProviderAPI.ListIdentityProviders(cmd.Context())
+
+			if ListIdentityProvidersall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -87,6 +99,8 @@ func NewListIdentityProvidersCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &ListIdentityProvidersall, false)
+
 	return cmd
 }
 
@@ -101,8 +115,11 @@ func NewCreateIdentityProviderKeyCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "createKey",
 		Long: "Create an X.509 Certificate Public Key",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.idps.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.IdentityProviderAPI.CreateIdentityProviderKey(apiClient.GetConfig().Context)
+			req := apiClient.IdentityProviderAPI.CreateIdentityProviderKey(cmd.Context())
 
 			if CreateIdentityProviderKeydata != "" {
 				req = req.Data(CreateIdentityProviderKeydata)
@@ -110,7 +127,7 @@ func NewCreateIdentityProviderKeyCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -139,16 +156,25 @@ func init() {
 	IdentityProviderCmd.AddCommand(CreateIdentityProviderKeyCmd)
 }
 
+var ListIdentityProviderKeysall bool
+
 func NewListIdentityProviderKeysCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listKeys",
 		Long: "List all Credential Keys",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.idps.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.IdentityProviderAPI.ListIdentityProviderKeys(apiClient.GetConfig().Context)
+			req := apiClient.IdentityProviderAPI.ListIdentityProviderKeys(cmd.Context())
+
+			if ListIdentityProviderKeysall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -166,6 +192,8 @@ func NewListIdentityProviderKeysCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &ListIdentityProviderKeysall, false)
+
 	return cmd
 }
 
@@ -180,12 +208,15 @@ func NewGetIdentityProviderKeyCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getKey",
 		Long: "Retrieve an Credential Key",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.idps.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.IdentityProviderAPI.GetIdentityProviderKey(apiClient.GetConfig().Context, GetIdentityProviderKeyidpKeyId)
+			req := apiClient.IdentityProviderAPI.GetIdentityProviderKey(cmd.Context(), GetIdentityProviderKeyidpKeyId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -220,12 +251,16 @@ func NewDeleteIdentityProviderKeyCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deleteKey",
 		Long: "Delete a Signing Credential Key",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.idps.manage",
+			destructiveAnnotation: "/api/v1/idps/credentials/keys/{idpKeyId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.IdentityProviderAPI.DeleteIdentityProviderKey(apiClient.GetConfig().Context, DeleteIdentityProviderKeyidpKeyId)
+			req := apiClient.IdentityProviderAPI.DeleteIdentityProviderKey(cmd.Context(), DeleteIdentityProviderKeyidpKeyId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -260,12 +295,15 @@ func NewGetIdentityProviderCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "get",
 		Long: "Retrieve an Identity Provider",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.idps.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.IdentityProviderAPI.GetIdentityProvider(apiClient.GetConfig().Context, GetIdentityProvideridpId)
+			req := apiClient.IdentityProviderAPI.GetIdentityProvider(cmd.Context(), GetIdentityProvideridpId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -304,8 +342,11 @@ func NewReplaceIdentityProviderCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replace",
 		Long: "Replace an Identity Provider",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.idps.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.IdentityProviderAPI.ReplaceIdentityProvider(apiClient.GetConfig().Context, ReplaceIdentityProvideridpId)
+			req := apiClient.IdentityProviderAPI.ReplaceIdentityProvider(cmd.Context(), ReplaceIdentityProvideridpId)
 
 			if ReplaceIdentityProviderdata != "" {
 				req = req.Data(ReplaceIdentityProviderdata)
@@ -313,7 +354,7 @@ func NewReplaceIdentityProviderCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -351,12 +392,16 @@ func NewDeleteIdentityProviderCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "delete",
 		Long: "Delete an Identity Provider",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.idps.manage",
+			destructiveAnnotation: "/api/v1/idps/{idpId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.IdentityProviderAPI.DeleteIdentityProvider(apiClient.GetConfig().Context, DeleteIdentityProvideridpId)
+			req := apiClient.IdentityProviderAPI.DeleteIdentityProvider(cmd.Context(), DeleteIdentityProvideridpId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -395,8 +440,11 @@ func NewGenerateCsrForIdentityProviderCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "generateCsrFor",
 		Long: "Generate a Certificate Signing Request",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.idps.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.IdentityProviderAPI.GenerateCsrForIdentityProvider(apiClient.GetConfig().Context, GenerateCsrForIdentityProvideridpId)
+			req := apiClient.IdentityProviderAPI.GenerateCsrForIdentityProvider(cmd.Context(), GenerateCsrForIdentityProvideridpId)
 
 			if GenerateCsrForIdentityProviderdata != "" {
 				req = req.Data(GenerateCsrForIdentityProviderdata)
@@ -404,7 +452,7 @@ func NewGenerateCsrForIdentityProviderCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -438,16 +486,25 @@ func init() {
 
 var ListCsrsForIdentityProvideridpId string
 
+var ListCsrsForIdentityProviderall bool
+
 func NewListCsrsForIdentityProviderCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listCsrsFor",
 		Long: "List all Certificate Signing Requests",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.idps.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.IdentityProviderAPI.ListCsrsForIdentityProvider(apiClient.GetConfig().Context, ListCsrsForIdentityProvideridpId)
+			req := apiClient.IdentityProviderAPI.ListCsrsForIdentityProvider(cmd.Context(), ListCsrsForIdentityProvideridpId)
+
+			if ListCsrsForIdentityProviderall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -468,6 +525,8 @@ func NewListCsrsForIdentityProviderCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListCsrsForIdentityProvideridpId, "idpId", "", "", "")
 	cmd.MarkFlagRequired("idpId")
 
+	allFlag.RegisterBool(cmd, &ListCsrsForIdentityProviderall, false)
+
 	return cmd
 }
 
@@ -486,12 +545,15 @@ func NewGetCsrForIdentityProviderCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getCsrFor",
 		Long: "Retrieve a Certificate Signing Request",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.idps.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.IdentityProviderAPI.GetCsrForIdentityProvider(apiClient.GetConfig().Context, GetCsrForIdentityProvideridpId, GetCsrForIdentityProvideridpCsrId)
+			req := apiClient.IdentityProviderAPI.GetCsrForIdentityProvider(cmd.Context(), GetCsrForIdentityProvideridpId, GetCsrForIdentityProvideridpCsrId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -533,12 +595,16 @@ func NewRevokeCsrForIdentityProviderCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "revokeCsrFor",
 		Long: "Revoke a Certificate Signing Request",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.idps.manage",
+			destructiveAnnotation: "/api/v1/idps/{idpId}/credentials/csrs/{idpCsrId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.IdentityProviderAPI.RevokeCsrForIdentityProvider(apiClient.GetConfig().Context, RevokeCsrForIdentityProvideridpId, RevokeCsrForIdentityProvideridpCsrId)
+			req := apiClient.IdentityProviderAPI.RevokeCsrForIdentityProvider(cmd.Context(), RevokeCsrForIdentityProvideridpId, RevokeCsrForIdentityProvideridpCsrId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -582,8 +648,11 @@ func NewPublishCsrForIdentityProviderCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "publishCsrFor",
 		Long: "Publish a Certificate Signing Request",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.idps.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.IdentityProviderAPI.PublishCsrForIdentityProvider(apiClient.GetConfig().Context, PublishCsrForIdentityProvideridpId, PublishCsrForIdentityProvideridpCsrId)
+			req := apiClient.IdentityProviderAPI.PublishCsrForIdentityProvider(cmd.Context(), PublishCsrForIdentityProvideridpId, PublishCsrForIdentityProvideridpCsrId)
 
 			if PublishCsrForIdentityProviderdata != "" {
 				req = req.Data(PublishCsrForIdentityProviderdata)
@@ -591,7 +660,7 @@ func NewPublishCsrForIdentityProviderCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -628,16 +697,25 @@ func init() {
 
 var ListIdentityProviderSigningKeysidpId string
 
+var ListIdentityProviderSigningKeysall bool
+
 func NewListIdentityProviderSigningKeysCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listSigningKeys",
 		Long: "List all Signing Credential Keys",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.idps.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.IdentityProviderAPI.ListIdentityProviderSigningKeys(apiClient.GetConfig().Context, ListIdentityProviderSigningKeysidpId)
+			req := apiClient.IdentityProviderAPI.ListIdentityProviderSigningKeys(cmd.Context(), ListIdentityProviderSigningKeysidpId)
+
+			if ListIdentityProviderSigningKeysall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -658,6 +736,8 @@ func NewListIdentityProviderSigningKeysCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListIdentityProviderSigningKeysidpId, "idpId", "", "", "")
 	cmd.MarkFlagRequired("idpId")
 
+	allFlag.RegisterBool(cmd, &ListIdentityProviderSigningKeysall, false)
+
 	return cmd
 }
 
@@ -672,12 +752,15 @@ func NewGenerateIdentityProviderSigningKeyCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "generateSigningKey",
 		Long: "Generate a new Signing Credential Key",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.idps.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.IdentityProviderAPI.GenerateIdentityProviderSigningKey(apiClient.GetConfig().Context, GenerateIdentityProviderSigningKeyidpId)
+			req := apiClient.IdentityProviderAPI.GenerateIdentityProviderSigningKey(cmd.Context(), GenerateIdentityProviderSigningKeyidpId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -716,12 +799,15 @@ func NewGetIdentityProviderSigningKeyCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getSigningKey",
 		Long: "Retrieve a Signing Credential Key",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.idps.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.IdentityProviderAPI.GetIdentityProviderSigningKey(apiClient.GetConfig().Context, GetIdentityProviderSigningKeyidpId, GetIdentityProviderSigningKeyidpKeyId)
+			req := apiClient.IdentityProviderAPI.GetIdentityProviderSigningKey(cmd.Context(), GetIdentityProviderSigningKeyidpId, GetIdentityProviderSigningKeyidpKeyId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -763,12 +849,15 @@ func NewCloneIdentityProviderKeyCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "cloneKey",
 		Long: "Clone a Signing Credential Key",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.idps.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.IdentityProviderAPI.CloneIdentityProviderKey(apiClient.GetConfig().Context, CloneIdentityProviderKeyidpId, CloneIdentityProviderKeyidpKeyId)
+			req := apiClient.IdentityProviderAPI.CloneIdentityProviderKey(cmd.Context(), CloneIdentityProviderKeyidpId, CloneIdentityProviderKeyidpKeyId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -806,12 +895,15 @@ func NewActivateIdentityProviderCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "activate",
 		Long: "Activate an Identity Provider",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.idps.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.IdentityProviderAPI.ActivateIdentityProvider(apiClient.GetConfig().Context, ActivateIdentityProvideridpId)
+			req := apiClient.IdentityProviderAPI.ActivateIdentityProvider(cmd.Context(), ActivateIdentityProvideridpId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -846,12 +938,16 @@ func NewDeactivateIdentityProviderCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deactivate",
 		Long: "Deactivate an Identity Provider",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.idps.manage",
+			destructiveAnnotation: "/api/v1/idps/{idpId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.IdentityProviderAPI.DeactivateIdentityProvider(apiClient.GetConfig().Context, DeactivateIdentityProvideridpId)
+			req := apiClient.IdentityProviderAPI.DeactivateIdentityProvider(cmd.Context(), DeactivateIdentityProvideridpId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -882,16 +978,25 @@ func init() {
 
 var ListIdentityProviderApplicationUsersidpId string
 
+var ListIdentityProviderApplicationUsersall bool
+
 func NewListIdentityProviderApplicationUsersCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listApplicationUsers",
 		Long: "List all Users",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.idps.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.IdentityProviderAPI.ListIdentityProviderApplicationUsers(apiClient.GetConfig().Context, ListIdentityProviderApplicationUsersidpId)
+			req := apiClient.IdentityProviderAPI.ListIdentityProviderApplicationUsers(cmd.Context(), ListIdentityProviderApplicationUsersidpId)
+
+			if ListIdentityProviderApplicationUsersall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -912,6 +1017,8 @@ func NewListIdentityProviderApplicationUsersCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListIdentityProviderApplicationUsersidpId, "idpId", "", "", "")
 	cmd.MarkFlagRequired("idpId")
 
+	allFlag.RegisterBool(cmd, &ListIdentityProviderApplicationUsersall, false)
+
 	return cmd
 }
 
@@ -932,8 +1039,11 @@ func NewLinkUserToIdentityProviderCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "linkUserTo",
 		Long: "Link a User to a Social IdP",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.users.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.IdentityProviderAPI.LinkUserToIdentityProvider(apiClient.GetConfig().Context, LinkUserToIdentityProvideridpId, LinkUserToIdentityProvideruserId)
+			req := apiClient.IdentityProviderAPI.LinkUserToIdentityProvider(cmd.Context(), LinkUserToIdentityProvideridpId, LinkUserToIdentityProvideruserId)
 
 			if LinkUserToIdentityProviderdata != "" {
 				req = req.Data(LinkUserToIdentityProviderdata)
@@ -941,7 +1051,7 @@ func NewLinkUserToIdentityProviderCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -986,12 +1096,15 @@ func NewGetIdentityProviderApplicationUserCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getApplicationUser",
 		Long: "Retrieve a User",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.idps.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.IdentityProviderAPI.GetIdentityProviderApplicationUser(apiClient.GetConfig().Context, GetIdentityProviderApplicationUseridpId, GetIdentityProviderApplicationUseruserId)
+			req := apiClient.IdentityProviderAPI.GetIdentityProviderApplicationUser(cmd.Context(), GetIdentityProviderApplicationUseridpId, GetIdentityProviderApplicationUseruserId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -1033,12 +1146,16 @@ func NewUnlinkUserFromIdentityProviderCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "unlinkUserFrom",
 		Long: "Unlink a User from IdP",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.idps.manage",
+			destructiveAnnotation: "/api/v1/idps/{idpId}/users/{userId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.IdentityProviderAPI.UnlinkUserFromIdentityProvider(apiClient.GetConfig().Context, UnlinkUserFromIdentityProvideridpId, UnlinkUserFromIdentityProvideruserId)
+			req := apiClient.IdentityProviderAPI.UnlinkUserFromIdentityProvider(cmd.Context(), UnlinkUserFromIdentityProvideridpId, UnlinkUserFromIdentityProvideruserId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -1076,16 +1193,25 @@ var (
 	ListSocialAuthTokensuserId string
 )
 
+var ListSocialAuthTokensall bool
+
 func NewListSocialAuthTokensCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listSocialAuthTokens",
 		Long: "List all Tokens from a OIDC Identity Provider",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.idps.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.IdentityProviderAPI.ListSocialAuthTokens(apiClient.GetConfig().Context, ListSocialAuthTokensidpId, ListSocialAuthTokensuserId)
+			req := apiClient.IdentityProviderAPI.ListSocialAuthTokens(cmd.Context(), ListSocialAuthTokensidpId, ListSocialAuthTokensuserId)
+
+			if ListSocialAuthTokensall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -1109,6 +1235,8 @@ func NewListSocialAuthTokensCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListSocialAuthTokensuserId, "userId", "", "", "")
 	cmd.MarkFlagRequired("userId")
 
+	allFlag.RegisterBool(cmd, &ListSocialAuthTokensall, false)
+
 	return cmd
 }
 
@@ -22,12 +22,15 @@ func NewCreateIdentitySourceSessionCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "createSession",
 		Long: "Create an Identity Source Session",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.identitySources.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.IdentitySourceAPI.CreateIdentitySourceSession(apiClient.GetConfig().Context, CreateIdentitySourceSessionidentitySourceId)
+			req := apiClient.IdentitySourceAPI.CreateIdentitySourceSession(cmd.Context(), CreateIdentitySourceSessionidentitySourceId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -58,16 +61,25 @@ func init() {
 
 var ListIdentitySourceSessionsidentitySourceId string
 
+var ListIdentitySourceSessionsall bool
+
 func NewListIdentitySourceSessionsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listSessions",
 		Long: "List all Identity Source Sessions",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.identitySources.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.IdentitySourceAPI.ListIdentitySourceSessions(apiClient.GetConfig().Context, ListIdentitySourceSessionsidentitySourceId)
+			req := apiClient.IdentitySourceAPI.ListIdentitySourceSessions(cmd.Context(), ListIdentitySourceSessionsidentitySourceId)
+
+			if ListIdentitySourceSessionsall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -88,6 +100,8 @@ func NewListIdentitySourceSessionsCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListIdentitySourceSessionsidentitySourceId, "identitySourceId", "", "", "")
 	cmd.MarkFlagRequired("identitySourceId")
 
+	allFlag.RegisterBool(cmd, &ListIdentitySourceSessionsall, false)
+
 	return cmd
 }
 
@@ -106,12 +120,15 @@ func NewGetIdentitySourceSessionCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getSession",
 		Long: "Retrieve an Identity Source Session",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.identitySources.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.IdentitySourceAPI.GetIdentitySourceSession(apiClient.GetConfig().Context, GetIdentitySourceSessionidentitySourceId, GetIdentitySourceSessionsessionId)
+			req := apiClient.IdentitySourceAPI.GetIdentitySourceSession(cmd.Context(), GetIdentitySourceSessionidentitySourceId, GetIdentitySourceSessionsessionId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -153,12 +170,16 @@ func NewDeleteIdentitySourceSessionCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deleteSession",
 		Long: "Delete an Identity Source Session",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.identitySources.manage",
+			destructiveAnnotation: "/api/v1/identity-sources/{identitySourceId}/sessions/{sessionId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.IdentitySourceAPI.DeleteIdentitySourceSession(apiClient.GetConfig().Context, DeleteIdentitySourceSessionidentitySourceId, DeleteIdentitySourceSessionsessionId)
+			req := apiClient.IdentitySourceAPI.DeleteIdentitySourceSession(cmd.Context(), DeleteIdentitySourceSessionidentitySourceId, DeleteIdentitySourceSessionsessionId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -202,8 +223,11 @@ func NewUploadIdentitySourceDataForDeleteCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "uploadDataForDelete",
 		Long: "Upload the data to be deleted in Okta",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.identitySources.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.IdentitySourceAPI.UploadIdentitySourceDataForDelete(apiClient.GetConfig().Context, UploadIdentitySourceDataForDeleteidentitySourceId, UploadIdentitySourceDataForDeletesessionId)
+			req := apiClient.IdentitySourceAPI.UploadIdentitySourceDataForDelete(cmd.Context(), UploadIdentitySourceDataForDeleteidentitySourceId, UploadIdentitySourceDataForDeletesessionId)
 
 			if UploadIdentitySourceDataForDeletedata != "" {
 				req = req.Data(UploadIdentitySourceDataForDeletedata)
@@ -211,7 +235,7 @@ func NewUploadIdentitySourceDataForDeleteCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -258,8 +282,11 @@ func NewUploadIdentitySourceDataForUpsertCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "uploadDataForUpsert",
 		Long: "Upload the data to be upserted in Okta",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.identitySources.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.IdentitySourceAPI.UploadIdentitySourceDataForUpsert(apiClient.GetConfig().Context, UploadIdentitySourceDataForUpsertidentitySourceId, UploadIdentitySourceDataForUpsertsessionId)
+			req := apiClient.IdentitySourceAPI.UploadIdentitySourceDataForUpsert(cmd.Context(), UploadIdentitySourceDataForUpsertidentitySourceId, UploadIdentitySourceDataForUpsertsessionId)
 
 			if UploadIdentitySourceDataForUpsertdata != "" {
 				req = req.Data(UploadIdentitySourceDataForUpsertdata)
@@ -267,7 +294,7 @@ func NewUploadIdentitySourceDataForUpsertCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -312,12 +339,15 @@ func NewStartImportFromIdentitySourceCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "startImportFrom",
 		Long: "Start the import from the Identity Source",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.identitySources.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.IdentitySourceAPI.StartImportFromIdentitySource(apiClient.GetConfig().Context, StartImportFromIdentitySourceidentitySourceId, StartImportFromIdentitySourcesessionId)
+			req := apiClient.IdentitySourceAPI.StartImportFromIdentitySource(cmd.Context(), StartImportFromIdentitySourceidentitySourceId, StartImportFromIdentitySourcesessionId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -22,8 +22,11 @@ func NewCreateInlineHookCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "create",
 		Long: "Create an Inline Hook",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.inlineHooks.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.InlineHookAPI.CreateInlineHook(apiClient.GetConfig().Context)
+			req := apiClient.InlineHookAPI.CreateInlineHook(cmd.Context())
 
 			if CreateInlineHookdata != "" {
 				req = req.Data(CreateInlineHookdata)
@@ -31,7 +34,7 @@ func NewCreateInlineHookCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -60,16 +63,25 @@ func init() {
 	InlineHookCmd.AddCommand(CreateInlineHookCmd)
 }
 
+var ListInlineHooksall bool
+
 func NewListInlineHooksCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "lists",
 		Long: "List all Inline Hooks",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.inlineHooks.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.InlineHookAPI.ListInlineHooks(apiClient.GetConfig().Context)
+			req := apiClient.InlineHookAPI.ListInlineHooks(cmd.Context())
+
+			if ListInlineHooksall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -87,6 +99,8 @@ func NewListInlineHooksCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &ListInlineHooksall, false)
+
 	return cmd
 }
 
@@ -101,12 +115,15 @@ func NewGetInlineHookCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "get",
 		Long: "Retrieve an Inline Hook",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.inlineHooks.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.InlineHookAPI.GetInlineHook(apiClient.GetConfig().Context, GetInlineHookinlineHookId)
+			req := apiClient.InlineHookAPI.GetInlineHook(cmd.Context(), GetInlineHookinlineHookId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -145,8 +162,11 @@ func NewReplaceInlineHookCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replace",
 		Long: "Replace an Inline Hook",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.inlineHooks.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.InlineHookAPI.ReplaceInlineHook(apiClient.GetConfig().Context, ReplaceInlineHookinlineHookId)
+			req := apiClient.InlineHookAPI.ReplaceInlineHook(cmd.Context(), ReplaceInlineHookinlineHookId)
 
 			if ReplaceInlineHookdata != "" {
 				req = req.Data(ReplaceInlineHookdata)
@@ -154,7 +174,7 @@ func NewReplaceInlineHookCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -192,12 +212,16 @@ func NewDeleteInlineHookCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "delete",
 		Long: "Delete an Inline Hook",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.inlineHooks.manage",
+			destructiveAnnotation: "/api/v1/inlineHooks/{inlineHookId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.InlineHookAPI.DeleteInlineHook(apiClient.GetConfig().Context, DeleteInlineHookinlineHookId)
+			req := apiClient.InlineHookAPI.DeleteInlineHook(cmd.Context(), DeleteInlineHookinlineHookId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -236,8 +260,11 @@ func NewExecuteInlineHookCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "execute",
 		Long: "Execute an Inline Hook",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.inlineHooks.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.InlineHookAPI.ExecuteInlineHook(apiClient.GetConfig().Context, ExecuteInlineHookinlineHookId)
+			req := apiClient.InlineHookAPI.ExecuteInlineHook(cmd.Context(), ExecuteInlineHookinlineHookId)
 
 			if ExecuteInlineHookdata != "" {
 				req = req.Data(ExecuteInlineHookdata)
@@ -245,7 +272,7 @@ func NewExecuteInlineHookCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -283,12 +310,15 @@ func NewActivateInlineHookCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "activate",
 		Long: "Activate an Inline Hook",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.inlineHooks.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.InlineHookAPI.ActivateInlineHook(apiClient.GetConfig().Context, ActivateInlineHookinlineHookId)
+			req := apiClient.InlineHookAPI.ActivateInlineHook(cmd.Context(), ActivateInlineHookinlineHookId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -323,12 +353,16 @@ func NewDeactivateInlineHookCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deactivate",
 		Long: "Deactivate an Inline Hook",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.inlineHooks.manage",
+			destructiveAnnotation: "/api/v1/inlineHooks/{inlineHookId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.InlineHookAPI.DeactivateInlineHook(apiClient.GetConfig().Context, DeactivateInlineHookinlineHookId)
+			req := apiClient.InlineHookAPI.DeactivateInlineHook(cmd.Context(), DeactivateInlineHookinlineHookId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -22,8 +22,11 @@ func NewCreateLinkedObjectDefinitionCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "createDefinition",
 		Long: "Create a Linked Object Definition",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.linkedObjects.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.LinkedObjectAPI.CreateLinkedObjectDefinition(apiClient.GetConfig().Context)
+			req := apiClient.LinkedObjectAPI.CreateLinkedObjectDefinition(cmd.Context())
 
 			if CreateLinkedObjectDefinitiondata != "" {
 				req = req.Data(CreateLinkedObjectDefinitiondata)
@@ -31,7 +34,7 @@ func NewCreateLinkedObjectDefinitionCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -60,16 +63,25 @@ func init() {
 	LinkedObjectCmd.AddCommand(CreateLinkedObjectDefinitionCmd)
 }
 
+var ListLinkedObjectDefinitionsall bool
+
 func NewListLinkedObjectDefinitionsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listDefinitions",
 		Long: "List all Linked Object Definitions",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.linkedObjects.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.LinkedObjectAPI.ListLinkedObjectDefinitions(apiClient.GetConfig().Context)
+			req := apiClient.LinkedObjectAPI.ListLinkedObjectDefinitions(cmd.Context())
+
+			if ListLinkedObjectDefinitionsall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -87,6 +99,8 @@ func NewListLinkedObjectDefinitionsCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &ListLinkedObjectDefinitionsall, false)
+
 	return cmd
 }
 
@@ -101,12 +115,15 @@ func NewGetLinkedObjectDefinitionCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getDefinition",
 		Long: "Retrieve a Linked Object Definition",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.linkedObjects.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.LinkedObjectAPI.GetLinkedObjectDefinition(apiClient.GetConfig().Context, GetLinkedObjectDefinitionlinkedObjectName)
+			req := apiClient.LinkedObjectAPI.GetLinkedObjectDefinition(cmd.Context(), GetLinkedObjectDefinitionlinkedObjectName)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -141,12 +158,16 @@ func NewDeleteLinkedObjectDefinitionCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deleteDefinition",
 		Long: "Delete a Linked Object Definition",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.linkedObjects.manage",
+			destructiveAnnotation: "/api/v1/meta/schemas/user/linkedObjects/{linkedObjectName}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.LinkedObjectAPI.DeleteLinkedObjectDefinition(apiClient.GetConfig().Context, DeleteLinkedObjectDefinitionlinkedObjectName)
+			req := apiClient.LinkedObjectAPI.DeleteLinkedObjectDefinition(cmd.Context(), DeleteLinkedObjectDefinitionlinkedObjectName)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -22,8 +22,11 @@ func NewCreateLogStreamCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "create",
 		Long: "Create a Log Stream",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.logStreams.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.LogStreamAPI.CreateLogStream(apiClient.GetConfig().Context)
+			req := apiClient.LogStreamAPI.CreateLogStream(cmd.Context())
 
 			if CreateLogStreamdata != "" {
 				req = req.Data(CreateLogStreamdata)
@@ -31,7 +34,7 @@ func NewCreateLogStreamCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -60,16 +63,25 @@ func init() {
 	LogStreamCmd.AddCommand(CreateLogStreamCmd)
 }
 
+var ListLogStreamsall bool
+
 func NewListLogStreamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "lists",
 		Long: "List all Log Streams",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.logStreams.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.LogStreamAPI.ListLogStreams(apiClient.GetConfig().Context)
+			req := apiClient.LogStreamAPI.ListLogStreams(cmd.Context())
+
+			if ListLogStreamsall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -87,6 +99,8 @@ func NewListLogStreamsCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &ListLogStreamsall, false)
+
 	return cmd
 }
 
@@ -101,12 +115,15 @@ func NewGetLogStreamCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "get",
 		Long: "Retrieve a Log Stream",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.logStreams.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.LogStreamAPI.GetLogStream(apiClient.GetConfig().Context, GetLogStreamlogStreamId)
+			req := apiClient.LogStreamAPI.GetLogStream(cmd.Context(), GetLogStreamlogStreamId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -145,8 +162,11 @@ func NewReplaceLogStreamCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replace",
 		Long: "Replace a Log Stream",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.logStreams.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.LogStreamAPI.ReplaceLogStream(apiClient.GetConfig().Context, ReplaceLogStreamlogStreamId)
+			req := apiClient.LogStreamAPI.ReplaceLogStream(cmd.Context(), ReplaceLogStreamlogStreamId)
 
 			if ReplaceLogStreamdata != "" {
 				req = req.Data(ReplaceLogStreamdata)
@@ -154,7 +174,7 @@ func NewReplaceLogStreamCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -192,12 +212,16 @@ func NewDeleteLogStreamCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "delete",
 		Long: "Delete a Log Stream",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.logStreams.manage",
+			destructiveAnnotation: "/api/v1/logStreams/{logStreamId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.LogStreamAPI.DeleteLogStream(apiClient.GetConfig().Context, DeleteLogStreamlogStreamId)
+			req := apiClient.LogStreamAPI.DeleteLogStream(cmd.Context(), DeleteLogStreamlogStreamId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -232,12 +256,15 @@ func NewActivateLogStreamCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "activate",
 		Long: "Activate a Log Stream",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.logStreams.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.LogStreamAPI.ActivateLogStream(apiClient.GetConfig().Context, ActivateLogStreamlogStreamId)
+			req := apiClient.LogStreamAPI.ActivateLogStream(cmd.Context(), ActivateLogStreamlogStreamId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -272,12 +299,16 @@ func NewDeactivateLogStreamCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deactivate",
 		Long: "Deactivate a Log Stream",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.logStreams.manage",
+			destructiveAnnotation: "/api/v1/logStreams/{logStreamId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.LogStreamAPI.DeactivateLogStream(apiClient.GetConfig().Context, DeactivateLogStreamlogStreamId)
+			req := apiClient.LogStreamAPI.DeactivateLogStream(cmd.Context(), DeactivateLogStreamlogStreamId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -22,8 +22,11 @@ func NewCreateNetworkZoneCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "create",
 		Long: "Create a Network Zone",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.networkZones.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.NetworkZoneAPI.CreateNetworkZone(apiClient.GetConfig().Context)
+			req := apiClient.NetworkZoneAPI.CreateNetworkZone(cmd.Context())
 
 			if CreateNetworkZonedata != "" {
 				req = req.Data(CreateNetworkZonedata)
@@ -31,7 +34,7 @@ func NewCreateNetworkZoneCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -60,16 +63,25 @@ func init() {
 	NetworkZoneCmd.AddCommand(CreateNetworkZoneCmd)
 }
 
+var ListNetworkZonesall bool
+
 func NewListNetworkZonesCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "lists",
 		Long: "List all Network Zones",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.networkZones.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.NetworkZoneAPI.ListNetworkZones(apiClient.GetConfig().Context)
+			req := apiClient.NetworkZoneAPI.ListNetworkZones(cmd.Context())
+
+			if ListNetworkZonesall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -87,6 +99,8 @@ func NewListNetworkZonesCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &ListNetworkZonesall, false)
+
 	return cmd
 }
 
@@ -101,12 +115,15 @@ func NewGetNetworkZoneCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "get",
 		Long: "Retrieve a Network Zone",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.networkZones.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.NetworkZoneAPI.GetNetworkZone(apiClient.GetConfig().Context, GetNetworkZonezoneId)
+			req := apiClient.NetworkZoneAPI.GetNetworkZone(cmd.Context(), GetNetworkZonezoneId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -145,8 +162,11 @@ func NewReplaceNetworkZoneCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replace",
 		Long: "Replace a Network Zone",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.networkZones.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.NetworkZoneAPI.ReplaceNetworkZone(apiClient.GetConfig().Context, ReplaceNetworkZonezoneId)
+			req := apiClient.NetworkZoneAPI.ReplaceNetworkZone(cmd.Context(), ReplaceNetworkZonezoneId)
 
 			if ReplaceNetworkZonedata != "" {
 				req = req.Data(ReplaceNetworkZonedata)
@@ -154,7 +174,7 @@ func NewReplaceNetworkZoneCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -192,12 +212,16 @@ func NewDeleteNetworkZoneCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "delete",
 		Long: "Delete a Network Zone",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.networkZones.manage",
+			destructiveAnnotation: "/api/v1/zones/{zoneId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.NetworkZoneAPI.DeleteNetworkZone(apiClient.GetConfig().Context, DeleteNetworkZonezoneId)
+			req := apiClient.NetworkZoneAPI.DeleteNetworkZone(cmd.Context(), DeleteNetworkZonezoneId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -232,12 +256,15 @@ func NewActivateNetworkZoneCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "activate",
 		Long: "Activate a Network Zone",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.networkZones.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.NetworkZoneAPI.ActivateNetworkZone(apiClient.GetConfig().Context, ActivateNetworkZonezoneId)
+			req := apiClient.NetworkZoneAPI.ActivateNetworkZone(cmd.Context(), ActivateNetworkZonezoneId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -272,12 +299,16 @@ func NewDeactivateNetworkZoneCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deactivate",
 		Long: "Deactivate a Network Zone",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.networkZones.manage",
+			destructiveAnnotation: "/api/v1/zones/{zoneId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.NetworkZoneAPI.DeactivateNetworkZone(apiClient.GetConfig().Context, DeactivateNetworkZonezoneId)
+			req := apiClient.NetworkZoneAPI.DeactivateNetworkZone(cmd.Context(), DeactivateNetworkZonezoneId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
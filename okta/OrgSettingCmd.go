@@ -21,11 +21,11 @@ func NewGetWellknownOrgMetadataCmd() *cobra.Command {
 		Use:  "getWellknownOrgMetadata",
 		Long: "Retrieve the Well-Known Org Metadata",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.OrgSettingAPI.GetWellknownOrgMetadata(apiClient.GetConfig().Context)
+			req := apiClient.OrgSettingAPI.GetWellknownOrgMetadata(cmd.Context())
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -57,8 +57,11 @@ func NewUpdateOrgSettingsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "updates",
 		Long: "Update the Org Settings",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.orgs.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.OrgSettingAPI.UpdateOrgSettings(apiClient.GetConfig().Context)
+			req := apiClient.OrgSettingAPI.UpdateOrgSettings(cmd.Context())
 
 			if UpdateOrgSettingsdata != "" {
 				req = req.Data(UpdateOrgSettingsdata)
@@ -66,7 +69,7 @@ func NewUpdateOrgSettingsCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -99,12 +102,15 @@ func NewGetOrgSettingsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "gets",
 		Long: "Retrieve the Org Settings",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.orgs.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.OrgSettingAPI.GetOrgSettings(apiClient.GetConfig().Context)
+			req := apiClient.OrgSettingAPI.GetOrgSettings(cmd.Context())
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -136,8 +142,11 @@ func NewReplaceOrgSettingsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replaces",
 		Long: "Replace the Org Settings",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.orgs.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.OrgSettingAPI.ReplaceOrgSettings(apiClient.GetConfig().Context)
+			req := apiClient.OrgSettingAPI.ReplaceOrgSettings(cmd.Context())
 
 			if ReplaceOrgSettingsdata != "" {
 				req = req.Data(ReplaceOrgSettingsdata)
@@ -145,7 +154,7 @@ func NewReplaceOrgSettingsCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -174,16 +183,25 @@ func init() {
 	OrgSettingCmd.AddCommand(ReplaceOrgSettingsCmd)
 }
 
+var GetOrgContactTypesall bool
+
 func NewGetOrgContactTypesCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getOrgContactTypes",
 		Long: "Retrieve the Org Contact Types",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.orgs.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.OrgSettingAPI.GetOrgContactTypes(apiClient.GetConfig().Context)
+			req := apiClient.OrgSettingAPI.GetOrgContactTypes(cmd.Context())
+
+			if GetOrgContactTypesall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -201,6 +219,8 @@ func NewGetOrgContactTypesCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &GetOrgContactTypesall, false)
+
 	return cmd
 }
 
@@ -215,12 +235,15 @@ func NewGetOrgContactUserCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getOrgContactUser",
 		Long: "Retrieve the User of the Contact Type",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.orgs.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.OrgSettingAPI.GetOrgContactUser(apiClient.GetConfig().Context, GetOrgContactUsercontactType)
+			req := apiClient.OrgSettingAPI.GetOrgContactUser(cmd.Context(), GetOrgContactUsercontactType)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -259,8 +282,11 @@ func NewReplaceOrgContactUserCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replaceOrgContactUser",
 		Long: "Replace the User of the Contact Type",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.orgs.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.OrgSettingAPI.ReplaceOrgContactUser(apiClient.GetConfig().Context, ReplaceOrgContactUsercontactType)
+			req := apiClient.OrgSettingAPI.ReplaceOrgContactUser(cmd.Context(), ReplaceOrgContactUsercontactType)
 
 			if ReplaceOrgContactUserdata != "" {
 				req = req.Data(ReplaceOrgContactUserdata)
@@ -268,7 +294,7 @@ func NewReplaceOrgContactUserCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -306,8 +332,11 @@ func NewBulkRemoveEmailAddressBouncesCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "bulkRemoveEmailAddressBounces",
 		Long: "Remove Emails from Email Provider Bounce List",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.orgs.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.OrgSettingAPI.BulkRemoveEmailAddressBounces(apiClient.GetConfig().Context)
+			req := apiClient.OrgSettingAPI.BulkRemoveEmailAddressBounces(cmd.Context())
 
 			if BulkRemoveEmailAddressBouncesdata != "" {
 				req = req.Data(BulkRemoveEmailAddressBouncesdata)
@@ -315,7 +344,7 @@ func NewBulkRemoveEmailAddressBouncesCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -350,8 +379,11 @@ func NewUploadOrgLogoCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "uploadOrgLogo",
 		Long: "Upload the Org Logo",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.apps.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.OrgSettingAPI.UploadOrgLogo(apiClient.GetConfig().Context)
+			req := apiClient.OrgSettingAPI.UploadOrgLogo(cmd.Context())
 
 			if UploadOrgLogodata != "" {
 				req = req.Data(UploadOrgLogodata)
@@ -359,7 +391,7 @@ func NewUploadOrgLogoCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -392,12 +424,15 @@ func NewUpdateThirdPartyAdminSettingCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "updateThirdPartyAdminSetting",
 		Long: "Update the Org Third-Party Admin setting",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.orgs.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.OrgSettingAPI.UpdateThirdPartyAdminSetting(apiClient.GetConfig().Context)
+			req := apiClient.OrgSettingAPI.UpdateThirdPartyAdminSetting(cmd.Context())
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -427,12 +462,15 @@ func NewGetThirdPartyAdminSettingCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getThirdPartyAdminSetting",
 		Long: "Retrieve the Org Third-Party Admin setting",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.orgs.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.OrgSettingAPI.GetThirdPartyAdminSetting(apiClient.GetConfig().Context)
+			req := apiClient.OrgSettingAPI.GetThirdPartyAdminSetting(cmd.Context())
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -462,12 +500,15 @@ func NewGetOrgPreferencesCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getOrgPreferences",
 		Long: "Retrieve the Org Preferences",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.orgs.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.OrgSettingAPI.GetOrgPreferences(apiClient.GetConfig().Context)
+			req := apiClient.OrgSettingAPI.GetOrgPreferences(cmd.Context())
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -497,12 +538,15 @@ func NewUpdateOrgHideOktaUIFooterCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "updateOrgHideOktaUIFooter",
 		Long: "Update the Preference to Hide the Okta Dashboard Footer",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.orgs.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.OrgSettingAPI.UpdateOrgHideOktaUIFooter(apiClient.GetConfig().Context)
+			req := apiClient.OrgSettingAPI.UpdateOrgHideOktaUIFooter(cmd.Context())
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -532,12 +576,15 @@ func NewUpdateOrgShowOktaUIFooterCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "updateOrgShowOktaUIFooter",
 		Long: "Update the Preference to Show the Okta Dashboard Footer",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.orgs.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.OrgSettingAPI.UpdateOrgShowOktaUIFooter(apiClient.GetConfig().Context)
+			req := apiClient.OrgSettingAPI.UpdateOrgShowOktaUIFooter(cmd.Context())
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -567,12 +614,15 @@ func NewGetOktaCommunicationSettingsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getOktaCommunicationSettings",
 		Long: "Retrieve the Okta Communication Settings",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.orgs.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.OrgSettingAPI.GetOktaCommunicationSettings(apiClient.GetConfig().Context)
+			req := apiClient.OrgSettingAPI.GetOktaCommunicationSettings(cmd.Context())
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -602,12 +652,15 @@ func NewOptInUsersToOktaCommunicationEmailsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "optInUsersToOktaCommunicationEmails",
 		Long: "Opt in all Users to Okta Communication emails",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.orgs.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.OrgSettingAPI.OptInUsersToOktaCommunicationEmails(apiClient.GetConfig().Context)
+			req := apiClient.OrgSettingAPI.OptInUsersToOktaCommunicationEmails(cmd.Context())
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -637,12 +690,15 @@ func NewOptOutUsersFromOktaCommunicationEmailsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "optOutUsersFromOktaCommunicationEmails",
 		Long: "Opt out all Users from Okta Communication emails",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.orgs.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.OrgSettingAPI.OptOutUsersFromOktaCommunicationEmails(apiClient.GetConfig().Context)
+			req := apiClient.OrgSettingAPI.OptOutUsersFromOktaCommunicationEmails(cmd.Context())
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -672,12 +728,15 @@ func NewGetOrgOktaSupportSettingsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getOrgOktaSupportSettings",
 		Long: "Retrieve the Okta Support Settings",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.orgs.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.OrgSettingAPI.GetOrgOktaSupportSettings(apiClient.GetConfig().Context)
+			req := apiClient.OrgSettingAPI.GetOrgOktaSupportSettings(cmd.Context())
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -707,12 +766,15 @@ func NewExtendOktaSupportCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "extendOktaSupport",
 		Long: "Extend Okta Support Access",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.orgs.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.OrgSettingAPI.ExtendOktaSupport(apiClient.GetConfig().Context)
+			req := apiClient.OrgSettingAPI.ExtendOktaSupport(cmd.Context())
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -742,12 +804,15 @@ func NewGrantOktaSupportCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "grantOktaSupport",
 		Long: "Grant Okta Support Access to your Org",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.orgs.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.OrgSettingAPI.GrantOktaSupport(apiClient.GetConfig().Context)
+			req := apiClient.OrgSettingAPI.GrantOktaSupport(cmd.Context())
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -777,12 +842,16 @@ func NewRevokeOktaSupportCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "revokeOktaSupport",
 		Long: "Revoke Okta Support Access",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.orgs.manage",
+			destructiveAnnotation: "/api/v1/org/privacy/oktaSupport/revoke",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.OrgSettingAPI.RevokeOktaSupport(apiClient.GetConfig().Context)
+			req := apiClient.OrgSettingAPI.RevokeOktaSupport(cmd.Context())
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -812,12 +881,15 @@ func NewGetClientPrivilegesSettingCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getClientPrivilegesSetting",
 		Long: "Retrieve the Org settings to assign the Super Admin role",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.orgs.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.OrgSettingAPI.GetClientPrivilegesSetting(apiClient.GetConfig().Context)
+			req := apiClient.OrgSettingAPI.GetClientPrivilegesSetting(cmd.Context())
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -849,8 +921,11 @@ func NewAssignClientPrivilegesSettingCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "assignClientPrivilegesSetting",
 		Long: "Assign the Super Admin role to a public client app",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.orgs.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.OrgSettingAPI.AssignClientPrivilegesSetting(apiClient.GetConfig().Context)
+			req := apiClient.OrgSettingAPI.AssignClientPrivilegesSetting(cmd.Context())
 
 			if AssignClientPrivilegesSettingdata != "" {
 				req = req.Data(AssignClientPrivilegesSettingdata)
@@ -858,7 +933,7 @@ func NewAssignClientPrivilegesSettingCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
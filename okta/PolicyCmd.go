@@ -22,8 +22,11 @@ func NewCreatePolicyCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "create",
 		Long: "Create a Policy",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.policies.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.PolicyAPI.CreatePolicy(apiClient.GetConfig().Context)
+			req := apiClient.PolicyAPI.CreatePolicy(cmd.Context())
 
 			if CreatePolicydata != "" {
 				req = req.Data(CreatePolicydata)
@@ -31,7 +34,7 @@ func NewCreatePolicyCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -60,16 +63,25 @@ func init() {
 	PolicyCmd.AddCommand(CreatePolicyCmd)
 }
 
+var ListPoliciesall bool
+
 func NewListPoliciesCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listPolicies",
 		Long: "List all Policies",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.policies.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.PolicyAPI.ListPolicies(apiClient.GetConfig().Context)
+			req := apiClient.PolicyAPI.ListPolicies(cmd.Context())
+
+			if ListPoliciesall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -87,6 +99,8 @@ func NewListPoliciesCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &ListPoliciesall, false)
+
 	return cmd
 }
 
@@ -101,8 +115,11 @@ func NewCreatePolicySimulationCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "createSimulation",
 		Long: "Create a Policy Simulation",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.policies.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.PolicyAPI.CreatePolicySimulation(apiClient.GetConfig().Context)
+			req := apiClient.PolicyAPI.CreatePolicySimulation(cmd.Context())
 
 			if CreatePolicySimulationdata != "" {
 				req = req.Data(CreatePolicySimulationdata)
@@ -110,7 +127,7 @@ func NewCreatePolicySimulationCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -145,12 +162,15 @@ func NewGetPolicyCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "get",
 		Long: "Retrieve a Policy",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.policies.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.PolicyAPI.GetPolicy(apiClient.GetConfig().Context, GetPolicypolicyId)
+			req := apiClient.PolicyAPI.GetPolicy(cmd.Context(), GetPolicypolicyId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -189,8 +209,11 @@ func NewReplacePolicyCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replace",
 		Long: "Replace a Policy",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.policies.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.PolicyAPI.ReplacePolicy(apiClient.GetConfig().Context, ReplacePolicypolicyId)
+			req := apiClient.PolicyAPI.ReplacePolicy(cmd.Context(), ReplacePolicypolicyId)
 
 			if ReplacePolicydata != "" {
 				req = req.Data(ReplacePolicydata)
@@ -198,7 +221,7 @@ func NewReplacePolicyCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -236,12 +259,16 @@ func NewDeletePolicyCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "delete",
 		Long: "Delete a Policy",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.policies.manage",
+			destructiveAnnotation: "/api/v1/policies/{policyId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.PolicyAPI.DeletePolicy(apiClient.GetConfig().Context, DeletePolicypolicyId)
+			req := apiClient.PolicyAPI.DeletePolicy(cmd.Context(), DeletePolicypolicyId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -272,16 +299,25 @@ func init() {
 
 var ListPolicyAppspolicyId string
 
+var ListPolicyAppsall bool
+
 func NewListPolicyAppsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listApps",
 		Long: "List all Applications mapped to a Policy",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.policies.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.PolicyAPI.ListPolicyApps(apiClient.GetConfig().Context, ListPolicyAppspolicyId)
+			req := apiClient.PolicyAPI.ListPolicyApps(cmd.Context(), ListPolicyAppspolicyId)
+
+			if ListPolicyAppsall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -302,6 +338,8 @@ func NewListPolicyAppsCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListPolicyAppspolicyId, "policyId", "", "", "")
 	cmd.MarkFlagRequired("policyId")
 
+	allFlag.RegisterBool(cmd, &ListPolicyAppsall, false)
+
 	return cmd
 }
 
@@ -316,12 +354,15 @@ func NewClonePolicyCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "clone",
 		Long: "Clone an existing Policy",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.policies.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.PolicyAPI.ClonePolicy(apiClient.GetConfig().Context, ClonePolicypolicyId)
+			req := apiClient.PolicyAPI.ClonePolicy(cmd.Context(), ClonePolicypolicyId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -356,12 +397,15 @@ func NewActivatePolicyCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "activate",
 		Long: "Activate a Policy",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.policies.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.PolicyAPI.ActivatePolicy(apiClient.GetConfig().Context, ActivatePolicypolicyId)
+			req := apiClient.PolicyAPI.ActivatePolicy(cmd.Context(), ActivatePolicypolicyId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -396,12 +440,16 @@ func NewDeactivatePolicyCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deactivate",
 		Long: "Deactivate a Policy",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.policies.manage",
+			destructiveAnnotation: "/api/v1/policies/{policyId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.PolicyAPI.DeactivatePolicy(apiClient.GetConfig().Context, DeactivatePolicypolicyId)
+			req := apiClient.PolicyAPI.DeactivatePolicy(cmd.Context(), DeactivatePolicypolicyId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -440,8 +488,11 @@ func NewMapResourceToPolicyCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "mapResourceTo",
 		Long: "Map a resource to a Policy",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.policies.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.PolicyAPI.MapResourceToPolicy(apiClient.GetConfig().Context, MapResourceToPolicypolicyId)
+			req := apiClient.PolicyAPI.MapResourceToPolicy(cmd.Context(), MapResourceToPolicypolicyId)
 
 			if MapResourceToPolicydata != "" {
 				req = req.Data(MapResourceToPolicydata)
@@ -449,7 +500,7 @@ func NewMapResourceToPolicyCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -483,16 +534,25 @@ func init() {
 
 var ListPolicyMappingspolicyId string
 
+var ListPolicyMappingsall bool
+
 func NewListPolicyMappingsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listMappings",
 		Long: "List all resources mapped to a Policy",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.policies.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.PolicyAPI.ListPolicyMappings(apiClient.GetConfig().Context, ListPolicyMappingspolicyId)
+			req := apiClient.PolicyAPI.ListPolicyMappings(cmd.Context(), ListPolicyMappingspolicyId)
+
+			if ListPolicyMappingsall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -513,6 +573,8 @@ func NewListPolicyMappingsCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListPolicyMappingspolicyId, "policyId", "", "", "")
 	cmd.MarkFlagRequired("policyId")
 
+	allFlag.RegisterBool(cmd, &ListPolicyMappingsall, false)
+
 	return cmd
 }
 
@@ -531,12 +593,15 @@ func NewGetPolicyMappingCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getMapping",
 		Long: "Retrieve a policy resource Mapping",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.policies.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.PolicyAPI.GetPolicyMapping(apiClient.GetConfig().Context, GetPolicyMappingpolicyId, GetPolicyMappingmappingId)
+			req := apiClient.PolicyAPI.GetPolicyMapping(cmd.Context(), GetPolicyMappingpolicyId, GetPolicyMappingmappingId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -578,12 +643,16 @@ func NewDeletePolicyResourceMappingCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deleteResourceMapping",
 		Long: "Delete a policy resource Mapping",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.policies.manage",
+			destructiveAnnotation: "/api/v1/policies/{policyId}/mappings/{mappingId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.PolicyAPI.DeletePolicyResourceMapping(apiClient.GetConfig().Context, DeletePolicyResourceMappingpolicyId, DeletePolicyResourceMappingmappingId)
+			req := apiClient.PolicyAPI.DeletePolicyResourceMapping(cmd.Context(), DeletePolicyResourceMappingpolicyId, DeletePolicyResourceMappingmappingId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -625,8 +694,11 @@ func NewCreatePolicyRuleCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "createRule",
 		Long: "Create a Policy Rule",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.policies.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.PolicyAPI.CreatePolicyRule(apiClient.GetConfig().Context, CreatePolicyRulepolicyId)
+			req := apiClient.PolicyAPI.CreatePolicyRule(cmd.Context(), CreatePolicyRulepolicyId)
 
 			if CreatePolicyRuledata != "" {
 				req = req.Data(CreatePolicyRuledata)
@@ -634,7 +706,7 @@ func NewCreatePolicyRuleCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -668,16 +740,25 @@ func init() {
 
 var ListPolicyRulespolicyId string
 
+var ListPolicyRulesall bool
+
 func NewListPolicyRulesCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listRules",
 		Long: "List all Policy Rules",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.policies.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.PolicyAPI.ListPolicyRules(apiClient.GetConfig().Context, ListPolicyRulespolicyId)
+			req := apiClient.PolicyAPI.ListPolicyRules(cmd.Context(), ListPolicyRulespolicyId)
+
+			if ListPolicyRulesall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -698,6 +779,8 @@ func NewListPolicyRulesCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListPolicyRulespolicyId, "policyId", "", "", "")
 	cmd.MarkFlagRequired("policyId")
 
+	allFlag.RegisterBool(cmd, &ListPolicyRulesall, false)
+
 	return cmd
 }
 
@@ -716,12 +799,15 @@ func NewGetPolicyRuleCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getRule",
 		Long: "Retrieve a Policy Rule",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.policies.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.PolicyAPI.GetPolicyRule(apiClient.GetConfig().Context, GetPolicyRulepolicyId, GetPolicyRuleruleId)
+			req := apiClient.PolicyAPI.GetPolicyRule(cmd.Context(), GetPolicyRulepolicyId, GetPolicyRuleruleId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -765,8 +851,11 @@ func NewReplacePolicyRuleCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replaceRule",
 		Long: "Replace a Policy Rule",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.policies.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.PolicyAPI.ReplacePolicyRule(apiClient.GetConfig().Context, ReplacePolicyRulepolicyId, ReplacePolicyRuleruleId)
+			req := apiClient.PolicyAPI.ReplacePolicyRule(cmd.Context(), ReplacePolicyRulepolicyId, ReplacePolicyRuleruleId)
 
 			if ReplacePolicyRuledata != "" {
 				req = req.Data(ReplacePolicyRuledata)
@@ -774,7 +863,7 @@ func NewReplacePolicyRuleCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -819,12 +908,16 @@ func NewDeletePolicyRuleCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deleteRule",
 		Long: "Delete a Policy Rule",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.policies.manage",
+			destructiveAnnotation: "/api/v1/policies/{policyId}/rules/{ruleId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.PolicyAPI.DeletePolicyRule(apiClient.GetConfig().Context, DeletePolicyRulepolicyId, DeletePolicyRuleruleId)
+			req := apiClient.PolicyAPI.DeletePolicyRule(cmd.Context(), DeletePolicyRulepolicyId, DeletePolicyRuleruleId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -866,12 +959,15 @@ func NewActivatePolicyRuleCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "activateRule",
 		Long: "Activate a Policy Rule",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.policies.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.PolicyAPI.ActivatePolicyRule(apiClient.GetConfig().Context, ActivatePolicyRulepolicyId, ActivatePolicyRuleruleId)
+			req := apiClient.PolicyAPI.ActivatePolicyRule(cmd.Context(), ActivatePolicyRulepolicyId, ActivatePolicyRuleruleId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -913,12 +1009,16 @@ func NewDeactivatePolicyRuleCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deactivateRule",
 		Long: "Deactivate a Policy Rule",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.policies.manage",
+			destructiveAnnotation: "/api/v1/policies/{policyId}/rules/{ruleId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.PolicyAPI.DeactivatePolicyRule(apiClient.GetConfig().Context, DeactivatePolicyRulepolicyId, DeactivatePolicyRuleruleId)
+			req := apiClient.PolicyAPI.DeactivatePolicyRule(cmd.Context(), DeactivatePolicyRulepolicyId, DeactivatePolicyRuleruleId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -22,8 +22,11 @@ func NewCreatePrincipalRateLimitEntityCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "createEntity",
 		Long: "Create a Principal Rate Limit",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.principalRateLimits.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.PrincipalRateLimitAPI.CreatePrincipalRateLimitEntity(apiClient.GetConfig().Context)
+			req := apiClient.PrincipalRateLimitAPI.CreatePrincipalRateLimitEntity(cmd.Context())
 
 			if CreatePrincipalRateLimitEntitydata != "" {
 				req = req.Data(CreatePrincipalRateLimitEntitydata)
@@ -31,7 +34,7 @@ func NewCreatePrincipalRateLimitEntityCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -60,16 +63,25 @@ func init() {
 	PrincipalRateLimitCmd.AddCommand(CreatePrincipalRateLimitEntityCmd)
 }
 
+var ListPrincipalRateLimitEntitiesall bool
+
 func NewListPrincipalRateLimitEntitiesCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listEntities",
 		Long: "List all Principal Rate Limits",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.principalRateLimits.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.PrincipalRateLimitAPI.ListPrincipalRateLimitEntities(apiClient.GetConfig().Context)
+			req := apiClient.PrincipalRateLimitAPI.ListPrincipalRateLimitEntities(cmd.Context())
+
+			if ListPrincipalRateLimitEntitiesall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -87,6 +99,8 @@ func NewListPrincipalRateLimitEntitiesCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &ListPrincipalRateLimitEntitiesall, false)
+
 	return cmd
 }
 
@@ -101,12 +115,15 @@ func NewGetPrincipalRateLimitEntityCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getEntity",
 		Long: "Retrieve a Principal Rate Limit",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.principalRateLimits.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.PrincipalRateLimitAPI.GetPrincipalRateLimitEntity(apiClient.GetConfig().Context, GetPrincipalRateLimitEntityprincipalRateLimitId)
+			req := apiClient.PrincipalRateLimitAPI.GetPrincipalRateLimitEntity(cmd.Context(), GetPrincipalRateLimitEntityprincipalRateLimitId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -145,8 +162,11 @@ func NewReplacePrincipalRateLimitEntityCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replaceEntity",
 		Long: "Replace a Principal Rate Limit",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.principalRateLimits.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.PrincipalRateLimitAPI.ReplacePrincipalRateLimitEntity(apiClient.GetConfig().Context, ReplacePrincipalRateLimitEntityprincipalRateLimitId)
+			req := apiClient.PrincipalRateLimitAPI.ReplacePrincipalRateLimitEntity(cmd.Context(), ReplacePrincipalRateLimitEntityprincipalRateLimitId)
 
 			if ReplacePrincipalRateLimitEntitydata != "" {
 				req = req.Data(ReplacePrincipalRateLimitEntitydata)
@@ -154,7 +174,7 @@ func NewReplacePrincipalRateLimitEntityCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
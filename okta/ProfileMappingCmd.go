@@ -16,16 +16,25 @@ func init() {
 	rootCmd.AddCommand(ProfileMappingCmd)
 }
 
+var ListProfileMappingsall bool
+
 func NewListProfileMappingsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "lists",
 		Long: "List all Profile Mappings",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.profileMappings.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ProfileMappingAPI.ListProfileMappings(apiClient.GetConfig().Context)
+			req := apiClient.ProfileMappingAPI.ListProfileMappings(cmd.Context())
+
+			if ListProfileMappingsall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -43,6 +52,8 @@ func NewListProfileMappingsCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &ListProfileMappingsall, false)
+
 	return cmd
 }
 
@@ -61,8 +72,11 @@ func NewUpdateProfileMappingCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "update",
 		Long: "Update a Profile Mapping",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.profileMappings.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ProfileMappingAPI.UpdateProfileMapping(apiClient.GetConfig().Context, UpdateProfileMappingmappingId)
+			req := apiClient.ProfileMappingAPI.UpdateProfileMapping(cmd.Context(), UpdateProfileMappingmappingId)
 
 			if UpdateProfileMappingdata != "" {
 				req = req.Data(UpdateProfileMappingdata)
@@ -70,7 +84,7 @@ func NewUpdateProfileMappingCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -108,12 +122,15 @@ func NewGetProfileMappingCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "get",
 		Long: "Retrieve a Profile Mapping",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.profileMappings.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ProfileMappingAPI.GetProfileMapping(apiClient.GetConfig().Context, GetProfileMappingmappingId)
+			req := apiClient.ProfileMappingAPI.GetProfileMapping(cmd.Context(), GetProfileMappingmappingId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
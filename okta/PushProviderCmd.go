@@ -22,8 +22,11 @@ func NewCreatePushProviderCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "create",
 		Long: "Create a Push Provider",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.pushProviders.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.PushProviderAPI.CreatePushProvider(apiClient.GetConfig().Context)
+			req := apiClient.PushProviderAPI.CreatePushProvider(cmd.Context())
 
 			if CreatePushProviderdata != "" {
 				req = req.Data(CreatePushProviderdata)
@@ -31,7 +34,7 @@ func NewCreatePushProviderCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -60,16 +63,25 @@ func init() {
 	PushProviderCmd.AddCommand(CreatePushProviderCmd)
 }
 
+var ListPushProvidersall bool
+
 func NewListPushProvidersCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "lists",
 		Long: "List all Push Providers",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.pushProviders.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.PushProviderAPI.ListPushProviders(apiClient.GetConfig().Context)
+			req := apiClient.PushProviderAPI.ListPushProviders(cmd.Context())
+
+			if ListPushProvidersall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -87,6 +99,8 @@ func NewListPushProvidersCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &ListPushProvidersall, false)
+
 	return cmd
 }
 
@@ -101,12 +115,15 @@ func NewGetPushProviderCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "get",
 		Long: "Retrieve a Push Provider",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.pushProviders.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.PushProviderAPI.GetPushProvider(apiClient.GetConfig().Context, GetPushProviderpushProviderId)
+			req := apiClient.PushProviderAPI.GetPushProvider(cmd.Context(), GetPushProviderpushProviderId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -145,8 +162,11 @@ func NewReplacePushProviderCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replace",
 		Long: "Replace a Push Provider",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.pushProviders.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.PushProviderAPI.ReplacePushProvider(apiClient.GetConfig().Context, ReplacePushProviderpushProviderId)
+			req := apiClient.PushProviderAPI.ReplacePushProvider(cmd.Context(), ReplacePushProviderpushProviderId)
 
 			if ReplacePushProviderdata != "" {
 				req = req.Data(ReplacePushProviderdata)
@@ -154,7 +174,7 @@ func NewReplacePushProviderCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -192,12 +212,16 @@ func NewDeletePushProviderCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "delete",
 		Long: "Delete a Push Provider",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.pushProviders.manage",
+			destructiveAnnotation: "/api/v1/push-providers/{pushProviderId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.PushProviderAPI.DeletePushProvider(apiClient.GetConfig().Context, DeletePushProviderpushProviderId)
+			req := apiClient.PushProviderAPI.DeletePushProvider(cmd.Context(), DeletePushProviderpushProviderId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
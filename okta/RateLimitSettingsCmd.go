@@ -20,12 +20,15 @@ func NewGetRateLimitSettingsAdminNotificationsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getAdminNotifications",
 		Long: "Retrieve the Rate Limit Admin Notification Settings",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.rateLimits.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RateLimitSettingsAPI.GetRateLimitSettingsAdminNotifications(apiClient.GetConfig().Context)
+			req := apiClient.RateLimitSettingsAPI.GetRateLimitSettingsAdminNotifications(cmd.Context())
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -57,8 +60,11 @@ func NewReplaceRateLimitSettingsAdminNotificationsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replaceAdminNotifications",
 		Long: "Replace the Rate Limit Admin Notification Settings",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.rateLimits.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RateLimitSettingsAPI.ReplaceRateLimitSettingsAdminNotifications(apiClient.GetConfig().Context)
+			req := apiClient.RateLimitSettingsAPI.ReplaceRateLimitSettingsAdminNotifications(cmd.Context())
 
 			if ReplaceRateLimitSettingsAdminNotificationsdata != "" {
 				req = req.Data(ReplaceRateLimitSettingsAdminNotificationsdata)
@@ -66,7 +72,7 @@ func NewReplaceRateLimitSettingsAdminNotificationsCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -99,12 +105,15 @@ func NewGetRateLimitSettingsPerClientCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getPerClient",
 		Long: "Retrieve the Per-Client Rate Limit Settings",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.rateLimits.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RateLimitSettingsAPI.GetRateLimitSettingsPerClient(apiClient.GetConfig().Context)
+			req := apiClient.RateLimitSettingsAPI.GetRateLimitSettingsPerClient(cmd.Context())
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -136,8 +145,11 @@ func NewReplaceRateLimitSettingsPerClientCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replacePerClient",
 		Long: "Replace the Per-Client Rate Limit Settings",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.rateLimits.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RateLimitSettingsAPI.ReplaceRateLimitSettingsPerClient(apiClient.GetConfig().Context)
+			req := apiClient.RateLimitSettingsAPI.ReplaceRateLimitSettingsPerClient(cmd.Context())
 
 			if ReplaceRateLimitSettingsPerClientdata != "" {
 				req = req.Data(ReplaceRateLimitSettingsPerClientdata)
@@ -145,7 +157,7 @@ func NewReplaceRateLimitSettingsPerClientCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -178,12 +190,15 @@ func NewGetRateLimitSettingsWarningThresholdCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getWarningThreshold",
 		Long: "Retrieve the Rate Limit Warning Threshold Percentage",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.rateLimits.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RateLimitSettingsAPI.GetRateLimitSettingsWarningThreshold(apiClient.GetConfig().Context)
+			req := apiClient.RateLimitSettingsAPI.GetRateLimitSettingsWarningThreshold(cmd.Context())
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -215,8 +230,11 @@ func NewReplaceRateLimitSettingsWarningThresholdCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replaceWarningThreshold",
 		Long: "Replace the Rate Limit Warning Threshold Percentage",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.rateLimits.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RateLimitSettingsAPI.ReplaceRateLimitSettingsWarningThreshold(apiClient.GetConfig().Context)
+			req := apiClient.RateLimitSettingsAPI.ReplaceRateLimitSettingsWarningThreshold(cmd.Context())
 
 			if ReplaceRateLimitSettingsWarningThresholddata != "" {
 				req = req.Data(ReplaceRateLimitSettingsWarningThresholddata)
@@ -224,7 +242,7 @@ func NewReplaceRateLimitSettingsWarningThresholdCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -22,8 +22,11 @@ func NewCreateRealmAssignmentCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "create",
 		Long: "Create a Realm Assignment",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.realmAssignments.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RealmAssignmentAPI.CreateRealmAssignment(apiClient.GetConfig().Context)
+			req := apiClient.RealmAssignmentAPI.CreateRealmAssignment(cmd.Context())
 
 			if CreateRealmAssignmentdata != "" {
 				req = req.Data(CreateRealmAssignmentdata)
@@ -31,7 +34,7 @@ func NewCreateRealmAssignmentCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -60,16 +63,25 @@ func init() {
 	RealmAssignmentCmd.AddCommand(CreateRealmAssignmentCmd)
 }
 
+var ListRealmAssignmentsall bool
+
 func NewListRealmAssignmentsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "lists",
 		Long: "List all Realm Assignments",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.realmAssignments.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RealmAssignmentAPI.ListRealmAssignments(apiClient.GetConfig().Context)
+			req := apiClient.RealmAssignmentAPI.ListRealmAssignments(cmd.Context())
+
+			if ListRealmAssignmentsall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -87,6 +99,8 @@ func NewListRealmAssignmentsCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &ListRealmAssignmentsall, false)
+
 	return cmd
 }
 
@@ -101,8 +115,11 @@ func NewExecuteRealmAssignmentCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "execute",
 		Long: "Execute a Realm Assignment",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.realmAssignments.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RealmAssignmentAPI.ExecuteRealmAssignment(apiClient.GetConfig().Context)
+			req := apiClient.RealmAssignmentAPI.ExecuteRealmAssignment(cmd.Context())
 
 			if ExecuteRealmAssignmentdata != "" {
 				req = req.Data(ExecuteRealmAssignmentdata)
@@ -110,7 +127,7 @@ func NewExecuteRealmAssignmentCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -139,16 +156,25 @@ func init() {
 	RealmAssignmentCmd.AddCommand(ExecuteRealmAssignmentCmd)
 }
 
+var ListRealmAssignmentOperationsall bool
+
 func NewListRealmAssignmentOperationsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listOperations",
 		Long: "List all Realm Assignment operations",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.realmAssignments.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RealmAssignmentAPI.ListRealmAssignmentOperations(apiClient.GetConfig().Context)
+			req := apiClient.RealmAssignmentAPI.ListRealmAssignmentOperations(cmd.Context())
+
+			if ListRealmAssignmentOperationsall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -166,6 +192,8 @@ func NewListRealmAssignmentOperationsCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &ListRealmAssignmentOperationsall, false)
+
 	return cmd
 }
 
@@ -180,12 +208,15 @@ func NewGetRealmAssignmentCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "get",
 		Long: "Retrieve a Realm Assignment",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.realmAssignments.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RealmAssignmentAPI.GetRealmAssignment(apiClient.GetConfig().Context, GetRealmAssignmentassignmentId)
+			req := apiClient.RealmAssignmentAPI.GetRealmAssignment(cmd.Context(), GetRealmAssignmentassignmentId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -224,8 +255,11 @@ func NewReplaceRealmAssignmentCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replace",
 		Long: "Replace a Realm Assignment",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.realmAssignments.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RealmAssignmentAPI.ReplaceRealmAssignment(apiClient.GetConfig().Context, ReplaceRealmAssignmentassignmentId)
+			req := apiClient.RealmAssignmentAPI.ReplaceRealmAssignment(cmd.Context(), ReplaceRealmAssignmentassignmentId)
 
 			if ReplaceRealmAssignmentdata != "" {
 				req = req.Data(ReplaceRealmAssignmentdata)
@@ -233,7 +267,7 @@ func NewReplaceRealmAssignmentCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -271,12 +305,16 @@ func NewDeleteRealmAssignmentCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "delete",
 		Long: "Delete a Realm Assignment",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.realmAssignments.manage",
+			destructiveAnnotation: "/api/v1/realm-assignments/{assignmentId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RealmAssignmentAPI.DeleteRealmAssignment(apiClient.GetConfig().Context, DeleteRealmAssignmentassignmentId)
+			req := apiClient.RealmAssignmentAPI.DeleteRealmAssignment(cmd.Context(), DeleteRealmAssignmentassignmentId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -311,12 +349,15 @@ func NewActivateRealmAssignmentCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "activate",
 		Long: "Activate a Realm Assignment",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.realmAssignments.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RealmAssignmentAPI.ActivateRealmAssignment(apiClient.GetConfig().Context, ActivateRealmAssignmentassignmentId)
+			req := apiClient.RealmAssignmentAPI.ActivateRealmAssignment(cmd.Context(), ActivateRealmAssignmentassignmentId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -351,12 +392,16 @@ func NewDeactivateRealmAssignmentCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deactivate",
 		Long: "Deactivate a Realm Assignment",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.realmAssignments.manage",
+			destructiveAnnotation: "/api/v1/realm-assignments/{assignmentId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RealmAssignmentAPI.DeactivateRealmAssignment(apiClient.GetConfig().Context, DeactivateRealmAssignmentassignmentId)
+			req := apiClient.RealmAssignmentAPI.DeactivateRealmAssignment(cmd.Context(), DeactivateRealmAssignmentassignmentId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
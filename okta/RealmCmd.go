@@ -22,8 +22,11 @@ func NewCreateRealmCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "create",
 		Long: "Create a Realm",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.realms.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RealmAPI.CreateRealm(apiClient.GetConfig().Context)
+			req := apiClient.RealmAPI.CreateRealm(cmd.Context())
 
 			if CreateRealmdata != "" {
 				req = req.Data(CreateRealmdata)
@@ -31,7 +34,7 @@ func NewCreateRealmCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -60,16 +63,25 @@ func init() {
 	RealmCmd.AddCommand(CreateRealmCmd)
 }
 
+var ListRealmsall bool
+
 func NewListRealmsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "lists",
 		Long: "List all Realms",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.realms.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RealmAPI.ListRealms(apiClient.GetConfig().Context)
+			req := apiClient.RealmAPI.ListRealms(cmd.Context())
+
+			if ListRealmsall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -87,6 +99,8 @@ func NewListRealmsCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &ListRealmsall, false)
+
 	return cmd
 }
 
@@ -101,12 +115,15 @@ func NewGetRealmCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "get",
 		Long: "Retrieve a Realm",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.realms.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RealmAPI.GetRealm(apiClient.GetConfig().Context, GetRealmrealmId)
+			req := apiClient.RealmAPI.GetRealm(cmd.Context(), GetRealmrealmId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -145,8 +162,11 @@ func NewReplaceRealmCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replace",
 		Long: "Replace the realm profile",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.realms.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RealmAPI.ReplaceRealm(apiClient.GetConfig().Context, ReplaceRealmrealmId)
+			req := apiClient.RealmAPI.ReplaceRealm(cmd.Context(), ReplaceRealmrealmId)
 
 			if ReplaceRealmdata != "" {
 				req = req.Data(ReplaceRealmdata)
@@ -154,7 +174,7 @@ func NewReplaceRealmCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -192,12 +212,16 @@ func NewDeleteRealmCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "delete",
 		Long: "Delete a Realm",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.realms.manage",
+			destructiveAnnotation: "/api/v1/realms/{realmId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RealmAPI.DeleteRealm(apiClient.GetConfig().Context, DeleteRealmrealmId)
+			req := apiClient.RealmAPI.DeleteRealm(cmd.Context(), DeleteRealmrealmId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -22,8 +22,11 @@ func NewCreateResourceSetCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "create",
 		Long: "Create a Resource Set",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.roles.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ResourceSetAPI.CreateResourceSet(apiClient.GetConfig().Context)
+			req := apiClient.ResourceSetAPI.CreateResourceSet(cmd.Context())
 
 			if CreateResourceSetdata != "" {
 				req = req.Data(CreateResourceSetdata)
@@ -31,7 +34,7 @@ func NewCreateResourceSetCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -60,16 +63,25 @@ func init() {
 	ResourceSetCmd.AddCommand(CreateResourceSetCmd)
 }
 
+var ListResourceSetsall bool
+
 func NewListResourceSetsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "lists",
 		Long: "List all Resource Sets",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.roles.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ResourceSetAPI.ListResourceSets(apiClient.GetConfig().Context)
+			req := apiClient.ResourceSetAPI.ListResourceSets(cmd.Context())
+
+			if ListResourceSetsall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -87,6 +99,8 @@ func NewListResourceSetsCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &ListResourceSetsall, false)
+
 	return cmd
 }
 
@@ -101,12 +115,15 @@ func NewGetResourceSetCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "get",
 		Long: "Retrieve a Resource Set",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.roles.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ResourceSetAPI.GetResourceSet(apiClient.GetConfig().Context, GetResourceSetresourceSetId)
+			req := apiClient.ResourceSetAPI.GetResourceSet(cmd.Context(), GetResourceSetresourceSetId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -145,8 +162,11 @@ func NewReplaceResourceSetCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replace",
 		Long: "Replace a Resource Set",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.roles.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ResourceSetAPI.ReplaceResourceSet(apiClient.GetConfig().Context, ReplaceResourceSetresourceSetId)
+			req := apiClient.ResourceSetAPI.ReplaceResourceSet(cmd.Context(), ReplaceResourceSetresourceSetId)
 
 			if ReplaceResourceSetdata != "" {
 				req = req.Data(ReplaceResourceSetdata)
@@ -154,7 +174,7 @@ func NewReplaceResourceSetCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -192,12 +212,16 @@ func NewDeleteResourceSetCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "delete",
 		Long: "Delete a Resource Set",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.roles.manage",
+			destructiveAnnotation: "/api/v1/iam/resource-sets/{resourceSetId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ResourceSetAPI.DeleteResourceSet(apiClient.GetConfig().Context, DeleteResourceSetresourceSetId)
+			req := apiClient.ResourceSetAPI.DeleteResourceSet(cmd.Context(), DeleteResourceSetresourceSetId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -236,8 +260,11 @@ func NewCreateResourceSetBindingCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "createBinding",
 		Long: "Create a Resource Set Binding",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.roles.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ResourceSetAPI.CreateResourceSetBinding(apiClient.GetConfig().Context, CreateResourceSetBindingresourceSetId)
+			req := apiClient.ResourceSetAPI.CreateResourceSetBinding(cmd.Context(), CreateResourceSetBindingresourceSetId)
 
 			if CreateResourceSetBindingdata != "" {
 				req = req.Data(CreateResourceSetBindingdata)
@@ -245,7 +272,7 @@ func NewCreateResourceSetBindingCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -283,12 +310,15 @@ func NewListBindingsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listBindings",
 		Long: "List all Bindings",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.roles.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ResourceSetAPI.ListBindings(apiClient.GetConfig().Context, ListBindingsresourceSetId)
+			req := apiClient.ResourceSetAPI.ListBindings(cmd.Context(), ListBindingsresourceSetId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -327,12 +357,15 @@ func NewGetBindingCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getBinding",
 		Long: "Retrieve a Binding",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.roles.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ResourceSetAPI.GetBinding(apiClient.GetConfig().Context, GetBindingresourceSetId, GetBindingroleIdOrLabel)
+			req := apiClient.ResourceSetAPI.GetBinding(cmd.Context(), GetBindingresourceSetId, GetBindingroleIdOrLabel)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -374,12 +407,16 @@ func NewDeleteBindingCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deleteBinding",
 		Long: "Delete a Binding",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.roles.manage",
+			destructiveAnnotation: "/api/v1/iam/resource-sets/{resourceSetId}/bindings/{roleIdOrLabel}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ResourceSetAPI.DeleteBinding(apiClient.GetConfig().Context, DeleteBindingresourceSetId, DeleteBindingroleIdOrLabel)
+			req := apiClient.ResourceSetAPI.DeleteBinding(cmd.Context(), DeleteBindingresourceSetId, DeleteBindingroleIdOrLabel)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -417,16 +454,25 @@ var (
 	ListMembersOfBindingroleIdOrLabel string
 )
 
+var ListMembersOfBindingall bool
+
 func NewListMembersOfBindingCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listMembersOfBinding",
 		Long: "List all Members of a binding",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.roles.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ResourceSetAPI.ListMembersOfBinding(apiClient.GetConfig().Context, ListMembersOfBindingresourceSetId, ListMembersOfBindingroleIdOrLabel)
+			req := apiClient.ResourceSetAPI.ListMembersOfBinding(cmd.Context(), ListMembersOfBindingresourceSetId, ListMembersOfBindingroleIdOrLabel)
+
+			if ListMembersOfBindingall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -450,6 +496,8 @@ func NewListMembersOfBindingCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListMembersOfBindingroleIdOrLabel, "roleIdOrLabel", "", "", "")
 	cmd.MarkFlagRequired("roleIdOrLabel")
 
+	allFlag.RegisterBool(cmd, &ListMembersOfBindingall, false)
+
 	return cmd
 }
 
@@ -470,8 +518,11 @@ func NewAddMembersToBindingCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "addMembersToBinding",
 		Long: "Add more Members to a binding",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.roles.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ResourceSetAPI.AddMembersToBinding(apiClient.GetConfig().Context, AddMembersToBindingresourceSetId, AddMembersToBindingroleIdOrLabel)
+			req := apiClient.ResourceSetAPI.AddMembersToBinding(cmd.Context(), AddMembersToBindingresourceSetId, AddMembersToBindingroleIdOrLabel)
 
 			if AddMembersToBindingdata != "" {
 				req = req.Data(AddMembersToBindingdata)
@@ -479,7 +530,7 @@ func NewAddMembersToBindingCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -526,12 +577,15 @@ func NewGetMemberOfBindingCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getMemberOfBinding",
 		Long: "Retrieve a Member of a binding",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.roles.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ResourceSetAPI.GetMemberOfBinding(apiClient.GetConfig().Context, GetMemberOfBindingresourceSetId, GetMemberOfBindingroleIdOrLabel, GetMemberOfBindingmemberId)
+			req := apiClient.ResourceSetAPI.GetMemberOfBinding(cmd.Context(), GetMemberOfBindingresourceSetId, GetMemberOfBindingroleIdOrLabel, GetMemberOfBindingmemberId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -578,12 +632,16 @@ func NewUnassignMemberFromBindingCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "unassignMemberFromBinding",
 		Long: "Unassign a Member from a binding",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.roles.manage",
+			destructiveAnnotation: "/api/v1/iam/resource-sets/{resourceSetId}/bindings/{roleIdOrLabel}/members/{memberId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ResourceSetAPI.UnassignMemberFromBinding(apiClient.GetConfig().Context, UnassignMemberFromBindingresourceSetId, UnassignMemberFromBindingroleIdOrLabel, UnassignMemberFromBindingmemberId)
+			req := apiClient.ResourceSetAPI.UnassignMemberFromBinding(cmd.Context(), UnassignMemberFromBindingresourceSetId, UnassignMemberFromBindingroleIdOrLabel, UnassignMemberFromBindingmemberId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -620,16 +678,25 @@ func init() {
 
 var ListResourceSetResourcesresourceSetId string
 
+var ListResourceSetResourcesall bool
+
 func NewListResourceSetResourcesCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listResources",
 		Long: "List all Resources of a Resource Set",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.roles.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ResourceSetAPI.ListResourceSetResources(apiClient.GetConfig().Context, ListResourceSetResourcesresourceSetId)
+			req := apiClient.ResourceSetAPI.ListResourceSetResources(cmd.Context(), ListResourceSetResourcesresourceSetId)
+
+			if ListResourceSetResourcesall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -650,6 +717,8 @@ func NewListResourceSetResourcesCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListResourceSetResourcesresourceSetId, "resourceSetId", "", "", "")
 	cmd.MarkFlagRequired("resourceSetId")
 
+	allFlag.RegisterBool(cmd, &ListResourceSetResourcesall, false)
+
 	return cmd
 }
 
@@ -668,8 +737,11 @@ func NewAddResourceSetResourceCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "addResource",
 		Long: "Add more Resource to a Resource Set",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.roles.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ResourceSetAPI.AddResourceSetResource(apiClient.GetConfig().Context, AddResourceSetResourceresourceSetId)
+			req := apiClient.ResourceSetAPI.AddResourceSetResource(cmd.Context(), AddResourceSetResourceresourceSetId)
 
 			if AddResourceSetResourcedata != "" {
 				req = req.Data(AddResourceSetResourcedata)
@@ -677,7 +749,7 @@ func NewAddResourceSetResourceCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -719,12 +791,16 @@ func NewDeleteResourceSetResourceCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deleteResource",
 		Long: "Delete a Resource from a Resource Set",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.roles.manage",
+			destructiveAnnotation: "/api/v1/iam/resource-sets/{resourceSetId}/resources/{resourceId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ResourceSetAPI.DeleteResourceSetResource(apiClient.GetConfig().Context, DeleteResourceSetResourceresourceSetId, DeleteResourceSetResourceresourceId)
+			req := apiClient.ResourceSetAPI.DeleteResourceSetResource(cmd.Context(), DeleteResourceSetResourceresourceSetId, DeleteResourceSetResourceresourceId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -22,8 +22,11 @@ func NewSendRiskEventsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "sends",
 		Long: "Send multiple Risk Events",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.riskEvents.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RiskEventAPI.SendRiskEvents(apiClient.GetConfig().Context)
+			req := apiClient.RiskEventAPI.SendRiskEvents(cmd.Context())
 
 			if SendRiskEventsdata != "" {
 				req = req.Data(SendRiskEventsdata)
@@ -31,7 +34,7 @@ func NewSendRiskEventsCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
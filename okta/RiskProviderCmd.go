@@ -22,8 +22,11 @@ func NewCreateRiskProviderCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "create",
 		Long: "Create a Risk Provider",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.riskProviders.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RiskProviderAPI.CreateRiskProvider(apiClient.GetConfig().Context)
+			req := apiClient.RiskProviderAPI.CreateRiskProvider(cmd.Context())
 
 			if CreateRiskProviderdata != "" {
 				req = req.Data(CreateRiskProviderdata)
@@ -31,7 +34,7 @@ func NewCreateRiskProviderCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -60,16 +63,25 @@ func init() {
 	RiskProviderCmd.AddCommand(CreateRiskProviderCmd)
 }
 
+var ListRiskProvidersall bool
+
 func NewListRiskProvidersCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "lists",
 		Long: "List all Risk Providers",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.riskProviders.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RiskProviderAPI.ListRiskProviders(apiClient.GetConfig().Context)
+			req := apiClient.RiskProviderAPI.ListRiskProviders(cmd.Context())
+
+			if ListRiskProvidersall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -87,6 +99,8 @@ func NewListRiskProvidersCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &ListRiskProvidersall, false)
+
 	return cmd
 }
 
@@ -101,12 +115,15 @@ func NewGetRiskProviderCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "get",
 		Long: "Retrieve a Risk Provider",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.riskProviders.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RiskProviderAPI.GetRiskProvider(apiClient.GetConfig().Context, GetRiskProviderriskProviderId)
+			req := apiClient.RiskProviderAPI.GetRiskProvider(cmd.Context(), GetRiskProviderriskProviderId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -145,8 +162,11 @@ func NewReplaceRiskProviderCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replace",
 		Long: "Replace a Risk Provider",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.riskProviders.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RiskProviderAPI.ReplaceRiskProvider(apiClient.GetConfig().Context, ReplaceRiskProviderriskProviderId)
+			req := apiClient.RiskProviderAPI.ReplaceRiskProvider(cmd.Context(), ReplaceRiskProviderriskProviderId)
 
 			if ReplaceRiskProviderdata != "" {
 				req = req.Data(ReplaceRiskProviderdata)
@@ -154,7 +174,7 @@ func NewReplaceRiskProviderCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -192,12 +212,16 @@ func NewDeleteRiskProviderCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "delete",
 		Long: "Delete a Risk Provider",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.riskProviders.manage",
+			destructiveAnnotation: "/api/v1/risk/providers/{riskProviderId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RiskProviderAPI.DeleteRiskProvider(apiClient.GetConfig().Context, DeleteRiskProviderriskProviderId)
+			req := apiClient.RiskProviderAPI.DeleteRiskProvider(cmd.Context(), DeleteRiskProviderriskProviderId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
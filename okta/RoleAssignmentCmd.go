@@ -26,8 +26,11 @@ func NewAssignRoleToGroupCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "assignRoleToGroup",
 		Long: "Assign a Role to a Group",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.roles.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RoleAssignmentAPI.AssignRoleToGroup(apiClient.GetConfig().Context, AssignRoleToGroupgroupId)
+			req := apiClient.RoleAssignmentAPI.AssignRoleToGroup(cmd.Context(), AssignRoleToGroupgroupId)
 
 			if AssignRoleToGroupdata != "" {
 				req = req.Data(AssignRoleToGroupdata)
@@ -35,7 +38,7 @@ func NewAssignRoleToGroupCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -69,16 +72,25 @@ func init() {
 
 var ListGroupAssignedRolesgroupId string
 
+var ListGroupAssignedRolesall bool
+
 func NewListGroupAssignedRolesCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listGroupAssignedRoles",
 		Long: "List all Assigned Roles of Group",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.roles.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RoleAssignmentAPI.ListGroupAssignedRoles(apiClient.GetConfig().Context, ListGroupAssignedRolesgroupId)
+			req := apiClient.RoleAssignmentAPI.ListGroupAssignedRoles(cmd.Context(), ListGroupAssignedRolesgroupId)
+
+			if ListGroupAssignedRolesall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -99,6 +111,8 @@ func NewListGroupAssignedRolesCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListGroupAssignedRolesgroupId, "groupId", "", "", "")
 	cmd.MarkFlagRequired("groupId")
 
+	allFlag.RegisterBool(cmd, &ListGroupAssignedRolesall, false)
+
 	return cmd
 }
 
@@ -117,12 +131,15 @@ func NewGetGroupAssignedRoleCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getGroupAssignedRole",
 		Long: "Retrieve a Role assigned to Group",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.roles.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RoleAssignmentAPI.GetGroupAssignedRole(apiClient.GetConfig().Context, GetGroupAssignedRolegroupId, GetGroupAssignedRoleroleId)
+			req := apiClient.RoleAssignmentAPI.GetGroupAssignedRole(cmd.Context(), GetGroupAssignedRolegroupId, GetGroupAssignedRoleroleId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -164,12 +181,16 @@ func NewUnassignRoleFromGroupCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "unassignRoleFromGroup",
 		Long: "Unassign a Role from a Group",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.roles.manage",
+			destructiveAnnotation: "/api/v1/groups/{groupId}/roles/{roleId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RoleAssignmentAPI.UnassignRoleFromGroup(apiClient.GetConfig().Context, UnassignRoleFromGroupgroupId, UnassignRoleFromGrouproleId)
+			req := apiClient.RoleAssignmentAPI.UnassignRoleFromGroup(cmd.Context(), UnassignRoleFromGroupgroupId, UnassignRoleFromGrouproleId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -201,16 +222,25 @@ func init() {
 	RoleAssignmentCmd.AddCommand(UnassignRoleFromGroupCmd)
 }
 
+var ListUsersWithRoleAssignmentsall bool
+
 func NewListUsersWithRoleAssignmentsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listUsersWiths",
 		Long: "List all Users with Role Assignments",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.roles.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RoleAssignmentAPI.ListUsersWithRoleAssignments(apiClient.GetConfig().Context)
+			req := apiClient.RoleAssignmentAPI.ListUsersWithRoleAssignments(cmd.Context())
+
+			if ListUsersWithRoleAssignmentsall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -228,6 +258,8 @@ func NewListUsersWithRoleAssignmentsCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &ListUsersWithRoleAssignmentsall, false)
+
 	return cmd
 }
 
@@ -246,8 +278,11 @@ func NewAssignRoleToUserCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "assignRoleToUser",
 		Long: "Assign a Role to a User",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.roles.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RoleAssignmentAPI.AssignRoleToUser(apiClient.GetConfig().Context, AssignRoleToUseruserId)
+			req := apiClient.RoleAssignmentAPI.AssignRoleToUser(cmd.Context(), AssignRoleToUseruserId)
 
 			if AssignRoleToUserdata != "" {
 				req = req.Data(AssignRoleToUserdata)
@@ -255,7 +290,7 @@ func NewAssignRoleToUserCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -289,16 +324,25 @@ func init() {
 
 var ListAssignedRolesForUseruserId string
 
+var ListAssignedRolesForUserall bool
+
 func NewListAssignedRolesForUserCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listAssignedRolesForUser",
 		Long: "List all Roles assigned to a User",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.roles.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RoleAssignmentAPI.ListAssignedRolesForUser(apiClient.GetConfig().Context, ListAssignedRolesForUseruserId)
+			req := apiClient.RoleAssignmentAPI.ListAssignedRolesForUser(cmd.Context(), ListAssignedRolesForUseruserId)
+
+			if ListAssignedRolesForUserall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -319,6 +363,8 @@ func NewListAssignedRolesForUserCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListAssignedRolesForUseruserId, "userId", "", "", "")
 	cmd.MarkFlagRequired("userId")
 
+	allFlag.RegisterBool(cmd, &ListAssignedRolesForUserall, false)
+
 	return cmd
 }
 
@@ -337,12 +383,15 @@ func NewGetUserAssignedRoleCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getUserAssignedRole",
 		Long: "Retrieve a Role assigned to a User",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.roles.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RoleAssignmentAPI.GetUserAssignedRole(apiClient.GetConfig().Context, GetUserAssignedRoleuserId, GetUserAssignedRoleroleId)
+			req := apiClient.RoleAssignmentAPI.GetUserAssignedRole(cmd.Context(), GetUserAssignedRoleuserId, GetUserAssignedRoleroleId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -384,12 +433,16 @@ func NewUnassignRoleFromUserCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "unassignRoleFromUser",
 		Long: "Unassign a Role from a User",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.roles.manage",
+			destructiveAnnotation: "/api/v1/users/{userId}/roles/{roleId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RoleAssignmentAPI.UnassignRoleFromUser(apiClient.GetConfig().Context, UnassignRoleFromUseruserId, UnassignRoleFromUserroleId)
+			req := apiClient.RoleAssignmentAPI.UnassignRoleFromUser(cmd.Context(), UnassignRoleFromUseruserId, UnassignRoleFromUserroleId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
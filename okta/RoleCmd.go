@@ -22,8 +22,11 @@ func NewCreateRoleCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "create",
 		Long: "Create a Role",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.roles.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RoleAPI.CreateRole(apiClient.GetConfig().Context)
+			req := apiClient.RoleAPI.CreateRole(cmd.Context())
 
 			if CreateRoledata != "" {
 				req = req.Data(CreateRoledata)
@@ -31,7 +34,7 @@ func NewCreateRoleCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -60,16 +63,25 @@ func init() {
 	RoleCmd.AddCommand(CreateRoleCmd)
 }
 
+var ListRolesall bool
+
 func NewListRolesCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "lists",
 		Long: "List all Roles",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.roles.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RoleAPI.ListRoles(apiClient.GetConfig().Context)
+			req := apiClient.RoleAPI.ListRoles(cmd.Context())
+
+			if ListRolesall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -87,6 +99,8 @@ func NewListRolesCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &ListRolesall, false)
+
 	return cmd
 }
 
@@ -101,12 +115,15 @@ func NewGetRoleCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "get",
 		Long: "Retrieve a Role",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.roles.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RoleAPI.GetRole(apiClient.GetConfig().Context, GetRoleroleIdOrLabel)
+			req := apiClient.RoleAPI.GetRole(cmd.Context(), GetRoleroleIdOrLabel)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -145,8 +162,11 @@ func NewReplaceRoleCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replace",
 		Long: "Replace a Role",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.roles.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RoleAPI.ReplaceRole(apiClient.GetConfig().Context, ReplaceRoleroleIdOrLabel)
+			req := apiClient.RoleAPI.ReplaceRole(cmd.Context(), ReplaceRoleroleIdOrLabel)
 
 			if ReplaceRoledata != "" {
 				req = req.Data(ReplaceRoledata)
@@ -154,7 +174,7 @@ func NewReplaceRoleCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -192,12 +212,16 @@ func NewDeleteRoleCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "delete",
 		Long: "Delete a Role",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.roles.manage",
+			destructiveAnnotation: "/api/v1/iam/roles/{roleIdOrLabel}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RoleAPI.DeleteRole(apiClient.GetConfig().Context, DeleteRoleroleIdOrLabel)
+			req := apiClient.RoleAPI.DeleteRole(cmd.Context(), DeleteRoleroleIdOrLabel)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -232,12 +256,15 @@ func NewListRolePermissionsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listPermissions",
 		Long: "List all Permissions",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.roles.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RoleAPI.ListRolePermissions(apiClient.GetConfig().Context, ListRolePermissionsroleIdOrLabel)
+			req := apiClient.RoleAPI.ListRolePermissions(cmd.Context(), ListRolePermissionsroleIdOrLabel)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -278,8 +305,11 @@ func NewCreateRolePermissionCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "createPermission",
 		Long: "Create a Permission",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.roles.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RoleAPI.CreateRolePermission(apiClient.GetConfig().Context, CreateRolePermissionroleIdOrLabel, CreateRolePermissionpermissionType)
+			req := apiClient.RoleAPI.CreateRolePermission(cmd.Context(), CreateRolePermissionroleIdOrLabel, CreateRolePermissionpermissionType)
 
 			if CreateRolePermissiondata != "" {
 				req = req.Data(CreateRolePermissiondata)
@@ -287,7 +317,7 @@ func NewCreateRolePermissionCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -332,12 +362,15 @@ func NewGetRolePermissionCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getPermission",
 		Long: "Retrieve a Permission",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.roles.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RoleAPI.GetRolePermission(apiClient.GetConfig().Context, GetRolePermissionroleIdOrLabel, GetRolePermissionpermissionType)
+			req := apiClient.RoleAPI.GetRolePermission(cmd.Context(), GetRolePermissionroleIdOrLabel, GetRolePermissionpermissionType)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -381,8 +414,11 @@ func NewReplaceRolePermissionCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replacePermission",
 		Long: "Replace a Permission",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.roles.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RoleAPI.ReplaceRolePermission(apiClient.GetConfig().Context, ReplaceRolePermissionroleIdOrLabel, ReplaceRolePermissionpermissionType)
+			req := apiClient.RoleAPI.ReplaceRolePermission(cmd.Context(), ReplaceRolePermissionroleIdOrLabel, ReplaceRolePermissionpermissionType)
 
 			if ReplaceRolePermissiondata != "" {
 				req = req.Data(ReplaceRolePermissiondata)
@@ -390,7 +426,7 @@ func NewReplaceRolePermissionCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -435,12 +471,16 @@ func NewDeleteRolePermissionCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deletePermission",
 		Long: "Delete a Permission",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.roles.manage",
+			destructiveAnnotation: "/api/v1/iam/roles/{roleIdOrLabel}/permissions/{permissionType}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RoleAPI.DeleteRolePermission(apiClient.GetConfig().Context, DeleteRolePermissionroleIdOrLabel, DeleteRolePermissionpermissionType)
+			req := apiClient.RoleAPI.DeleteRolePermission(cmd.Context(), DeleteRolePermissionroleIdOrLabel, DeleteRolePermissionpermissionType)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
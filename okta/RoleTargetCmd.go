@@ -22,16 +22,25 @@ var (
 	ListApplicationTargetsForApplicationAdministratorRoleForGrouproleId string
 )
 
+var ListApplicationTargetsForApplicationAdministratorRoleForGroupall bool
+
 func NewListApplicationTargetsForApplicationAdministratorRoleForGroupCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listApplicationTargetsForApplicationAdministratorRoleForGroup",
 		Long: "List all Application Targets for an Application Administrator Role",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.roles.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RoleTargetAPI.ListApplicationTargetsForApplicationAdministratorRoleForGroup(apiClient.GetConfig().Context, ListApplicationTargetsForApplicationAdministratorRoleForGroupgroupId, ListApplicationTargetsForApplicationAdministratorRoleForGrouproleId)
+			req := apiClient.RoleTargetAPI.ListApplicationTargetsForApplicationAdministratorRoleForGroup(cmd.Context(), ListApplicationTargetsForApplicationAdministratorRoleForGroupgroupId, ListApplicationTargetsForApplicationAdministratorRoleForGrouproleId)
+
+			if ListApplicationTargetsForApplicationAdministratorRoleForGroupall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -55,6 +64,8 @@ func NewListApplicationTargetsForApplicationAdministratorRoleForGroupCmd() *cobr
 	cmd.Flags().StringVarP(&ListApplicationTargetsForApplicationAdministratorRoleForGrouproleId, "roleId", "", "", "")
 	cmd.MarkFlagRequired("roleId")
 
+	allFlag.RegisterBool(cmd, &ListApplicationTargetsForApplicationAdministratorRoleForGroupall, false)
+
 	return cmd
 }
 
@@ -75,12 +86,15 @@ func NewAssignAppTargetToAdminRoleForGroupCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "assignAppTargetToAdminRoleForGroup",
 		Long: "Assign an Application Target to Administrator Role",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.roles.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RoleTargetAPI.AssignAppTargetToAdminRoleForGroup(apiClient.GetConfig().Context, AssignAppTargetToAdminRoleForGroupgroupId, AssignAppTargetToAdminRoleForGrouproleId, AssignAppTargetToAdminRoleForGroupappName)
+			req := apiClient.RoleTargetAPI.AssignAppTargetToAdminRoleForGroup(cmd.Context(), AssignAppTargetToAdminRoleForGroupgroupId, AssignAppTargetToAdminRoleForGrouproleId, AssignAppTargetToAdminRoleForGroupappName)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -127,12 +141,16 @@ func NewUnassignAppTargetToAdminRoleForGroupCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "unassignAppTargetToAdminRoleForGroup",
 		Long: "Unassign an Application Target from Application Administrator Role",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.roles.manage",
+			destructiveAnnotation: "/api/v1/groups/{groupId}/roles/{roleId}/targets/catalog/apps/{appName}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RoleTargetAPI.UnassignAppTargetToAdminRoleForGroup(apiClient.GetConfig().Context, UnassignAppTargetToAdminRoleForGroupgroupId, UnassignAppTargetToAdminRoleForGrouproleId, UnassignAppTargetToAdminRoleForGroupappName)
+			req := apiClient.RoleTargetAPI.UnassignAppTargetToAdminRoleForGroup(cmd.Context(), UnassignAppTargetToAdminRoleForGroupgroupId, UnassignAppTargetToAdminRoleForGrouproleId, UnassignAppTargetToAdminRoleForGroupappName)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -181,12 +199,15 @@ func NewAssignAppInstanceTargetToAppAdminRoleForGroupCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "assignAppInstanceTargetToAppAdminRoleForGroup",
 		Long: "Assign an Application Instance Target to Application Administrator Role",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.roles.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RoleTargetAPI.AssignAppInstanceTargetToAppAdminRoleForGroup(apiClient.GetConfig().Context, AssignAppInstanceTargetToAppAdminRoleForGroupgroupId, AssignAppInstanceTargetToAppAdminRoleForGrouproleId, AssignAppInstanceTargetToAppAdminRoleForGroupappName, AssignAppInstanceTargetToAppAdminRoleForGroupappId)
+			req := apiClient.RoleTargetAPI.AssignAppInstanceTargetToAppAdminRoleForGroup(cmd.Context(), AssignAppInstanceTargetToAppAdminRoleForGroupgroupId, AssignAppInstanceTargetToAppAdminRoleForGrouproleId, AssignAppInstanceTargetToAppAdminRoleForGroupappName, AssignAppInstanceTargetToAppAdminRoleForGroupappId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -238,12 +259,16 @@ func NewUnassignAppInstanceTargetToAppAdminRoleForGroupCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "unassignAppInstanceTargetToAppAdminRoleForGroup",
 		Long: "Unassign an Application Instance Target from an Application Administrator Role",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.roles.manage",
+			destructiveAnnotation: "/api/v1/groups/{groupId}/roles/{roleId}/targets/catalog/apps/{appName}/{appId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RoleTargetAPI.UnassignAppInstanceTargetToAppAdminRoleForGroup(apiClient.GetConfig().Context, UnassignAppInstanceTargetToAppAdminRoleForGroupgroupId, UnassignAppInstanceTargetToAppAdminRoleForGrouproleId, UnassignAppInstanceTargetToAppAdminRoleForGroupappName, UnassignAppInstanceTargetToAppAdminRoleForGroupappId)
+			req := apiClient.RoleTargetAPI.UnassignAppInstanceTargetToAppAdminRoleForGroup(cmd.Context(), UnassignAppInstanceTargetToAppAdminRoleForGroupgroupId, UnassignAppInstanceTargetToAppAdminRoleForGrouproleId, UnassignAppInstanceTargetToAppAdminRoleForGroupappName, UnassignAppInstanceTargetToAppAdminRoleForGroupappId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -287,16 +312,25 @@ var (
 	ListGroupTargetsForGroupRoleroleId string
 )
 
+var ListGroupTargetsForGroupRoleall bool
+
 func NewListGroupTargetsForGroupRoleCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listGroupTargetsForGroupRole",
 		Long: "List all Group Targets for a Group Role",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.roles.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RoleTargetAPI.ListGroupTargetsForGroupRole(apiClient.GetConfig().Context, ListGroupTargetsForGroupRolegroupId, ListGroupTargetsForGroupRoleroleId)
+			req := apiClient.RoleTargetAPI.ListGroupTargetsForGroupRole(cmd.Context(), ListGroupTargetsForGroupRolegroupId, ListGroupTargetsForGroupRoleroleId)
+
+			if ListGroupTargetsForGroupRoleall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -320,6 +354,8 @@ func NewListGroupTargetsForGroupRoleCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListGroupTargetsForGroupRoleroleId, "roleId", "", "", "")
 	cmd.MarkFlagRequired("roleId")
 
+	allFlag.RegisterBool(cmd, &ListGroupTargetsForGroupRoleall, false)
+
 	return cmd
 }
 
@@ -340,12 +376,15 @@ func NewAssignGroupTargetToGroupAdminRoleCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "assignGroupTargetToGroupAdminRole",
 		Long: "Assign a Group Target to a Group Role",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.roles.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RoleTargetAPI.AssignGroupTargetToGroupAdminRole(apiClient.GetConfig().Context, AssignGroupTargetToGroupAdminRolegroupId, AssignGroupTargetToGroupAdminRoleroleId, AssignGroupTargetToGroupAdminRoletargetGroupId)
+			req := apiClient.RoleTargetAPI.AssignGroupTargetToGroupAdminRole(cmd.Context(), AssignGroupTargetToGroupAdminRolegroupId, AssignGroupTargetToGroupAdminRoleroleId, AssignGroupTargetToGroupAdminRoletargetGroupId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -392,12 +431,16 @@ func NewUnassignGroupTargetFromGroupAdminRoleCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "unassignGroupTargetFromGroupAdminRole",
 		Long: "Unassign a Group Target from a Group Role",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.roles.manage",
+			destructiveAnnotation: "/api/v1/groups/{groupId}/roles/{roleId}/targets/groups/{targetGroupId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RoleTargetAPI.UnassignGroupTargetFromGroupAdminRole(apiClient.GetConfig().Context, UnassignGroupTargetFromGroupAdminRolegroupId, UnassignGroupTargetFromGroupAdminRoleroleId, UnassignGroupTargetFromGroupAdminRoletargetGroupId)
+			req := apiClient.RoleTargetAPI.UnassignGroupTargetFromGroupAdminRole(cmd.Context(), UnassignGroupTargetFromGroupAdminRolegroupId, UnassignGroupTargetFromGroupAdminRoleroleId, UnassignGroupTargetFromGroupAdminRoletargetGroupId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -438,16 +481,25 @@ var (
 	ListApplicationTargetsForApplicationAdministratorRoleForUserroleId string
 )
 
+var ListApplicationTargetsForApplicationAdministratorRoleForUserall bool
+
 func NewListApplicationTargetsForApplicationAdministratorRoleForUserCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listApplicationTargetsForApplicationAdministratorRoleForUser",
 		Long: "List all Application Targets for Application Administrator Role",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.roles.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RoleTargetAPI.ListApplicationTargetsForApplicationAdministratorRoleForUser(apiClient.GetConfig().Context, ListApplicationTargetsForApplicationAdministratorRoleForUseruserId, ListApplicationTargetsForApplicationAdministratorRoleForUserroleId)
+			req := apiClient.RoleTargetAPI.ListApplicationTargetsForApplicationAdministratorRoleForUser(cmd.Context(), ListApplicationTargetsForApplicationAdministratorRoleForUseruserId, ListApplicationTargetsForApplicationAdministratorRoleForUserroleId)
+
+			if ListApplicationTargetsForApplicationAdministratorRoleForUserall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -471,6 +523,8 @@ func NewListApplicationTargetsForApplicationAdministratorRoleForUserCmd() *cobra
 	cmd.Flags().StringVarP(&ListApplicationTargetsForApplicationAdministratorRoleForUserroleId, "roleId", "", "", "")
 	cmd.MarkFlagRequired("roleId")
 
+	allFlag.RegisterBool(cmd, &ListApplicationTargetsForApplicationAdministratorRoleForUserall, false)
+
 	return cmd
 }
 
@@ -489,12 +543,15 @@ func NewAssignAllAppsAsTargetToRoleForUserCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "assignAllAppsAsTargetToRoleForUser",
 		Long: "Assign all Apps as Target to Role",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.roles.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RoleTargetAPI.AssignAllAppsAsTargetToRoleForUser(apiClient.GetConfig().Context, AssignAllAppsAsTargetToRoleForUseruserId, AssignAllAppsAsTargetToRoleForUserroleId)
+			req := apiClient.RoleTargetAPI.AssignAllAppsAsTargetToRoleForUser(cmd.Context(), AssignAllAppsAsTargetToRoleForUseruserId, AssignAllAppsAsTargetToRoleForUserroleId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -538,12 +595,15 @@ func NewAssignAppTargetToAdminRoleForUserCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "assignAppTargetToAdminRoleForUser",
 		Long: "Assign an Application Target to Administrator Role",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.roles.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RoleTargetAPI.AssignAppTargetToAdminRoleForUser(apiClient.GetConfig().Context, AssignAppTargetToAdminRoleForUseruserId, AssignAppTargetToAdminRoleForUserroleId, AssignAppTargetToAdminRoleForUserappName)
+			req := apiClient.RoleTargetAPI.AssignAppTargetToAdminRoleForUser(cmd.Context(), AssignAppTargetToAdminRoleForUseruserId, AssignAppTargetToAdminRoleForUserroleId, AssignAppTargetToAdminRoleForUserappName)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -590,12 +650,16 @@ func NewUnassignAppTargetFromAppAdminRoleForUserCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "unassignAppTargetFromAppAdminRoleForUser",
 		Long: "Unassign an Application Target from an Application Administrator Role",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.roles.manage",
+			destructiveAnnotation: "/api/v1/users/{userId}/roles/{roleId}/targets/catalog/apps/{appName}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RoleTargetAPI.UnassignAppTargetFromAppAdminRoleForUser(apiClient.GetConfig().Context, UnassignAppTargetFromAppAdminRoleForUseruserId, UnassignAppTargetFromAppAdminRoleForUserroleId, UnassignAppTargetFromAppAdminRoleForUserappName)
+			req := apiClient.RoleTargetAPI.UnassignAppTargetFromAppAdminRoleForUser(cmd.Context(), UnassignAppTargetFromAppAdminRoleForUseruserId, UnassignAppTargetFromAppAdminRoleForUserroleId, UnassignAppTargetFromAppAdminRoleForUserappName)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -644,12 +708,15 @@ func NewAssignAppInstanceTargetToAppAdminRoleForUserCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "assignAppInstanceTargetToAppAdminRoleForUser",
 		Long: "Assign an Application Instance Target to an Application Administrator Role",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.roles.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RoleTargetAPI.AssignAppInstanceTargetToAppAdminRoleForUser(apiClient.GetConfig().Context, AssignAppInstanceTargetToAppAdminRoleForUseruserId, AssignAppInstanceTargetToAppAdminRoleForUserroleId, AssignAppInstanceTargetToAppAdminRoleForUserappName, AssignAppInstanceTargetToAppAdminRoleForUserappId)
+			req := apiClient.RoleTargetAPI.AssignAppInstanceTargetToAppAdminRoleForUser(cmd.Context(), AssignAppInstanceTargetToAppAdminRoleForUseruserId, AssignAppInstanceTargetToAppAdminRoleForUserroleId, AssignAppInstanceTargetToAppAdminRoleForUserappName, AssignAppInstanceTargetToAppAdminRoleForUserappId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -701,12 +768,16 @@ func NewUnassignAppInstanceTargetFromAdminRoleForUserCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "unassignAppInstanceTargetFromAdminRoleForUser",
 		Long: "Unassign an Application Instance Target from an Application Administrator Role",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.roles.manage",
+			destructiveAnnotation: "/api/v1/users/{userId}/roles/{roleId}/targets/catalog/apps/{appName}/{appId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RoleTargetAPI.UnassignAppInstanceTargetFromAdminRoleForUser(apiClient.GetConfig().Context, UnassignAppInstanceTargetFromAdminRoleForUseruserId, UnassignAppInstanceTargetFromAdminRoleForUserroleId, UnassignAppInstanceTargetFromAdminRoleForUserappName, UnassignAppInstanceTargetFromAdminRoleForUserappId)
+			req := apiClient.RoleTargetAPI.UnassignAppInstanceTargetFromAdminRoleForUser(cmd.Context(), UnassignAppInstanceTargetFromAdminRoleForUseruserId, UnassignAppInstanceTargetFromAdminRoleForUserroleId, UnassignAppInstanceTargetFromAdminRoleForUserappName, UnassignAppInstanceTargetFromAdminRoleForUserappId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -750,16 +821,25 @@ var (
 	ListGroupTargetsForRoleroleId string
 )
 
+var ListGroupTargetsForRoleall bool
+
 func NewListGroupTargetsForRoleCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listGroupTargetsForRole",
 		Long: "List all Group Targets for Role",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.roles.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RoleTargetAPI.ListGroupTargetsForRole(apiClient.GetConfig().Context, ListGroupTargetsForRoleuserId, ListGroupTargetsForRoleroleId)
+			req := apiClient.RoleTargetAPI.ListGroupTargetsForRole(cmd.Context(), ListGroupTargetsForRoleuserId, ListGroupTargetsForRoleroleId)
+
+			if ListGroupTargetsForRoleall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -783,6 +863,8 @@ func NewListGroupTargetsForRoleCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListGroupTargetsForRoleroleId, "roleId", "", "", "")
 	cmd.MarkFlagRequired("roleId")
 
+	allFlag.RegisterBool(cmd, &ListGroupTargetsForRoleall, false)
+
 	return cmd
 }
 
@@ -803,12 +885,15 @@ func NewAssignGroupTargetToUserRoleCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "assignGroupTargetToUserRole",
 		Long: "Assign a Group Target to Role",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.roles.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RoleTargetAPI.AssignGroupTargetToUserRole(apiClient.GetConfig().Context, AssignGroupTargetToUserRoleuserId, AssignGroupTargetToUserRoleroleId, AssignGroupTargetToUserRolegroupId)
+			req := apiClient.RoleTargetAPI.AssignGroupTargetToUserRole(cmd.Context(), AssignGroupTargetToUserRoleuserId, AssignGroupTargetToUserRoleroleId, AssignGroupTargetToUserRolegroupId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -855,12 +940,16 @@ func NewUnassignGroupTargetFromUserAdminRoleCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "unassignGroupTargetFromUserAdminRole",
 		Long: "Unassign a Group Target from Role",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.roles.manage",
+			destructiveAnnotation: "/api/v1/users/{userId}/roles/{roleId}/targets/groups/{groupId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.RoleTargetAPI.UnassignGroupTargetFromUserAdminRole(apiClient.GetConfig().Context, UnassignGroupTargetFromUserAdminRoleuserId, UnassignGroupTargetFromUserAdminRoleroleId, UnassignGroupTargetFromUserAdminRolegroupId)
+			req := apiClient.RoleTargetAPI.UnassignGroupTargetFromUserAdminRole(cmd.Context(), UnassignGroupTargetFromUserAdminRoleuserId, UnassignGroupTargetFromUserAdminRoleroleId, UnassignGroupTargetFromUserAdminRolegroupId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -26,8 +26,11 @@ func NewUpdateApplicationUserProfileCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "updateApplicationUserProfile",
 		Long: "Update the default Application User Schema for an Application",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.schemas.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.SchemaAPI.UpdateApplicationUserProfile(apiClient.GetConfig().Context, UpdateApplicationUserProfileappId)
+			req := apiClient.SchemaAPI.UpdateApplicationUserProfile(cmd.Context(), UpdateApplicationUserProfileappId)
 
 			if UpdateApplicationUserProfiledata != "" {
 				req = req.Data(UpdateApplicationUserProfiledata)
@@ -35,7 +38,7 @@ func NewUpdateApplicationUserProfileCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -73,12 +76,15 @@ func NewGetApplicationUserSchemaCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getApplicationUser",
 		Long: "Retrieve the default Application User Schema for an Application",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.schemas.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.SchemaAPI.GetApplicationUserSchema(apiClient.GetConfig().Context, GetApplicationUserSchemaappId)
+			req := apiClient.SchemaAPI.GetApplicationUserSchema(cmd.Context(), GetApplicationUserSchemaappId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -113,8 +119,11 @@ func NewUpdateGroupSchemaCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "updateGroup",
 		Long: "Update the default Group Schema",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.schemas.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.SchemaAPI.UpdateGroupSchema(apiClient.GetConfig().Context)
+			req := apiClient.SchemaAPI.UpdateGroupSchema(cmd.Context())
 
 			if UpdateGroupSchemadata != "" {
 				req = req.Data(UpdateGroupSchemadata)
@@ -122,7 +131,7 @@ func NewUpdateGroupSchemaCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -155,12 +164,15 @@ func NewGetGroupSchemaCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getGroup",
 		Long: "Retrieve the default Group Schema",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.schemas.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.SchemaAPI.GetGroupSchema(apiClient.GetConfig().Context)
+			req := apiClient.SchemaAPI.GetGroupSchema(cmd.Context())
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -186,16 +198,25 @@ func init() {
 	SchemaCmd.AddCommand(GetGroupSchemaCmd)
 }
 
+var ListLogStreamSchemasall bool
+
 func NewListLogStreamSchemasCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listLogStreams",
 		Long: "List the Log Stream Schemas",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.logStreams.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.SchemaAPI.ListLogStreamSchemas(apiClient.GetConfig().Context)
+			req := apiClient.SchemaAPI.ListLogStreamSchemas(cmd.Context())
+
+			if ListLogStreamSchemasall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -213,6 +234,8 @@ func NewListLogStreamSchemasCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &ListLogStreamSchemasall, false)
+
 	return cmd
 }
 
@@ -227,12 +250,15 @@ func NewGetLogStreamSchemaCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getLogStream",
 		Long: "Retrieve the Log Stream Schema for the schema type",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.logStreams.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.SchemaAPI.GetLogStreamSchema(apiClient.GetConfig().Context, GetLogStreamSchemalogStreamType)
+			req := apiClient.SchemaAPI.GetLogStreamSchema(cmd.Context(), GetLogStreamSchemalogStreamType)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -271,8 +297,11 @@ func NewUpdateUserProfileCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "updateUserProfile",
 		Long: "Update a User Schema",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.schemas.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.SchemaAPI.UpdateUserProfile(apiClient.GetConfig().Context, UpdateUserProfileschemaId)
+			req := apiClient.SchemaAPI.UpdateUserProfile(cmd.Context(), UpdateUserProfileschemaId)
 
 			if UpdateUserProfiledata != "" {
 				req = req.Data(UpdateUserProfiledata)
@@ -280,7 +309,7 @@ func NewUpdateUserProfileCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -318,12 +347,15 @@ func NewGetUserSchemaCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getUser",
 		Long: "Retrieve a User Schema",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.schemas.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.SchemaAPI.GetUserSchema(apiClient.GetConfig().Context, GetUserSchemaschemaId)
+			req := apiClient.SchemaAPI.GetUserSchema(cmd.Context(), GetUserSchemaschemaId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -23,7 +23,7 @@ func NewCreateSessionCmd() *cobra.Command {
 		Use:  "create",
 		Long: "Create a Session with session token",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.SessionAPI.CreateSession(apiClient.GetConfig().Context)
+			req := apiClient.SessionAPI.CreateSession(cmd.Context())
 
 			if CreateSessiondata != "" {
 				req = req.Data(CreateSessiondata)
@@ -31,7 +31,7 @@ func NewCreateSessionCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -65,11 +65,11 @@ func NewGetCurrentSessionCmd() *cobra.Command {
 		Use:  "getCurrent",
 		Long: "Retrieve the current Session",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.SessionAPI.GetCurrentSession(apiClient.GetConfig().Context)
+			req := apiClient.SessionAPI.GetCurrentSession(cmd.Context())
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -99,12 +99,15 @@ func NewCloseCurrentSessionCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "closeCurrent",
 		Long: "Close the current Session",
+		Annotations: map[string]string{
+			destructiveAnnotation: "/api/v1/sessions/me",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.SessionAPI.CloseCurrentSession(apiClient.GetConfig().Context)
+			req := apiClient.SessionAPI.CloseCurrentSession(cmd.Context())
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -135,11 +138,11 @@ func NewRefreshCurrentSessionCmd() *cobra.Command {
 		Use:  "refreshCurrent",
 		Long: "Refresh the current Session",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.SessionAPI.RefreshCurrentSession(apiClient.GetConfig().Context)
+			req := apiClient.SessionAPI.RefreshCurrentSession(cmd.Context())
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -171,12 +174,15 @@ func NewGetSessionCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "get",
 		Long: "Retrieve a Session",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.sessions.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.SessionAPI.GetSession(apiClient.GetConfig().Context, GetSessionsessionId)
+			req := apiClient.SessionAPI.GetSession(cmd.Context(), GetSessionsessionId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -211,12 +217,16 @@ func NewRevokeSessionCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "revoke",
 		Long: "Revoke a Session",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.sessions.manage",
+			destructiveAnnotation: "/api/v1/sessions/{sessionId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.SessionAPI.RevokeSession(apiClient.GetConfig().Context, RevokeSessionsessionId)
+			req := apiClient.SessionAPI.RevokeSession(cmd.Context(), RevokeSessionsessionId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -251,12 +261,15 @@ func NewRefreshSessionCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "refresh",
 		Long: "Refresh a Session",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.sessions.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.SessionAPI.RefreshSession(apiClient.GetConfig().Context, RefreshSessionsessionId)
+			req := apiClient.SessionAPI.RefreshSession(cmd.Context(), RefreshSessionsessionId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
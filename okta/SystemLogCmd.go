@@ -16,16 +16,25 @@ func init() {
 	rootCmd.AddCommand(SystemLogCmd)
 }
 
+var ListLogEventsall bool
+
 func NewListLogEventsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listLogEvents",
 		Long: "List all System Log Events",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.logs.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.SystemLogAPI.ListLogEvents(apiClient.GetConfig().Context)
+			req := apiClient.SystemLogAPI.ListLogEvents(cmd.Context())
+
+			if ListLogEventsall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -43,6 +52,8 @@ func NewListLogEventsCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &ListLogEventsall, false)
+
 	return cmd
 }
 
@@ -22,8 +22,11 @@ func NewCreateSmsTemplateCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "createSms",
 		Long: "Create an SMS Template",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.templates.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.TemplateAPI.CreateSmsTemplate(apiClient.GetConfig().Context)
+			req := apiClient.TemplateAPI.CreateSmsTemplate(cmd.Context())
 
 			if CreateSmsTemplatedata != "" {
 				req = req.Data(CreateSmsTemplatedata)
@@ -31,7 +34,7 @@ func NewCreateSmsTemplateCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -60,16 +63,25 @@ func init() {
 	TemplateCmd.AddCommand(CreateSmsTemplateCmd)
 }
 
+var ListSmsTemplatesall bool
+
 func NewListSmsTemplatesCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listSmss",
 		Long: "List all SMS Templates",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.templates.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.TemplateAPI.ListSmsTemplates(apiClient.GetConfig().Context)
+			req := apiClient.TemplateAPI.ListSmsTemplates(cmd.Context())
+
+			if ListSmsTemplatesall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -87,6 +99,8 @@ func NewListSmsTemplatesCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &ListSmsTemplatesall, false)
+
 	return cmd
 }
 
@@ -105,8 +119,11 @@ func NewUpdateSmsTemplateCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "updateSms",
 		Long: "Update an SMS Template",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.templates.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.TemplateAPI.UpdateSmsTemplate(apiClient.GetConfig().Context, UpdateSmsTemplatetemplateId)
+			req := apiClient.TemplateAPI.UpdateSmsTemplate(cmd.Context(), UpdateSmsTemplatetemplateId)
 
 			if UpdateSmsTemplatedata != "" {
 				req = req.Data(UpdateSmsTemplatedata)
@@ -114,7 +131,7 @@ func NewUpdateSmsTemplateCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -152,12 +169,15 @@ func NewGetSmsTemplateCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getSms",
 		Long: "Retrieve an SMS Template",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.templates.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.TemplateAPI.GetSmsTemplate(apiClient.GetConfig().Context, GetSmsTemplatetemplateId)
+			req := apiClient.TemplateAPI.GetSmsTemplate(cmd.Context(), GetSmsTemplatetemplateId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -196,8 +216,11 @@ func NewReplaceSmsTemplateCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replaceSms",
 		Long: "Replace an SMS Template",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.templates.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.TemplateAPI.ReplaceSmsTemplate(apiClient.GetConfig().Context, ReplaceSmsTemplatetemplateId)
+			req := apiClient.TemplateAPI.ReplaceSmsTemplate(cmd.Context(), ReplaceSmsTemplatetemplateId)
 
 			if ReplaceSmsTemplatedata != "" {
 				req = req.Data(ReplaceSmsTemplatedata)
@@ -205,7 +228,7 @@ func NewReplaceSmsTemplateCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -243,12 +266,16 @@ func NewDeleteSmsTemplateCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deleteSms",
 		Long: "Delete an SMS Template",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.templates.manage",
+			destructiveAnnotation: "/api/v1/templates/sms/{templateId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.TemplateAPI.DeleteSmsTemplate(apiClient.GetConfig().Context, DeleteSmsTemplatetemplateId)
+			req := apiClient.TemplateAPI.DeleteSmsTemplate(cmd.Context(), DeleteSmsTemplatetemplateId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
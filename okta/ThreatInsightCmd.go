@@ -22,8 +22,11 @@ func NewUpdateConfigurationCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "updateConfiguration",
 		Long: "Update the ThreatInsight Configuration",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.threatInsights.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ThreatInsightAPI.UpdateConfiguration(apiClient.GetConfig().Context)
+			req := apiClient.ThreatInsightAPI.UpdateConfiguration(cmd.Context())
 
 			if UpdateConfigurationdata != "" {
 				req = req.Data(UpdateConfigurationdata)
@@ -31,7 +34,7 @@ func NewUpdateConfigurationCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -64,12 +67,15 @@ func NewGetCurrentConfigurationCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getCurrentConfiguration",
 		Long: "Retrieve the ThreatInsight Configuration",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.threatInsights.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.ThreatInsightAPI.GetCurrentConfiguration(apiClient.GetConfig().Context)
+			req := apiClient.ThreatInsightAPI.GetCurrentConfiguration(cmd.Context())
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
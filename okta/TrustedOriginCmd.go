@@ -22,8 +22,11 @@ func NewCreateTrustedOriginCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "create",
 		Long: "Create a Trusted Origin",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.trustedOrigins.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.TrustedOriginAPI.CreateTrustedOrigin(apiClient.GetConfig().Context)
+			req := apiClient.TrustedOriginAPI.CreateTrustedOrigin(cmd.Context())
 
 			if CreateTrustedOrigindata != "" {
 				req = req.Data(CreateTrustedOrigindata)
@@ -31,7 +34,7 @@ func NewCreateTrustedOriginCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -60,16 +63,25 @@ func init() {
 	TrustedOriginCmd.AddCommand(CreateTrustedOriginCmd)
 }
 
+var ListTrustedOriginsall bool
+
 func NewListTrustedOriginsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "lists",
 		Long: "List all Trusted Origins",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.trustedOrigins.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.TrustedOriginAPI.ListTrustedOrigins(apiClient.GetConfig().Context)
+			req := apiClient.TrustedOriginAPI.ListTrustedOrigins(cmd.Context())
+
+			if ListTrustedOriginsall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -87,6 +99,8 @@ func NewListTrustedOriginsCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &ListTrustedOriginsall, false)
+
 	return cmd
 }
 
@@ -101,12 +115,15 @@ func NewGetTrustedOriginCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "get",
 		Long: "Retrieve a Trusted Origin",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.trustedOrigins.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.TrustedOriginAPI.GetTrustedOrigin(apiClient.GetConfig().Context, GetTrustedOrigintrustedOriginId)
+			req := apiClient.TrustedOriginAPI.GetTrustedOrigin(cmd.Context(), GetTrustedOrigintrustedOriginId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -145,8 +162,11 @@ func NewReplaceTrustedOriginCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replace",
 		Long: "Replace a Trusted Origin",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.trustedOrigins.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.TrustedOriginAPI.ReplaceTrustedOrigin(apiClient.GetConfig().Context, ReplaceTrustedOrigintrustedOriginId)
+			req := apiClient.TrustedOriginAPI.ReplaceTrustedOrigin(cmd.Context(), ReplaceTrustedOrigintrustedOriginId)
 
 			if ReplaceTrustedOrigindata != "" {
 				req = req.Data(ReplaceTrustedOrigindata)
@@ -154,7 +174,7 @@ func NewReplaceTrustedOriginCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -192,12 +212,16 @@ func NewDeleteTrustedOriginCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "delete",
 		Long: "Delete a Trusted Origin",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.trustedOrigins.manage",
+			destructiveAnnotation: "/api/v1/trustedOrigins/{trustedOriginId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.TrustedOriginAPI.DeleteTrustedOrigin(apiClient.GetConfig().Context, DeleteTrustedOrigintrustedOriginId)
+			req := apiClient.TrustedOriginAPI.DeleteTrustedOrigin(cmd.Context(), DeleteTrustedOrigintrustedOriginId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -232,12 +256,15 @@ func NewActivateTrustedOriginCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "activate",
 		Long: "Activate a Trusted Origin",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.trustedOrigins.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.TrustedOriginAPI.ActivateTrustedOrigin(apiClient.GetConfig().Context, ActivateTrustedOrigintrustedOriginId)
+			req := apiClient.TrustedOriginAPI.ActivateTrustedOrigin(cmd.Context(), ActivateTrustedOrigintrustedOriginId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -272,12 +299,16 @@ func NewDeactivateTrustedOriginCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deactivate",
 		Long: "Deactivate a Trusted Origin",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.trustedOrigins.manage",
+			destructiveAnnotation: "/api/v1/trustedOrigins/{trustedOriginId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.TrustedOriginAPI.DeactivateTrustedOrigin(apiClient.GetConfig().Context, DeactivateTrustedOrigintrustedOriginId)
+			req := apiClient.TrustedOriginAPI.DeactivateTrustedOrigin(cmd.Context(), DeactivateTrustedOrigintrustedOriginId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -22,8 +22,11 @@ func NewCreateUISchemaCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "create",
 		Long: "Create a UI Schema",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.uischemas.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UISchemaAPI.CreateUISchema(apiClient.GetConfig().Context)
+			req := apiClient.UISchemaAPI.CreateUISchema(cmd.Context())
 
 			if CreateUISchemadata != "" {
 				req = req.Data(CreateUISchemadata)
@@ -31,7 +34,7 @@ func NewCreateUISchemaCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -60,16 +63,25 @@ func init() {
 	UISchemaCmd.AddCommand(CreateUISchemaCmd)
 }
 
+var ListUISchemasall bool
+
 func NewListUISchemasCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "lists",
 		Long: "List all UI Schemas",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.uischemas.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UISchemaAPI.ListUISchemas(apiClient.GetConfig().Context)
+			req := apiClient.UISchemaAPI.ListUISchemas(cmd.Context())
+
+			if ListUISchemasall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -87,6 +99,8 @@ func NewListUISchemasCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &ListUISchemasall, false)
+
 	return cmd
 }
 
@@ -101,12 +115,15 @@ func NewGetUISchemaCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "get",
 		Long: "Retrieve a UI Schema",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.uischemas.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UISchemaAPI.GetUISchema(apiClient.GetConfig().Context, GetUISchemaid)
+			req := apiClient.UISchemaAPI.GetUISchema(cmd.Context(), GetUISchemaid)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -145,8 +162,11 @@ func NewReplaceUISchemasCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replaces",
 		Long: "Replace a UI Schema",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.uischemas.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UISchemaAPI.ReplaceUISchemas(apiClient.GetConfig().Context, ReplaceUISchemasid)
+			req := apiClient.UISchemaAPI.ReplaceUISchemas(cmd.Context(), ReplaceUISchemasid)
 
 			if ReplaceUISchemasdata != "" {
 				req = req.Data(ReplaceUISchemasdata)
@@ -154,7 +174,7 @@ func NewReplaceUISchemasCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -192,12 +212,16 @@ func NewDeleteUISchemasCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deletes",
 		Long: "Delete a UI Schema",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.uischemas.manage",
+			destructiveAnnotation: "/api/v1/meta/uischemas/{id}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UISchemaAPI.DeleteUISchemas(apiClient.GetConfig().Context, DeleteUISchemasid)
+			req := apiClient.UISchemaAPI.DeleteUISchemas(cmd.Context(), DeleteUISchemasid)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -22,8 +22,11 @@ func NewCreateUserCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "create",
 		Long: "Create a User",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.users.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserAPI.CreateUser(apiClient.GetConfig().Context)
+			req := apiClient.UserAPI.CreateUser(cmd.Context())
 
 			if CreateUserdata != "" {
 				req = req.Data(CreateUserdata)
@@ -31,7 +34,7 @@ func NewCreateUserCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -60,16 +63,25 @@ func init() {
 	UserCmd.AddCommand(CreateUserCmd)
 }
 
+var ListUsersall bool
+
 func NewListUsersCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "lists",
 		Long: "List all Users",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.users.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserAPI.ListUsers(apiClient.GetConfig().Context)
+			req := apiClient.UserAPI.ListUsers(cmd.Context())
+
+			if ListUsersall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -87,6 +99,8 @@ func NewListUsersCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &ListUsersall, false)
+
 	return cmd
 }
 
@@ -105,8 +119,11 @@ func NewUpdateUserCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "update",
 		Long: "Update a User",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.users.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserAPI.UpdateUser(apiClient.GetConfig().Context, UpdateUseruserId)
+			req := apiClient.UserAPI.UpdateUser(cmd.Context(), UpdateUseruserId)
 
 			if UpdateUserdata != "" {
 				req = req.Data(UpdateUserdata)
@@ -114,7 +131,7 @@ func NewUpdateUserCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -152,12 +169,15 @@ func NewGetUserCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "get",
 		Long: "Retrieve a User",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.users.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserAPI.GetUser(apiClient.GetConfig().Context, GetUseruserId)
+			req := apiClient.UserAPI.GetUser(cmd.Context(), GetUseruserId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -196,8 +216,11 @@ func NewReplaceUserCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replace",
 		Long: "Replace a User",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.users.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserAPI.ReplaceUser(apiClient.GetConfig().Context, ReplaceUseruserId)
+			req := apiClient.UserAPI.ReplaceUser(cmd.Context(), ReplaceUseruserId)
 
 			if ReplaceUserdata != "" {
 				req = req.Data(ReplaceUserdata)
@@ -205,7 +228,7 @@ func NewReplaceUserCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -243,12 +266,16 @@ func NewDeleteUserCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "delete",
 		Long: "Delete a User",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.users.manage",
+			destructiveAnnotation: "/api/v1/users/{userId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserAPI.DeleteUser(apiClient.GetConfig().Context, DeleteUseruserId)
+			req := apiClient.UserAPI.DeleteUser(cmd.Context(), DeleteUseruserId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -279,16 +306,25 @@ func init() {
 
 var ListAppLinksuserId string
 
+var ListAppLinksall bool
+
 func NewListAppLinksCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listAppLinks",
 		Long: "List all Assigned Application Links",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.users.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserAPI.ListAppLinks(apiClient.GetConfig().Context, ListAppLinksuserId)
+			req := apiClient.UserAPI.ListAppLinks(cmd.Context(), ListAppLinksuserId)
+
+			if ListAppLinksall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -309,6 +345,8 @@ func NewListAppLinksCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListAppLinksuserId, "userId", "", "", "")
 	cmd.MarkFlagRequired("userId")
 
+	allFlag.RegisterBool(cmd, &ListAppLinksall, false)
+
 	return cmd
 }
 
@@ -319,16 +357,25 @@ func init() {
 
 var ListUserBlocksuserId string
 
+var ListUserBlocksall bool
+
 func NewListUserBlocksCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listBlocks",
 		Long: "List all User Blocks",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.users.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserAPI.ListUserBlocks(apiClient.GetConfig().Context, ListUserBlocksuserId)
+			req := apiClient.UserAPI.ListUserBlocks(cmd.Context(), ListUserBlocksuserId)
+
+			if ListUserBlocksall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -349,6 +396,8 @@ func NewListUserBlocksCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListUserBlocksuserId, "userId", "", "", "")
 	cmd.MarkFlagRequired("userId")
 
+	allFlag.RegisterBool(cmd, &ListUserBlocksall, false)
+
 	return cmd
 }
 
@@ -359,16 +408,25 @@ func init() {
 
 var ListUserClientsuserId string
 
+var ListUserClientsall bool
+
 func NewListUserClientsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listClients",
 		Long: "List all Clients",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.users.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserAPI.ListUserClients(apiClient.GetConfig().Context, ListUserClientsuserId)
+			req := apiClient.UserAPI.ListUserClients(cmd.Context(), ListUserClientsuserId)
+
+			if ListUserClientsall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -389,6 +447,8 @@ func NewListUserClientsCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListUserClientsuserId, "userId", "", "", "")
 	cmd.MarkFlagRequired("userId")
 
+	allFlag.RegisterBool(cmd, &ListUserClientsall, false)
+
 	return cmd
 }
 
@@ -403,16 +463,25 @@ var (
 	ListGrantsForUserAndClientclientId string
 )
 
+var ListGrantsForUserAndClientall bool
+
 func NewListGrantsForUserAndClientCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listGrantsForAndClient",
 		Long: "List all Grants for a Client",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.users.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserAPI.ListGrantsForUserAndClient(apiClient.GetConfig().Context, ListGrantsForUserAndClientuserId, ListGrantsForUserAndClientclientId)
+			req := apiClient.UserAPI.ListGrantsForUserAndClient(cmd.Context(), ListGrantsForUserAndClientuserId, ListGrantsForUserAndClientclientId)
+
+			if ListGrantsForUserAndClientall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -436,6 +505,8 @@ func NewListGrantsForUserAndClientCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListGrantsForUserAndClientclientId, "clientId", "", "", "")
 	cmd.MarkFlagRequired("clientId")
 
+	allFlag.RegisterBool(cmd, &ListGrantsForUserAndClientall, false)
+
 	return cmd
 }
 
@@ -454,12 +525,16 @@ func NewRevokeGrantsForUserAndClientCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "revokeGrantsForAndClient",
 		Long: "Revoke all Grants for a Client",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.users.manage",
+			destructiveAnnotation: "/api/v1/users/{userId}/clients/{clientId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserAPI.RevokeGrantsForUserAndClient(apiClient.GetConfig().Context, RevokeGrantsForUserAndClientuserId, RevokeGrantsForUserAndClientclientId)
+			req := apiClient.UserAPI.RevokeGrantsForUserAndClient(cmd.Context(), RevokeGrantsForUserAndClientuserId, RevokeGrantsForUserAndClientclientId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -497,16 +572,25 @@ var (
 	ListRefreshTokensForUserAndClientclientId string
 )
 
+var ListRefreshTokensForUserAndClientall bool
+
 func NewListRefreshTokensForUserAndClientCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listRefreshTokensForAndClient",
 		Long: "List all Refresh Tokens for a Client",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.users.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserAPI.ListRefreshTokensForUserAndClient(apiClient.GetConfig().Context, ListRefreshTokensForUserAndClientuserId, ListRefreshTokensForUserAndClientclientId)
+			req := apiClient.UserAPI.ListRefreshTokensForUserAndClient(cmd.Context(), ListRefreshTokensForUserAndClientuserId, ListRefreshTokensForUserAndClientclientId)
+
+			if ListRefreshTokensForUserAndClientall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -530,6 +614,8 @@ func NewListRefreshTokensForUserAndClientCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListRefreshTokensForUserAndClientclientId, "clientId", "", "", "")
 	cmd.MarkFlagRequired("clientId")
 
+	allFlag.RegisterBool(cmd, &ListRefreshTokensForUserAndClientall, false)
+
 	return cmd
 }
 
@@ -548,12 +634,16 @@ func NewRevokeTokensForUserAndClientCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "revokeTokensForAndClient",
 		Long: "Revoke all Refresh Tokens for a Client",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.users.manage",
+			destructiveAnnotation: "/api/v1/users/{userId}/clients/{clientId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserAPI.RevokeTokensForUserAndClient(apiClient.GetConfig().Context, RevokeTokensForUserAndClientuserId, RevokeTokensForUserAndClientclientId)
+			req := apiClient.UserAPI.RevokeTokensForUserAndClient(cmd.Context(), RevokeTokensForUserAndClientuserId, RevokeTokensForUserAndClientclientId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -597,12 +687,15 @@ func NewGetRefreshTokenForUserAndClientCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getRefreshTokenForAndClient",
 		Long: "Retrieve a Refresh Token for a Client",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.users.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserAPI.GetRefreshTokenForUserAndClient(apiClient.GetConfig().Context, GetRefreshTokenForUserAndClientuserId, GetRefreshTokenForUserAndClientclientId, GetRefreshTokenForUserAndClienttokenId)
+			req := apiClient.UserAPI.GetRefreshTokenForUserAndClient(cmd.Context(), GetRefreshTokenForUserAndClientuserId, GetRefreshTokenForUserAndClientclientId, GetRefreshTokenForUserAndClienttokenId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -649,12 +742,16 @@ func NewRevokeTokenForUserAndClientCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "revokeTokenForAndClient",
 		Long: "Revoke a Token for a Client",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.users.manage",
+			destructiveAnnotation: "/api/v1/users/{userId}/clients/{clientId}/tokens/{tokenId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserAPI.RevokeTokenForUserAndClient(apiClient.GetConfig().Context, RevokeTokenForUserAndClientuserId, RevokeTokenForUserAndClientclientId, RevokeTokenForUserAndClienttokenId)
+			req := apiClient.UserAPI.RevokeTokenForUserAndClient(cmd.Context(), RevokeTokenForUserAndClientuserId, RevokeTokenForUserAndClientclientId, RevokeTokenForUserAndClienttokenId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -699,8 +796,11 @@ func NewChangePasswordCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "changePassword",
 		Long: "Change Password",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.users.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserAPI.ChangePassword(apiClient.GetConfig().Context, ChangePassworduserId)
+			req := apiClient.UserAPI.ChangePassword(cmd.Context(), ChangePassworduserId)
 
 			if ChangePassworddata != "" {
 				req = req.Data(ChangePassworddata)
@@ -708,7 +808,7 @@ func NewChangePasswordCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -750,8 +850,11 @@ func NewChangeRecoveryQuestionCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "changeRecoveryQuestion",
 		Long: "Change Recovery Question",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.users.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserAPI.ChangeRecoveryQuestion(apiClient.GetConfig().Context, ChangeRecoveryQuestionuserId)
+			req := apiClient.UserAPI.ChangeRecoveryQuestion(cmd.Context(), ChangeRecoveryQuestionuserId)
 
 			if ChangeRecoveryQuestiondata != "" {
 				req = req.Data(ChangeRecoveryQuestiondata)
@@ -759,7 +862,7 @@ func NewChangeRecoveryQuestionCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -797,12 +900,15 @@ func NewForgotPasswordCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "forgotPassword",
 		Long: "Initiate Forgot Password",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.users.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserAPI.ForgotPassword(apiClient.GetConfig().Context, ForgotPassworduserId)
+			req := apiClient.UserAPI.ForgotPassword(cmd.Context(), ForgotPassworduserId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -841,8 +947,11 @@ func NewForgotPasswordSetNewPasswordCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "forgotPasswordSetNewPassword",
 		Long: "Reset Password with Recovery Question",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.users.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserAPI.ForgotPasswordSetNewPassword(apiClient.GetConfig().Context, ForgotPasswordSetNewPassworduserId)
+			req := apiClient.UserAPI.ForgotPasswordSetNewPassword(cmd.Context(), ForgotPasswordSetNewPassworduserId)
 
 			if ForgotPasswordSetNewPassworddata != "" {
 				req = req.Data(ForgotPasswordSetNewPassworddata)
@@ -850,7 +959,7 @@ func NewForgotPasswordSetNewPasswordCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -884,16 +993,25 @@ func init() {
 
 var ListUserGrantsuserId string
 
+var ListUserGrantsall bool
+
 func NewListUserGrantsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listGrants",
 		Long: "List all User Grants",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.users.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserAPI.ListUserGrants(apiClient.GetConfig().Context, ListUserGrantsuserId)
+			req := apiClient.UserAPI.ListUserGrants(cmd.Context(), ListUserGrantsuserId)
+
+			if ListUserGrantsall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -914,6 +1032,8 @@ func NewListUserGrantsCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListUserGrantsuserId, "userId", "", "", "")
 	cmd.MarkFlagRequired("userId")
 
+	allFlag.RegisterBool(cmd, &ListUserGrantsall, false)
+
 	return cmd
 }
 
@@ -928,12 +1048,16 @@ func NewRevokeUserGrantsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "revokeGrants",
 		Long: "Revoke all User Grants",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.users.manage",
+			destructiveAnnotation: "/api/v1/users/{userId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserAPI.RevokeUserGrants(apiClient.GetConfig().Context, RevokeUserGrantsuserId)
+			req := apiClient.UserAPI.RevokeUserGrants(cmd.Context(), RevokeUserGrantsuserId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -972,12 +1096,15 @@ func NewGetUserGrantCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getGrant",
 		Long: "Retrieve a User Grant",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.users.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserAPI.GetUserGrant(apiClient.GetConfig().Context, GetUserGrantuserId, GetUserGrantgrantId)
+			req := apiClient.UserAPI.GetUserGrant(cmd.Context(), GetUserGrantuserId, GetUserGrantgrantId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -1019,12 +1146,16 @@ func NewRevokeUserGrantCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "revokeGrant",
 		Long: "Revoke a User Grant",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.users.manage",
+			destructiveAnnotation: "/api/v1/users/{userId}/grants/{grantId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserAPI.RevokeUserGrant(apiClient.GetConfig().Context, RevokeUserGrantuserId, RevokeUserGrantgrantId)
+			req := apiClient.UserAPI.RevokeUserGrant(cmd.Context(), RevokeUserGrantuserId, RevokeUserGrantgrantId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -1058,16 +1189,25 @@ func init() {
 
 var ListUserGroupsuserId string
 
+var ListUserGroupsall bool
+
 func NewListUserGroupsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listGroups",
 		Long: "List all Groups",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.users.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserAPI.ListUserGroups(apiClient.GetConfig().Context, ListUserGroupsuserId)
+			req := apiClient.UserAPI.ListUserGroups(cmd.Context(), ListUserGroupsuserId)
+
+			if ListUserGroupsall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -1088,6 +1228,8 @@ func NewListUserGroupsCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListUserGroupsuserId, "userId", "", "", "")
 	cmd.MarkFlagRequired("userId")
 
+	allFlag.RegisterBool(cmd, &ListUserGroupsall, false)
+
 	return cmd
 }
 
@@ -1098,16 +1240,25 @@ func init() {
 
 var ListUserIdentityProvidersuserId string
 
+var ListUserIdentityProvidersall bool
+
 func NewListUserIdentityProvidersCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listIdentityProviders",
 		Long: "List all Identity Providers",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.users.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserAPI.ListUserIdentityProviders(apiClient.GetConfig().Context, ListUserIdentityProvidersuserId)
+			req := apiClient.UserAPI.ListUserIdentityProviders(cmd.Context(), ListUserIdentityProvidersuserId)
+
+			if ListUserIdentityProvidersall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -1128,6 +1279,8 @@ func NewListUserIdentityProvidersCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListUserIdentityProvidersuserId, "userId", "", "", "")
 	cmd.MarkFlagRequired("userId")
 
+	allFlag.RegisterBool(cmd, &ListUserIdentityProvidersall, false)
+
 	return cmd
 }
 
@@ -1142,12 +1295,15 @@ func NewActivateUserCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "activate",
 		Long: "Activate a User",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.users.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserAPI.ActivateUser(apiClient.GetConfig().Context, ActivateUseruserId)
+			req := apiClient.UserAPI.ActivateUser(cmd.Context(), ActivateUseruserId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -1182,12 +1338,16 @@ func NewDeactivateUserCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deactivate",
 		Long: "Deactivate a User",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.users.manage",
+			destructiveAnnotation: "/api/v1/users/{userId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserAPI.DeactivateUser(apiClient.GetConfig().Context, DeactivateUseruserId)
+			req := apiClient.UserAPI.DeactivateUser(cmd.Context(), DeactivateUseruserId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -1222,12 +1382,16 @@ func NewExpirePasswordCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "expirePassword",
 		Long: "Expire Password",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.users.manage",
+			destructiveAnnotation: "/api/v1/users/{userId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserAPI.ExpirePassword(apiClient.GetConfig().Context, ExpirePassworduserId)
+			req := apiClient.UserAPI.ExpirePassword(cmd.Context(), ExpirePassworduserId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -1262,12 +1426,16 @@ func NewExpirePasswordAndGetTemporaryPasswordCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "expirePasswordAndGetTemporaryPassword",
 		Long: "Expire Password and Set Temporary Password",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.users.manage",
+			destructiveAnnotation: "/api/v1/users/{userId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserAPI.ExpirePasswordAndGetTemporaryPassword(apiClient.GetConfig().Context, ExpirePasswordAndGetTemporaryPassworduserId)
+			req := apiClient.UserAPI.ExpirePasswordAndGetTemporaryPassword(cmd.Context(), ExpirePasswordAndGetTemporaryPassworduserId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -1302,12 +1470,15 @@ func NewReactivateUserCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "reactivate",
 		Long: "Reactivate a User",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.users.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserAPI.ReactivateUser(apiClient.GetConfig().Context, ReactivateUseruserId)
+			req := apiClient.UserAPI.ReactivateUser(cmd.Context(), ReactivateUseruserId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -1342,12 +1513,16 @@ func NewResetFactorsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "resetFactors",
 		Long: "Reset all Factors",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.users.manage",
+			destructiveAnnotation: "/api/v1/users/{userId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserAPI.ResetFactors(apiClient.GetConfig().Context, ResetFactorsuserId)
+			req := apiClient.UserAPI.ResetFactors(cmd.Context(), ResetFactorsuserId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -1382,12 +1557,15 @@ func NewGenerateResetPasswordTokenCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "generateResetPasswordToken",
 		Long: "Generate a Reset Password Token",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.users.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserAPI.GenerateResetPasswordToken(apiClient.GetConfig().Context, GenerateResetPasswordTokenuserId)
+			req := apiClient.UserAPI.GenerateResetPasswordToken(cmd.Context(), GenerateResetPasswordTokenuserId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -1422,12 +1600,15 @@ func NewSuspendUserCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "suspend",
 		Long: "Suspend a User",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.users.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserAPI.SuspendUser(apiClient.GetConfig().Context, SuspendUseruserId)
+			req := apiClient.UserAPI.SuspendUser(cmd.Context(), SuspendUseruserId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -1462,12 +1643,15 @@ func NewUnlockUserCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "unlock",
 		Long: "Unlock a User",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.users.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserAPI.UnlockUser(apiClient.GetConfig().Context, UnlockUseruserId)
+			req := apiClient.UserAPI.UnlockUser(cmd.Context(), UnlockUseruserId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -1502,12 +1686,15 @@ func NewUnsuspendUserCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "unsuspend",
 		Long: "Unsuspend a User",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.users.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserAPI.UnsuspendUser(apiClient.GetConfig().Context, UnsuspendUseruserId)
+			req := apiClient.UserAPI.UnsuspendUser(cmd.Context(), UnsuspendUseruserId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -1548,12 +1735,15 @@ func NewSetLinkedObjectForUserCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "setLinkedObjectFor",
 		Long: "Create a Linked Object for two Users",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.users.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserAPI.SetLinkedObjectForUser(apiClient.GetConfig().Context, SetLinkedObjectForUseruserId, SetLinkedObjectForUserprimaryRelationshipName, SetLinkedObjectForUserprimaryUserId)
+			req := apiClient.UserAPI.SetLinkedObjectForUser(cmd.Context(), SetLinkedObjectForUseruserId, SetLinkedObjectForUserprimaryRelationshipName, SetLinkedObjectForUserprimaryUserId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -1594,16 +1784,25 @@ var (
 	ListLinkedObjectsForUserrelationshipName string
 )
 
+var ListLinkedObjectsForUserall bool
+
 func NewListLinkedObjectsForUserCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listLinkedObjectsFor",
 		Long: "List all Linked Objects",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.users.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserAPI.ListLinkedObjectsForUser(apiClient.GetConfig().Context, ListLinkedObjectsForUseruserId, ListLinkedObjectsForUserrelationshipName)
+			req := apiClient.UserAPI.ListLinkedObjectsForUser(cmd.Context(), ListLinkedObjectsForUseruserId, ListLinkedObjectsForUserrelationshipName)
+
+			if ListLinkedObjectsForUserall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -1627,6 +1826,8 @@ func NewListLinkedObjectsForUserCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListLinkedObjectsForUserrelationshipName, "relationshipName", "", "", "")
 	cmd.MarkFlagRequired("relationshipName")
 
+	allFlag.RegisterBool(cmd, &ListLinkedObjectsForUserall, false)
+
 	return cmd
 }
 
@@ -1645,12 +1846,16 @@ func NewDeleteLinkedObjectForUserCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deleteLinkedObjectFor",
 		Long: "Delete a Linked Object",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.users.manage",
+			destructiveAnnotation: "/api/v1/users/{userId}/linkedObjects/{relationshipName}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserAPI.DeleteLinkedObjectForUser(apiClient.GetConfig().Context, DeleteLinkedObjectForUseruserId, DeleteLinkedObjectForUserrelationshipName)
+			req := apiClient.UserAPI.DeleteLinkedObjectForUser(cmd.Context(), DeleteLinkedObjectForUseruserId, DeleteLinkedObjectForUserrelationshipName)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -1688,12 +1893,16 @@ func NewRevokeUserSessionsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "revokeSessions",
 		Long: "Revoke all User Sessions",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.users.manage",
+			destructiveAnnotation: "/api/v1/users/{userId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserAPI.RevokeUserSessions(apiClient.GetConfig().Context, RevokeUserSessionsuserId)
+			req := apiClient.UserAPI.RevokeUserSessions(cmd.Context(), RevokeUserSessionsuserId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -26,8 +26,11 @@ func NewEnrollFactorCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "enrollFactor",
 		Long: "Enroll a Factor",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.users.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserFactorAPI.EnrollFactor(apiClient.GetConfig().Context, EnrollFactoruserId)
+			req := apiClient.UserFactorAPI.EnrollFactor(cmd.Context(), EnrollFactoruserId)
 
 			if EnrollFactordata != "" {
 				req = req.Data(EnrollFactordata)
@@ -35,7 +38,7 @@ func NewEnrollFactorCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -69,16 +72,25 @@ func init() {
 
 var ListFactorsuserId string
 
+var ListFactorsall bool
+
 func NewListFactorsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listFactors",
 		Long: "List all enrolled Factors",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.users.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserFactorAPI.ListFactors(apiClient.GetConfig().Context, ListFactorsuserId)
+			req := apiClient.UserFactorAPI.ListFactors(cmd.Context(), ListFactorsuserId)
+
+			if ListFactorsall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -99,6 +111,8 @@ func NewListFactorsCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListFactorsuserId, "userId", "", "", "")
 	cmd.MarkFlagRequired("userId")
 
+	allFlag.RegisterBool(cmd, &ListFactorsall, false)
+
 	return cmd
 }
 
@@ -109,16 +123,25 @@ func init() {
 
 var ListSupportedFactorsuserId string
 
+var ListSupportedFactorsall bool
+
 func NewListSupportedFactorsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listSupportedFactors",
 		Long: "List all supported Factors",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.users.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserFactorAPI.ListSupportedFactors(apiClient.GetConfig().Context, ListSupportedFactorsuserId)
+			req := apiClient.UserFactorAPI.ListSupportedFactors(cmd.Context(), ListSupportedFactorsuserId)
+
+			if ListSupportedFactorsall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -139,6 +162,8 @@ func NewListSupportedFactorsCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListSupportedFactorsuserId, "userId", "", "", "")
 	cmd.MarkFlagRequired("userId")
 
+	allFlag.RegisterBool(cmd, &ListSupportedFactorsall, false)
+
 	return cmd
 }
 
@@ -149,16 +174,22 @@ func init() {
 
 var ListSupportedSecurityQuestionsuserId string
 
+var ListSupportedSecurityQuestionsall bool
+
 func NewListSupportedSecurityQuestionsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listSupportedSecurityQuestions",
 		Long: "List all supported Security Questions",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserFactorAPI.ListSupportedSecurityQuestions(apiClient.GetConfig().Context, ListSupportedSecurityQuestionsuserId)
+			req := apiClient.UserFactorAPI.ListSupportedSecurityQuestions(cmd.Context(), ListSupportedSecurityQuestionsuserId)
+
+			if ListSupportedSecurityQuestionsall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -179,6 +210,8 @@ func NewListSupportedSecurityQuestionsCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListSupportedSecurityQuestionsuserId, "userId", "", "", "")
 	cmd.MarkFlagRequired("userId")
 
+	allFlag.RegisterBool(cmd, &ListSupportedSecurityQuestionsall, false)
+
 	return cmd
 }
 
@@ -197,12 +230,15 @@ func NewGetFactorCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getFactor",
 		Long: "Retrieve a Factor",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.users.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserFactorAPI.GetFactor(apiClient.GetConfig().Context, GetFactoruserId, GetFactorfactorId)
+			req := apiClient.UserFactorAPI.GetFactor(cmd.Context(), GetFactoruserId, GetFactorfactorId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -244,12 +280,16 @@ func NewUnenrollFactorCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "unenrollFactor",
 		Long: "Unenroll a Factor",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.users.manage",
+			destructiveAnnotation: "/api/v1/users/{userId}/factors/{factorId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserFactorAPI.UnenrollFactor(apiClient.GetConfig().Context, UnenrollFactoruserId, UnenrollFactorfactorId)
+			req := apiClient.UserFactorAPI.UnenrollFactor(cmd.Context(), UnenrollFactoruserId, UnenrollFactorfactorId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -293,8 +333,11 @@ func NewActivateFactorCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "activateFactor",
 		Long: "Activate a Factor",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.users.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserFactorAPI.ActivateFactor(apiClient.GetConfig().Context, ActivateFactoruserId, ActivateFactorfactorId)
+			req := apiClient.UserFactorAPI.ActivateFactor(cmd.Context(), ActivateFactoruserId, ActivateFactorfactorId)
 
 			if ActivateFactordata != "" {
 				req = req.Data(ActivateFactordata)
@@ -302,7 +345,7 @@ func NewActivateFactorCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -349,8 +392,11 @@ func NewResendEnrollFactorCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "resendEnrollFactor",
 		Long: "Resend a Factor enrollment",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.users.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserFactorAPI.ResendEnrollFactor(apiClient.GetConfig().Context, ResendEnrollFactoruserId, ResendEnrollFactorfactorId)
+			req := apiClient.UserFactorAPI.ResendEnrollFactor(cmd.Context(), ResendEnrollFactoruserId, ResendEnrollFactorfactorId)
 
 			if ResendEnrollFactordata != "" {
 				req = req.Data(ResendEnrollFactordata)
@@ -358,7 +404,7 @@ func NewResendEnrollFactorCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -405,12 +451,15 @@ func NewGetFactorTransactionStatusCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "getFactorTransactionStatus",
 		Long: "Retrieve a Factor transaction status",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.users.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserFactorAPI.GetFactorTransactionStatus(apiClient.GetConfig().Context, GetFactorTransactionStatususerId, GetFactorTransactionStatusfactorId, GetFactorTransactionStatustransactionId)
+			req := apiClient.UserFactorAPI.GetFactorTransactionStatus(cmd.Context(), GetFactorTransactionStatususerId, GetFactorTransactionStatusfactorId, GetFactorTransactionStatustransactionId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -457,8 +506,11 @@ func NewVerifyFactorCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "verifyFactor",
 		Long: "Verify a Factor",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.users.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserFactorAPI.VerifyFactor(apiClient.GetConfig().Context, VerifyFactoruserId, VerifyFactorfactorId)
+			req := apiClient.UserFactorAPI.VerifyFactor(cmd.Context(), VerifyFactoruserId, VerifyFactorfactorId)
 
 			if VerifyFactordata != "" {
 				req = req.Data(VerifyFactordata)
@@ -466,7 +518,7 @@ func NewVerifyFactorCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -22,8 +22,11 @@ func NewCreateUserTypeCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "create",
 		Long: "Create a User Type",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.userTypes.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserTypeAPI.CreateUserType(apiClient.GetConfig().Context)
+			req := apiClient.UserTypeAPI.CreateUserType(cmd.Context())
 
 			if CreateUserTypedata != "" {
 				req = req.Data(CreateUserTypedata)
@@ -31,7 +34,7 @@ func NewCreateUserTypeCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -60,16 +63,25 @@ func init() {
 	UserTypeCmd.AddCommand(CreateUserTypeCmd)
 }
 
+var ListUserTypesall bool
+
 func NewListUserTypesCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "lists",
 		Long: "List all User Types",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.userTypes.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserTypeAPI.ListUserTypes(apiClient.GetConfig().Context)
+			req := apiClient.UserTypeAPI.ListUserTypes(cmd.Context())
+
+			if ListUserTypesall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -87,6 +99,8 @@ func NewListUserTypesCmd() *cobra.Command {
 		},
 	}
 
+	allFlag.RegisterBool(cmd, &ListUserTypesall, false)
+
 	return cmd
 }
 
@@ -105,8 +119,11 @@ func NewUpdateUserTypeCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "update",
 		Long: "Update a User Type",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.userTypes.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserTypeAPI.UpdateUserType(apiClient.GetConfig().Context, UpdateUserTypetypeId)
+			req := apiClient.UserTypeAPI.UpdateUserType(cmd.Context(), UpdateUserTypetypeId)
 
 			if UpdateUserTypedata != "" {
 				req = req.Data(UpdateUserTypedata)
@@ -114,7 +131,7 @@ func NewUpdateUserTypeCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -152,12 +169,15 @@ func NewGetUserTypeCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "get",
 		Long: "Retrieve a User Type",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.userTypes.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserTypeAPI.GetUserType(apiClient.GetConfig().Context, GetUserTypetypeId)
+			req := apiClient.UserTypeAPI.GetUserType(cmd.Context(), GetUserTypetypeId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -196,8 +216,11 @@ func NewReplaceUserTypeCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "replace",
 		Long: "Replace a User Type",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.userTypes.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserTypeAPI.ReplaceUserType(apiClient.GetConfig().Context, ReplaceUserTypetypeId)
+			req := apiClient.UserTypeAPI.ReplaceUserType(cmd.Context(), ReplaceUserTypetypeId)
 
 			if ReplaceUserTypedata != "" {
 				req = req.Data(ReplaceUserTypedata)
@@ -205,7 +228,7 @@ func NewReplaceUserTypeCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -243,12 +266,16 @@ func NewDeleteUserTypeCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "delete",
 		Long: "Delete a User Type",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.userTypes.manage",
+			destructiveAnnotation: "/api/v1/meta/types/user/{typeId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.UserTypeAPI.DeleteUserType(apiClient.GetConfig().Context, DeleteUserTypetypeId)
+			req := apiClient.UserTypeAPI.DeleteUserType(cmd.Context(), DeleteUserTypetypeId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
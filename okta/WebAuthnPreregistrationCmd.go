@@ -22,8 +22,11 @@ func NewActivatePreregistrationEnrollmentCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "activatePreregistrationEnrollment",
 		Long: "Activate a Preregistered WebAuthn Factor",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.users.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.WebAuthnPreregistrationAPI.ActivatePreregistrationEnrollment(apiClient.GetConfig().Context)
+			req := apiClient.WebAuthnPreregistrationAPI.ActivatePreregistrationEnrollment(cmd.Context())
 
 			if ActivatePreregistrationEnrollmentdata != "" {
 				req = req.Data(ActivatePreregistrationEnrollmentdata)
@@ -31,7 +34,7 @@ func NewActivatePreregistrationEnrollmentCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -66,8 +69,11 @@ func NewEnrollPreregistrationEnrollmentCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "enrollPreregistrationEnrollment",
 		Long: "Enroll a Preregistered WebAuthn Factor",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.users.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.WebAuthnPreregistrationAPI.EnrollPreregistrationEnrollment(apiClient.GetConfig().Context)
+			req := apiClient.WebAuthnPreregistrationAPI.EnrollPreregistrationEnrollment(cmd.Context())
 
 			if EnrollPreregistrationEnrollmentdata != "" {
 				req = req.Data(EnrollPreregistrationEnrollmentdata)
@@ -75,7 +81,7 @@ func NewEnrollPreregistrationEnrollmentCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -110,8 +116,11 @@ func NewGenerateFulfillmentRequestCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "generateFulfillmentRequest",
 		Long: "Generate a Fulfillment Request",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.users.manage",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.WebAuthnPreregistrationAPI.GenerateFulfillmentRequest(apiClient.GetConfig().Context)
+			req := apiClient.WebAuthnPreregistrationAPI.GenerateFulfillmentRequest(cmd.Context())
 
 			if GenerateFulfillmentRequestdata != "" {
 				req = req.Data(GenerateFulfillmentRequestdata)
@@ -119,7 +128,7 @@ func NewGenerateFulfillmentRequestCmd() *cobra.Command {
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -150,16 +159,25 @@ func init() {
 
 var ListWebAuthnPreregistrationFactorsuserId string
 
+var ListWebAuthnPreregistrationFactorsall bool
+
 func NewListWebAuthnPreregistrationFactorsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "listFactors",
 		Long: "List all WebAuthn Preregistration Factors",
+		Annotations: map[string]string{
+			scopesAnnotation: "okta.users.read",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.WebAuthnPreregistrationAPI.ListWebAuthnPreregistrationFactors(apiClient.GetConfig().Context, ListWebAuthnPreregistrationFactorsuserId)
+			req := apiClient.WebAuthnPreregistrationAPI.ListWebAuthnPreregistrationFactors(cmd.Context(), ListWebAuthnPreregistrationFactorsuserId)
+
+			if ListWebAuthnPreregistrationFactorsall {
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
@@ -180,6 +198,8 @@ func NewListWebAuthnPreregistrationFactorsCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&ListWebAuthnPreregistrationFactorsuserId, "userId", "", "", "")
 	cmd.MarkFlagRequired("userId")
 
+	allFlag.RegisterBool(cmd, &ListWebAuthnPreregistrationFactorsall, false)
+
 	return cmd
 }
 
@@ -198,12 +218,16 @@ func NewDeleteWebAuthnPreregistrationFactorCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deleteFactor",
 		Long: "Delete a WebAuthn Preregistration Factor",
+		Annotations: map[string]string{
+			scopesAnnotation:      "okta.users.manage",
+			destructiveAnnotation: "/webauthn-registration/api/v1/users/{userId}/enrollments/{authenticatorEnrollmentId}",
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			req := apiClient.WebAuthnPreregistrationAPI.DeleteWebAuthnPreregistrationFactor(apiClient.GetConfig().Context, DeleteWebAuthnPreregistrationFactoruserId, DeleteWebAuthnPreregistrationFactorauthenticatorEnrollmentId)
+			req := apiClient.WebAuthnPreregistrationAPI.DeleteWebAuthnPreregistrationFactor(cmd.Context(), DeleteWebAuthnPreregistrationFactoruserId, DeleteWebAuthnPreregistrationFactorauthenticatorEnrollmentId)
 
 			resp, err := req.Execute()
 			if err != nil {
-				if resp != nil && resp.Body != nil {
+				if resp != nil && resp.Response != nil && resp.Body != nil {
 					d, err := io.ReadAll(resp.Body)
 					if err == nil {
 						utils.PrettyPrintByte(d)
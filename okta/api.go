@@ -0,0 +1,146 @@
+package okta
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	apiData = Flag{
+		Name:      "Data",
+		LongForm:  "data",
+		ShortForm: "d",
+		Help:      "Body of the request, JSON or @file to read it from a file, @- from stdin.",
+	}
+	apiQuery = Flag{
+		Name:     "Query",
+		LongForm: "query",
+		Help:     "Query parameter as key=value, repeat the flag for several.",
+	}
+	apiHeader = Flag{
+		Name:     "Header",
+		LongForm: "header",
+		Help:     "Header as \"Name: value\", repeat the flag for several.",
+	}
+	apiPaginate = Flag{
+		Name:     "Paginate",
+		LongForm: "paginate",
+		Help:     "Follow the pagination of a GET request and print the items of every page as one array.",
+	}
+)
+
+type APIInputs struct {
+	Data     string
+	Query    []string
+	Header   []string
+	Paginate bool
+}
+
+func NewAPICmd() *cobra.Command {
+	inputs := APIInputs{}
+	cmd := &cobra.Command{
+		Use:   "api <method> <path>",
+		Args:  cobra.ExactArgs(2),
+		Short: "Send a request to any endpoint of the org",
+		Long: "Send a request to any endpoint of the org.\n\n" +
+			"The request is authorized, retried and proxied like those of the other commands,\n" +
+			"for endpoints without a command of their own. The path may hold a query, or be a\n" +
+			"URL of the org like the next link of a page. The JSON response is pretty printed.\n\n" +
+			"DELETE requests, and deactivations, revocations, resets, expirations, rotations and\n" +
+			"unassignments, are confirmed like the destructive commands.",
+		Example: `okta-cli-client api GET /api/v1/users --query limit=2 --paginate
+okta-cli-client api POST /api/v1/groups --data '{"profile": {"name": "Admins"}}'
+okta-cli-client api PUT /api/v1/apps/0oa1/logo --data @logo.json --header "Accept: application/json"`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := apiClient.RawRequest(cmd.Context(), args[0], args[1])
+			body, err := readAPIData(cmd, inputs.Data)
+			if err != nil {
+				return err
+			}
+			if body != "" {
+				req = req.Body(body)
+			}
+			for _, param := range inputs.Query {
+				key, value, ok := strings.Cut(param, "=")
+				if !ok {
+					return fmt.Errorf("invalid query parameter %q, expected key=value", param)
+				}
+				req = req.Query(key, value)
+			}
+			for _, header := range inputs.Header {
+				name, value, ok := strings.Cut(header, ":")
+				if !ok {
+					return fmt.Errorf("invalid header %q, expected \"Name: value\"", header)
+				}
+				req = req.Header(strings.TrimSpace(name), strings.TrimSpace(value))
+			}
+			if inputs.Paginate {
+				if !strings.EqualFold(args[0], http.MethodGet) {
+					return fmt.Errorf("--%v only applies to GET requests", apiPaginate.LongForm)
+				}
+				return printAll(cmd.Context(), cmd.OutOrStdout(), req)
+			}
+			if err = confirmRequest(cmd, strings.ToUpper(args[0]), args[1]); err != nil {
+				return err
+			}
+			resp, err := req.Execute()
+			if resp != nil && resp.Response != nil && resp.Body != nil {
+				d, readErr := io.ReadAll(resp.Body)
+				if readErr == nil {
+					printAPIBody(cmd.OutOrStdout(), d)
+				}
+			}
+			return err
+		},
+	}
+	apiData.RegisterString(cmd, &inputs.Data, "")
+	cmd.Flags().StringArrayVar(&inputs.Query, apiQuery.LongForm, nil, apiQuery.Help)
+	cmd.Flags().StringArrayVar(&inputs.Header, apiHeader.LongForm, nil, apiHeader.Help)
+	apiPaginate.RegisterBool(cmd, &inputs.Paginate, false)
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(NewAPICmd())
+}
+
+// readAPIData returns the body given with --data, reading it from a file
+// or stdin when it starts with @.
+func readAPIData(cmd *cobra.Command, data string) (string, error) {
+	if !strings.HasPrefix(data, "@") {
+		return data, nil
+	}
+	var b []byte
+	var err error
+	if data == "@-" {
+		b, err = io.ReadAll(cmd.InOrStdin())
+	} else {
+		b, err = os.ReadFile(data[1:])
+	}
+	return string(b), err
+}
+
+// printAPIBody pretty prints a JSON body like the other commands, and other
+// bodies as they are.
+func printAPIBody(w io.Writer, body []byte) {
+	if len(body) == 0 {
+		return
+	}
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err == nil {
+		if indented, err := json.MarshalIndent(v, "", " "); err == nil {
+			fmt.Fprintln(w, string(indented))
+			return
+		}
+	}
+	w.Write(body)
+	if body[len(body)-1] != '\n' {
+		fmt.Fprintln(w)
+	}
+}
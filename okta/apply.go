@@ -0,0 +1,963 @@
+package okta
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/okta/okta-cli-client/sdk"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	applyFilename = Flag{
+		Name:      "Filename",
+		LongForm:  "filename",
+		ShortForm: "f",
+		Help:      "Manifest file, or directory of .yaml and .yml manifests, to apply, - to read stdin. Repeat the flag for several.",
+	}
+	applyPrune = Flag{
+		Name:     "Prune",
+		LongForm: "prune",
+		Help:     "Delete the objects of these kinds, like Group,GroupRule or Scope, that the manifests do not declare. The manifests must declare objects of each kind.",
+	}
+)
+
+// The actions of a change of the plan.
+const (
+	actionCreate = "create"
+	actionUpdate = "update"
+	actionDelete = "delete"
+)
+
+// resourceKind is a kind of object apply manages.
+type resourceKind struct {
+	// Name is the kind of the manifests, like Group, or of the nested
+	// objects, like Scope.
+	Name string
+	// Field is the field listing the nested objects in the manifest of their
+	// parent, like scopes.
+	Field string
+	// Collection is the path listing and creating the objects, relative to
+	// the path of the parent for nested objects.
+	Collection string
+	// Key is the field naming the objects, matching those of the manifests
+	// with those of the org.
+	Key string
+	// Search is the query parameter of Collection searching the objects by
+	// key, like q, for the kinds apply does not prune to list only the
+	// objects of the manifests rather than all of those of the org.
+	Search   string
+	Children []*resourceKind
+	// ActivateOnCreate, DeactivateToReplace and DeactivateToDelete are set
+	// for the kinds the org creates inactive, or only replaces or deletes
+	// when inactive.
+	ActivateOnCreate    bool
+	DeactivateToReplace bool
+	DeactivateToDelete  bool
+	// Managed tells the objects apply may change, those the org does not
+	// flag as system objects when nil.
+	Managed func(object map[string]interface{}) bool
+}
+
+// applyKinds are the kinds apply manages, in the order they are created:
+// the objects referenced first.
+var applyKinds = []*resourceKind{
+	{
+		Name: "Group", Collection: "/api/v1/groups", Key: "profile.name", Search: "q",
+		Managed: func(object map[string]interface{}) bool { return object["type"] == "OKTA_GROUP" },
+	},
+	{Name: "NetworkZone", Collection: "/api/v1/zones", Key: "name", DeactivateToDelete: true},
+	{Name: "TrustedOrigin", Collection: "/api/v1/trustedOrigins", Key: "name", Search: "q"},
+	{
+		Name: "AuthorizationServer", Collection: "/api/v1/authorizationServers", Key: "name", Search: "q",
+		Children: []*resourceKind{
+			{Name: "Scope", Field: "scopes", Collection: "/scopes", Key: "name"},
+			{Name: "Claim", Field: "claims", Collection: "/claims", Key: "name"},
+			{
+				Name: "Policy", Field: "policies", Collection: "/policies", Key: "name",
+				Children: []*resourceKind{{Name: "Rule", Field: "rules", Collection: "/rules", Key: "name"}},
+			},
+		},
+	},
+	{
+		Name: "GroupRule", Collection: "/api/v1/groups/rules", Key: "name", Search: "search",
+		ActivateOnCreate: true, DeactivateToReplace: true, DeactivateToDelete: true,
+	},
+}
+
+// reference matches the references to objects in the specs, like
+// ${Group/Engineering}, replaced by their ID.
+var reference = regexp.MustCompile(`\$\{([A-Za-z]+)/([^}]+)\}`)
+
+type ApplyInputs struct {
+	Filenames []string
+	Prune     []string
+}
+
+// manifest is a YAML document of a manifest file.
+type manifest struct {
+	Kind string                 `yaml:"kind"`
+	Spec map[string]interface{} `yaml:"spec"`
+	// Nested holds the lists of nested objects, like the scopes of an
+	// AuthorizationServer.
+	Nested map[string]interface{} `yaml:",inline"`
+	Source string                 `yaml:"-"`
+}
+
+// declaredObject is an object declared by the manifests.
+type declaredObject struct {
+	Kind   *resourceKind
+	Key    string
+	Spec   map[string]interface{}
+	Source string
+	// Children are the nested objects by the field listing them, the fields
+	// the manifest leaves out are missing.
+	Children map[string][]*declaredObject
+}
+
+// change is a step of the plan, or an object of the org the plan does not
+// change when Action is empty.
+type change struct {
+	Action   string
+	Kind     *resourceKind
+	Key      string
+	Parent   *change
+	Declared *declaredObject
+	// ID is set once created for the objects the plan creates.
+	ID      string
+	Current map[string]interface{}
+	// Spec is the spec of Declared with the references known before the
+	// plan is applied resolved.
+	Spec map[string]interface{}
+}
+
+type applyPlan struct {
+	// Changes are in the order they are applied.
+	Changes []*change
+	// deletes are applied last, in order
+	deletes []*change
+	// index holds the objects of the kinds of the manifests by address,
+	// for the references, nil for the addresses of several objects.
+	index map[string]*change
+}
+
+func NewApplyCmd() *cobra.Command {
+	inputs := ApplyInputs{}
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Args:  cobra.NoArgs,
+		Short: "Apply YAML manifests of groups, group rules, network zones, trusted origins and authorization servers",
+		Long: "Apply YAML manifests of groups, group rules, network zones, trusted origins and authorization servers.\n\n" +
+			"Each manifest has a kind, Group, GroupRule, NetworkZone, TrustedOrigin or AuthorizationServer,\n" +
+			"and a spec, the object as the Management API takes it. An AuthorizationServer also lists its\n" +
+			"scopes, claims and policies, and a policy its rules. The objects are matched with those of\n" +
+			"the org by name, the profile name of groups, and ${Kind/name} in a spec is replaced by the ID\n" +
+			"of the object, like ${Group/Engineering}.\n\n" +
+			"The plan creating the missing objects and updating those that differ from their spec is\n" +
+			"printed, and applied once confirmed like the destructive commands. --dry-run only prints it.",
+		Example: `okta-cli-client apply -f manifests/ --dry-run
+okta-cli-client apply -f groups.yaml -f rules.yaml
+okta-cli-client apply -f manifests/ --prune Group,GroupRule --yes`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(inputs.Filenames) == 0 {
+				return fmt.Errorf("pass the manifests to apply with --%v", applyFilename.LongForm)
+			}
+			manifests, err := readManifests(cmd, inputs.Filenames)
+			if err != nil {
+				return err
+			}
+			var resources []*declaredObject
+			for _, m := range manifests {
+				r, err := parseManifest(m)
+				if err != nil {
+					return err
+				}
+				resources = append(resources, r)
+			}
+			if cmd.Flags().Changed(applyPrune.LongForm) && len(inputs.Prune) == 0 {
+				return fmt.Errorf("pass the kinds to prune to --%v, like Group,GroupRule", applyPrune.LongForm)
+			}
+			prune, err := pruneKinds(inputs.Prune, resources)
+			if err != nil {
+				return err
+			}
+			plan, err := planApply(cmd.Context(), resources, prune)
+			if err != nil {
+				return err
+			}
+			orgURL := apiClient.GetConfig().Okta.Client.OrgUrl
+			printPlan(cmd.OutOrStdout(), orgURL, plan)
+			if len(plan.Changes) == 0 || dryRunValue {
+				return nil
+			}
+			err = confirmRun(cmd, func(name string) string {
+				return fmt.Sprintf("Apply the plan to %v?", orgURL)
+			})
+			if err != nil {
+				return err
+			}
+			return plan.apply(cmd.Context(), cmd.OutOrStdout())
+		},
+	}
+	cmd.Flags().StringArrayVarP(&inputs.Filenames, applyFilename.LongForm, applyFilename.ShortForm, nil, applyFilename.Help)
+	cmd.Flags().StringSliceVar(&inputs.Prune, applyPrune.LongForm, nil, applyPrune.Help)
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(NewApplyCmd())
+}
+
+// readManifests reads the documents of the manifest files, and of the
+// .yaml and .yml files of the directories, in the order of their names.
+func readManifests(cmd *cobra.Command, filenames []string) ([]*manifest, error) {
+	var manifests []*manifest
+	for _, filename := range filenames {
+		if filename == "-" {
+			decoded, err := decodeManifests(cmd.InOrStdin(), "stdin")
+			if err != nil {
+				return nil, err
+			}
+			manifests = append(manifests, decoded...)
+			continue
+		}
+		files := []string{filename}
+		info, err := os.Stat(filename)
+		if err != nil {
+			return nil, err
+		}
+		if info.IsDir() {
+			entries, err := os.ReadDir(filename)
+			if err != nil {
+				return nil, err
+			}
+			files = nil
+			for _, entry := range entries {
+				if ext := filepath.Ext(entry.Name()); !entry.IsDir() && (ext == ".yaml" || ext == ".yml") {
+					files = append(files, filepath.Join(filename, entry.Name()))
+				}
+			}
+		}
+		for _, file := range files {
+			f, err := os.Open(file)
+			if err != nil {
+				return nil, err
+			}
+			decoded, err := decodeManifests(f, file)
+			f.Close()
+			if err != nil {
+				return nil, err
+			}
+			manifests = append(manifests, decoded...)
+		}
+	}
+	return manifests, nil
+}
+
+func decodeManifests(r io.Reader, source string) ([]*manifest, error) {
+	var manifests []*manifest
+	decoder := yaml.NewDecoder(r)
+	for i := 1; ; i++ {
+		m := &manifest{}
+		err := decoder.Decode(m)
+		if errors.Is(err, io.EOF) {
+			return manifests, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", source, err)
+		}
+		if m.Kind == "" && m.Spec == nil && len(m.Nested) == 0 {
+			continue
+		}
+		m.Source = fmt.Sprintf("%v, document %d", source, i)
+		manifests = append(manifests, m)
+	}
+}
+
+func parseManifest(m *manifest) (*declaredObject, error) {
+	var kind *resourceKind
+	names := make([]string, len(applyKinds))
+	for i, k := range applyKinds {
+		names[i] = k.Name
+		if k.Name == m.Kind {
+			kind = k
+		}
+	}
+	if kind == nil {
+		return nil, fmt.Errorf("%v: unknown kind %q, expected one of %v", m.Source, m.Kind, strings.Join(names, ", "))
+	}
+	if m.Spec == nil {
+		return nil, fmt.Errorf("%v: the %v has no spec", m.Source, kind.Name)
+	}
+	// the YAML values are made JSON ones, like float64 numbers, to be
+	// compared with those of the org
+	var spec, nested map[string]interface{}
+	if err := toJSON(m.Spec, &spec); err != nil {
+		return nil, fmt.Errorf("%v: %w", m.Source, err)
+	}
+	if err := toJSON(m.Nested, &nested); err != nil {
+		return nil, fmt.Errorf("%v: %w", m.Source, err)
+	}
+	return parseResource(kind, spec, nested, m.Source)
+}
+
+func toJSON(v interface{}, target interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, target)
+}
+
+// parseResource returns the object of kind with spec, and the nested
+// objects the fields of nested list.
+func parseResource(kind *resourceKind, spec, nested map[string]interface{}, source string) (*declaredObject, error) {
+	r := &declaredObject{Kind: kind, Spec: spec, Source: source, Children: map[string][]*declaredObject{}}
+	r.Key, _ = lookupField(spec, kind.Key).(string)
+	if r.Key == "" {
+		return nil, fmt.Errorf("%v: the spec of the %v has no %v", source, kind.Name, kind.Key)
+	}
+	for field, value := range nested {
+		child := kind.child(field)
+		if child == nil {
+			return nil, fmt.Errorf("%v: unknown field %q of the %v %q", source, field, kind.Name, r.Key)
+		}
+		items, ok := value.([]interface{})
+		if !ok && value != nil {
+			return nil, fmt.Errorf("%v: %v of the %v %q is not a list", source, field, kind.Name, r.Key)
+		}
+		r.Children[field] = []*declaredObject{}
+		for _, item := range items {
+			object, ok := item.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("%v: %v of the %v %q holds %v, not an object", source, field, kind.Name, r.Key, item)
+			}
+			childSpec := map[string]interface{}{}
+			childNested := map[string]interface{}{}
+			for key, value := range object {
+				if child.child(key) != nil {
+					childNested[key] = value
+				} else {
+					childSpec[key] = value
+				}
+			}
+			c, err := parseResource(child, childSpec, childNested, source)
+			if err != nil {
+				return nil, err
+			}
+			r.Children[field] = append(r.Children[field], c)
+		}
+	}
+	return r, nil
+}
+
+// pruneKinds returns the kinds named, which the resources must declare
+// objects of: pruning a kind the manifests leave out would delete all its
+// objects.
+func pruneKinds(names []string, resources []*declaredObject) (map[*resourceKind]bool, error) {
+	declared := map[string]*resourceKind{}
+	for _, r := range resources {
+		declaredKinds(r, declared)
+	}
+	prune := map[*resourceKind]bool{}
+	for _, name := range names {
+		kind, ok := declared[name]
+		if !ok {
+			if findKind(applyKinds, name) == nil {
+				return nil, fmt.Errorf("unknown kind %q in --%v", name, applyPrune.LongForm)
+			}
+			return nil, fmt.Errorf("the manifests declare no %v, it cannot be pruned", name)
+		}
+		prune[kind] = true
+	}
+	return prune, nil
+}
+
+// declaredKinds adds the kind of r, and those of the nested lists it
+// declares, to kinds by name.
+func declaredKinds(r *declaredObject, kinds map[string]*resourceKind) {
+	kinds[r.Kind.Name] = r.Kind
+	for field, children := range r.Children {
+		kinds[r.Kind.child(field).Name] = r.Kind.child(field)
+		for _, child := range children {
+			declaredKinds(child, kinds)
+		}
+	}
+}
+
+// findKind returns the kind named name among kinds and their nested kinds.
+func findKind(kinds []*resourceKind, name string) *resourceKind {
+	for _, kind := range kinds {
+		if kind.Name == name {
+			return kind
+		}
+		if child := findKind(kind.Children, name); child != nil {
+			return child
+		}
+	}
+	return nil
+}
+
+func (k *resourceKind) child(field string) *resourceKind {
+	for _, child := range k.Children {
+		if child.Field == field {
+			return child
+		}
+	}
+	return nil
+}
+
+func (k *resourceKind) manages(object map[string]interface{}) bool {
+	if k.Managed != nil {
+		return k.Managed(object)
+	}
+	system, _ := object["system"].(bool)
+	return !system
+}
+
+// lookupField returns the value of the field of object at path, like
+// profile.name.
+func lookupField(object map[string]interface{}, path string) interface{} {
+	var value interface{} = object
+	for _, name := range strings.Split(path, ".") {
+		fields, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = fields[name]
+	}
+	return value
+}
+
+// address returns the address of the object, its kind and key after those
+// of its parent, like AuthorizationServer/api/Scope/read.
+func (c *change) address() string {
+	address := c.Kind.Name + "/" + c.Key
+	if c.Parent != nil {
+		address = c.Parent.address() + "/" + address
+	}
+	return address
+}
+
+func (c *change) collection() string {
+	if c.Parent == nil {
+		return c.Kind.Collection
+	}
+	return c.Parent.path() + c.Kind.Collection
+}
+
+func (c *change) path() string {
+	return c.collection() + "/" + url.PathEscape(c.ID)
+}
+
+// planApply matches resources with the objects of the org and returns the
+// plan creating and updating them, and deleting the others of the kinds to
+// prune, nested objects only in the lists the resources declare.
+func planApply(ctx context.Context, resources []*declaredObject, prune map[*resourceKind]bool) (*applyPlan, error) {
+	declared := map[*resourceKind][]*declaredObject{}
+	// keys are the keys of the objects of each kind the resources declare or
+	// reference
+	keys := map[*resourceKind]map[string]bool{}
+	for _, r := range resources {
+		declared[r.Kind] = append(declared[r.Kind], r)
+		if keys[r.Kind] == nil {
+			keys[r.Kind] = map[string]bool{}
+		}
+		keys[r.Kind][r.Key] = true
+		if err := findReferences(r, keys); err != nil {
+			return nil, err
+		}
+	}
+	p := &applyPlan{index: map[string]*change{}}
+	var changes []*change
+	for _, kind := range applyKinds {
+		if len(keys[kind]) == 0 {
+			continue
+		}
+		pruned := prune[kind] && len(declared[kind]) > 0
+		var existing []map[string]interface{}
+		var err error
+		if pruned || kind.Search == "" {
+			existing, err = listObjects(ctx, kind.Collection)
+		} else {
+			existing, err = searchObjects(ctx, kind, keys[kind])
+		}
+		if err != nil {
+			return nil, err
+		}
+		matched, err := p.match(kind, nil, declared[kind], existing, pruned)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, matched...)
+	}
+	// the objects are all indexed before the specs are resolved, for the
+	// references to objects of the kinds that come later
+	for _, c := range changes {
+		if err := p.planChange(ctx, c, prune); err != nil {
+			return nil, err
+		}
+	}
+	p.Changes = append(p.Changes, p.deletes...)
+	return p, nil
+}
+
+// findReferences adds the keys of the objects r and its nested objects
+// reference to keys by kind.
+func findReferences(r *declaredObject, keys map[*resourceKind]map[string]bool) error {
+	for _, match := range specReferences(r.Spec) {
+		found := false
+		for _, kind := range applyKinds {
+			if kind.Name == match[1] {
+				if keys[kind] == nil {
+					keys[kind] = map[string]bool{}
+				}
+				keys[kind][match[2]] = true
+				found = true
+			}
+		}
+		if !found {
+			return fmt.Errorf("%v: %v references an object of the unknown kind %v", r.Source, match[0], match[1])
+		}
+	}
+	for _, children := range r.Children {
+		for _, child := range children {
+			if err := findReferences(child, keys); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// specReferences returns the matches of reference in the strings of v.
+func specReferences(v interface{}) [][]string {
+	var matches [][]string
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for _, value := range v {
+			matches = append(matches, specReferences(value)...)
+		}
+	case []interface{}:
+		for _, value := range v {
+			matches = append(matches, specReferences(value)...)
+		}
+	case string:
+		matches = reference.FindAllStringSubmatch(v, -1)
+	}
+	return matches
+}
+
+// listObjects returns the objects of the collection at path, from the org
+// rather than the cache.
+func listObjects(ctx context.Context, path string) ([]map[string]interface{}, error) {
+	ctx = context.WithValue(ctx, sdk.ContextSkipCache, true)
+	var objects []map[string]interface{}
+	for object, err := range sdk.All[map[string]interface{}](ctx, apiClient.RawRequest(ctx, http.MethodGet, path)) {
+		if err != nil {
+			return nil, fmt.Errorf("listing %v: %w", path, err)
+		}
+		objects = append(objects, object)
+	}
+	return objects, nil
+}
+
+// searchObjects returns the objects of kind whose key is one of keys,
+// searched one key at a time, from the org rather than the cache.
+func searchObjects(ctx context.Context, kind *resourceKind, keys map[string]bool) ([]map[string]interface{}, error) {
+	ctx = context.WithValue(ctx, sdk.ContextSkipCache, true)
+	sorted := make([]string, 0, len(keys))
+	for key := range keys {
+		sorted = append(sorted, key)
+	}
+	sort.Strings(sorted)
+	var objects []map[string]interface{}
+	for _, key := range sorted {
+		req := apiClient.RawRequest(ctx, http.MethodGet, kind.Collection).Query(kind.Search, key)
+		for object, err := range sdk.All[map[string]interface{}](ctx, req) {
+			if err != nil {
+				return nil, fmt.Errorf("searching %v %q: %w", kind.Collection, key, err)
+			}
+			// the search also matches the keys starting with key, and other
+			// fields
+			if lookupField(object, kind.Key) == key {
+				objects = append(objects, object)
+			}
+		}
+	}
+	return objects, nil
+}
+
+// match pairs the resources of kind declared under parent with the existing
+// objects, and plans the deletion of the other objects when prune is set.
+func (p *applyPlan) match(kind *resourceKind, parent *change, declared []*declaredObject, existing []map[string]interface{}, prune bool) ([]*change, error) {
+	byKey := map[string][]map[string]interface{}{}
+	for _, object := range existing {
+		key, _ := lookupField(object, kind.Key).(string)
+		byKey[key] = append(byKey[key], object)
+	}
+	var changes, deletes []*change
+	seen := map[string]bool{}
+	for _, r := range declared {
+		c := &change{Kind: kind, Key: r.Key, Parent: parent, Declared: r}
+		if seen[r.Key] {
+			return nil, fmt.Errorf("%v: %v is declared twice", r.Source, c.address())
+		}
+		seen[r.Key] = true
+		switch objects := byKey[r.Key]; {
+		case len(objects) > 1:
+			return nil, fmt.Errorf("%v: the org has %d objects %v, rename all but one to apply it", r.Source, len(objects), c.address())
+		case len(objects) == 1:
+			if !kind.manages(objects[0]) {
+				return nil, fmt.Errorf("%v: %v is managed by the org, it cannot be applied", r.Source, c.address())
+			}
+			c.Current = objects[0]
+			c.ID, _ = objects[0]["id"].(string)
+		}
+		changes = append(changes, c)
+		if parent == nil {
+			p.index[c.address()] = c
+		}
+	}
+	for _, object := range existing {
+		key, _ := lookupField(object, kind.Key).(string)
+		if seen[key] {
+			continue
+		}
+		c := &change{Kind: kind, Key: key, Parent: parent, Current: object}
+		c.ID, _ = object["id"].(string)
+		if prune && kind.manages(object) {
+			c.Action = actionDelete
+			deletes = append(deletes, c)
+		}
+		if parent == nil {
+			if _, ok := p.index[c.address()]; ok {
+				p.index[c.address()] = nil
+			} else {
+				p.index[c.address()] = c
+			}
+		}
+	}
+	// the objects are deleted in the reverse order of their kinds, those
+	// referencing others first
+	p.deletes = append(deletes, p.deletes...)
+	return changes, nil
+}
+
+// planChange plans the creation or update of the object of c, then of its
+// nested objects.
+func (p *applyPlan) planChange(ctx context.Context, c *change, prune map[*resourceKind]bool) error {
+	r := c.Declared
+	spec, err := p.resolve(r.Spec, false)
+	if err != nil {
+		return fmt.Errorf("%v: %w", r.Source, err)
+	}
+	c.Spec = spec.(map[string]interface{})
+	switch {
+	case c.ID == "":
+		c.Action = actionCreate
+	case !contains(c.Current, c.Spec):
+		c.Action = actionUpdate
+	}
+	if c.Action != "" {
+		p.Changes = append(p.Changes, c)
+	}
+	for _, kind := range c.Kind.Children {
+		declared, ok := r.Children[kind.Field]
+		if !ok {
+			continue
+		}
+		var existing []map[string]interface{}
+		if c.ID != "" {
+			existing, err = listObjects(ctx, c.path()+kind.Collection)
+			if err != nil {
+				return err
+			}
+		}
+		children, err := p.match(kind, c, declared, existing, prune[kind])
+		if err != nil {
+			return err
+		}
+		for _, child := range children {
+			if err := p.planChange(ctx, child, prune); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// resolve returns a copy of v with the references replaced by the IDs of
+// the objects. The references to objects the plan creates are kept until
+// they are created, unless final is set.
+func (p *applyPlan) resolve(v interface{}, final bool) (interface{}, error) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		resolved := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			var err error
+			if resolved[key], err = p.resolve(value, final); err != nil {
+				return nil, err
+			}
+		}
+		return resolved, nil
+	case []interface{}:
+		resolved := make([]interface{}, len(v))
+		for i, value := range v {
+			var err error
+			if resolved[i], err = p.resolve(value, final); err != nil {
+				return nil, err
+			}
+		}
+		return resolved, nil
+	case string:
+		var err error
+		resolved := reference.ReplaceAllStringFunc(v, func(ref string) string {
+			address := ref[2 : len(ref)-1]
+			c, ok := p.index[address]
+			switch {
+			case !ok:
+				err = fmt.Errorf("%v is neither in the manifests nor in the org", address)
+			case c == nil:
+				err = fmt.Errorf("the org has several objects %v", address)
+			case c.Action == actionDelete:
+				err = fmt.Errorf("%v is referenced but deleted by the plan", address)
+			case c.ID != "":
+				return c.ID
+			case final:
+				err = fmt.Errorf("%v is referenced before it is created", address)
+			}
+			return ref
+		})
+		return resolved, err
+	}
+	return v, nil
+}
+
+// contains tells whether current has the fields and values of desired,
+// the lists having the same length.
+func contains(current, desired interface{}) bool {
+	switch desired := desired.(type) {
+	case map[string]interface{}:
+		fields, ok := current.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for key, value := range desired {
+			if !contains(fields[key], value) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		items, ok := current.([]interface{})
+		if !ok || len(items) != len(desired) {
+			return false
+		}
+		for i := range desired {
+			if !contains(items[i], desired[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(current, desired)
+}
+
+// merge sets the fields of src in dst, merging the objects they both have.
+func merge(dst, src map[string]interface{}) {
+	for key, value := range src {
+		fields, ok := value.(map[string]interface{})
+		if current, isObject := dst[key].(map[string]interface{}); ok && isObject {
+			merged := make(map[string]interface{}, len(current))
+			for k, v := range current {
+				merged[k] = v
+			}
+			merge(merged, fields)
+			dst[key] = merged
+			continue
+		}
+		dst[key] = value
+	}
+}
+
+func printPlan(w io.Writer, orgURL string, p *applyPlan) {
+	if len(p.Changes) == 0 {
+		fmt.Fprintln(w, "No changes, the org matches the manifests.")
+		return
+	}
+	fmt.Fprintf(w, "The plan changes %v as follows:\n", orgURL)
+	counts := map[string]int{}
+	for _, c := range p.Changes {
+		counts[c.Action]++
+		fmt.Fprintln(w)
+		switch c.Action {
+		case actionCreate:
+			fmt.Fprintf(w, "  + %v\n", c.address())
+		case actionUpdate:
+			fmt.Fprintf(w, "  ~ %v (%v)\n", c.address(), c.ID)
+		case actionDelete:
+			fmt.Fprintf(w, "  - %v (%v)\n", c.address(), c.ID)
+			continue
+		}
+		printFieldChanges(w, "", c.Current, c.Spec)
+	}
+	fmt.Fprintf(w, "\nPlan: %d to add, %d to change, %d to destroy.\n", counts[actionCreate], counts[actionUpdate], counts[actionDelete])
+}
+
+// printFieldChanges prints the fields of desired current does not have,
+// and those it has with other values, by their path.
+func printFieldChanges(w io.Writer, path string, current, desired interface{}) {
+	if fields, ok := desired.(map[string]interface{}); ok {
+		currentFields, _ := current.(map[string]interface{})
+		keys := make([]string, 0, len(fields))
+		for key := range fields {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			fieldPath := key
+			if path != "" {
+				fieldPath = path + "." + key
+			}
+			printFieldChanges(w, fieldPath, currentFields[key], fields[key])
+		}
+		return
+	}
+	if contains(current, desired) {
+		return
+	}
+	value, _ := json.Marshal(desired)
+	if current == nil {
+		fmt.Fprintf(w, "      + %v: %s\n", path, value)
+		return
+	}
+	currentValue, _ := json.Marshal(current)
+	fmt.Fprintf(w, "      ~ %v: %s -> %s\n", path, currentValue, value)
+}
+
+// apply applies the changes in order, stopping at the first that fails.
+func (p *applyPlan) apply(ctx context.Context, w io.Writer) error {
+	counts := map[string]int{}
+	for _, c := range p.Changes {
+		var err error
+		switch c.Action {
+		case actionCreate:
+			if err = p.create(ctx, c); err == nil {
+				fmt.Fprintf(w, "%v: created (%v)\n", c.address(), c.ID)
+			}
+		case actionUpdate:
+			if err = p.update(ctx, c); err == nil {
+				fmt.Fprintf(w, "%v: updated\n", c.address())
+			}
+		case actionDelete:
+			if err = p.delete(ctx, c); err == nil {
+				fmt.Fprintf(w, "%v: deleted\n", c.address())
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("%v %v: %w", strings.TrimSuffix(c.Action, "e")+"ing", c.address(), err)
+		}
+		counts[c.Action]++
+	}
+	fmt.Fprintf(w, "\nApply complete: %d added, %d changed, %d destroyed.\n", counts[actionCreate], counts[actionUpdate], counts[actionDelete])
+	return nil
+}
+
+func (p *applyPlan) create(ctx context.Context, c *change) error {
+	body, err := p.resolve(c.Declared.Spec, true)
+	if err != nil {
+		return err
+	}
+	created, err := sendObject(ctx, http.MethodPost, c.collection(), body)
+	if err != nil {
+		return err
+	}
+	c.ID, _ = created["id"].(string)
+	if c.ID == "" {
+		return errors.New("the org answered no id")
+	}
+	if c.Kind.ActivateOnCreate {
+		_, err = sendObject(ctx, http.MethodPost, c.path()+"/lifecycle/activate", nil)
+	}
+	return err
+}
+
+// update replaces the object with its current fields, but those the org
+// sets, merged with its spec.
+func (p *applyPlan) update(ctx context.Context, c *change) error {
+	spec, err := p.resolve(c.Declared.Spec, true)
+	if err != nil {
+		return err
+	}
+	body := make(map[string]interface{}, len(c.Current))
+	for key, value := range c.Current {
+		body[key] = value
+	}
+	for _, field := range readOnlyFields {
+		// the replaces of zones, rules and policies require their type
+		if field != "type" {
+			delete(body, field)
+		}
+	}
+	merge(body, spec.(map[string]interface{}))
+	reactivate := c.Kind.DeactivateToReplace && c.Current["status"] == "ACTIVE"
+	if reactivate {
+		if _, err := sendObject(ctx, http.MethodPost, c.path()+"/lifecycle/deactivate", nil); err != nil {
+			return err
+		}
+	}
+	_, err = sendObject(ctx, http.MethodPut, c.path(), body)
+	if reactivate {
+		// the object is activated again even if the replace failed
+		if _, activateErr := sendObject(ctx, http.MethodPost, c.path()+"/lifecycle/activate", nil); err == nil {
+			err = activateErr
+		}
+	}
+	return err
+}
+
+func (p *applyPlan) delete(ctx context.Context, c *change) error {
+	if c.Kind.DeactivateToDelete && c.Current["status"] == "ACTIVE" {
+		if _, err := sendObject(ctx, http.MethodPost, c.path()+"/lifecycle/deactivate", nil); err != nil {
+			return err
+		}
+	}
+	_, err := sendObject(ctx, http.MethodDelete, c.path(), nil)
+	return err
+}
+
+// sendObject sends a request with body encoded to JSON, if any, and returns
+// the object the org answers, if any.
+func sendObject(ctx context.Context, method, path string, body interface{}) (map[string]interface{}, error) {
+	req := apiClient.RawRequest(ctx, method, path)
+	if body != nil {
+		req = req.Body(body)
+	}
+	resp, err := req.Execute()
+	if err != nil {
+		return nil, err
+	}
+	var object map[string]interface{}
+	if resp.Body != nil {
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		_ = json.Unmarshal(b, &object)
+	}
+	return object, nil
+}
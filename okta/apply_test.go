@@ -0,0 +1,576 @@
+package okta
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/okta/okta-cli-client/mockorg"
+	"github.com/okta/okta-cli-client/sdk"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	engineeringGroup = `
+kind: Group
+spec:
+  profile:
+    name: Engineering
+    description: Engineers
+`
+	engineersRule = `
+kind: GroupRule
+spec:
+  name: Engineers
+  type: group_rule
+  conditions:
+    expression:
+      type: urn:okta:expression:1.0
+      value: user.department=="Engineering"
+  actions:
+    assignUserToGroups:
+      groupIds: ["${Group/Engineering}"]
+`
+	officeZone = `
+kind: NetworkZone
+spec:
+  type: IP
+  name: Office
+  gateways:
+    - type: CIDR
+      value: 10.0.0.0/8
+`
+	portalOrigin = `
+kind: TrustedOrigin
+spec:
+  name: Portal
+  origin: https://portal.example.org
+  scopes:
+    - type: CORS
+`
+	apiServer = `
+kind: AuthorizationServer
+spec:
+  name: api
+  audiences: ["api://orders"]
+scopes:
+  - name: orders.read
+claims:
+  - name: tenant
+    claimType: RESOURCE
+    valueType: EXPRESSION
+    value: user.tenant
+policies:
+  - name: Default
+    type: OAUTH_AUTHORIZATION_POLICY
+    rules:
+      - name: Engineers
+        type: RESOURCE_ACCESS
+        conditions:
+          people:
+            groups:
+              include: ["${Group/Engineering}"]
+`
+)
+
+// useMockOrg points the client of the commands to an empty mock org.
+func useMockOrg(t *testing.T) {
+	org := mockorg.New()
+	useOrg(t, org, org.Token())
+}
+
+// useOrg points the client of the commands to the org served by handler,
+// authorized with token, and returns its URL.
+func useOrg(t *testing.T, handler http.Handler, token string, setters ...sdk.ConfigSetter) string {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	client, err := sdk.NewTestClient(server.URL, token, setters...)
+	require.NoError(t, err)
+	previous := apiClient
+	apiClient = client
+	t.Cleanup(func() { apiClient = previous })
+	return server.URL
+}
+
+// createObject creates an object in the org and returns its ID.
+func createObject(t *testing.T, path string, object map[string]interface{}) string {
+	created, err := sendObject(context.Background(), http.MethodPost, path, object)
+	require.NoError(t, err)
+	return created["id"].(string)
+}
+
+func parseManifests(t *testing.T, documents ...string) []*declaredObject {
+	manifests, err := decodeManifests(strings.NewReader(strings.Join(documents, "---\n")), "test.yaml")
+	require.NoError(t, err)
+	var resources []*declaredObject
+	for _, m := range manifests {
+		r, err := parseManifest(m)
+		require.NoError(t, err)
+		resources = append(resources, r)
+	}
+	return resources
+}
+
+func planSteps(p *applyPlan) []string {
+	var steps []string
+	for _, c := range p.Changes {
+		steps = append(steps, c.Action+" "+c.address())
+	}
+	return steps
+}
+
+func TestApply(t *testing.T) {
+	for name, test := range map[string]struct {
+		// org fills the org before the plan
+		org       func(t *testing.T)
+		manifests []string
+		prune     []string
+		steps     []string
+		err       string
+		// check inspects the org once the plan is applied, given the IDs of
+		// the groups by name
+		check func(t *testing.T, groups map[string]string)
+	}{
+		"create with references": {
+			manifests: []string{engineersRule, engineeringGroup},
+			steps:     []string{"create Group/Engineering", "create GroupRule/Engineers"},
+			check: func(t *testing.T, groups map[string]string) {
+				rules, err := listObjects(context.Background(), "/api/v1/groups/rules")
+				require.NoError(t, err)
+				require.Len(t, rules, 1)
+				require.Equal(t, "ACTIVE", rules[0]["status"], "created rules are activated")
+				require.Equal(t, []interface{}{groups["Engineering"]}, lookupField(rules[0], "actions.assignUserToGroups.groupIds"),
+					"the reference is resolved once the group is created")
+			},
+		},
+		"no changes": {
+			org: func(t *testing.T) {
+				createObject(t, "/api/v1/groups", map[string]interface{}{"profile": map[string]interface{}{"name": "Engineering", "description": "Engineers"}})
+			},
+			manifests: []string{engineeringGroup},
+		},
+		"update": {
+			org: func(t *testing.T) {
+				createObject(t, "/api/v1/groups", map[string]interface{}{"profile": map[string]interface{}{"name": "Engineering", "description": "Developers"}})
+			},
+			manifests: []string{engineeringGroup},
+			steps:     []string{"update Group/Engineering"},
+			check: func(t *testing.T, groups map[string]string) {
+				group, err := sendObject(context.Background(), http.MethodGet, "/api/v1/groups/"+groups["Engineering"], nil)
+				require.NoError(t, err)
+				require.Equal(t, "Engineers", lookupField(group, "profile.description"))
+			},
+		},
+		"update of an active rule": {
+			org: func(t *testing.T) {
+				createObject(t, "/api/v1/groups", map[string]interface{}{"profile": map[string]interface{}{"name": "Engineering", "description": "Engineers"}})
+				other := createObject(t, "/api/v1/groups", map[string]interface{}{"profile": map[string]interface{}{"name": "Other"}})
+				ruleID := createObject(t, "/api/v1/groups/rules", map[string]interface{}{
+					"name": "Engineers", "type": "group_rule",
+					"conditions": map[string]interface{}{"expression": map[string]interface{}{"type": "urn:okta:expression:1.0", "value": `user.department=="Engineering"`}},
+					"actions":    map[string]interface{}{"assignUserToGroups": map[string]interface{}{"groupIds": []interface{}{other}}},
+				})
+				_, err := sendObject(context.Background(), http.MethodPost, "/api/v1/groups/rules/"+ruleID+"/lifecycle/activate", nil)
+				require.NoError(t, err)
+			},
+			manifests: []string{engineeringGroup, engineersRule},
+			steps:     []string{"update GroupRule/Engineers"},
+			check: func(t *testing.T, groups map[string]string) {
+				rules, err := listObjects(context.Background(), "/api/v1/groups/rules")
+				require.NoError(t, err)
+				require.Equal(t, "ACTIVE", rules[0]["status"], "the rule deactivated to be replaced is activated again")
+				require.Equal(t, []interface{}{groups["Engineering"]}, lookupField(rules[0], "actions.assignUserToGroups.groupIds"))
+			},
+		},
+		"without prune": {
+			org: func(t *testing.T) {
+				createObject(t, "/api/v1/groups", map[string]interface{}{"profile": map[string]interface{}{"name": "Legacy"}})
+			},
+			manifests: []string{engineeringGroup},
+			steps:     []string{"create Group/Engineering"},
+			check: func(t *testing.T, groups map[string]string) {
+				require.Contains(t, groups, "Legacy")
+			},
+		},
+		"prune": {
+			org: func(t *testing.T) {
+				legacy := createObject(t, "/api/v1/groups", map[string]interface{}{"profile": map[string]interface{}{"name": "Legacy"}})
+				ruleID := createObject(t, "/api/v1/groups/rules", map[string]interface{}{
+					"name": "Old", "type": "group_rule",
+					"actions": map[string]interface{}{"assignUserToGroups": map[string]interface{}{"groupIds": []interface{}{legacy}}},
+				})
+				_, err := sendObject(context.Background(), http.MethodPost, "/api/v1/groups/rules/"+ruleID+"/lifecycle/activate", nil)
+				require.NoError(t, err)
+			},
+			manifests: []string{engineeringGroup, engineersRule},
+			prune:     []string{"Group", "GroupRule"},
+			// the objects referencing others are deleted first
+			steps: []string{"create Group/Engineering", "create GroupRule/Engineers", "delete GroupRule/Old", "delete Group/Legacy"},
+			check: func(t *testing.T, groups map[string]string) {
+				require.NotContains(t, groups, "Legacy")
+				rules, err := listObjects(context.Background(), "/api/v1/groups/rules")
+				require.NoError(t, err)
+				require.Len(t, rules, 1)
+				require.Equal(t, "Engineers", rules[0]["name"])
+			},
+		},
+		"prune of one kind": {
+			org: func(t *testing.T) {
+				createObject(t, "/api/v1/groups", map[string]interface{}{"profile": map[string]interface{}{"name": "Legacy"}})
+				createObject(t, "/api/v1/groups/rules", map[string]interface{}{"name": "Old", "type": "group_rule"})
+			},
+			manifests: []string{engineeringGroup, engineersRule},
+			prune:     []string{"GroupRule"},
+			steps:     []string{"create Group/Engineering", "create GroupRule/Engineers", "delete GroupRule/Old"},
+		},
+		"zones and trusted origins": {
+			manifests: []string{officeZone, portalOrigin},
+			steps:     []string{"create NetworkZone/Office", "create TrustedOrigin/Portal"},
+			check: func(t *testing.T, groups map[string]string) {
+				origins, err := listObjects(context.Background(), "/api/v1/trustedOrigins")
+				require.NoError(t, err)
+				require.Len(t, origins, 1)
+				require.Equal(t, "https://portal.example.org", origins[0]["origin"])
+			},
+		},
+		"prune of an active zone": {
+			org: func(t *testing.T) {
+				createObject(t, "/api/v1/zones", map[string]interface{}{"type": "IP", "name": "Legacy"})
+			},
+			manifests: []string{officeZone},
+			prune:     []string{"NetworkZone"},
+			// the org deletes inactive zones only
+			steps: []string{"create NetworkZone/Office", "delete NetworkZone/Legacy"},
+			check: func(t *testing.T, groups map[string]string) {
+				zones, err := listObjects(context.Background(), "/api/v1/zones")
+				require.NoError(t, err)
+				require.Len(t, zones, 1)
+				require.Equal(t, "Office", zones[0]["name"])
+			},
+		},
+		"prune of a referenced object": {
+			org: func(t *testing.T) {
+				createObject(t, "/api/v1/groups", map[string]interface{}{"profile": map[string]interface{}{"name": "Legacy"}})
+			},
+			manifests: []string{engineeringGroup, strings.ReplaceAll(engineersRule, "${Group/Engineering}", "${Group/Legacy}")},
+			prune:     []string{"Group"},
+			err:       "Group/Legacy is referenced but deleted by the plan",
+		},
+		"prune of a kind not declared": {
+			manifests: []string{engineeringGroup},
+			prune:     []string{"GroupRule"},
+			err:       "the manifests declare no GroupRule, it cannot be pruned",
+		},
+		"prune of an unknown kind": {
+			manifests: []string{engineeringGroup},
+			prune:     []string{"Groups"},
+			err:       `unknown kind "Groups"`,
+		},
+		"reference to an unknown object": {
+			manifests: []string{engineersRule},
+			err:       "Group/Engineering is neither in the manifests nor in the org",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			useMockOrg(t)
+			ctx := context.Background()
+			if test.org != nil {
+				test.org(t)
+			}
+			resources := parseManifests(t, test.manifests...)
+			prune, err := pruneKinds(test.prune, resources)
+			if err == nil {
+				var p *applyPlan
+				if p, err = planApply(ctx, resources, prune); err == nil {
+					require.Equal(t, test.steps, planSteps(p))
+					var out bytes.Buffer
+					require.NoError(t, p.apply(ctx, &out))
+				}
+			}
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+
+			groups := map[string]string{}
+			objects, err := listObjects(ctx, "/api/v1/groups")
+			require.NoError(t, err)
+			for _, group := range objects {
+				groups[lookupField(group, "profile.name").(string)] = group["id"].(string)
+			}
+			if test.check != nil {
+				test.check(t, groups)
+			}
+			// the org matches the manifests once applied
+			p, err := planApply(ctx, resources, prune)
+			require.NoError(t, err)
+			require.Empty(t, planSteps(p))
+		})
+	}
+}
+
+func TestPlanApplySearch(t *testing.T) {
+	org := mockorg.New()
+	var lists []string
+	useOrg(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			lists = append(lists, r.URL.RequestURI())
+		}
+		org.ServeHTTP(w, r)
+	}), org.Token())
+	ctx := context.Background()
+	for _, name := range []string{"Engineering", "Engineering Managers", "Legacy"} {
+		createObject(t, "/api/v1/groups", map[string]interface{}{"profile": map[string]interface{}{"name": name, "description": "Engineers"}})
+	}
+	resources := parseManifests(t, engineeringGroup, engineersRule, officeZone)
+
+	// the kinds not pruned are searched by key, those that cannot be
+	// searched are listed
+	lists = nil
+	p, err := planApply(ctx, resources, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"create NetworkZone/Office", "create GroupRule/Engineers"}, planSteps(p),
+		"the groups starting with the key are left out")
+	require.Equal(t, []string{"/api/v1/groups?q=Engineering", "/api/v1/zones", "/api/v1/groups/rules?search=Engineers"}, lists)
+
+	// the kinds pruned are listed
+	lists = nil
+	p, err = planApply(ctx, resources, map[*resourceKind]bool{findKind(applyKinds, "Group"): true})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"create NetworkZone/Office", "create GroupRule/Engineers",
+		"delete Group/Engineering Managers", "delete Group/Legacy",
+	}, planSteps(p))
+	require.Equal(t, []string{"/api/v1/groups", "/api/v1/zones", "/api/v1/groups/rules?search=Engineers"}, lists)
+}
+
+func TestApplyAuthorizationServer(t *testing.T) {
+	useMockOrg(t)
+	ctx := context.Background()
+
+	// the nested objects of the server the plan creates are created in it
+	resources := parseManifests(t, engineeringGroup, apiServer)
+	p, err := planApply(ctx, resources, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"create Group/Engineering",
+		"create AuthorizationServer/api",
+		"create AuthorizationServer/api/Scope/orders.read",
+		"create AuthorizationServer/api/Claim/tenant",
+		"create AuthorizationServer/api/Policy/Default",
+		"create AuthorizationServer/api/Policy/Default/Rule/Engineers",
+	}, planSteps(p))
+	var out bytes.Buffer
+	require.NoError(t, p.apply(ctx, &out))
+	serverID, policyID := p.Changes[1].ID, p.Changes[4].ID
+	rules, err := listObjects(ctx, "/api/v1/authorizationServers/"+serverID+"/policies/"+policyID+"/rules")
+	require.NoError(t, err)
+	require.Len(t, rules, 1)
+	require.Equal(t, []interface{}{p.Changes[0].ID}, lookupField(rules[0], "conditions.people.groups.include"),
+		"the reference of the nested rule is resolved")
+	p, err = planApply(ctx, resources, nil)
+	require.NoError(t, err)
+	require.Empty(t, planSteps(p))
+
+	// the objects of the lists to prune are deleted, nested ones first,
+	// those of the lists left out are kept
+	createObject(t, "/api/v1/authorizationServers/"+serverID+"/policies/"+policyID+"/rules", map[string]interface{}{"name": "Old", "type": "RESOURCE_ACCESS"})
+	createObject(t, "/api/v1/authorizationServers/"+serverID+"/claims", map[string]interface{}{"name": "legacy"})
+	resources = parseManifests(t, engineeringGroup, strings.ReplaceAll(apiServer, "orders.read", "orders.write"))
+	prune, err := pruneKinds([]string{"Scope", "Rule"}, resources)
+	require.NoError(t, err)
+	p, err = planApply(ctx, resources, prune)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"create AuthorizationServer/api/Scope/orders.write",
+		"delete AuthorizationServer/api/Policy/Default/Rule/Old",
+		"delete AuthorizationServer/api/Scope/orders.read",
+	}, planSteps(p))
+	require.NoError(t, p.apply(ctx, &out))
+	scopes, err := listObjects(ctx, "/api/v1/authorizationServers/"+serverID+"/scopes")
+	require.NoError(t, err)
+	require.Len(t, scopes, 1)
+	require.Equal(t, "orders.write", scopes[0]["name"])
+	claims, err := listObjects(ctx, "/api/v1/authorizationServers/"+serverID+"/claims")
+	require.NoError(t, err)
+	require.Len(t, claims, 2)
+	p, err = planApply(ctx, resources, prune)
+	require.NoError(t, err)
+	require.Empty(t, planSteps(p))
+}
+
+func TestPrintPlan(t *testing.T) {
+	useMockOrg(t)
+	ctx := context.Background()
+	createObject(t, "/api/v1/groups", map[string]interface{}{"profile": map[string]interface{}{"name": "Legacy"}})
+	resources := parseManifests(t, engineeringGroup, engineersRule)
+	p, err := planApply(ctx, resources, map[*resourceKind]bool{findKind(applyKinds, "Group"): true})
+	require.NoError(t, err)
+	var out bytes.Buffer
+	printPlan(&out, "https://example.okta.com", p)
+	legacy := p.Changes[len(p.Changes)-1].ID
+	require.Equal(t, `The plan changes https://example.okta.com as follows:
+
+  + Group/Engineering
+      + profile.description: "Engineers"
+      + profile.name: "Engineering"
+
+  + GroupRule/Engineers
+      + actions.assignUserToGroups.groupIds: ["${Group/Engineering}"]
+      + conditions.expression.type: "urn:okta:expression:1.0"
+      + conditions.expression.value: "user.department==\"Engineering\""
+      + name: "Engineers"
+      + type: "group_rule"
+
+  - Group/Legacy (`+legacy+`)
+
+Plan: 2 to add, 0 to change, 1 to destroy.
+`, out.String())
+
+	out.Reset()
+	require.NoError(t, p.apply(ctx, &out))
+	engineering := p.Changes[0].ID
+	rule := p.Changes[1].ID
+	require.Equal(t, `Group/Engineering: created (`+engineering+`)
+GroupRule/Engineers: created (`+rule+`)
+Group/Legacy: deleted
+
+Apply complete: 2 added, 0 changed, 1 destroyed.
+`, out.String())
+
+	p, err = planApply(ctx, resources, nil)
+	require.NoError(t, err)
+	out.Reset()
+	printPlan(&out, "https://example.okta.com", p)
+	require.Equal(t, "No changes, the org matches the manifests.\n", out.String())
+}
+
+func TestResolve(t *testing.T) {
+	existing := &change{Kind: applyKinds[0], Key: "Engineering", ID: "00g1"}
+	created := &change{Kind: applyKinds[0], Key: "New", Action: actionCreate}
+	deleted := &change{Kind: applyKinds[0], Key: "Legacy", ID: "00g2", Action: actionDelete}
+	p := &applyPlan{index: map[string]*change{
+		"Group/Engineering": existing, "Group/New": created, "Group/Legacy": deleted, "Group/Twice": nil,
+	}}
+	for name, test := range map[string]struct {
+		value    interface{}
+		final    bool
+		resolved interface{}
+		err      string
+	}{
+		"existing": {
+			value:    map[string]interface{}{"groupIds": []interface{}{"${Group/Engineering}", "00g9"}},
+			resolved: map[string]interface{}{"groupIds": []interface{}{"00g1", "00g9"}},
+		},
+		"in a string": {
+			value:    "group ${Group/Engineering}",
+			resolved: "group 00g1",
+		},
+		"created by the plan": {
+			value:    "${Group/New}",
+			resolved: "${Group/New}",
+		},
+		"created by the plan, final": {
+			value: "${Group/New}",
+			final: true,
+			err:   "Group/New is referenced before it is created",
+		},
+		"deleted by the plan": {
+			value: "${Group/Legacy}",
+			err:   "Group/Legacy is referenced but deleted by the plan",
+		},
+		"several objects": {
+			value: "${Group/Twice}",
+			err:   "the org has several objects Group/Twice",
+		},
+		"unknown": {
+			value: "${Group/Unknown}",
+			err:   "Group/Unknown is neither in the manifests nor in the org",
+		},
+		"other values": {
+			value:    map[string]interface{}{"priority": 1.0, "enabled": true},
+			resolved: map[string]interface{}{"priority": 1.0, "enabled": true},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			resolved, err := p.resolve(test.value, test.final)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.resolved, resolved)
+		})
+	}
+}
+
+func TestContains(t *testing.T) {
+	current := map[string]interface{}{
+		"id":      "00g1",
+		"profile": map[string]interface{}{"name": "Engineering", "description": "Engineers"},
+		"groups":  []interface{}{"00g1", "00g2"},
+	}
+	for name, test := range map[string]struct {
+		desired  interface{}
+		contains bool
+	}{
+		"subset":             {map[string]interface{}{"profile": map[string]interface{}{"name": "Engineering"}}, true},
+		"other value":        {map[string]interface{}{"profile": map[string]interface{}{"name": "Sales"}}, false},
+		"missing field":      {map[string]interface{}{"type": "OKTA_GROUP"}, false},
+		"same list":          {map[string]interface{}{"groups": []interface{}{"00g1", "00g2"}}, true},
+		"shorter list":       {map[string]interface{}{"groups": []interface{}{"00g1"}}, false},
+		"list of other type": {map[string]interface{}{"profile": []interface{}{}}, false},
+		"object of a string": {map[string]interface{}{"id": map[string]interface{}{}}, false},
+	} {
+		require.Equal(t, test.contains, contains(current, test.desired), name)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	dst := map[string]interface{}{
+		"id":      "0pr1",
+		"name":    "Engineers",
+		"actions": map[string]interface{}{"assignUserToGroups": map[string]interface{}{"groupIds": []interface{}{"00g1"}}},
+		"conditions": map[string]interface{}{
+			"expression": map[string]interface{}{"type": "urn:okta:expression:1.0", "value": "true"},
+		},
+	}
+	src := map[string]interface{}{
+		"actions":    map[string]interface{}{"assignUserToGroups": map[string]interface{}{"groupIds": []interface{}{"00g2"}}},
+		"conditions": map[string]interface{}{"expression": map[string]interface{}{"value": "false"}},
+		"type":       "group_rule",
+	}
+	merge(dst, src)
+	require.Equal(t, map[string]interface{}{
+		"id":      "0pr1",
+		"name":    "Engineers",
+		"type":    "group_rule",
+		"actions": map[string]interface{}{"assignUserToGroups": map[string]interface{}{"groupIds": []interface{}{"00g2"}}},
+		"conditions": map[string]interface{}{
+			"expression": map[string]interface{}{"type": "urn:okta:expression:1.0", "value": "false"},
+		},
+	}, dst)
+}
+
+func TestPruneKinds(t *testing.T) {
+	resources := parseManifests(t, engineeringGroup, `
+kind: AuthorizationServer
+spec:
+  name: api
+scopes:
+  - name: orders.read
+`)
+	prune, err := pruneKinds([]string{"Group", "Scope"}, resources)
+	require.NoError(t, err)
+	require.Equal(t, map[*resourceKind]bool{findKind(applyKinds, "Group"): true, findKind(applyKinds, "Scope"): true}, prune)
+	_, err = pruneKinds([]string{"Claim"}, resources)
+	require.ErrorContains(t, err, "the manifests declare no Claim", "the nested lists left out are not pruned")
+	prune, err = pruneKinds(nil, resources)
+	require.NoError(t, err)
+	require.Empty(t, prune)
+}
@@ -0,0 +1,222 @@
+package okta
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/okta/okta-cli-client/sdk"
+
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/spf13/cobra"
+)
+
+type AuthStatus struct {
+	OrgURL            string     `json:"orgUrl"`
+	AuthorizationMode string     `json:"authorizationMode"`
+	Principal         string     `json:"principal"`
+	PrincipalID       string     `json:"principalId,omitempty"`
+	Scopes            []string   `json:"scopes,omitempty"`
+	ExpiresAt         *time.Time `json:"expiresAt,omitempty"`
+	DPoP              bool       `json:"dpop"`
+	OpaqueToken       bool       `json:"opaqueToken,omitempty"`
+	Roles             []string   `json:"roles"`
+}
+
+type accessTokenClaims struct {
+	Scopes       []string         `json:"scp,omitempty"`
+	Expiry       *jwt.NumericDate `json:"exp,omitempty"`
+	Confirmation struct {
+		JKT string `json:"jkt,omitempty"`
+	} `json:"cnf,omitempty"`
+}
+
+type principalProfile struct {
+	ID      string `json:"id"`
+	Profile struct {
+		Login string `json:"login"`
+	} `json:"profile"`
+}
+
+type assignedRole struct {
+	ID    string `json:"id"`
+	Label string `json:"label"`
+	Type  string `json:"type"`
+}
+
+var outputFormat = Flag{
+	Name:      "Output",
+	LongForm:  "output",
+	ShortForm: "o",
+	Help:      "Output format, either text or json.",
+}
+
+var authCmd = &cobra.Command{
+	Use:  "auth",
+	Long: "Inspect the credentials the CLI is acting as",
+}
+
+func NewAuthStatusCmd(use string) *cobra.Command {
+	var format string
+	cmd := &cobra.Command{
+		Use:   use,
+		Args:  cobra.NoArgs,
+		Short: "Show who the CLI is acting as",
+		Long: "Show who the CLI is acting as.\n\n" +
+			"Reports the org URL, authorization mode, principal, granted scopes, token expiry,\n" +
+			"DPoP binding and admin roles of the configured credentials.",
+		Example: `okta-cli-client auth status
+okta-cli-client auth status --output json
+okta-cli-client whoami`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			status, err := getAuthStatus(cmd.Context(), cmd.ErrOrStderr())
+			if err != nil {
+				return err
+			}
+			switch format {
+			case "json":
+				encoder := json.NewEncoder(cmd.OutOrStdout())
+				encoder.SetIndent("", " ")
+				return encoder.Encode(status)
+			case "", "text":
+				printAuthStatus(cmd.OutOrStdout(), status)
+				return nil
+			default:
+				return fmt.Errorf("unknown output format %q, expected text or json", format)
+			}
+		},
+	}
+	outputFormat.RegisterString(cmd, &format, "text")
+	return cmd
+}
+
+func init() {
+	authCmd.AddCommand(NewAuthStatusCmd("status"))
+	rootCmd.AddCommand(authCmd)
+	rootCmd.AddCommand(NewAuthStatusCmd("whoami"))
+}
+
+// getAuthStatus inspects the credentials of the client with the requests
+// bound to ctx, warning on stderr of what it cannot read.
+func getAuthStatus(ctx context.Context, stderr io.Writer) (*AuthStatus, error) {
+	cfg := apiClient.GetConfig()
+	status := &AuthStatus{
+		OrgURL:            cfg.Okta.Client.OrgUrl,
+		AuthorizationMode: cfg.Okta.Client.AuthorizationMode,
+		Roles:             []string{},
+	}
+	tokenType, token, err := apiClient.AccessToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to obtain access token: %w", err)
+	}
+	status.DPoP = tokenType == "DPoP"
+	if tokenType != "SSWS" {
+		// opaque tokens, like some Bearer tokens, can't be inspected but
+		// still identify the principal
+		if claims, err := parseAccessToken(token); err != nil {
+			status.OpaqueToken = true
+		} else {
+			status.Scopes = claims.Scopes
+			if claims.Expiry != nil {
+				expiresAt := claims.Expiry.Time()
+				status.ExpiresAt = &expiresAt
+			}
+			status.DPoP = status.DPoP || claims.Confirmation.JKT != ""
+		}
+	}
+
+	var roles []assignedRole
+	switch cfg.Okta.Client.AuthorizationMode {
+	case "PrivateKey", "JWT":
+		status.Principal = cfg.Okta.Client.ClientId
+		status.PrincipalID = cfg.Okta.Client.ClientId
+		err = getJSON(ctx, fmt.Sprintf("%v/oauth2/v1/clients/%v/roles", cfg.Okta.Client.OrgUrl, cfg.Okta.Client.ClientId), &roles)
+	default:
+		var me principalProfile
+		var resp *sdk.APIResponse
+		resp, err = apiClient.UserAPI.GetUser(ctx, "me").Execute()
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up token owner: %w", err)
+		}
+		err = json.NewDecoder(resp.Body).Decode(&me)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		status.Principal = me.Profile.Login
+		status.PrincipalID = me.ID
+		resp, err = apiClient.RoleAssignmentAPI.ListAssignedRolesForUser(ctx, me.ID).Execute()
+		if err == nil {
+			err = json.NewDecoder(resp.Body).Decode(&roles)
+			resp.Body.Close()
+		}
+	}
+	if err != nil {
+		// Reading role assignments needs more privileges than the status
+		// itself, so report the rest rather than failing outright.
+		fmt.Fprintf(stderr, "Could not read admin roles: %v\n", err)
+	}
+	for _, role := range roles {
+		status.Roles = append(status.Roles, role.Type)
+	}
+	return status, nil
+}
+
+// parseAccessToken decodes the claims of an Okta access token without
+// verifying its signature; the token is only inspected, never trusted.
+func parseAccessToken(token string) (*accessTokenClaims, error) {
+	parsed, err := jwt.ParseSigned(token)
+	if err != nil {
+		return nil, fmt.Errorf("access token is not a JWT: %w", err)
+	}
+	claims := &accessTokenClaims{}
+	if err = parsed.UnsafeClaimsWithoutVerification(claims); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+func getJSON(ctx context.Context, url string, v interface{}) error {
+	req, err := apiClient.PrepareRequest(ctx, url, http.MethodGet, nil, map[string]string{"Accept": "application/json"}, nil, nil, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := apiClient.Do(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %v: %v", url, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+func printAuthStatus(w io.Writer, status *AuthStatus) {
+	fmt.Fprintf(w, "Org URL:            %v\n", status.OrgURL)
+	fmt.Fprintf(w, "Authorization mode: %v\n", status.AuthorizationMode)
+	if status.PrincipalID != "" && status.PrincipalID != status.Principal {
+		fmt.Fprintf(w, "Principal:          %v (%v)\n", status.Principal, status.PrincipalID)
+	} else {
+		fmt.Fprintf(w, "Principal:          %v\n", status.Principal)
+	}
+	if status.OpaqueToken {
+		fmt.Fprintln(w, "Access token:       opaque, its scopes and expiry are unknown")
+	}
+	if len(status.Scopes) > 0 {
+		fmt.Fprintf(w, "Scopes:             %v\n", strings.Join(status.Scopes, " "))
+	}
+	if status.ExpiresAt != nil {
+		fmt.Fprintf(w, "Token expires:      %v (in %v)\n", status.ExpiresAt.Format(time.RFC3339), time.Until(*status.ExpiresAt).Round(time.Second))
+	}
+	fmt.Fprintf(w, "DPoP bound:         %v\n", status.DPoP)
+	if len(status.Roles) > 0 {
+		fmt.Fprintf(w, "Admin roles:        %v\n", strings.Join(status.Roles, ", "))
+	} else {
+		fmt.Fprintln(w, "Admin roles:        none")
+	}
+}
@@ -0,0 +1,98 @@
+package okta
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/okta/okta-cli-client/mockorg"
+	"github.com/okta/okta-cli-client/sdk"
+
+	"github.com/stretchr/testify/require"
+)
+
+// issueMockToken returns an opaque access token issued by org.
+func issueMockToken(t *testing.T, org *mockorg.Server) string {
+	server := httptest.NewServer(org)
+	defer server.Close()
+	resp, err := http.PostForm(server.URL+"/oauth2/v1/token", url.Values{"grant_type": {"client_credentials"}})
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	var token struct {
+		AccessToken string `json:"access_token"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&token))
+	return token.AccessToken
+}
+
+func TestGetAuthStatus(t *testing.T) {
+	for name, test := range map[string]struct {
+		use     func(t *testing.T)
+		status  AuthStatus
+		warning string
+	}{
+		"api token": {
+			use: func(t *testing.T) { useMockOrg(t) },
+			status: AuthStatus{
+				AuthorizationMode: "SSWS", Principal: mockorg.AdminLogin, PrincipalID: mockorg.AdminID, Roles: []string{"SUPER_ADMIN"},
+			},
+		},
+		"opaque access token": {
+			use: func(t *testing.T) {
+				org := mockorg.New()
+				useOrg(t, org, issueMockToken(t, org), sdk.WithAuthorizationMode("Bearer"))
+			},
+			status: AuthStatus{
+				AuthorizationMode: "Bearer", Principal: mockorg.AdminLogin, PrincipalID: mockorg.AdminID, Roles: []string{"SUPER_ADMIN"},
+				OpaqueToken: true,
+			},
+		},
+		"roles denied": {
+			use: func(t *testing.T) {
+				org := mockorg.New()
+				useOrg(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					if strings.HasSuffix(r.URL.Path, "/roles") {
+						w.WriteHeader(http.StatusForbidden)
+						return
+					}
+					org.ServeHTTP(w, r)
+				}), org.Token())
+			},
+			status: AuthStatus{
+				AuthorizationMode: "SSWS", Principal: mockorg.AdminLogin, PrincipalID: mockorg.AdminID, Roles: []string{},
+			},
+			warning: "Could not read admin roles: 403 Forbidden",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.use(t)
+			var stderr bytes.Buffer
+			status, err := getAuthStatus(context.Background(), &stderr)
+			require.NoError(t, err)
+			test.status.OrgURL = apiClient.GetConfig().Okta.Client.OrgUrl
+			require.Equal(t, &test.status, status)
+			if test.warning == "" {
+				require.Empty(t, stderr.String())
+			} else {
+				require.Contains(t, stderr.String(), test.warning)
+			}
+		})
+	}
+}
+
+func TestAuthStatusJSON(t *testing.T) {
+	useMockOrg(t)
+	cmd := NewAuthStatusCmd("status")
+	var stdout bytes.Buffer
+	cmd.SetOut(&stdout)
+	cmd.SetArgs([]string{"--output", "json"})
+	require.NoError(t, cmd.Execute())
+	var status AuthStatus
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &status))
+	require.Equal(t, mockorg.AdminLogin, status.Principal)
+}
@@ -0,0 +1,48 @@
+package okta
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/okta/okta-cli-client/sdk"
+)
+
+const (
+	DefaultCacheDir = ".okta/cache"
+	// DefaultCacheTtl bounds in seconds how long a cached response without
+	// caching headers is served when okta.client.cache.defaultTtl is unset.
+	DefaultCacheTtl = 300
+)
+
+var cacheFlag = Flag{
+	Name:     "Cache",
+	LongForm: "cache",
+	Help:     "Keep the responses of GET requests in ~/.okta/cache for the next commands, the Cache-Control and ETag headers of the org decide when they are revalidated and writes invalidate the collection they change.",
+}
+
+var cacheValue bool
+
+func init() {
+	rootCmd.PersistentFlags().BoolVar(&cacheValue, cacheFlag.LongForm, false, cacheFlag.Help)
+}
+
+// cacheSetters returns the SDK options caching the responses of the command
+// in files shared by the invocations of the CLI. The entries of every
+// profile are keyed by its credentials.
+func cacheSetters() ([]sdk.ConfigSetter, error) {
+	if !cacheValue {
+		return nil, nil
+	}
+	usr, err := os.UserHomeDir()
+	if err != nil {
+		return nil, err
+	}
+	return []sdk.ConfigSetter{sdk.WithCache(true), func(c *sdk.Configuration) {
+		if c.Okta.Client.Cache.Dir == "" {
+			c.Okta.Client.Cache.Dir = filepath.Join(usr, DefaultCacheDir)
+		}
+		if c.Okta.Client.Cache.DefaultTtl == 0 {
+			c.Okta.Client.Cache.DefaultTtl = DefaultCacheTtl
+		}
+	}}, nil
+}
@@ -0,0 +1,193 @@
+package okta
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/okta/okta-cli-client/prompt"
+	"github.com/okta/okta-cli-client/sdk"
+
+	"github.com/spf13/cobra"
+)
+
+// destructiveAnnotation is the command annotation marking the commands that
+// delete, deactivate, revoke, reset, expire, rotate or unassign, holding the
+// path of the object they act on, like /api/v1/groups/{groupId}.
+const destructiveAnnotation = "destructive"
+
+// The values of the confirmDestructive configuration.
+const (
+	// confirmPrompt asks in a terminal, and requires --yes otherwise.
+	confirmPrompt = "prompt"
+	// confirmAlways asks in a terminal even with --yes, the commands fail
+	// otherwise.
+	confirmAlways = "always"
+	confirmNever  = "never"
+)
+
+var yes = Flag{
+	Name:     "Yes",
+	LongForm: "yes",
+	Help:     "Run destructive commands, like deletes, without asking for a confirmation. Required to run them without a terminal.",
+}
+
+var yesValue bool
+
+// destructivePrefixes start the operationIds of the operations that, like
+// DELETE ones, take away what they act on, as cmdTools marks the generated
+// commands.
+var destructivePrefixes = []string{"deactivate", "revoke", "reset", "expire", "rotate", "unassign"}
+
+var pathParam = regexp.MustCompile(`\{([^}]+)\}`)
+
+func init() {
+	rootCmd.PersistentFlags().BoolVar(&yesValue, yes.LongForm, false, yes.Help)
+}
+
+// confirmDestructive asks for a confirmation before a destructive command
+// runs, naming the object it acts on.
+func confirmDestructive(cmd *cobra.Command) error {
+	target, ok := cmd.Annotations[destructiveAnnotation]
+	if !ok {
+		return nil
+	}
+	return confirmRun(cmd, func(name string) string {
+		return fmt.Sprintf("Run %q on %v?", name, describeTarget(cmd.Context(), targetPath(cmd, target)))
+	})
+}
+
+// confirmRequest asks for a confirmation before a request of the api command
+// to a destructive operation is sent, naming the object it acts on.
+func confirmRequest(cmd *cobra.Command, method, target string) error {
+	u, err := url.Parse(target)
+	if err != nil {
+		// reported by the request
+		return nil
+	}
+	operationID, route, ok := sdk.OperationOf(method, u.Path)
+	if !isDestructive(method, operationID) {
+		return nil
+	}
+	return confirmRun(cmd, func(name string) string {
+		if !ok {
+			return fmt.Sprintf("Send %v %v?", method, u.Path)
+		}
+		return fmt.Sprintf("Run %q on %v?", operationID, describeTarget(cmd.Context(), objectPath(route, u.Path)))
+	})
+}
+
+// isDestructive reports whether a request with method for the operation
+// operationID needs a confirmation.
+func isDestructive(method, operationID string) bool {
+	if method == http.MethodDelete {
+		return true
+	}
+	for _, prefix := range destructivePrefixes {
+		if strings.HasPrefix(operationID, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// objectPath returns path up to the segment of the last path parameter of
+// route, that of the object the operation acts on: /api/v1/apps/0oa1 for
+// /api/v1/apps/{appId}/lifecycle/deactivate and
+// /api/v1/apps/0oa1/lifecycle/deactivate.
+func objectPath(route, path string) string {
+	routeSegments := strings.Split(route, "/")
+	pathSegments := strings.Split(path, "/")
+	for i := len(routeSegments) - 1; i >= 0; i-- {
+		if strings.HasPrefix(routeSegments[i], "{") && i < len(pathSegments) {
+			return strings.Join(pathSegments[:i+1], "/")
+		}
+	}
+	return path
+}
+
+// confirmRun asks for a confirmation of cmd with the message returned by
+// message for the name of cmd, as confirmDestructive configures.
+func confirmRun(cmd *cobra.Command, message func(name string) string) error {
+	if dryRunValue {
+		return nil
+	}
+	mode := cliSettings.ConfirmDestructive
+	switch mode {
+	case "", confirmPrompt:
+		if yesValue {
+			return nil
+		}
+	case confirmAlways:
+	case confirmNever:
+		return nil
+	default:
+		return fmt.Errorf("invalid confirmDestructive %q, expected %v, %v or %v", mode, confirmPrompt, confirmAlways, confirmNever)
+	}
+	name := strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()+" ")
+	if !canPrompt(cmd) {
+		if mode == confirmAlways {
+			return fmt.Errorf("%q needs a confirmation and confirmDestructive is %v, run it in a terminal", name, confirmAlways)
+		}
+		return fmt.Errorf("%q needs a confirmation, pass --%v to run it without a terminal", name, yes.LongForm)
+	}
+	var confirmed bool
+	input := prompt.ConfirmInput("", message(name), "Pass --"+yes.LongForm+" to run the command without this question", false)
+	if err := prompt.AskOne(input, &confirmed); err != nil {
+		return handleInputError(err)
+	}
+	if !confirmed {
+		return errors.New("cancelled")
+	}
+	return nil
+}
+
+// targetPath fills the path parameters of target with the flags of cmd
+// named after them.
+func targetPath(cmd *cobra.Command, target string) string {
+	return pathParam.ReplaceAllStringFunc(target, func(param string) string {
+		value, err := cmd.Flags().GetString(param[1 : len(param)-1])
+		if err != nil {
+			return param
+		}
+		return url.PathEscape(value)
+	})
+}
+
+// describeTarget returns the name and ID of the object at path, like
+// "Engineering" (00g1), or path when the org does not answer it.
+func describeTarget(ctx context.Context, path string) string {
+	resp, err := apiClient.RawRequest(ctx, http.MethodGet, path).Execute()
+	if err != nil {
+		return path
+	}
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return path
+	}
+	var object struct {
+		Id          string `json:"id"`
+		Name        string `json:"name"`
+		Label       string `json:"label"`
+		DisplayName string `json:"displayName"`
+		Profile     struct {
+			Login string `json:"login"`
+			Name  string `json:"name"`
+		} `json:"profile"`
+	}
+	if json.Unmarshal(b, &object) != nil || object.Id == "" {
+		return path
+	}
+	for _, name := range []string{object.Profile.Login, object.Profile.Name, object.Label, object.Name, object.DisplayName} {
+		if name != "" {
+			return fmt.Sprintf("%q (%v)", name, object.Id)
+		}
+	}
+	return object.Id
+}
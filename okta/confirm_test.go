@@ -0,0 +1,190 @@
+package okta
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+// useConfirmation sets --yes, --dry-run and confirmDestructive for the test.
+func useConfirmation(t *testing.T, yes, dryRun bool, mode string) {
+	yesValue, dryRunValue, cliSettings = yes, dryRun, cliConfig{ConfirmDestructive: mode}
+	t.Cleanup(func() {
+		yesValue, dryRunValue, cliSettings = false, false, cliConfig{}
+	})
+}
+
+func TestConfirmRun(t *testing.T) {
+	// the tests run without a terminal
+	for name, test := range map[string]struct {
+		yes    bool
+		dryRun bool
+		mode   string
+		err    string
+	}{
+		"default":             {err: `"group delete" needs a confirmation, pass --yes`},
+		"default with --yes":  {yes: true, mode: ""},
+		"prompt":              {mode: confirmPrompt, err: `"group delete" needs a confirmation, pass --yes`},
+		"prompt with --yes":   {yes: true, mode: confirmPrompt},
+		"always":              {mode: confirmAlways, err: "confirmDestructive is always, run it in a terminal"},
+		"always with --yes":   {yes: true, mode: confirmAlways, err: "confirmDestructive is always, run it in a terminal"},
+		"never":               {mode: confirmNever},
+		"invalid":             {yes: true, mode: "sometimes", err: `invalid confirmDestructive "sometimes"`},
+		"dry run":             {dryRun: true, mode: confirmAlways},
+		"dry run with prompt": {dryRun: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			useConfirmation(t, test.yes, test.dryRun, test.mode)
+			root := &cobra.Command{Use: "okta-cli-client"}
+			group := &cobra.Command{Use: "group"}
+			cmd := &cobra.Command{Use: "delete"}
+			root.AddCommand(group)
+			group.AddCommand(cmd)
+			err := confirmRun(cmd, func(name string) string { return "Run " + name + "?" })
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestConfirmDestructive(t *testing.T) {
+	useConfirmation(t, false, false, confirmPrompt)
+	require.NoError(t, confirmDestructive(&cobra.Command{Use: "list"}), "not destructive")
+	cmd := &cobra.Command{Use: "delete", Annotations: map[string]string{destructiveAnnotation: "/api/v1/groups/{groupId}"}}
+	require.ErrorContains(t, confirmDestructive(cmd), "needs a confirmation")
+	yesValue = true
+	require.NoError(t, confirmDestructive(cmd))
+}
+
+func TestConfirmRequest(t *testing.T) {
+	useConfirmation(t, false, false, confirmPrompt)
+	for target, test := range map[string]struct {
+		method      string
+		destructive bool
+	}{
+		"/api/v1/groups/00g1":                             {method: "GET"},
+		"/api/v1/groups":                                  {method: "POST"},
+		"/api/v1/groups/00g1?limit=1":                     {method: "DELETE", destructive: true},
+		"/api/v1/unknown":                                 {method: "DELETE", destructive: true},
+		"/api/v1/users/00u1/lifecycle/activate":           {method: "POST"},
+		"/api/v1/users/00u1/lifecycle/suspend":            {method: "POST"},
+		"/api/v1/apps/0oa1/lifecycle/deactivate":          {method: "POST", destructive: true},
+		"https://example.okta.com/api/v1/api-tokens/00T1": {method: "DELETE", destructive: true},
+	} {
+		err := confirmRequest(&cobra.Command{Use: "api"}, test.method, target)
+		if test.destructive {
+			require.ErrorContains(t, err, "needs a confirmation", target)
+		} else {
+			require.NoError(t, err, target)
+		}
+	}
+}
+
+func TestIsDestructive(t *testing.T) {
+	for operationID, destructive := range map[string]bool{
+		"deactivateApplication":       true,
+		"revokeApiToken":              true,
+		"resetFactors":                true,
+		"expirePassword":              true,
+		"rotateApplicationKey":        true,
+		"unassignUserFromGroup":       true,
+		"activateApplication":         false,
+		"assignUserToGroup":           false,
+		"replaceGroup":                false,
+		"suspendUser":                 false,
+		"listAuthorizationServerKeys": false,
+	} {
+		require.Equal(t, destructive, isDestructive("POST", operationID), operationID)
+	}
+	require.True(t, isDestructive("DELETE", "deleteGroup"))
+}
+
+func TestObjectPath(t *testing.T) {
+	for path, test := range map[string]struct {
+		route  string
+		object string
+	}{
+		"/api/v1/apps/0oa1/lifecycle/deactivate": {route: "/api/v1/apps/{appId}/lifecycle/deactivate", object: "/api/v1/apps/0oa1"},
+		"/api/v1/groups/00g1/users/00u1":         {route: "/api/v1/groups/{groupId}/users/{userId}", object: "/api/v1/groups/00g1/users/00u1"},
+		"/api/v1/api-tokens/current":             {route: "/api/v1/api-tokens/current", object: "/api/v1/api-tokens/current"},
+	} {
+		require.Equal(t, test.object, objectPath(test.route, path), path)
+	}
+}
+
+func TestTargetPath(t *testing.T) {
+	cmd := &cobra.Command{Use: "delete"}
+	cmd.Flags().String("groupId", "", "")
+	cmd.Flags().String("userId", "", "")
+	require.NoError(t, cmd.Flags().Set("groupId", "00g1"))
+	require.NoError(t, cmd.Flags().Set("userId", "a/b"))
+	for target, path := range map[string]string{
+		"/api/v1/groups/{groupId}":                  "/api/v1/groups/00g1",
+		"/api/v1/groups/{groupId}/users/{userId}":   "/api/v1/groups/00g1/users/a%2Fb",
+		"/api/v1/apps/{appId}/users/{userId}":       "/api/v1/apps/{appId}/users/a%2Fb",
+		"/api/v1/api-tokens/current":                "/api/v1/api-tokens/current",
+		"/api/v1/groups/{groupId}/roles/{roleId}":   "/api/v1/groups/00g1/roles/{roleId}",
+		"/api/v1/groups/{groupId}/owners/{ownerId}": "/api/v1/groups/00g1/owners/{ownerId}",
+	} {
+		require.Equal(t, path, targetPath(cmd, target), target)
+	}
+}
+
+func TestDescribeTarget(t *testing.T) {
+	useMockOrg(t)
+	groupID := createObject(t, "/api/v1/groups", map[string]interface{}{"profile": map[string]interface{}{"name": "Engineering"}})
+	userID := createObject(t, "/api/v1/users", map[string]interface{}{"profile": map[string]interface{}{
+		"login": "ada@example.org", "email": "ada@example.org", "firstName": "Ada", "lastName": "Lovelace",
+	}})
+	ruleID := createObject(t, "/api/v1/groups/rules", map[string]interface{}{"type": "group_rule", "name": "Engineers"})
+	for path, description := range map[string]string{
+		"/api/v1/groups/" + groupID:      `"Engineering" (` + groupID + `)`,
+		"/api/v1/users/" + userID:        `"ada@example.org" (` + userID + `)`,
+		"/api/v1/groups/rules/" + ruleID: `"Engineers" (` + ruleID + `)`,
+		"/api/v1/groups/00gmissing":      "/api/v1/groups/00gmissing",
+	} {
+		require.Equal(t, description, describeTarget(context.Background(), path), path)
+	}
+}
+
+func TestReadCLIConfig(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv(ProfileEnvVar, "")
+	t.Setenv(ConfirmDestructiveEnvVar, "")
+	config, err := readCLIConfig()
+	require.NoError(t, err)
+	require.Equal(t, cliConfig{}, config, "no configuration file")
+
+	require.NoError(t, os.MkdirAll(filepath.Join(home, ".okta", "profiles"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(home, ".okta", "okta.yaml"),
+		[]byte("okta:\n  client:\n    orgUrl: https://example.okta.com\n  cli:\n    confirmDestructive: never\n"), 0o600))
+	config, err = readCLIConfig()
+	require.NoError(t, err)
+	require.Equal(t, confirmNever, config.ConfirmDestructive)
+
+	require.NoError(t, os.WriteFile(filepath.Join(home, ".okta", "profiles", "prod.yaml"),
+		[]byte("okta:\n  cli:\n    confirmDestructive: always\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(home, ".okta", "profiles", "dev.yaml"),
+		[]byte("okta:\n  client:\n    orgUrl: https://dev.okta.com\n"), 0o600))
+	t.Setenv(ProfileEnvVar, "prod")
+	config, err = readCLIConfig()
+	require.NoError(t, err)
+	require.Equal(t, confirmAlways, config.ConfirmDestructive, "the profile overrides the default configuration")
+	t.Setenv(ProfileEnvVar, "dev")
+	config, err = readCLIConfig()
+	require.NoError(t, err)
+	require.Equal(t, confirmNever, config.ConfirmDestructive, "the profile keeps the default configuration")
+
+	t.Setenv(ConfirmDestructiveEnvVar, confirmPrompt)
+	config, err = readCLIConfig()
+	require.NoError(t, err)
+	require.Equal(t, confirmPrompt, config.ConfirmDestructive, "the environment overrides the files")
+}
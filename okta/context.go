@@ -0,0 +1,78 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/okta/okta-cli-client/sdk"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	// ExitInterrupted is the exit code of a command stopped by Ctrl-C or
+	// SIGTERM, like shells report for SIGINT.
+	ExitInterrupted = 130
+	// ExitTimeout is the exit code of a command that ran out of --timeout,
+	// like timeout(1) reports.
+	ExitTimeout = 124
+)
+
+var timeoutFlag = Flag{
+	Name:     "Timeout",
+	LongForm: "timeout",
+	Help:     "Stop the command if it is still running after the duration, like 30s or 5m, all requests and pages included.",
+}
+
+var (
+	timeoutValue  time.Duration
+	timeoutCtx    context.Context
+	cancelTimeout context.CancelFunc = func() {}
+)
+
+func init() {
+	rootCmd.PersistentFlags().DurationVar(&timeoutValue, timeoutFlag.LongForm, 0, timeoutFlag.Help)
+}
+
+// signalContext returns a context cancelled by the first Ctrl-C or SIGTERM.
+// A second one kills the process as usual.
+func signalContext() (context.Context, context.CancelFunc) {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-ctx.Done()
+		stop()
+	}()
+	return ctx, stop
+}
+
+// applyTimeout bounds the context of the command by --timeout.
+func applyTimeout(cmd *cobra.Command) {
+	if timeoutValue <= 0 {
+		return
+	}
+	timeoutCtx, cancelTimeout = context.WithTimeout(cmd.Context(), timeoutValue)
+	cmd.SetContext(timeoutCtx)
+}
+
+// exitCode reports the error of the command run with ctx and returns its
+// exit code, telling interruptions and timeouts, reported on stderr not to
+// mix with the partial output, from other failures.
+func exitCode(ctx context.Context, err error) int {
+	switch {
+	case errors.Is(err, sdk.ErrDryRun):
+		return 0
+	case ctx.Err() != nil:
+		fmt.Fprintln(os.Stderr, "interrupted")
+		return ExitInterrupted
+	case timeoutCtx != nil && timeoutCtx.Err() == context.DeadlineExceeded:
+		fmt.Fprintf(os.Stderr, "timed out after %v\n", timeoutValue)
+		return ExitTimeout
+	}
+	fmt.Println(err)
+	return 1
+}
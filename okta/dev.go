@@ -0,0 +1,104 @@
+package okta
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/okta/okta-cli-client/mockorg"
+
+	"github.com/spf13/cobra"
+)
+
+type MockServerInputs struct {
+	Addr      string
+	Token     string
+	Users     int
+	Groups    int
+	PageSize  int
+	RateLimit int
+}
+
+var devCmd = &cobra.Command{
+	Use:   "dev",
+	Short: "Tools for developing and testing against the Management API",
+}
+
+var (
+	mockAddr = Flag{
+		Name:     "Address",
+		LongForm: "addr",
+		Help:     "Address the mock org listens on.",
+	}
+	mockToken = Flag{
+		Name:     "Token",
+		LongForm: "token",
+		Help:     "API token the mock org accepts in SSWS authorization headers.",
+	}
+	mockUsers = Flag{
+		Name:     "Users",
+		LongForm: "users",
+		Help:     "Number of users to seed the org with.",
+	}
+	mockGroups = Flag{
+		Name:     "Groups",
+		LongForm: "groups",
+		Help:     "Number of groups to seed the org with, the users are spread over them.",
+	}
+	mockPageSize = Flag{
+		Name:     "Page size",
+		LongForm: "page-size",
+		Help:     "Page size of lists requested without a limit.",
+	}
+	mockRateLimit = Flag{
+		Name:     "Rate limit",
+		LongForm: "rate-limit",
+		Help:     "Requests per minute each endpoint accepts before answering 429 Too Many Requests, 0 for no limit.",
+	}
+)
+
+func NewDevMockServerCmd() *cobra.Command {
+	inputs := MockServerInputs{}
+	cmd := &cobra.Command{
+		Use:   "mock-server",
+		Args:  cobra.NoArgs,
+		Short: "Serve an in-memory mock Okta org",
+		Long: "Serve an in-memory mock Okta org.\n\n" +
+			"Users, groups, group rules, apps, group memberships, policies, network zones, trusted\n" +
+			"origins, authorization servers with their scopes, claims, policies and rules, and the\n" +
+			"system log are kept in memory, lists are paginated and support filter, search and q.\n" +
+			"Other operations of the Management API answer 501 Not Implemented.",
+		Example: `okta-cli-client dev mock-server --users 50 --groups 5
+OKTA_CLIENT_ORGURL=http://127.0.0.1:8080 OKTA_CLIENT_TOKEN=mock-api-token OKTA_TESTING_DISABLE_HTTPS_CHECK=true okta-cli-client user lists`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options := []mockorg.Option{mockorg.WithToken(inputs.Token), mockorg.WithPageSize(inputs.PageSize)}
+			if inputs.RateLimit > 0 {
+				options = append(options, mockorg.WithRateLimit(inputs.RateLimit, time.Minute))
+			}
+			org := mockorg.New(options...)
+			org.Seed(inputs.Users, inputs.Groups)
+
+			listener, err := net.Listen("tcp", inputs.Addr)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.ErrOrStderr(), "Mock org listening on http://%v, point the CLI at it with:\n\n"+
+				"export OKTA_CLIENT_ORGURL=http://%v\nexport OKTA_CLIENT_AUTHORIZATIONMODE=SSWS\nexport OKTA_CLIENT_TOKEN=%v\nexport OKTA_TESTING_DISABLE_HTTPS_CHECK=true\n",
+				listener.Addr(), listener.Addr(), inputs.Token)
+			return http.Serve(listener, org)
+		},
+	}
+	mockAddr.RegisterString(cmd, &inputs.Addr, "127.0.0.1:8080")
+	mockToken.RegisterString(cmd, &inputs.Token, mockorg.DefaultToken)
+	cmd.Flags().IntVar(&inputs.Users, mockUsers.LongForm, 0, mockUsers.Help)
+	cmd.Flags().IntVar(&inputs.Groups, mockGroups.LongForm, 0, mockGroups.Help)
+	cmd.Flags().IntVar(&inputs.PageSize, mockPageSize.LongForm, mockorg.DefaultPageSize, mockPageSize.Help)
+	cmd.Flags().IntVar(&inputs.RateLimit, mockRateLimit.LongForm, 0, mockRateLimit.Help)
+	return cmd
+}
+
+func init() {
+	devCmd.AddCommand(NewDevMockServerCmd())
+	rootCmd.AddCommand(devCmd)
+}
@@ -0,0 +1,159 @@
+package okta
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+
+	"github.com/okta/okta-cli-client/sdk"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/spf13/cobra"
+)
+
+var dryRunFlag = Flag{
+	Name:     "DryRun",
+	LongForm: "dry-run",
+	Help:     "Print the request that would change the org, with the credentials redacted, instead of sending it. GET requests are still sent.",
+}
+
+var diffFlag = Flag{
+	Name:     "Diff",
+	LongForm: "diff",
+	Help:     "With --dry-run, also fetch the object a replace request (PUT) would change and print the diff of its JSON with the body.",
+}
+
+var (
+	dryRunValue bool
+	diffValue   bool
+)
+
+// readOnlyFields are the fields of the objects the org sets, left out of the
+// diff of a replace unless the body sets them too.
+var readOnlyFields = []string{
+	"id", "type", "objectClass", "created", "activated", "lastUpdated", "statusChanged",
+	"lastLogin", "passwordChanged", "lastMembershipUpdated", "_links", "_embedded",
+}
+
+func init() {
+	rootCmd.PersistentFlags().BoolVar(&dryRunValue, dryRunFlag.LongForm, false, dryRunFlag.Help)
+	rootCmd.PersistentFlags().BoolVar(&diffValue, diffFlag.LongForm, false, diffFlag.Help)
+}
+
+// dryRunSetters returns the SDK options printing the requests of the command
+// that change the org instead of sending them.
+func dryRunSetters(cmd *cobra.Command) ([]sdk.ConfigSetter, error) {
+	if diffValue && !dryRunValue {
+		return nil, fmt.Errorf("--%v only applies with --%v", diffFlag.LongForm, dryRunFlag.LongForm)
+	}
+	if !dryRunValue {
+		return nil, nil
+	}
+	// the command stops with sdk.ErrDryRun once the request is printed,
+	// exitCode tells it from the errors to report
+	cmd.SilenceErrors = true
+	w := cmd.OutOrStdout()
+	return []sdk.ConfigSetter{sdk.WithDryRun(func(req *http.Request) error {
+		return printDryRun(w, req)
+	})}, nil
+}
+
+// printDryRun prints req like it would be sent, and for a replace with
+// --diff how it changes the current object.
+func printDryRun(w io.Writer, req *http.Request) error {
+	fmt.Fprintf(w, "%v %v\n", req.Method, req.URL)
+	header := sdk.RedactHeader(req.Header)
+	names := make([]string, 0, len(header))
+	for name := range header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, value := range header[name] {
+			fmt.Fprintf(w, "%v: %v\n", name, value)
+		}
+	}
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = io.ReadAll(req.Body); err != nil {
+			return err
+		}
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		fmt.Fprintln(w)
+		printAPIBody(w, body)
+	}
+	if diffValue && req.Method == http.MethodPut {
+		return printReplaceDiff(w, req, body)
+	}
+	return nil
+}
+
+// printReplaceDiff prints the unified diff of the object at the URL of req
+// with body, the object replacing it.
+func printReplaceDiff(w io.Writer, req *http.Request, body []byte) error {
+	u := *req.URL
+	u.RawQuery = ""
+	resp, err := apiClient.RawRequest(req.Context(), http.MethodGet, u.String()).Execute()
+	if err != nil {
+		return fmt.Errorf("fetching the object to replace: %w", err)
+	}
+	current, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	proposed, err := indentJSON(body, nil)
+	if err != nil {
+		return fmt.Errorf("the body is not JSON: %w", err)
+	}
+	var fields map[string]interface{}
+	_ = json.Unmarshal(body, &fields)
+	before, err := indentJSON(current, func(object map[string]interface{}) {
+		for _, field := range readOnlyFields {
+			if _, ok := fields[field]; !ok {
+				delete(object, field)
+			}
+		}
+	})
+	if err != nil {
+		return err
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(before),
+		B:        difflib.SplitLines(proposed),
+		FromFile: "current",
+		ToFile:   "proposed",
+		Context:  3,
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(w)
+	if diff == "" {
+		fmt.Fprintln(w, "The replace does not change the object.")
+		return nil
+	}
+	fmt.Fprint(w, diff)
+	return nil
+}
+
+// indentJSON returns b indented with the keys sorted, for diffs line by
+// line. filter is given the object b holds, if any.
+func indentJSON(b []byte, filter func(map[string]interface{})) (string, error) {
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return "", err
+	}
+	if object, ok := v.(map[string]interface{}); ok && filter != nil {
+		filter(object)
+	}
+	indented, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(indented), nil
+}
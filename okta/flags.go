@@ -124,7 +124,7 @@ func unexpectedError(err error) error {
 
 func handleInputError(err error) error {
 	if err == terminal.InterruptErr {
-		os.Exit(0)
+		os.Exit(ExitInterrupted)
 	}
 
 	return unexpectedError(err)
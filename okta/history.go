@@ -0,0 +1,418 @@
+package okta
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/okta/okta-cli-client/sdk"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	DefaultHistoryPath = ".okta/history.jsonl"
+	// HistoryEnvVar overrides the path of the journal.
+	HistoryEnvVar = "OKTA_CLI_HISTORY"
+)
+
+var snapshotFlag = Flag{
+	Name:     "Snapshot",
+	LongForm: "snapshot",
+	Help:     "Also write to the journal the object each request changes, as fetched before the change.",
+}
+
+var historyLimit = Flag{
+	Name:     "Limit",
+	LongForm: "limit",
+	Help:     "Number of the most recent entries to list, 0 for all.",
+}
+
+var snapshotValue bool
+
+// JournalEntry is a line of the journal, a request of the CLI that changed
+// the org or tried to.
+type JournalEntry struct {
+	ID          string          `json:"id"`
+	Time        time.Time       `json:"time"`
+	Profile     string          `json:"profile"`
+	OrgURL      string          `json:"orgUrl"`
+	Principal   string          `json:"principal,omitempty"`
+	Command     string          `json:"command"`
+	Method      string          `json:"method"`
+	Path        string          `json:"path"`
+	OperationID string          `json:"operationId,omitempty"`
+	Body        json.RawMessage `json:"body,omitempty"`
+	Status      int             `json:"status,omitempty"`
+	Error       string          `json:"error,omitempty"`
+	RequestID   string          `json:"requestId,omitempty"`
+	// Snapshot is the object the request changes, as fetched before the
+	// request with --snapshot.
+	Snapshot     json.RawMessage `json:"snapshot,omitempty"`
+	SnapshotPath string          `json:"snapshotPath,omitempty"`
+}
+
+// journal appends the requests of a command changing the org to the file
+// at path.
+type journal struct {
+	path      string
+	cmd       *cobra.Command
+	once      sync.Once
+	principal string
+}
+
+func init() {
+	rootCmd.PersistentFlags().BoolVar(&snapshotValue, snapshotFlag.LongForm, false, snapshotFlag.Help)
+	rootCmd.AddCommand(NewHistoryCmd())
+}
+
+// historyPath returns the path of the journal.
+func historyPath() (string, error) {
+	if path := os.Getenv(HistoryEnvVar); path != "" {
+		return path, nil
+	}
+	usr, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(usr, DefaultHistoryPath), nil
+}
+
+// journalSetters returns the SDK options writing the requests of cmd that
+// change the org to the journal.
+func journalSetters(cmd *cobra.Command) ([]sdk.ConfigSetter, error) {
+	path, err := historyPath()
+	if err != nil {
+		return nil, err
+	}
+	j := &journal{path: path, cmd: cmd}
+	return []sdk.ConfigSetter{sdk.WithCallMiddleware(j.middleware)}, nil
+}
+
+func (j *journal) middleware(next sdk.Doer) sdk.Doer {
+	return sdk.DoerFunc(func(req *http.Request) (*http.Response, error) {
+		if !changesOrg(req) {
+			return next.Do(req)
+		}
+		entry := j.newEntry(req)
+		if req.Body != nil {
+			body, err := io.ReadAll(req.Body)
+			if err != nil {
+				return nil, err
+			}
+			req.Body.Close()
+			req.Body = io.NopCloser(bytes.NewReader(body))
+			entry.Body = journalBody(body)
+		}
+		if snapshotValue {
+			entry.SnapshotPath, entry.Snapshot = snapshot(req.Context(), req.Method, req.URL.Path)
+		}
+		resp, err := next.Do(req)
+		if err != nil {
+			entry.Error = err.Error()
+		} else {
+			entry.Status = resp.StatusCode
+			entry.RequestID = resp.Header.Get("X-Okta-Request-Id")
+		}
+		// the request was sent, failing to record it must not hide its outcome
+		if writeErr := appendJournal(j.path, entry); writeErr != nil {
+			fmt.Fprintf(j.cmd.ErrOrStderr(), "Could not write the journal %v: %v\n", j.path, writeErr)
+		}
+		return resp, err
+	})
+}
+
+func (j *journal) newEntry(req *http.Request) *JournalEntry {
+	cfg := apiClient.GetConfig()
+	profile := selectedProfile()
+	if profile == "" {
+		profile = "default"
+	}
+	path := req.URL.Path
+	if req.URL.RawQuery != "" {
+		path += "?" + req.URL.RawQuery
+	}
+	operationID, _, _ := sdk.OperationOf(req.Method, req.URL.Path)
+	return &JournalEntry{
+		ID:          strings.ReplaceAll(uuid.NewString(), "-", "")[:12],
+		Time:        time.Now().UTC(),
+		Profile:     profile,
+		OrgURL:      cfg.Okta.Client.OrgUrl,
+		Principal:   j.lookUpPrincipal(req.Context()),
+		Command:     commandLine(j.cmd),
+		Method:      req.Method,
+		Path:        path,
+		OperationID: operationID,
+	}
+}
+
+// lookUpPrincipal returns the client ID of the service app, or the login of
+// the admin owning the token, the CLI is acting as, or why it is unknown.
+func (j *journal) lookUpPrincipal(ctx context.Context) string {
+	j.once.Do(func() {
+		cfg := apiClient.GetConfig()
+		switch cfg.Okta.Client.AuthorizationMode {
+		case "PrivateKey", "JWT":
+			j.principal = cfg.Okta.Client.ClientId
+			return
+		}
+		resp, err := apiClient.RawRequest(ctx, http.MethodGet, "/api/v1/users/me").Execute()
+		if err == nil {
+			defer resp.Body.Close()
+			var me principalProfile
+			if err = json.NewDecoder(resp.Body).Decode(&me); err == nil {
+				j.principal = me.Profile.Login
+				return
+			}
+		}
+		// the request is journaled anyway, with why its principal is missing
+		j.principal = fmt.Sprintf("unknown (%v)", err)
+	})
+	return j.principal
+}
+
+// changesOrg reports whether req may change the org, token requests aside.
+func changesOrg(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+	return !strings.HasSuffix(req.URL.Path, "/v1/token")
+}
+
+// journalBody returns the redacted body as JSON, a JSON string when it is
+// not JSON.
+func journalBody(body []byte) json.RawMessage {
+	if len(body) == 0 {
+		return nil
+	}
+	body = sdk.RedactBody(body)
+	if json.Valid(body) {
+		return body
+	}
+	b, _ := json.Marshal(string(body))
+	return b
+}
+
+// snapshot returns the path and the JSON of the object a request with method
+// to path changes, the path up to the last parameter of its operation: the
+// group of a replace, the application of
+// /api/v1/apps/{appId}/lifecycle/deactivate. It returns nothing for the
+// requests creating objects, or when the org has no such object to read.
+func snapshot(ctx context.Context, method, path string) (string, json.RawMessage) {
+	_, route, ok := sdk.OperationOf(method, path)
+	i := strings.LastIndex(route, "}")
+	if !ok || i < 0 {
+		return "", nil
+	}
+	target := strings.Join(strings.Split(path, "/")[:strings.Count(route[:i], "/")+1], "/")
+	resp, err := apiClient.RawRequest(context.WithValue(ctx, sdk.ContextSkipCache, true), http.MethodGet, target).Execute()
+	if err != nil {
+		return "", nil
+	}
+	b, err := io.ReadAll(resp.Body)
+	if err != nil || !json.Valid(b) {
+		return "", nil
+	}
+	return target, b
+}
+
+// commandLine returns the command with the flags set, the values of those
+// that may hold secrets left out.
+func commandLine(cmd *cobra.Command) string {
+	args := []string{cmd.CommandPath()}
+	cmd.Flags().Visit(func(flag *pflag.Flag) {
+		value := flag.Value.String()
+		if flag.Name == apiData.LongForm || flag.Name == apiHeader.LongForm {
+			value = "REDACTED"
+		}
+		if flag.Value.Type() == "bool" && value == "true" {
+			args = append(args, "--"+flag.Name)
+			return
+		}
+		args = append(args, fmt.Sprintf("--%v=%v", flag.Name, value))
+	})
+	return strings.Join(append(args, cmd.Flags().Args()...), " ")
+}
+
+// appendJournal appends entry to the journal at path as a line of JSON.
+func appendJournal(path string, entry *JournalEntry) error {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(append(b, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// readJournal returns the entries of the journal at path, oldest first.
+func readJournal(path string) ([]*JournalEntry, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var entries []*JournalEntry
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 16*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		entry := &JournalEntry{}
+		if err = json.Unmarshal(scanner.Bytes(), entry); err != nil {
+			return nil, fmt.Errorf("%v:%v: %w", path, line, err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, scanner.Err()
+}
+
+// findJournalEntry returns the entry of the journal whose ID is or starts
+// with id.
+func findJournalEntry(id string) (*JournalEntry, error) {
+	path, err := historyPath()
+	if err != nil {
+		return nil, err
+	}
+	entries, err := readJournal(path)
+	if err != nil {
+		return nil, err
+	}
+	var found *JournalEntry
+	for _, entry := range entries {
+		if !strings.HasPrefix(entry.ID, id) {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("%q matches several entries of the journal", id)
+		}
+		found = entry
+	}
+	if found == nil {
+		return nil, fmt.Errorf("no entry %q in the journal %v", id, path)
+	}
+	return found, nil
+}
+
+func NewHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "history",
+		Long: "Inspect the journal of the requests the CLI sent to change the org",
+	}
+	cmd.AddCommand(NewHistoryListCmd())
+	cmd.AddCommand(NewHistoryShowCmd())
+	return cmd
+}
+
+func NewHistoryListCmd() *cobra.Command {
+	var format string
+	var limit int
+	cmd := &cobra.Command{
+		Use:  "list",
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			localAnnotation: "true",
+		},
+		Short: "List the most recent entries of the journal",
+		Long: "List the most recent entries of the journal, oldest first.\n\n" +
+			"Every request of the CLI that changes the org is appended to ~/.okta/history.jsonl,\n" +
+			"or $" + HistoryEnvVar + ", with the profile, principal, command line, redacted body,\n" +
+			"status and Okta request ID.",
+		Example: `okta-cli-client history list
+okta-cli-client history list --limit 0 --output json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path, err := historyPath()
+			if err != nil {
+				return err
+			}
+			entries, err := readJournal(path)
+			if err != nil {
+				return err
+			}
+			if limit > 0 && len(entries) > limit {
+				entries = entries[len(entries)-limit:]
+			}
+			switch format {
+			case "json":
+				if entries == nil {
+					entries = []*JournalEntry{}
+				}
+				encoder := json.NewEncoder(cmd.OutOrStdout())
+				encoder.SetIndent("", " ")
+				return encoder.Encode(entries)
+			case "", "text":
+				printJournal(cmd.OutOrStdout(), entries)
+				return nil
+			default:
+				return fmt.Errorf("unknown output format %q, expected text or json", format)
+			}
+		},
+	}
+	outputFormat.RegisterString(cmd, &format, "text")
+	cmd.Flags().IntVar(&limit, historyLimit.LongForm, 20, historyLimit.Help)
+	return cmd
+}
+
+func NewHistoryShowCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "show <id>",
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			localAnnotation: "true",
+		},
+		Short:   "Print an entry of the journal",
+		Long:    "Print an entry of the journal as JSON, its snapshot included. The ID may be shortened.",
+		Example: `okta-cli-client history show 3f2a9c`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			entry, err := findJournalEntry(args[0])
+			if err != nil {
+				return err
+			}
+			encoder := json.NewEncoder(cmd.OutOrStdout())
+			encoder.SetIndent("", " ")
+			return encoder.Encode(entry)
+		},
+	}
+	return cmd
+}
+
+func printJournal(w io.Writer, entries []*JournalEntry) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tTIME\tPROFILE\tPRINCIPAL\tSTATUS\tREQUEST\tCOMMAND")
+	for _, entry := range entries {
+		status := fmt.Sprint(entry.Status)
+		if entry.Error != "" {
+			status = "error"
+		}
+		fmt.Fprintf(tw, "%v\t%v\t%v\t%v\t%v\t%v %v\t%v\n", entry.ID, entry.Time.Local().Format(time.DateTime), entry.Profile,
+			entry.Principal, status, entry.Method, entry.Path, entry.Command)
+	}
+	tw.Flush()
+}
@@ -0,0 +1,178 @@
+package okta
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/okta/okta-cli-client/mockorg"
+	"github.com/okta/okta-cli-client/sdk"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJournal(t *testing.T) {
+	var mu sync.Mutex
+	calls := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		calls[r.Method+" "+r.URL.Path]++
+		n := calls[r.Method+" "+r.URL.Path]
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/api/v1/users/me":
+			fmt.Fprint(w, `{"id":"00u1","profile":{"login":"admin@example.org"}}`)
+		case r.Method == http.MethodGet:
+			fmt.Fprint(w, `{"id":"00g1","profile":{"name":"Before"}}`)
+		case n == 1:
+			// the first attempt fails and is retried
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.Header().Set("X-Okta-Request-Id", "req-2")
+			fmt.Fprint(w, `{"id":"00g1","profile":{"name":"After"}}`)
+		}
+	}))
+	defer server.Close()
+	path := filepath.Join(t.TempDir(), "history.jsonl")
+	t.Setenv(HistoryEnvVar, path)
+	cmd := &cobra.Command{Use: "replace"}
+	journaling, err := journalSetters(cmd)
+	require.NoError(t, err)
+	client, err := sdk.NewTestClient(server.URL, "token", append(journaling, sdk.WithRetryBackoff(0, 0))...)
+	require.NoError(t, err)
+	previous := apiClient
+	apiClient = client
+	snapshotValue = true
+	defer func() {
+		apiClient = previous
+		snapshotValue = false
+	}()
+
+	ctx := context.Background()
+	_, err = apiClient.RawRequest(ctx, http.MethodGet, "/api/v1/groups").Execute()
+	require.NoError(t, err)
+	_, err = apiClient.RawRequest(ctx, http.MethodPut, "/api/v1/groups/00g1").Body([]byte(`{"profile":{"name":"After"}}`)).Execute()
+	require.NoError(t, err)
+
+	entries, err := readJournal(path)
+	require.NoError(t, err)
+	require.Len(t, entries, 1, "reads are not journaled, retries are journaled once")
+	entry := entries[0]
+	require.Equal(t, http.MethodPut, entry.Method)
+	require.Equal(t, "/api/v1/groups/00g1", entry.Path)
+	require.Equal(t, "replaceGroup", entry.OperationID)
+	require.Equal(t, http.StatusOK, entry.Status)
+	require.Equal(t, "req-2", entry.RequestID)
+	require.Equal(t, "admin@example.org", entry.Principal)
+	require.Equal(t, "default", entry.Profile)
+	require.JSONEq(t, `{"profile":{"name":"After"}}`, string(entry.Body))
+	require.Equal(t, "/api/v1/groups/00g1", entry.SnapshotPath)
+	require.JSONEq(t, `{"id":"00g1","profile":{"name":"Before"}}`, string(entry.Snapshot))
+	require.Equal(t, 2, calls["PUT /api/v1/groups/00g1"])
+	require.Equal(t, 1, calls["GET /api/v1/groups/00g1"], "the snapshot is fetched once")
+}
+
+func TestLookUpPrincipal(t *testing.T) {
+	useMockOrg(t)
+	j := &journal{}
+	require.Equal(t, mockorg.AdminLogin, j.lookUpPrincipal(context.Background()))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+	client, err := sdk.NewTestClient(server.URL, "token")
+	require.NoError(t, err)
+	apiClient = client
+	j = &journal{}
+	require.Equal(t, "unknown (403 Forbidden)", j.lookUpPrincipal(context.Background()))
+}
+
+func TestReadJournal(t *testing.T) {
+	dir := t.TempDir()
+	for name, test := range map[string]struct {
+		content string
+		ids     []string
+		err     string
+	}{
+		"missing": {},
+		"entries": {
+			content: `{"id":"a1"}` + "\n\n" + `{"id":"b2"}` + "\n",
+			ids:     []string{"a1", "b2"},
+		},
+		"invalid": {
+			content: `{"id":"a1"}` + "\n" + `{"id":` + "\n",
+			err:     "invalid:2:",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(dir, name)
+			if test.content != "" {
+				require.NoError(t, os.WriteFile(path, []byte(test.content), 0o600))
+			}
+			entries, err := readJournal(path)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			var ids []string
+			for _, entry := range entries {
+				ids = append(ids, entry.ID)
+			}
+			require.Equal(t, test.ids, ids)
+		})
+	}
+}
+
+func TestFindJournalEntry(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history.jsonl")
+	t.Setenv(HistoryEnvVar, path)
+	for _, id := range []string{"3f2a9c000001", "3f2a9c000002", "7b1e00000003"} {
+		require.NoError(t, appendJournal(path, &JournalEntry{ID: id}))
+	}
+	for id, test := range map[string]struct {
+		found string
+		err   string
+	}{
+		"3f2a9c000002": {found: "3f2a9c000002"},
+		"7b":           {found: "7b1e00000003"},
+		"3f2a9c":       {err: "matches several entries"},
+		"ffff":         {err: "no entry"},
+	} {
+		entry, err := findJournalEntry(id)
+		if test.err != "" {
+			require.ErrorContains(t, err, test.err, id)
+			continue
+		}
+		require.NoError(t, err, id)
+		require.Equal(t, test.found, entry.ID, id)
+	}
+}
+
+func TestHistoryJSON(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history.jsonl")
+	t.Setenv(HistoryEnvVar, path)
+	require.NoError(t, appendJournal(path, &JournalEntry{ID: "3f2a9c000001", Method: http.MethodPut}))
+	for name, test := range map[string]struct {
+		cmd  *cobra.Command
+		args []string
+	}{
+		"list": {cmd: NewHistoryListCmd(), args: []string{"--output", "json"}},
+		"show": {cmd: NewHistoryShowCmd(), args: []string{"3f2a9c"}},
+	} {
+		var stdout bytes.Buffer
+		test.cmd.SetOut(&stdout)
+		test.cmd.SetArgs(test.args)
+		require.NoError(t, test.cmd.Execute(), name)
+		require.Contains(t, stdout.String(), `"id": "3f2a9c000001"`, name)
+	}
+}
@@ -0,0 +1,291 @@
+package okta
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/lestrrat-go/jwx/jwk"
+	"github.com/spf13/cobra"
+)
+
+type KeygenInputs struct {
+	Type  string
+	Bits  int
+	Curve string
+	KeyID string
+	Out   string
+	AppID string
+	// PrivateKeyJWT switches the app to private_key_jwt client authentication.
+	PrivateKeyJWT bool
+	Save          bool
+}
+
+type JSONWebKeySet struct {
+	Keys []jwk.Key `json:"keys"`
+}
+
+var (
+	keyType = Flag{
+		Name:     "Key type",
+		LongForm: "type",
+		Help:     "Key type, either rsa or ec.",
+	}
+	keyBits = Flag{
+		Name:     "Key size",
+		LongForm: "bits",
+		Help:     "RSA key size in bits: 2048, 3072 or 4096.",
+	}
+	keyCurve = Flag{
+		Name:     "Curve",
+		LongForm: "curve",
+		Help:     "EC curve, either P-256 or P-384.",
+	}
+	keyID = Flag{
+		Name:     "Key ID",
+		LongForm: "kid",
+		Help:     "Key ID of the JWK. Defaults to the RFC 7638 thumbprint of the public key.",
+	}
+	keyOut = Flag{
+		Name:     "Output directory",
+		LongForm: "out",
+		Help:     "Directory the <kid>.pem private key and <kid>.jwks.json public key set are written to.",
+	}
+	keyAppID = Flag{
+		Name:     "App ID",
+		LongForm: "app-id",
+		Help:     "Register the public JWK on this service app.",
+	}
+	keyPrivateKeyJWT = Flag{
+		Name:     "Private key JWT",
+		LongForm: "private-key-jwt",
+		Help:     "With --app-id, also switch the app to private_key_jwt client authentication.",
+	}
+	keySave = Flag{
+		Name:     "Save",
+		LongForm: "save",
+		Help:     "Write privateKey and privateKeyId, plus clientId and authorizationMode when --app-id is set, into the selected profile.",
+	}
+)
+
+func NewAuthKeygenCmd() *cobra.Command {
+	inputs := KeygenInputs{}
+	cmd := &cobra.Command{
+		Use:  "keygen",
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			createsProfileAnnotation: "true",
+		},
+		Short: "Generate a key pair for the PrivateKey authorization mode",
+		Long: "Generate a key pair for the PrivateKey authorization mode.\n\n" +
+			"Creates an RSA or EC private key, writes it as a PKCS#8 PEM next to the public JWKS\n" +
+			"and prints the public JWK. Optionally registers the JWK on the service app and\n" +
+			"stores the key in the selected profile.",
+		Example: `okta-cli-client auth keygen
+okta-cli-client auth keygen --type ec --curve P-384 --out ~/.okta
+okta-cli-client auth keygen --app-id 0oa1example --private-key-jwt --save --profile prod`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// the flags are checked before any file is written
+			if inputs.PrivateKeyJWT && inputs.AppID == "" {
+				return fmt.Errorf("--%v only applies with --%v", keyPrivateKeyJWT.LongForm, keyAppID.LongForm)
+			}
+			var profilePath string
+			if inputs.Save {
+				var err error
+				if profilePath, err = profileConfigPath(selectedProfile()); err != nil {
+					return err
+				}
+			}
+			privateKey, alg, err := generateKey(inputs)
+			if err != nil {
+				return err
+			}
+			publicJWK, err := jwk.New(privateKey.Public())
+			if err != nil {
+				return err
+			}
+			if inputs.KeyID == "" {
+				if err = jwk.AssignKeyID(publicJWK); err != nil {
+					return err
+				}
+			} else if err = publicJWK.Set(jwk.KeyIDKey, inputs.KeyID); err != nil {
+				return err
+			}
+			if err = publicJWK.Set(jwk.KeyUsageKey, "sig"); err != nil {
+				return err
+			}
+			if err = publicJWK.Set(jwk.AlgorithmKey, alg); err != nil {
+				return err
+			}
+			kid := publicJWK.KeyID()
+
+			der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+			if err != nil {
+				return err
+			}
+			privatePEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+			jwks, err := json.MarshalIndent(JSONWebKeySet{Keys: []jwk.Key{publicJWK}}, "", " ")
+			if err != nil {
+				return err
+			}
+			if err = os.MkdirAll(inputs.Out, 0o700); err != nil {
+				return err
+			}
+			pemPath := filepath.Join(inputs.Out, kid+".pem")
+			if err = os.WriteFile(pemPath, privatePEM, 0o600); err != nil {
+				return err
+			}
+			jwksPath := filepath.Join(inputs.Out, kid+".jwks.json")
+			if err = os.WriteFile(jwksPath, jwks, 0o644); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.ErrOrStderr(), "Private key written to %v\nPublic JWKS written to %v\n", pemPath, jwksPath)
+
+			var clientID string
+			if inputs.AppID != "" {
+				ctx := cmd.Context()
+				if clientID, err = registerAppJWK(ctx, inputs.AppID, publicJWK); err != nil {
+					return fmt.Errorf("failed to register the key on app %v: %w", inputs.AppID, err)
+				}
+				fmt.Fprintf(cmd.ErrOrStderr(), "Public key %v registered on app %v\n", kid, inputs.AppID)
+				if inputs.PrivateKeyJWT {
+					if err = usePrivateKeyJWT(ctx, inputs.AppID); err != nil {
+						return fmt.Errorf("failed to switch app %v to private_key_jwt: %w", inputs.AppID, err)
+					}
+					fmt.Fprintf(cmd.ErrOrStderr(), "App %v switched to private_key_jwt client authentication\n", inputs.AppID)
+				}
+			}
+			if inputs.Save {
+				values := []clientValue{
+					{Key: "privateKey", Value: string(privatePEM)},
+					{Key: "privateKeyId", Value: kid},
+				}
+				if clientID != "" {
+					values = append(values, clientValue{Key: "clientId", Value: clientID}, clientValue{Key: "authorizationMode", Value: "PrivateKey"})
+				}
+				if err = setProfileClientValues(profilePath, values...); err != nil {
+					return err
+				}
+				fmt.Fprintf(cmd.ErrOrStderr(), "Configuration written to %v\n", profilePath)
+			}
+
+			out, err := json.MarshalIndent(publicJWK, "", " ")
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), string(out))
+			return nil
+		},
+	}
+	keyType.RegisterString(cmd, &inputs.Type, "rsa")
+	cmd.Flags().IntVar(&inputs.Bits, keyBits.LongForm, 2048, keyBits.Help)
+	keyCurve.RegisterString(cmd, &inputs.Curve, "P-256")
+	keyID.RegisterString(cmd, &inputs.KeyID, "")
+	keyOut.RegisterString(cmd, &inputs.Out, ".")
+	keyAppID.RegisterString(cmd, &inputs.AppID, "")
+	keyPrivateKeyJWT.RegisterBool(cmd, &inputs.PrivateKeyJWT, false)
+	keySave.RegisterBool(cmd, &inputs.Save, false)
+	return cmd
+}
+
+func init() {
+	authCmd.AddCommand(NewAuthKeygenCmd())
+}
+
+// generateKey creates the private key described by inputs and returns it
+// together with the JWS algorithm Okta should expect for it.
+func generateKey(inputs KeygenInputs) (crypto.Signer, string, error) {
+	switch inputs.Type {
+	case "rsa":
+		switch inputs.Bits {
+		case 2048, 3072, 4096:
+		default:
+			return nil, "", fmt.Errorf("unsupported RSA key size %v, expected 2048, 3072 or 4096", inputs.Bits)
+		}
+		key, err := rsa.GenerateKey(rand.Reader, inputs.Bits)
+		return key, "RS256", err
+	case "ec":
+		var curve elliptic.Curve
+		var alg string
+		switch inputs.Curve {
+		case "P-256":
+			curve, alg = elliptic.P256(), "ES256"
+		case "P-384":
+			curve, alg = elliptic.P384(), "ES384"
+		default:
+			return nil, "", fmt.Errorf("unsupported EC curve %v, expected P-256 or P-384", inputs.Curve)
+		}
+		key, err := ecdsa.GenerateKey(curve, rand.Reader)
+		return key, alg, err
+	default:
+		return nil, "", fmt.Errorf("unsupported key type %v, expected rsa or ec", inputs.Type)
+	}
+}
+
+// registerAppJWK adds the public key to the JWKS of an OAuth 2.0 service app
+// through its credentials endpoint and returns the app's client ID.
+func registerAppJWK(ctx context.Context, appID string, key jwk.Key) (string, error) {
+	app, err := getApp(ctx, appID)
+	if err != nil {
+		return "", err
+	}
+	if app["signOnMode"] != "OPENID_CONNECT" {
+		return "", errors.New("app is not an OAuth 2.0 / OIDC app")
+	}
+	path := "/api/v1/apps/" + url.PathEscape(appID) + "/credentials/jwks"
+	if _, err = apiClient.RawRequest(ctx, http.MethodPost, path).Body(key).Execute(); err != nil {
+		return "", err
+	}
+	clientID, _ := objectAt(app, "credentials", "oauthClient")["client_id"].(string)
+	if clientID == "" {
+		clientID = appID
+	}
+	return clientID, nil
+}
+
+// usePrivateKeyJWT switches the client authentication of an OAuth 2.0 app
+// to private_key_jwt, replacing the app.
+func usePrivateKeyJWT(ctx context.Context, appID string) error {
+	app, err := getApp(ctx, appID)
+	if err != nil {
+		return err
+	}
+	objectAt(app, "credentials", "oauthClient")["token_endpoint_auth_method"] = "private_key_jwt"
+	_, err = apiClient.ApplicationAPI.ReplaceApplication(ctx, appID).Data(app).Execute()
+	return err
+}
+
+func getApp(ctx context.Context, appID string) (map[string]interface{}, error) {
+	resp, err := apiClient.ApplicationAPI.GetApplication(ctx, appID).Execute()
+	if err != nil {
+		return nil, err
+	}
+	var app map[string]interface{}
+	err = json.NewDecoder(resp.Body).Decode(&app)
+	return app, err
+}
+
+// objectAt walks m along keys, adding empty objects for missing keys.
+func objectAt(m map[string]interface{}, keys ...string) map[string]interface{} {
+	for _, key := range keys {
+		next, ok := m[key].(map[string]interface{})
+		if !ok {
+			next = map[string]interface{}{}
+			m[key] = next
+		}
+		m = next
+	}
+	return m
+}
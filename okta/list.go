@@ -0,0 +1,47 @@
+package okta
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/okta/okta-cli-client/sdk"
+)
+
+var allFlag = Flag{
+	Name:     "All",
+	LongForm: "all",
+	Help:     "Follow the pagination and list the items of every page.",
+}
+
+// printAll follows the pages of a list request and prints their items as
+// one JSON array, formatted like utils.PrettyPrintByte. Items are written
+// as the pages arrive, so a long listing shows progress.
+func printAll(ctx context.Context, w io.Writer, req sdk.Pageable) error {
+	count := 0
+	for item, err := range sdk.All[interface{}](ctx, req) {
+		if err != nil {
+			if count > 0 {
+				fmt.Fprintln(w, "\n]")
+			}
+			return err
+		}
+		b, err := json.MarshalIndent(item, " ", " ")
+		if err != nil {
+			return err
+		}
+		separator := ",\n"
+		if count == 0 {
+			separator = "[\n"
+		}
+		fmt.Fprint(w, separator+" "+string(b))
+		count++
+	}
+	if count == 0 {
+		fmt.Fprintln(w, "[]")
+		return nil
+	}
+	fmt.Fprintln(w, "\n]")
+	return nil
+}
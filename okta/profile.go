@@ -0,0 +1,177 @@
+package okta
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	ProfileEnvVar     = "OKTA_CLI_PROFILE"
+	DefaultProfileDir = ".okta/profiles"
+	// ConfirmDestructiveEnvVar overrides the confirmDestructive setting of
+	// the configuration files.
+	ConfirmDestructiveEnvVar = "OKTA_CLI_CONFIRM_DESTRUCTIVE"
+)
+
+// createsProfileAnnotation marks commands that may run with a profile whose
+// configuration file does not exist yet.
+const createsProfileAnnotation = "createsProfile"
+
+var profile = Flag{
+	Name:     "Profile",
+	LongForm: "profile",
+	Help:     "Configuration profile to use, read from ~/.okta/profiles/<profile>.yaml on top of the default configuration. Defaults to $" + ProfileEnvVar + ".",
+}
+
+var profileValue string
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&profileValue, profile.LongForm, "", profile.Help)
+}
+
+// selectedProfile returns the profile named by --profile or the environment,
+// empty for the default ~/.okta/okta.yaml configuration.
+func selectedProfile() string {
+	if profileValue != "" {
+		return profileValue
+	}
+	return os.Getenv(ProfileEnvVar)
+}
+
+// profileConfigPath returns the configuration file backing the named profile.
+func profileConfigPath(name string) (string, error) {
+	if name == "" || name == "default" {
+		return getOktaConfigPath()
+	}
+	if strings.ContainsAny(name, `/\`) || strings.HasPrefix(name, ".") {
+		return "", fmt.Errorf("invalid profile name %q", name)
+	}
+	usr, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(usr, DefaultProfileDir, name+".yaml"), nil
+}
+
+// cliConfig holds the settings of the CLI itself rather than of the client,
+// read from the okta.cli section of the same configuration files.
+type cliConfig struct {
+	// ConfirmDestructive tells when destructive commands ask for a
+	// confirmation: prompt (the default), always or never.
+	ConfirmDestructive string `yaml:"confirmDestructive"`
+}
+
+// cliSettings are the settings of the running command, read by
+// readCLIConfig once its flags are parsed.
+var cliSettings cliConfig
+
+// readCLIConfig reads the CLI settings of ~/.okta/okta.yaml, then of the
+// selected profile, then of the environment, each overriding the previous.
+func readCLIConfig() (cliConfig, error) {
+	var config cliConfig
+	paths := []string{}
+	if path, err := getOktaConfigPath(); err == nil {
+		paths = append(paths, path)
+	}
+	if name := selectedProfile(); name != "" {
+		path, err := profileConfigPath(name)
+		if err != nil {
+			return config, err
+		}
+		paths = append(paths, path)
+	}
+	for _, path := range paths {
+		content, err := os.ReadFile(path)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return config, err
+		}
+		var file struct {
+			Okta struct {
+				CLI cliConfig `yaml:"cli"`
+			} `yaml:"okta"`
+		}
+		file.Okta.CLI = config
+		if err = yaml.Unmarshal(content, &file); err != nil {
+			return config, fmt.Errorf("failed to parse %v: %w", path, err)
+		}
+		config = file.Okta.CLI
+	}
+	if mode := os.Getenv(ConfirmDestructiveEnvVar); mode != "" {
+		config.ConfirmDestructive = mode
+	}
+	return config, nil
+}
+
+type clientValue struct {
+	Key   string
+	Value string
+}
+
+// setProfileClientValues sets okta.client.<key> values in the configuration
+// file at path, creating the file if needed and keeping everything else in it.
+func setProfileClientValues(path string, values ...clientValue) error {
+	var doc yaml.Node
+	content, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	if err = yaml.Unmarshal(content, &doc); err != nil {
+		return fmt.Errorf("failed to parse %v: %w", path, err)
+	}
+	if doc.Kind == 0 {
+		doc = yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode}}}
+	}
+	client := mappingValue(mappingValue(doc.Content[0], "okta"), "client")
+	for _, v := range values {
+		value := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: v.Value}
+		if strings.Contains(v.Value, "\n") {
+			value.Style = yaml.LiteralStyle
+		}
+		setMappingValue(client, v.Key, value)
+	}
+	var out bytes.Buffer
+	encoder := yaml.NewEncoder(&out)
+	encoder.SetIndent(2)
+	if err = encoder.Encode(&doc); err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	return os.WriteFile(path, out.Bytes(), 0o600)
+}
+
+// mappingValue returns the mapping stored under key in node, adding an empty
+// one when the key is missing.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			if node.Content[i+1].Kind != yaml.MappingNode {
+				node.Content[i+1] = &yaml.Node{Kind: yaml.MappingNode}
+			}
+			return node.Content[i+1]
+		}
+	}
+	value := &yaml.Node{Kind: yaml.MappingNode}
+	setMappingValue(node, key, value)
+	return value
+}
+
+func setMappingValue(node *yaml.Node, key string, value *yaml.Node) {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			node.Content[i+1] = value
+			return
+		}
+	}
+	node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: key}, value)
+}
@@ -0,0 +1,43 @@
+package okta
+
+import (
+	"errors"
+
+	"github.com/okta/okta-cli-client/sdk"
+)
+
+var record = Flag{
+	Name:     "Record",
+	LongForm: "record",
+	Help:     "Store the requests and responses of the command as fixtures in the directory, with credentials and personal data scrubbed.",
+}
+
+var replay = Flag{
+	Name:     "Replay",
+	LongForm: "replay",
+	Help:     "Answer the requests of the command with the fixtures recorded in the directory instead of contacting the org.",
+}
+
+var (
+	recordValue string
+	replayValue string
+)
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&recordValue, record.LongForm, "", record.Help)
+	rootCmd.PersistentFlags().StringVar(&replayValue, replay.LongForm, "", replay.Help)
+}
+
+// recordSetters returns the SDK options recording or replaying the requests
+// of the command.
+func recordSetters() ([]sdk.ConfigSetter, error) {
+	switch {
+	case recordValue != "" && replayValue != "":
+		return nil, errors.New("--record and --replay cannot be used together")
+	case recordValue != "":
+		return []sdk.ConfigSetter{sdk.WithRecord(recordValue)}, nil
+	case replayValue != "":
+		return []sdk.ConfigSetter{sdk.WithReplay(replayValue)}, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,46 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/okta/okta-cli-client/sdk"
+
+	"github.com/spf13/cobra"
+)
+
+var retryNonIdempotent = Flag{
+	Name:     "Retry non-idempotent",
+	LongForm: "retry-non-idempotent",
+	Help:     "Also retry POST and DELETE requests after a 5xx response, a connection reset or a timeout. Only use it when sending the request twice is harmless.",
+}
+
+var rateHeadroom = Flag{
+	Name:     "Rate headroom",
+	LongForm: "rate-headroom",
+	Help:     "Percent of each rate limit to leave unused, requests wait for the limit to reset instead.",
+}
+
+var (
+	retryNonIdempotentValue bool
+	rateHeadroomValue       int32
+)
+
+func init() {
+	rootCmd.PersistentFlags().BoolVar(&retryNonIdempotentValue, retryNonIdempotent.LongForm, false, retryNonIdempotent.Help)
+	rootCmd.PersistentFlags().Int32Var(&rateHeadroomValue, rateHeadroom.LongForm, 0, rateHeadroom.Help)
+}
+
+// applyRetryNonIdempotent lets the requests sent with the context of the
+// command opt in to retrying non-idempotent operations.
+func applyRetryNonIdempotent(cmd *cobra.Command) {
+	if retryNonIdempotentValue {
+		cmd.SetContext(context.WithValue(cmd.Context(), sdk.ContextRetryNonIdempotent, true))
+	}
+}
+
+// applyRateHeadroom overrides the configured rate headroom.
+func applyRateHeadroom(cmd *cobra.Command, configuration *sdk.Configuration) {
+	if cmd.Flags().Changed(rateHeadroom.LongForm) {
+		configuration.Okta.Client.RateLimit.Headroom = rateHeadroomValue
+	}
+}
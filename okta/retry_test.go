@@ -0,0 +1,65 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryNonIdempotent(t *testing.T) {
+	for name, test := range map[string]struct {
+		args     []string
+		attempts int32
+	}{
+		"default":     {attempts: 1},
+		"with opt-in": {args: []string{"--retry-non-idempotent"}, attempts: 2},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				if attempts.Add(1) == 1 {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprint(w, `{}`)
+			}))
+			defer server.Close()
+			home := t.TempDir()
+			t.Setenv("HOME", home)
+			t.Setenv(HistoryEnvVar, filepath.Join(home, "history.jsonl"))
+			t.Setenv("OKTA_CLIENT_ORGURL", server.URL)
+			t.Setenv("OKTA_CLIENT_AUTHORIZATIONMODE", "SSWS")
+			t.Setenv("OKTA_CLIENT_TOKEN", "token")
+			t.Setenv("OKTA_TESTING_DISABLE_HTTPS_CHECK", "true")
+			t.Setenv("OKTA_CLIENT_RETRY_MIN_BACKOFF", "0")
+			t.Setenv("OKTA_CLIENT_RETRY_MAX_BACKOFF", "0")
+			args := append([]string{"group", "create", "--data", `{"profile":{"name":"Engineering"}}`, "--skip-scope-check"}, test.args...)
+			cmd, _, err := rootCmd.Find(args)
+			require.NoError(t, err)
+			previous := apiClient
+			defer func() {
+				apiClient = previous
+				retryNonIdempotentValue, skipScopeCheckValue = false, false
+				rootCmd.SetArgs(nil)
+				// cobra keeps the context of the command between runs
+				cmd.SetContext(nil)
+			}()
+
+			// creating a group is not retried unless the command opts in
+			rootCmd.SetArgs(args)
+			_ = rootCmd.ExecuteContext(context.Background())
+			require.Equal(t, test.attempts, attempts.Load())
+		})
+	}
+}
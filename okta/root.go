@@ -1,29 +1,61 @@
 package okta
 
 import (
+	"errors"
 	"fmt"
 	"os"
 
 	"github.com/okta/okta-cli-client/iostream"
+	"github.com/okta/okta-cli-client/prompt"
 	"github.com/okta/okta-cli-client/sdk"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 )
 
+// localAnnotation marks commands that only read local files, which run
+// without a client and so without a configuration or scopes.
+const localAnnotation = "local"
+
 var rootCmd = &cobra.Command{
 	Use:  "okta-cli-client",
 	Long: "A command line tool for management API\n\nhttps://github.com/okta/okta-cli-client",
 	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 		prepareInteractivity(cmd)
-		return nil
+		// the flags and arguments are valid by now, the usage does not
+		// help with the errors of the request
+		cmd.SilenceUsage = true
+		applyTimeout(cmd)
+		applyRetryNonIdempotent(cmd)
+		if cmd.Annotations[localAnnotation] != "" {
+			return nil
+		}
+		var err error
+		if cliSettings, err = readCLIConfig(); err != nil {
+			return err
+		}
+		if apiClient, err = newAPIClient(cmd); err != nil {
+			return err
+		}
+		if err = checkScopes(cmd); err != nil {
+			return err
+		}
+		return confirmDestructive(cmd)
 	},
 }
 
 func Execute() {
-	if err := rootCmd.Execute(); err != nil {
-		fmt.Println(err)
-		os.Exit(1)
+	ctx, stop := signalContext()
+	code := 0
+	if err := rootCmd.ExecuteContext(ctx); err != nil {
+		// before stop, which cancels ctx as well
+		code = exitCode(ctx, err)
+	}
+	cancelTimeout()
+	shutdownTracing()
+	stop()
+	if code != 0 {
+		os.Exit(code)
 	}
 }
 
@@ -32,13 +64,82 @@ var apiClient *sdk.APIClient
 func init() {
 	configuration, err := sdk.NewConfiguration(sdk.WithCache(false))
 	if err != nil {
-		fmt.Printf("Create new config should not be error %v", err)
+		// reported by newAPIClient once a command runs
+		return
 	}
 	configuration.Debug = false
 
 	apiClient = sdk.NewAPIClient(configuration)
 }
 
+// newAPIClient builds the client from the configuration sources and the
+// global flags, which are only parsed by the time a command runs.
+func newAPIClient(cmd *cobra.Command) (*sdk.APIClient, error) {
+	setters := []sdk.ConfigSetter{sdk.WithContext(cmd.Context()), sdk.WithCache(false), sdk.WithPrivateKeyPassphraseFunc(func() ([]byte, error) {
+		return askPrivateKeyPassphrase(cmd)
+	})}
+	if name := selectedProfile(); name != "" {
+		path, err := profileConfigPath(name)
+		if err != nil {
+			return nil, err
+		}
+		_, err = os.Stat(path)
+		switch {
+		case err == nil:
+			setters = append(setters, sdk.WithConfigFile(path))
+		case !errors.Is(err, os.ErrNotExist) || cmd.Annotations[createsProfileAnnotation] == "":
+			return nil, fmt.Errorf("profile %q not found: %w", name, err)
+		}
+	}
+	recording, err := recordSetters()
+	if err != nil {
+		return nil, err
+	}
+	setters = append(setters, recording...)
+	caching, err := cacheSetters()
+	if err != nil {
+		return nil, err
+	}
+	setters = append(setters, caching...)
+	tracing, err := traceSetters()
+	if err != nil {
+		return nil, err
+	}
+	setters = append(setters, tracing...)
+	dryRun, err := dryRunSetters(cmd)
+	if err != nil {
+		return nil, err
+	}
+	setters = append(setters, dryRun...)
+	journaling, err := journalSetters(cmd)
+	if err != nil {
+		return nil, err
+	}
+	setters = append(setters, journaling...)
+	configuration, err := sdk.NewConfiguration(setters...)
+	if err != nil {
+		return nil, err
+	}
+	configuration.Debug = false
+	applyRateHeadroom(cmd, configuration)
+
+	return sdk.NewAPIClient(configuration), nil
+}
+
+// askPrivateKeyPassphrase prompts for the passphrase of an encrypted private
+// key that has none configured.
+func askPrivateKeyPassphrase(cmd *cobra.Command) ([]byte, error) {
+	if !canPrompt(cmd) {
+		return nil, errors.New("set OKTA_CLIENT_PRIVATEKEYPASSPHRASE or run the command in a terminal")
+	}
+	var passphrase string
+	input := prompt.PasswordInput("", "Private key passphrase:", "Passphrase of the encrypted private key", true)
+	if err := prompt.AskOne(input, &passphrase); err != nil {
+		return nil, err
+	}
+	return []byte(passphrase), nil
+}
+
 func canPrompt(cmd *cobra.Command) bool {
 	res := iostream.IsInputTerminal() && iostream.IsOutputTerminal()
 	return res
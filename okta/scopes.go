@@ -0,0 +1,161 @@
+package okta
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// scopesAnnotation is the command annotation holding the space separated
+// oauth2 scopes the spec requires for the command's operation.
+const scopesAnnotation = "scopes"
+
+var skipScopeCheck = Flag{
+	Name:     "Skip scope check",
+	LongForm: "skip-scope-check",
+	Help:     "Send the request even if the configured OAuth 2.0 scopes do not cover the command.",
+}
+
+var skipScopeCheckValue bool
+
+func NewScopesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "scopes",
+		Long: "Inspect the OAuth 2.0 scopes commands need",
+	}
+	cmd.AddCommand(NewScopesForCmd())
+	return cmd
+}
+
+func NewScopesForCmd() *cobra.Command {
+	var format string
+	cmd := &cobra.Command{
+		Use:   "for <command...>",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Print the minimal scope set needed by a list of commands",
+		Long: "Print the minimal scope set needed by a list of commands.\n\n" +
+			"Each argument is a command path, quoted when it has more than one word. A command\n" +
+			"group such as `group` stands for all of its subcommands. Use the output to provision\n" +
+			"the scopes granted to a service app used with the PrivateKey authorization mode.",
+		Example: `okta-cli-client scopes for "group create" "group assignUserTo"
+okta-cli-client scopes for user group --output json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var needed []string
+			for _, arg := range args {
+				target, rest, err := rootCmd.Find(strings.Fields(arg))
+				if err != nil {
+					return err
+				}
+				if len(rest) > 0 || target == rootCmd {
+					return fmt.Errorf("unknown command %q", arg)
+				}
+				needed = append(needed, commandScopes(target)...)
+			}
+			scopes := minimalScopes(needed)
+			switch format {
+			case "json":
+				encoder := json.NewEncoder(cmd.OutOrStdout())
+				encoder.SetIndent("", " ")
+				return encoder.Encode(scopes)
+			case "", "text":
+				for _, scope := range scopes {
+					fmt.Fprintln(cmd.OutOrStdout(), scope)
+				}
+				return nil
+			default:
+				return fmt.Errorf("unknown output format %q, expected text or json", format)
+			}
+		},
+	}
+	outputFormat.RegisterString(cmd, &format, "text")
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(NewScopesCmd())
+	rootCmd.PersistentFlags().BoolVar(&skipScopeCheckValue, skipScopeCheck.LongForm, false, skipScopeCheck.Help)
+}
+
+// commandScopes returns the scopes needed by cmd and, for command groups, by
+// all of its subcommands.
+func commandScopes(cmd *cobra.Command) []string {
+	scopes := strings.Fields(cmd.Annotations[scopesAnnotation])
+	for _, sub := range cmd.Commands() {
+		scopes = append(scopes, commandScopes(sub)...)
+	}
+	return scopes
+}
+
+// minimalScopes de-duplicates scopes and drops read scopes already covered
+// by the matching manage scope.
+func minimalScopes(scopes []string) []string {
+	set := map[string]bool{}
+	for _, scope := range scopes {
+		set[scope] = true
+	}
+	res := []string{}
+	for scope := range set {
+		if resource, ok := strings.CutSuffix(scope, ".read"); ok && set[resource+".manage"] {
+			continue
+		}
+		res = append(res, scope)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// hasScope reports whether granted covers needed. Okta manage scopes also
+// allow reading the resource.
+func hasScope(granted []string, needed string) bool {
+	for _, scope := range granted {
+		if scope == needed {
+			return true
+		}
+		if resource, ok := strings.CutSuffix(needed, ".read"); ok && scope == resource+".manage" {
+			return true
+		}
+	}
+	return false
+}
+
+// checkScopes fails before any request is sent when the scopes configured
+// for, or granted to, the access token do not cover the command.
+func checkScopes(cmd *cobra.Command) error {
+	needed := strings.Fields(cmd.Annotations[scopesAnnotation])
+	if len(needed) == 0 || skipScopeCheckValue {
+		return nil
+	}
+	cfg := apiClient.GetConfig()
+	var granted []string
+	switch cfg.Okta.Client.AuthorizationMode {
+	case "PrivateKey", "JWT":
+		granted = cfg.Okta.Client.Scopes
+	case "Bearer":
+		_, token, err := apiClient.AccessToken()
+		if err != nil {
+			return err
+		}
+		claims, err := parseAccessToken(token)
+		if err != nil {
+			// opaque tokens can't be inspected, leave it to the server
+			return nil
+		}
+		granted = claims.Scopes
+	default:
+		// API tokens carry the permissions of the admin who created them
+		return nil
+	}
+	var missing []string
+	for _, scope := range needed {
+		if !hasScope(granted, scope) {
+			missing = append(missing, scope)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("this command needs %v, add it to the configured scopes and grant it to the service app or use --%v", strings.Join(missing, " "), skipScopeCheck.LongForm)
+	}
+	return nil
+}
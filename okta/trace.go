@@ -0,0 +1,174 @@
+package okta
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/okta/okta-cli-client/sdk"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+var traceFileFlag = Flag{
+	Name:     "TraceFile",
+	LongForm: "trace-file",
+	Help:     "Append a span per request to the org, access tokens included, to the file as OTLP JSON, one export request per line.",
+}
+
+var (
+	traceFileValue string
+	// shutdownTracing writes the spans still buffered once the command ran.
+	shutdownTracing = func() {}
+)
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&traceFileValue, traceFileFlag.LongForm, "", traceFileFlag.Help)
+}
+
+// traceSetters returns the SDK options tracing the requests of the command
+// into --trace-file.
+func traceSetters() ([]sdk.ConfigSetter, error) {
+	if traceFileValue == "" {
+		return nil, nil
+	}
+	f, err := os.OpenFile(traceFileValue, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(&otlpFileExporter{f: f}),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", "okta-cli-client"))),
+	)
+	shutdownTracing = func() {
+		_ = provider.Shutdown(context.Background())
+	}
+	return []sdk.ConfigSetter{sdk.WithTracerProvider(provider)}, nil
+}
+
+// otlpFileExporter writes the spans of every export as a line of OTLP JSON,
+// the ExportTraceServiceRequest the collector's file receiver and the OTLP
+// tooling read.
+type otlpFileExporter struct {
+	mu sync.Mutex
+	f  *os.File
+}
+
+func (e *otlpFileExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	if len(spans) == 0 {
+		return nil
+	}
+	b, err := json.Marshal(otlpTraces(spans))
+	if err != nil {
+		return err
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	_, err = e.f.Write(append(b, '\n'))
+	return err
+}
+
+func (e *otlpFileExporter) Shutdown(ctx context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.f.Close()
+}
+
+// otlpTraces returns the ExportTraceServiceRequest of spans in the JSON
+// mapping of OTLP, with hex IDs and 64-bit integers as strings. The spans of
+// the CLI share the resource of its tracer provider.
+func otlpTraces(spans []sdktrace.ReadOnlySpan) map[string]interface{} {
+	var scopeSpans []map[string]interface{}
+	index := map[string]int{}
+	for _, span := range spans {
+		scope := span.InstrumentationScope()
+		i, ok := index[scope.Name+"@"+scope.Version]
+		if !ok {
+			i = len(scopeSpans)
+			index[scope.Name+"@"+scope.Version] = i
+			scopeSpans = append(scopeSpans, map[string]interface{}{
+				"scope": map[string]interface{}{"name": scope.Name, "version": scope.Version},
+				"spans": []interface{}{},
+			})
+		}
+		scopeSpans[i]["spans"] = append(scopeSpans[i]["spans"].([]interface{}), otlpSpan(span))
+	}
+	return map[string]interface{}{"resourceSpans": []interface{}{map[string]interface{}{
+		"resource":   map[string]interface{}{"attributes": otlpAttributes(spans[0].Resource().Attributes())},
+		"scopeSpans": scopeSpans,
+	}}}
+}
+
+func otlpSpan(span sdktrace.ReadOnlySpan) map[string]interface{} {
+	traceID := span.SpanContext().TraceID()
+	spanID := span.SpanContext().SpanID()
+	s := map[string]interface{}{
+		"traceId":           hex.EncodeToString(traceID[:]),
+		"spanId":            hex.EncodeToString(spanID[:]),
+		"name":              span.Name(),
+		"kind":              int(span.SpanKind()),
+		"startTimeUnixNano": strconv.FormatInt(span.StartTime().UnixNano(), 10),
+		"endTimeUnixNano":   strconv.FormatInt(span.EndTime().UnixNano(), 10),
+		"attributes":        otlpAttributes(span.Attributes()),
+		"status":            otlpStatus(span.Status()),
+	}
+	if parent := span.Parent(); parent.HasSpanID() {
+		parentID := parent.SpanID()
+		s["parentSpanId"] = hex.EncodeToString(parentID[:])
+	}
+	var events []map[string]interface{}
+	for _, event := range span.Events() {
+		events = append(events, map[string]interface{}{
+			"timeUnixNano": strconv.FormatInt(event.Time.UnixNano(), 10),
+			"name":         event.Name,
+			"attributes":   otlpAttributes(event.Attributes),
+		})
+	}
+	if events != nil {
+		s["events"] = events
+	}
+	return s
+}
+
+// otlpStatus maps the status of a span, whose codes are numbered unlike in
+// OTLP.
+func otlpStatus(status sdktrace.Status) map[string]interface{} {
+	s := map[string]interface{}{}
+	switch status.Code {
+	case codes.Ok:
+		s["code"] = 1
+	case codes.Error:
+		s["code"] = 2
+	}
+	if status.Description != "" {
+		s["message"] = status.Description
+	}
+	return s
+}
+
+func otlpAttributes(attrs []attribute.KeyValue) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(attrs))
+	for _, attr := range attrs {
+		res = append(res, map[string]interface{}{"key": string(attr.Key), "value": otlpValue(attr.Value)})
+	}
+	return res
+}
+
+func otlpValue(v attribute.Value) map[string]interface{} {
+	switch v.Type() {
+	case attribute.BOOL:
+		return map[string]interface{}{"boolValue": v.AsBool()}
+	case attribute.INT64:
+		return map[string]interface{}{"intValue": strconv.FormatInt(v.AsInt64(), 10)}
+	case attribute.FLOAT64:
+		return map[string]interface{}{"doubleValue": v.AsFloat64()}
+	}
+	// strings, and the slices the client never records, as text
+	return map[string]interface{}{"stringValue": v.Emit()}
+}
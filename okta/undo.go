@@ -0,0 +1,267 @@
+package okta
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/okta/okta-cli-client/sdk"
+
+	"github.com/spf13/cobra"
+)
+
+// inversePrefixes pairs the prefixes of the operationIds undoing each other,
+// like deactivateApplication and activateApplication.
+var inversePrefixes = map[string]string{
+	"activate":   "deactivate",
+	"deactivate": "activate",
+	"suspend":    "unsuspend",
+	"unsuspend":  "suspend",
+}
+
+// irreversiblePrefixes start the operationIds of the operations whose effect
+// the org offers no way back from, like revokeApiToken.
+var irreversiblePrefixes = []string{"delete", "revoke", "reset", "expire", "rotate"}
+
+// undoPlan is the request undoing an entry of the journal.
+type undoPlan struct {
+	Method      string
+	Path        string
+	OperationID string
+	Body        json.RawMessage
+	// DeactivateToReplace is set for the objects the org only replaces when
+	// inactive, deactivated for the replace and activated again when active.
+	DeactivateToReplace bool
+	// Note tells what the request does not restore.
+	Note string
+}
+
+func NewUndoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "undo <journal-id>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Undo a change recorded in the journal",
+		Long: "Undo a change recorded in the journal by sending the inverse operation.\n\n" +
+			"deactivate and activate, suspend and unsuspend, and assign and unassign operations\n" +
+			"undo each other. A replace is undone by replacing the object with its snapshot, so\n" +
+			"it must have run with --snapshot. A deactivated user is activated again without its\n" +
+			"password and factors, and the activation link is printed rather than emailed.\n" +
+			"Deletes, revocations, resets, expirations and rotations cannot be undone. The plan is printed, and confirmed like the destructive\n" +
+			"commands, before the request is sent.",
+		Example: `okta-cli-client history list
+okta-cli-client undo 3f2a9c
+okta-cli-client undo 3f2a9c --dry-run`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			entry, err := findJournalEntry(args[0])
+			if err != nil {
+				return err
+			}
+			if orgURL := apiClient.GetConfig().Okta.Client.OrgUrl; entry.OrgURL != orgURL {
+				return fmt.Errorf("%v changed %v, not %v: use the profile %q", entry.ID, entry.OrgURL, orgURL, entry.Profile)
+			}
+			plan, err := planUndo(entry)
+			if err != nil {
+				return err
+			}
+			printUndoPlan(cmd.OutOrStdout(), entry, plan)
+			err = confirmRun(cmd, func(name string) string {
+				return fmt.Sprintf("Undo %v with %v %v?", entry.ID, plan.Method, plan.Path)
+			})
+			if err != nil {
+				return err
+			}
+			resp, err := sendUndo(cmd.Context(), plan)
+			if resp != nil && resp.Response != nil && resp.Body != nil {
+				d, readErr := io.ReadAll(resp.Body)
+				if readErr == nil {
+					printAPIBody(cmd.OutOrStdout(), d)
+				}
+			}
+			return err
+		},
+	}
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(NewUndoCmd())
+}
+
+// planUndo returns the request undoing entry, or why it cannot be undone.
+func planUndo(entry *JournalEntry) (*undoPlan, error) {
+	if entry.Status < 200 || entry.Status > 299 {
+		return nil, fmt.Errorf("%v failed, there is nothing to undo", entry.ID)
+	}
+	path, _, _ := strings.Cut(entry.Path, "?")
+	operationID, route, ok := sdk.OperationOf(entry.Method, path)
+	if !ok {
+		return nil, fmt.Errorf("%v %v is not an operation of the Management API, it cannot be undone", entry.Method, path)
+	}
+	for prefix, inverse := range inversePrefixes {
+		if !strings.HasPrefix(operationID, prefix) {
+			continue
+		}
+		inverseID := inverse + strings.TrimPrefix(operationID, prefix)
+		method, inverseRoute, ok := sdk.OperationRoute(inverseID)
+		if !ok {
+			break
+		}
+		inversePath, err := fillRoute(inverseRoute, route, path)
+		if err != nil {
+			return nil, err
+		}
+		plan := &undoPlan{Method: method, Path: inversePath, OperationID: inverseID}
+		if inverseID == "activateUser" {
+			// the org resets the credentials of deactivated users, the
+			// activation link is returned rather than emailed
+			plan.Path += "?sendEmail=false"
+			plan.Note = "The user is activated without its password and factors, send it the activation link of the response to set them again."
+		}
+		return plan, nil
+	}
+	switch {
+	case strings.HasPrefix(operationID, "assign"):
+		// the unassign operation deletes what the assign one put
+		if inverseID, _, ok := sdk.OperationOf(http.MethodDelete, path); ok && strings.HasPrefix(inverseID, "unassign") {
+			return &undoPlan{Method: http.MethodDelete, Path: path, OperationID: inverseID}, nil
+		}
+	case strings.HasPrefix(operationID, "unassign"):
+		if inverseID, _, ok := sdk.OperationOf(http.MethodPut, path); ok && strings.HasPrefix(inverseID, "assign") {
+			// the snapshot of the assignment holds its properties, like
+			// the profile of an application user
+			plan := &undoPlan{Method: http.MethodPut, Path: path, OperationID: inverseID}
+			if entry.SnapshotPath == path {
+				plan.Body = withoutReadOnlyFields(entry.Snapshot)
+			}
+			return plan, nil
+		}
+	case strings.HasPrefix(operationID, "replace") && entry.Method == http.MethodPut:
+		if entry.Snapshot == nil || entry.SnapshotPath != path {
+			return nil, fmt.Errorf("%v has no snapshot of the object it replaced, run replaces with --%v to be able to undo them", entry.ID, snapshotFlag.LongForm)
+		}
+		return &undoPlan{
+			Method: http.MethodPut, Path: path, OperationID: operationID, Body: withoutReadOnlyFields(entry.Snapshot),
+			DeactivateToReplace: deactivatesToReplace(path),
+		}, nil
+	}
+	if entry.Method == http.MethodDelete {
+		return nil, fmt.Errorf("%v (%v) cannot be undone", entry.ID, operationID)
+	}
+	for _, prefix := range irreversiblePrefixes {
+		if strings.HasPrefix(operationID, prefix) {
+			return nil, fmt.Errorf("%v (%v) cannot be undone", entry.ID, operationID)
+		}
+	}
+	return nil, fmt.Errorf("undoing %v (%v) is not supported", entry.ID, operationID)
+}
+
+// fillRoute returns the path of target with the values the parameters of
+// route have in path, like /api/v1/apps/0oa1/lifecycle/activate for
+// /api/v1/apps/{appId}/lifecycle/activate, /api/v1/apps/{appId}/lifecycle/deactivate
+// and /api/v1/apps/0oa1/lifecycle/deactivate.
+func fillRoute(target, route, path string) (string, error) {
+	values := map[string]string{}
+	routeSegments := strings.Split(route, "/")
+	pathSegments := strings.Split(path, "/")
+	for i, segment := range routeSegments {
+		if strings.HasPrefix(segment, "{") && i < len(pathSegments) {
+			values[segment] = pathSegments[i]
+		}
+	}
+	var err error
+	filled := pathParam.ReplaceAllStringFunc(target, func(param string) string {
+		value, ok := values[param]
+		if !ok {
+			err = fmt.Errorf("the inverse operation %v needs %v", target, param)
+		}
+		return value
+	})
+	return filled, err
+}
+
+// deactivatesToReplace reports whether path is that of an object of a kind
+// apply deactivates to replace, like a group rule.
+func deactivatesToReplace(path string) bool {
+	for _, kind := range applyKinds {
+		id, ok := strings.CutPrefix(path, kind.Collection+"/")
+		if ok && kind.DeactivateToReplace && id != "" && !strings.Contains(id, "/") {
+			return true
+		}
+	}
+	return false
+}
+
+// sendUndo sends the request of plan, deactivating the object around it like
+// apply does when the org only replaces it inactive.
+func sendUndo(ctx context.Context, plan *undoPlan) (*sdk.APIResponse, error) {
+	reactivate := false
+	if plan.DeactivateToReplace {
+		current, err := sendObject(ctx, http.MethodGet, plan.Path, nil)
+		if err != nil {
+			return nil, err
+		}
+		if reactivate = current["status"] == "ACTIVE"; reactivate {
+			if _, err := sendObject(ctx, http.MethodPost, plan.Path+"/lifecycle/deactivate", nil); err != nil {
+				return nil, err
+			}
+		}
+	}
+	req := apiClient.RawRequest(ctx, plan.Method, plan.Path)
+	if plan.Body != nil {
+		req = req.Body(string(plan.Body))
+	}
+	resp, err := req.Execute()
+	if reactivate {
+		// the object is activated again even if the replace failed
+		if _, activateErr := sendObject(ctx, http.MethodPost, plan.Path+"/lifecycle/activate", nil); err == nil {
+			err = activateErr
+		}
+	}
+	return resp, err
+}
+
+// withoutReadOnlyFields returns the JSON object snapshot without the fields
+// the org sets, but its type the replaces of zones, rules and policies require.
+func withoutReadOnlyFields(snapshot json.RawMessage) json.RawMessage {
+	var object map[string]interface{}
+	if json.Unmarshal(snapshot, &object) != nil {
+		return snapshot
+	}
+	for _, field := range readOnlyFields {
+		if field != "type" {
+			delete(object, field)
+		}
+	}
+	b, err := json.Marshal(object)
+	if err != nil {
+		return snapshot
+	}
+	return b
+}
+
+func printUndoPlan(w io.Writer, entry *JournalEntry, plan *undoPlan) {
+	fmt.Fprintf(w, "Undoing %v, %v at %v:\n", entry.ID, entry.Command, entry.Time.Local().Format(time.DateTime))
+	fmt.Fprintf(w, "  %v %v (%v)\n", entry.Method, entry.Path, entry.OperationID)
+	fmt.Fprintln(w, "Plan:")
+	if plan.DeactivateToReplace {
+		fmt.Fprintf(w, "  POST %v/lifecycle/deactivate, if it is active\n", plan.Path)
+	}
+	fmt.Fprintf(w, "  %v %v (%v)\n", plan.Method, plan.Path, plan.OperationID)
+	if plan.Body != nil {
+		b, err := json.MarshalIndent(plan.Body, "  ", "  ")
+		if err != nil {
+			b = plan.Body
+		}
+		fmt.Fprintf(w, "  %s\n", b)
+	}
+	if plan.DeactivateToReplace {
+		fmt.Fprintf(w, "  POST %v/lifecycle/activate, if it was active\n", plan.Path)
+	}
+	if plan.Note != "" {
+		fmt.Fprintln(w, plan.Note)
+	}
+}
@@ -0,0 +1,186 @@
+package okta
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanUndo(t *testing.T) {
+	group := json.RawMessage(`{"id":"00g1","created":"2024-01-01T00:00:00.000Z","profile":{"name":"Before"},"_links":{}}`)
+	assignment := json.RawMessage(`{"id":"00g2","priority":1,"profile":{"role":"admin"}}`)
+	zone := json.RawMessage(`{"id":"nzo1","type":"IP","name":"Office","status":"ACTIVE","gateways":[{"type":"CIDR","value":"10.0.0.0/8"}]}`)
+	rule := json.RawMessage(`{"id":"0pr1","type":"group_rule","name":"Engineers","status":"INACTIVE","created":"2024-01-01T00:00:00.000Z"}`)
+	for name, test := range map[string]struct {
+		entry JournalEntry
+		plan  *undoPlan
+		err   string
+	}{
+		"deactivate": {
+			entry: JournalEntry{Method: http.MethodPost, Path: "/api/v1/apps/0oa1/lifecycle/deactivate", Status: 200},
+			plan:  &undoPlan{Method: http.MethodPost, Path: "/api/v1/apps/0oa1/lifecycle/activate", OperationID: "activateApplication"},
+		},
+		"deactivate a user": {
+			entry: JournalEntry{Method: http.MethodPost, Path: "/api/v1/users/00u1/lifecycle/deactivate", Status: 200},
+			plan: &undoPlan{
+				Method: http.MethodPost, Path: "/api/v1/users/00u1/lifecycle/activate?sendEmail=false", OperationID: "activateUser",
+				Note: "The user is activated without its password and factors, send it the activation link of the response to set them again.",
+			},
+		},
+		"activate": {
+			entry: JournalEntry{Method: http.MethodPost, Path: "/api/v1/users/00u1/lifecycle/activate?sendEmail=false", Status: 200},
+			plan:  &undoPlan{Method: http.MethodPost, Path: "/api/v1/users/00u1/lifecycle/deactivate", OperationID: "deactivateUser"},
+		},
+		"assign": {
+			entry: JournalEntry{Method: http.MethodPut, Path: "/api/v1/groups/00g1/users/00u1", Status: 204},
+			plan:  &undoPlan{Method: http.MethodDelete, Path: "/api/v1/groups/00g1/users/00u1", OperationID: "unassignUserFromGroup"},
+		},
+		"unassign with a snapshot": {
+			entry: JournalEntry{
+				Method: http.MethodDelete, Path: "/api/v1/apps/0oa1/groups/00g2", Status: 204,
+				SnapshotPath: "/api/v1/apps/0oa1/groups/00g2", Snapshot: assignment,
+			},
+			plan: &undoPlan{
+				Method: http.MethodPut, Path: "/api/v1/apps/0oa1/groups/00g2", OperationID: "assignGroupToApplication",
+				Body: json.RawMessage(`{"priority":1,"profile":{"role":"admin"}}`),
+			},
+		},
+		"unassign without a snapshot": {
+			entry: JournalEntry{Method: http.MethodDelete, Path: "/api/v1/groups/00g1/users/00u1", Status: 204},
+			plan:  &undoPlan{Method: http.MethodPut, Path: "/api/v1/groups/00g1/users/00u1", OperationID: "assignUserToGroup"},
+		},
+		"replace with a snapshot": {
+			entry: JournalEntry{
+				Method: http.MethodPut, Path: "/api/v1/groups/00g1", Status: 200,
+				SnapshotPath: "/api/v1/groups/00g1", Snapshot: group,
+			},
+			plan: &undoPlan{
+				Method: http.MethodPut, Path: "/api/v1/groups/00g1", OperationID: "replaceGroup",
+				Body: json.RawMessage(`{"profile":{"name":"Before"}}`),
+			},
+		},
+		"replace a zone": {
+			entry: JournalEntry{
+				Method: http.MethodPut, Path: "/api/v1/zones/nzo1", Status: 200,
+				SnapshotPath: "/api/v1/zones/nzo1", Snapshot: zone,
+			},
+			plan: &undoPlan{
+				Method: http.MethodPut, Path: "/api/v1/zones/nzo1", OperationID: "replaceNetworkZone",
+				Body: json.RawMessage(`{"type":"IP","name":"Office","status":"ACTIVE","gateways":[{"type":"CIDR","value":"10.0.0.0/8"}]}`),
+			},
+		},
+		"replace a group rule": {
+			entry: JournalEntry{
+				Method: http.MethodPut, Path: "/api/v1/groups/rules/0pr1", Status: 200,
+				SnapshotPath: "/api/v1/groups/rules/0pr1", Snapshot: rule,
+			},
+			plan: &undoPlan{
+				Method: http.MethodPut, Path: "/api/v1/groups/rules/0pr1", OperationID: "replaceGroupRule",
+				Body:                json.RawMessage(`{"type":"group_rule","name":"Engineers","status":"INACTIVE"}`),
+				DeactivateToReplace: true,
+			},
+		},
+		"replace without a snapshot": {
+			entry: JournalEntry{ID: "e1", Method: http.MethodPut, Path: "/api/v1/groups/00g1", Status: 200},
+			err:   "e1 has no snapshot of the object it replaced",
+		},
+		"delete": {
+			entry: JournalEntry{
+				ID: "e1", Method: http.MethodDelete, Path: "/api/v1/groups/00g1", Status: 204,
+				SnapshotPath: "/api/v1/groups/00g1", Snapshot: group,
+			},
+			err: "e1 (deleteGroup) cannot be undone",
+		},
+		"revoke": {
+			entry: JournalEntry{ID: "e1", Method: http.MethodDelete, Path: "/api/v1/api-tokens/00T1", Status: 204},
+			err:   "e1 (revokeApiToken) cannot be undone",
+		},
+		"irreversible": {
+			entry: JournalEntry{ID: "e1", Method: http.MethodPost, Path: "/api/v1/users/00u1/lifecycle/reset_factors", Status: 200},
+			err:   "e1 (resetFactors) cannot be undone",
+		},
+		"failed": {
+			entry: JournalEntry{ID: "e1", Method: http.MethodPost, Path: "/api/v1/apps/0oa1/lifecycle/deactivate", Status: 404},
+			err:   "e1 failed, there is nothing to undo",
+		},
+		"unknown": {
+			entry: JournalEntry{ID: "e1", Method: http.MethodPost, Path: "/api/v1/unknown", Status: 200},
+			err:   "is not an operation of the Management API",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			plan, err := planUndo(&test.entry)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			if test.plan.Body != nil {
+				require.JSONEq(t, string(test.plan.Body), string(plan.Body))
+				plan.Body, test.plan.Body = nil, nil
+			}
+			require.Equal(t, test.plan, plan)
+		})
+	}
+}
+
+func TestFillRoute(t *testing.T) {
+	for name, test := range map[string]struct {
+		target, route, path string
+		filled              string
+		err                 string
+	}{
+		"same parameters": {
+			target: "/api/v1/apps/{appId}/lifecycle/activate",
+			route:  "/api/v1/apps/{appId}/lifecycle/deactivate",
+			path:   "/api/v1/apps/0oa1/lifecycle/deactivate",
+			filled: "/api/v1/apps/0oa1/lifecycle/activate",
+		},
+		"several parameters": {
+			target: "/api/v1/groups/{groupId}/users/{userId}",
+			route:  "/api/v1/groups/{groupId}/users/{userId}",
+			path:   "/api/v1/groups/00g1/users/00u1",
+			filled: "/api/v1/groups/00g1/users/00u1",
+		},
+		"missing parameter": {
+			target: "/api/v1/groups/{groupId}/users/{userId}",
+			route:  "/api/v1/groups/{groupId}",
+			path:   "/api/v1/groups/00g1",
+			err:    "needs {userId}",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			filled, err := fillRoute(test.target, test.route, test.path)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.filled, filled)
+		})
+	}
+}
+
+func TestSendUndo(t *testing.T) {
+	useMockOrg(t)
+	ctx := context.Background()
+	ruleID := createObject(t, "/api/v1/groups/rules", map[string]interface{}{"type": "group_rule", "name": "Engineers"})
+	_, err := sendObject(ctx, http.MethodPost, "/api/v1/groups/rules/"+ruleID+"/lifecycle/activate", nil)
+	require.NoError(t, err)
+
+	// the active rule is deactivated for the replace and activated again
+	plan := &undoPlan{
+		Method: http.MethodPut, Path: "/api/v1/groups/rules/" + ruleID, OperationID: "replaceGroupRule",
+		Body:                json.RawMessage(`{"type":"group_rule","name":"Engineers before"}`),
+		DeactivateToReplace: true,
+	}
+	_, err = sendUndo(ctx, plan)
+	require.NoError(t, err)
+	rule, err := sendObject(ctx, http.MethodGet, plan.Path, nil)
+	require.NoError(t, err)
+	require.Equal(t, "Engineers before", rule["name"])
+	require.Equal(t, "ACTIVE", rule["status"])
+}
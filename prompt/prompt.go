@@ -31,3 +31,23 @@ func TextInput(name string, message string, help string, defaultValue string, re
 
 	return input
 }
+
+func PasswordInput(name string, message string, help string, required bool) *survey.Question {
+	input := &survey.Question{
+		Name:   name,
+		Prompt: &survey.Password{Message: message, Help: help},
+	}
+
+	if required {
+		input.Validate = survey.Required
+	}
+
+	return input
+}
+
+func ConfirmInput(name string, message string, help string, defaultValue bool) *survey.Question {
+	return &survey.Question{
+		Name:   name,
+		Prompt: &survey.Confirm{Message: message, Help: help, Default: defaultValue},
+	}
+}
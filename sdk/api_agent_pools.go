@@ -262,6 +262,11 @@ func (r ApiActivateAgentPoolsUpdateRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ActivateAgentPoolsUpdateExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into AgentPoolUpdate.
+func (r ApiActivateAgentPoolsUpdateRequest) ExecuteTyped() (AgentPoolUpdate, *APIResponse, error) {
+	return decodeResponse[AgentPoolUpdate](r.Execute())
+}
+
 /*
 ActivateAgentPoolsUpdate Activate an Agent Pool update
 
@@ -303,7 +308,7 @@ func (a *AgentPoolsAPIService) ActivateAgentPoolsUpdateExecute(r ApiActivateAgen
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AgentPoolsAPIService.ActivateAgentPoolsUpdate")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/agentPools/{poolId}/updates/{updateId}/activate"
@@ -352,7 +357,7 @@ func (a *AgentPoolsAPIService) ActivateAgentPoolsUpdateExecute(r ApiActivateAgen
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -367,6 +372,7 @@ func (a *AgentPoolsAPIService) ActivateAgentPoolsUpdateExecute(r ApiActivateAgen
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -433,6 +439,11 @@ func (r ApiCreateAgentPoolsUpdateRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.CreateAgentPoolsUpdateExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into AgentPoolUpdate.
+func (r ApiCreateAgentPoolsUpdateRequest) ExecuteTyped() (AgentPoolUpdate, *APIResponse, error) {
+	return decodeResponse[AgentPoolUpdate](r.Execute())
+}
+
 /*
 CreateAgentPoolsUpdate Create an Agent Pool update
 
@@ -472,7 +483,7 @@ func (a *AgentPoolsAPIService) CreateAgentPoolsUpdateExecute(r ApiCreateAgentPoo
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AgentPoolsAPIService.CreateAgentPoolsUpdate")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/agentPools/{poolId}/updates"
@@ -523,7 +534,7 @@ func (a *AgentPoolsAPIService) CreateAgentPoolsUpdateExecute(r ApiCreateAgentPoo
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -538,6 +549,7 @@ func (a *AgentPoolsAPIService) CreateAgentPoolsUpdateExecute(r ApiCreateAgentPoo
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -611,6 +623,11 @@ func (r ApiDeactivateAgentPoolsUpdateRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.DeactivateAgentPoolsUpdateExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into AgentPoolUpdate.
+func (r ApiDeactivateAgentPoolsUpdateRequest) ExecuteTyped() (AgentPoolUpdate, *APIResponse, error) {
+	return decodeResponse[AgentPoolUpdate](r.Execute())
+}
+
 /*
 DeactivateAgentPoolsUpdate Deactivate an Agent Pool update
 
@@ -652,7 +669,7 @@ func (a *AgentPoolsAPIService) DeactivateAgentPoolsUpdateExecute(r ApiDeactivate
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AgentPoolsAPIService.DeactivateAgentPoolsUpdate")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/agentPools/{poolId}/updates/{updateId}/deactivate"
@@ -701,7 +718,7 @@ func (a *AgentPoolsAPIService) DeactivateAgentPoolsUpdateExecute(r ApiDeactivate
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -716,6 +733,7 @@ func (a *AgentPoolsAPIService) DeactivateAgentPoolsUpdateExecute(r ApiDeactivate
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -817,7 +835,7 @@ func (a *AgentPoolsAPIService) DeleteAgentPoolsUpdateExecute(r ApiDeleteAgentPoo
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AgentPoolsAPIService.DeleteAgentPoolsUpdate")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/agentPools/{poolId}/updates/{updateId}"
@@ -866,7 +884,7 @@ func (a *AgentPoolsAPIService) DeleteAgentPoolsUpdateExecute(r ApiDeleteAgentPoo
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -881,6 +899,7 @@ func (a *AgentPoolsAPIService) DeleteAgentPoolsUpdateExecute(r ApiDeleteAgentPoo
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -942,6 +961,11 @@ func (r ApiGetAgentPoolsUpdateInstanceRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetAgentPoolsUpdateInstanceExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into AgentPoolUpdate.
+func (r ApiGetAgentPoolsUpdateInstanceRequest) ExecuteTyped() (AgentPoolUpdate, *APIResponse, error) {
+	return decodeResponse[AgentPoolUpdate](r.Execute())
+}
+
 /*
 GetAgentPoolsUpdateInstance Retrieve an Agent Pool update by id
 
@@ -983,7 +1007,7 @@ func (a *AgentPoolsAPIService) GetAgentPoolsUpdateInstanceExecute(r ApiGetAgentP
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AgentPoolsAPIService.GetAgentPoolsUpdateInstance")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/agentPools/{poolId}/updates/{updateId}"
@@ -1032,7 +1056,7 @@ func (a *AgentPoolsAPIService) GetAgentPoolsUpdateInstanceExecute(r ApiGetAgentP
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1047,6 +1071,7 @@ func (a *AgentPoolsAPIService) GetAgentPoolsUpdateInstanceExecute(r ApiGetAgentP
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1107,6 +1132,11 @@ func (r ApiGetAgentPoolsUpdateSettingsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetAgentPoolsUpdateSettingsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into AgentPoolUpdateSetting.
+func (r ApiGetAgentPoolsUpdateSettingsRequest) ExecuteTyped() (AgentPoolUpdateSetting, *APIResponse, error) {
+	return decodeResponse[AgentPoolUpdateSetting](r.Execute())
+}
+
 /*
 GetAgentPoolsUpdateSettings Retrieve an Agent Pool update's settings
 
@@ -1146,7 +1176,7 @@ func (a *AgentPoolsAPIService) GetAgentPoolsUpdateSettingsExecute(r ApiGetAgentP
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AgentPoolsAPIService.GetAgentPoolsUpdateSettings")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/agentPools/{poolId}/updates/settings"
@@ -1194,7 +1224,7 @@ func (a *AgentPoolsAPIService) GetAgentPoolsUpdateSettingsExecute(r ApiGetAgentP
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1209,6 +1239,7 @@ func (a *AgentPoolsAPIService) GetAgentPoolsUpdateSettingsExecute(r ApiGetAgentP
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1289,6 +1320,11 @@ func (r ApiListAgentPoolsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListAgentPoolsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []AgentPool.
+func (r ApiListAgentPoolsRequest) ExecuteTyped() ([]AgentPool, *APIResponse, error) {
+	return decodeResponse[[]AgentPool](r.Execute())
+}
+
 /*
 ListAgentPools List all Agent Pools
 
@@ -1326,7 +1362,7 @@ func (a *AgentPoolsAPIService) ListAgentPoolsExecute(r ApiListAgentPoolsRequest)
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AgentPoolsAPIService.ListAgentPools")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/agentPools"
@@ -1382,7 +1418,7 @@ func (a *AgentPoolsAPIService) ListAgentPoolsExecute(r ApiListAgentPoolsRequest)
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1397,6 +1433,7 @@ func (a *AgentPoolsAPIService) ListAgentPoolsExecute(r ApiListAgentPoolsRequest)
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1452,6 +1489,11 @@ func (r ApiListAgentPoolsUpdatesRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListAgentPoolsUpdatesExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []AgentPoolUpdate.
+func (r ApiListAgentPoolsUpdatesRequest) ExecuteTyped() ([]AgentPoolUpdate, *APIResponse, error) {
+	return decodeResponse[[]AgentPoolUpdate](r.Execute())
+}
+
 /*
 ListAgentPoolsUpdates List all Agent Pool updates
 
@@ -1491,7 +1533,7 @@ func (a *AgentPoolsAPIService) ListAgentPoolsUpdatesExecute(r ApiListAgentPoolsU
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AgentPoolsAPIService.ListAgentPoolsUpdates")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/agentPools/{poolId}/updates"
@@ -1542,7 +1584,7 @@ func (a *AgentPoolsAPIService) ListAgentPoolsUpdatesExecute(r ApiListAgentPoolsU
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1557,6 +1599,7 @@ func (a *AgentPoolsAPIService) ListAgentPoolsUpdatesExecute(r ApiListAgentPoolsU
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1618,6 +1661,11 @@ func (r ApiPauseAgentPoolsUpdateRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.PauseAgentPoolsUpdateExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into AgentPoolUpdate.
+func (r ApiPauseAgentPoolsUpdateRequest) ExecuteTyped() (AgentPoolUpdate, *APIResponse, error) {
+	return decodeResponse[AgentPoolUpdate](r.Execute())
+}
+
 /*
 PauseAgentPoolsUpdate Pause an Agent Pool update
 
@@ -1659,7 +1707,7 @@ func (a *AgentPoolsAPIService) PauseAgentPoolsUpdateExecute(r ApiPauseAgentPools
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AgentPoolsAPIService.PauseAgentPoolsUpdate")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/agentPools/{poolId}/updates/{updateId}/pause"
@@ -1708,7 +1756,7 @@ func (a *AgentPoolsAPIService) PauseAgentPoolsUpdateExecute(r ApiPauseAgentPools
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1723,6 +1771,7 @@ func (a *AgentPoolsAPIService) PauseAgentPoolsUpdateExecute(r ApiPauseAgentPools
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1784,6 +1833,11 @@ func (r ApiResumeAgentPoolsUpdateRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ResumeAgentPoolsUpdateExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into AgentPoolUpdate.
+func (r ApiResumeAgentPoolsUpdateRequest) ExecuteTyped() (AgentPoolUpdate, *APIResponse, error) {
+	return decodeResponse[AgentPoolUpdate](r.Execute())
+}
+
 /*
 ResumeAgentPoolsUpdate Resume an Agent Pool update
 
@@ -1825,7 +1879,7 @@ func (a *AgentPoolsAPIService) ResumeAgentPoolsUpdateExecute(r ApiResumeAgentPoo
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AgentPoolsAPIService.ResumeAgentPoolsUpdate")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/agentPools/{poolId}/updates/{updateId}/resume"
@@ -1874,7 +1928,7 @@ func (a *AgentPoolsAPIService) ResumeAgentPoolsUpdateExecute(r ApiResumeAgentPoo
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1889,6 +1943,7 @@ func (a *AgentPoolsAPIService) ResumeAgentPoolsUpdateExecute(r ApiResumeAgentPoo
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1950,6 +2005,11 @@ func (r ApiRetryAgentPoolsUpdateRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.RetryAgentPoolsUpdateExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into AgentPoolUpdate.
+func (r ApiRetryAgentPoolsUpdateRequest) ExecuteTyped() (AgentPoolUpdate, *APIResponse, error) {
+	return decodeResponse[AgentPoolUpdate](r.Execute())
+}
+
 /*
 RetryAgentPoolsUpdate Retry an Agent Pool update
 
@@ -1991,7 +2051,7 @@ func (a *AgentPoolsAPIService) RetryAgentPoolsUpdateExecute(r ApiRetryAgentPools
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AgentPoolsAPIService.RetryAgentPoolsUpdate")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/agentPools/{poolId}/updates/{updateId}/retry"
@@ -2040,7 +2100,7 @@ func (a *AgentPoolsAPIService) RetryAgentPoolsUpdateExecute(r ApiRetryAgentPools
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2055,6 +2115,7 @@ func (a *AgentPoolsAPIService) RetryAgentPoolsUpdateExecute(r ApiRetryAgentPools
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2116,6 +2177,11 @@ func (r ApiStopAgentPoolsUpdateRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.StopAgentPoolsUpdateExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into AgentPoolUpdate.
+func (r ApiStopAgentPoolsUpdateRequest) ExecuteTyped() (AgentPoolUpdate, *APIResponse, error) {
+	return decodeResponse[AgentPoolUpdate](r.Execute())
+}
+
 /*
 StopAgentPoolsUpdate Stop an Agent Pool update
 
@@ -2157,7 +2223,7 @@ func (a *AgentPoolsAPIService) StopAgentPoolsUpdateExecute(r ApiStopAgentPoolsUp
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AgentPoolsAPIService.StopAgentPoolsUpdate")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/agentPools/{poolId}/updates/{updateId}/stop"
@@ -2206,7 +2272,7 @@ func (a *AgentPoolsAPIService) StopAgentPoolsUpdateExecute(r ApiStopAgentPoolsUp
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2221,6 +2287,7 @@ func (a *AgentPoolsAPIService) StopAgentPoolsUpdateExecute(r ApiStopAgentPoolsUp
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2288,6 +2355,11 @@ func (r ApiUpdateAgentPoolsUpdateRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.UpdateAgentPoolsUpdateExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into AgentPoolUpdate.
+func (r ApiUpdateAgentPoolsUpdateRequest) ExecuteTyped() (AgentPoolUpdate, *APIResponse, error) {
+	return decodeResponse[AgentPoolUpdate](r.Execute())
+}
+
 /*
 UpdateAgentPoolsUpdate Update an Agent Pool update by id
 
@@ -2329,7 +2401,7 @@ func (a *AgentPoolsAPIService) UpdateAgentPoolsUpdateExecute(r ApiUpdateAgentPoo
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AgentPoolsAPIService.UpdateAgentPoolsUpdate")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/agentPools/{poolId}/updates/{updateId}"
@@ -2381,7 +2453,7 @@ func (a *AgentPoolsAPIService) UpdateAgentPoolsUpdateExecute(r ApiUpdateAgentPoo
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2396,6 +2468,7 @@ func (a *AgentPoolsAPIService) UpdateAgentPoolsUpdateExecute(r ApiUpdateAgentPoo
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -2474,6 +2547,11 @@ func (r ApiUpdateAgentPoolsUpdateSettingsRequest) Execute() (*APIResponse, error
 	return r.ApiService.UpdateAgentPoolsUpdateSettingsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into AgentPoolUpdateSetting.
+func (r ApiUpdateAgentPoolsUpdateSettingsRequest) ExecuteTyped() (AgentPoolUpdateSetting, *APIResponse, error) {
+	return decodeResponse[AgentPoolUpdateSetting](r.Execute())
+}
+
 /*
 UpdateAgentPoolsUpdateSettings Update an Agent Pool update settings
 
@@ -2513,7 +2591,7 @@ func (a *AgentPoolsAPIService) UpdateAgentPoolsUpdateSettingsExecute(r ApiUpdate
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AgentPoolsAPIService.UpdateAgentPoolsUpdateSettings")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/agentPools/{poolId}/updates/settings"
@@ -2564,7 +2642,7 @@ func (a *AgentPoolsAPIService) UpdateAgentPoolsUpdateSettingsExecute(r ApiUpdate
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2579,6 +2657,7 @@ func (a *AgentPoolsAPIService) UpdateAgentPoolsUpdateSettingsExecute(r ApiUpdate
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
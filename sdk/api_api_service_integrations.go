@@ -179,6 +179,11 @@ func (r ApiActivateApiServiceIntegrationInstanceSecretRequest) Execute() (*APIRe
 	return r.ApiService.ActivateApiServiceIntegrationInstanceSecretExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into APIServiceIntegrationInstanceSecret.
+func (r ApiActivateApiServiceIntegrationInstanceSecretRequest) ExecuteTyped() (APIServiceIntegrationInstanceSecret, *APIResponse, error) {
+	return decodeResponse[APIServiceIntegrationInstanceSecret](r.Execute())
+}
+
 /*
 ActivateApiServiceIntegrationInstanceSecret Activate an API Service Integration instance Secret
 
@@ -220,7 +225,7 @@ func (a *ApiServiceIntegrationsAPIService) ActivateApiServiceIntegrationInstance
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApiServiceIntegrationsAPIService.ActivateApiServiceIntegrationInstanceSecret")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/integrations/api/v1/api-services/{apiServiceId}/credentials/secrets/{secretId}/lifecycle/activate"
@@ -269,7 +274,7 @@ func (a *ApiServiceIntegrationsAPIService) ActivateApiServiceIntegrationInstance
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -284,6 +289,7 @@ func (a *ApiServiceIntegrationsAPIService) ActivateApiServiceIntegrationInstance
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
@@ -361,6 +367,11 @@ func (r ApiCreateApiServiceIntegrationInstanceRequest) Execute() (*APIResponse,
 	return r.ApiService.CreateApiServiceIntegrationInstanceExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into PostAPIServiceIntegrationInstance.
+func (r ApiCreateApiServiceIntegrationInstanceRequest) ExecuteTyped() (PostAPIServiceIntegrationInstance, *APIResponse, error) {
+	return decodeResponse[PostAPIServiceIntegrationInstance](r.Execute())
+}
+
 /*
 CreateApiServiceIntegrationInstance Create an API Service Integration instance
 
@@ -398,7 +409,7 @@ func (a *ApiServiceIntegrationsAPIService) CreateApiServiceIntegrationInstanceEx
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApiServiceIntegrationsAPIService.CreateApiServiceIntegrationInstance")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/integrations/api/v1/api-services"
@@ -448,7 +459,7 @@ func (a *ApiServiceIntegrationsAPIService) CreateApiServiceIntegrationInstanceEx
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -463,6 +474,7 @@ func (a *ApiServiceIntegrationsAPIService) CreateApiServiceIntegrationInstanceEx
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -535,6 +547,11 @@ func (r ApiCreateApiServiceIntegrationInstanceSecretRequest) Execute() (*APIResp
 	return r.ApiService.CreateApiServiceIntegrationInstanceSecretExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into APIServiceIntegrationInstanceSecret.
+func (r ApiCreateApiServiceIntegrationInstanceSecretRequest) ExecuteTyped() (APIServiceIntegrationInstanceSecret, *APIResponse, error) {
+	return decodeResponse[APIServiceIntegrationInstanceSecret](r.Execute())
+}
+
 /*
 CreateApiServiceIntegrationInstanceSecret Create an API Service Integration instance Secret
 
@@ -574,7 +591,7 @@ func (a *ApiServiceIntegrationsAPIService) CreateApiServiceIntegrationInstanceSe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApiServiceIntegrationsAPIService.CreateApiServiceIntegrationInstanceSecret")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/integrations/api/v1/api-services/{apiServiceId}/credentials/secrets"
@@ -622,7 +639,7 @@ func (a *ApiServiceIntegrationsAPIService) CreateApiServiceIntegrationInstanceSe
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -637,6 +654,7 @@ func (a *ApiServiceIntegrationsAPIService) CreateApiServiceIntegrationInstanceSe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -710,6 +728,11 @@ func (r ApiDeactivateApiServiceIntegrationInstanceSecretRequest) Execute() (*API
 	return r.ApiService.DeactivateApiServiceIntegrationInstanceSecretExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into APIServiceIntegrationInstanceSecret.
+func (r ApiDeactivateApiServiceIntegrationInstanceSecretRequest) ExecuteTyped() (APIServiceIntegrationInstanceSecret, *APIResponse, error) {
+	return decodeResponse[APIServiceIntegrationInstanceSecret](r.Execute())
+}
+
 /*
 DeactivateApiServiceIntegrationInstanceSecret Deactivate an API Service Integration instance Secret
 
@@ -751,7 +774,7 @@ func (a *ApiServiceIntegrationsAPIService) DeactivateApiServiceIntegrationInstan
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApiServiceIntegrationsAPIService.DeactivateApiServiceIntegrationInstanceSecret")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/integrations/api/v1/api-services/{apiServiceId}/credentials/secrets/{secretId}/lifecycle/deactivate"
@@ -800,7 +823,7 @@ func (a *ApiServiceIntegrationsAPIService) DeactivateApiServiceIntegrationInstan
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -815,6 +838,7 @@ func (a *ApiServiceIntegrationsAPIService) DeactivateApiServiceIntegrationInstan
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
@@ -925,7 +949,7 @@ func (a *ApiServiceIntegrationsAPIService) DeleteApiServiceIntegrationInstanceEx
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApiServiceIntegrationsAPIService.DeleteApiServiceIntegrationInstance")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/integrations/api/v1/api-services/{apiServiceId}"
@@ -973,7 +997,7 @@ func (a *ApiServiceIntegrationsAPIService) DeleteApiServiceIntegrationInstanceEx
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -988,6 +1012,7 @@ func (a *ApiServiceIntegrationsAPIService) DeleteApiServiceIntegrationInstanceEx
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
@@ -1101,7 +1126,7 @@ func (a *ApiServiceIntegrationsAPIService) DeleteApiServiceIntegrationInstanceSe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApiServiceIntegrationsAPIService.DeleteApiServiceIntegrationInstanceSecret")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/integrations/api/v1/api-services/{apiServiceId}/credentials/secrets/{secretId}"
@@ -1150,7 +1175,7 @@ func (a *ApiServiceIntegrationsAPIService) DeleteApiServiceIntegrationInstanceSe
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1165,6 +1190,7 @@ func (a *ApiServiceIntegrationsAPIService) DeleteApiServiceIntegrationInstanceSe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
@@ -1237,6 +1263,11 @@ func (r ApiGetApiServiceIntegrationInstanceRequest) Execute() (*APIResponse, err
 	return r.ApiService.GetApiServiceIntegrationInstanceExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into APIServiceIntegrationInstance.
+func (r ApiGetApiServiceIntegrationInstanceRequest) ExecuteTyped() (APIServiceIntegrationInstance, *APIResponse, error) {
+	return decodeResponse[APIServiceIntegrationInstance](r.Execute())
+}
+
 /*
 GetApiServiceIntegrationInstance Retrieve an API Service Integration instance
 
@@ -1276,7 +1307,7 @@ func (a *ApiServiceIntegrationsAPIService) GetApiServiceIntegrationInstanceExecu
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApiServiceIntegrationsAPIService.GetApiServiceIntegrationInstance")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/integrations/api/v1/api-services/{apiServiceId}"
@@ -1324,7 +1355,7 @@ func (a *ApiServiceIntegrationsAPIService) GetApiServiceIntegrationInstanceExecu
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1339,6 +1370,7 @@ func (a *ApiServiceIntegrationsAPIService) GetApiServiceIntegrationInstanceExecu
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
@@ -1411,6 +1443,11 @@ func (r ApiListApiServiceIntegrationInstanceSecretsRequest) Execute() (*APIRespo
 	return r.ApiService.ListApiServiceIntegrationInstanceSecretsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []APIServiceIntegrationInstanceSecret.
+func (r ApiListApiServiceIntegrationInstanceSecretsRequest) ExecuteTyped() ([]APIServiceIntegrationInstanceSecret, *APIResponse, error) {
+	return decodeResponse[[]APIServiceIntegrationInstanceSecret](r.Execute())
+}
+
 /*
 ListApiServiceIntegrationInstanceSecrets List all API Service Integration instance Secrets
 
@@ -1450,7 +1487,7 @@ func (a *ApiServiceIntegrationsAPIService) ListApiServiceIntegrationInstanceSecr
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApiServiceIntegrationsAPIService.ListApiServiceIntegrationInstanceSecrets")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/integrations/api/v1/api-services/{apiServiceId}/credentials/secrets"
@@ -1498,7 +1535,7 @@ func (a *ApiServiceIntegrationsAPIService) ListApiServiceIntegrationInstanceSecr
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1513,6 +1550,7 @@ func (a *ApiServiceIntegrationsAPIService) ListApiServiceIntegrationInstanceSecr
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
@@ -1591,6 +1629,11 @@ func (r ApiListApiServiceIntegrationInstancesRequest) Execute() (*APIResponse, e
 	return r.ApiService.ListApiServiceIntegrationInstancesExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []APIServiceIntegrationInstance.
+func (r ApiListApiServiceIntegrationInstancesRequest) ExecuteTyped() ([]APIServiceIntegrationInstance, *APIResponse, error) {
+	return decodeResponse[[]APIServiceIntegrationInstance](r.Execute())
+}
+
 /*
 ListApiServiceIntegrationInstances List all API Service Integration instances
 
@@ -1628,7 +1671,7 @@ func (a *ApiServiceIntegrationsAPIService) ListApiServiceIntegrationInstancesExe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApiServiceIntegrationsAPIService.ListApiServiceIntegrationInstances")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/integrations/api/v1/api-services"
@@ -1678,7 +1721,7 @@ func (a *ApiServiceIntegrationsAPIService) ListApiServiceIntegrationInstancesExe
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1693,6 +1736,7 @@ func (a *ApiServiceIntegrationsAPIService) ListApiServiceIntegrationInstancesExe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
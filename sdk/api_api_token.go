@@ -100,6 +100,11 @@ func (r ApiGetApiTokenRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetApiTokenExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ApiToken.
+func (r ApiGetApiTokenRequest) ExecuteTyped() (ApiToken, *APIResponse, error) {
+	return decodeResponse[ApiToken](r.Execute())
+}
+
 /*
 GetApiToken Retrieve an API Token's Metadata
 
@@ -139,7 +144,7 @@ func (a *ApiTokenAPIService) GetApiTokenExecute(r ApiGetApiTokenRequest) (*APIRe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApiTokenAPIService.GetApiToken")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/api-tokens/{apiTokenId}"
@@ -187,7 +192,7 @@ func (a *ApiTokenAPIService) GetApiTokenExecute(r ApiGetApiTokenRequest) (*APIRe
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -202,6 +207,7 @@ func (a *ApiTokenAPIService) GetApiTokenExecute(r ApiGetApiTokenRequest) (*APIRe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -261,6 +267,11 @@ func (r ApiListApiTokensRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListApiTokensExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []ApiToken.
+func (r ApiListApiTokensRequest) ExecuteTyped() ([]ApiToken, *APIResponse, error) {
+	return decodeResponse[[]ApiToken](r.Execute())
+}
+
 /*
 ListApiTokens List all API Token Metadata
 
@@ -298,7 +309,7 @@ func (a *ApiTokenAPIService) ListApiTokensExecute(r ApiListApiTokensRequest) (*A
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApiTokenAPIService.ListApiTokens")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/api-tokens"
@@ -345,7 +356,7 @@ func (a *ApiTokenAPIService) ListApiTokensExecute(r ApiListApiTokensRequest) (*A
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -360,6 +371,7 @@ func (a *ApiTokenAPIService) ListApiTokensExecute(r ApiListApiTokensRequest) (*A
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -446,7 +458,7 @@ func (a *ApiTokenAPIService) RevokeApiTokenExecute(r ApiRevokeApiTokenRequest) (
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApiTokenAPIService.RevokeApiToken")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/api-tokens/{apiTokenId}"
@@ -494,7 +506,7 @@ func (a *ApiTokenAPIService) RevokeApiTokenExecute(r ApiRevokeApiTokenRequest) (
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -509,6 +521,7 @@ func (a *ApiTokenAPIService) RevokeApiTokenExecute(r ApiRevokeApiTokenRequest) (
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -604,7 +617,7 @@ func (a *ApiTokenAPIService) RevokeCurrentApiTokenExecute(r ApiRevokeCurrentApiT
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApiTokenAPIService.RevokeCurrentApiToken")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/api-tokens/current"
@@ -651,7 +664,7 @@ func (a *ApiTokenAPIService) RevokeCurrentApiTokenExecute(r ApiRevokeCurrentApiT
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -666,6 +679,7 @@ func (a *ApiTokenAPIService) RevokeCurrentApiTokenExecute(r ApiRevokeCurrentApiT
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -182,7 +182,7 @@ func (a *ApplicationAPIService) ActivateApplicationExecute(r ApiActivateApplicat
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationAPIService.ActivateApplication")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/lifecycle/activate"
@@ -230,7 +230,7 @@ func (a *ApplicationAPIService) ActivateApplicationExecute(r ApiActivateApplicat
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -245,6 +245,7 @@ func (a *ApplicationAPIService) ActivateApplicationExecute(r ApiActivateApplicat
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -323,6 +324,11 @@ func (r ApiCreateApplicationRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.CreateApplicationExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ListApplications200ResponseInner.
+func (r ApiCreateApplicationRequest) ExecuteTyped() (ListApplications200ResponseInner, *APIResponse, error) {
+	return decodeResponse[ListApplications200ResponseInner](r.Execute())
+}
+
 /*
 CreateApplication Create an Application
 
@@ -360,7 +366,7 @@ func (a *ApplicationAPIService) CreateApplicationExecute(r ApiCreateApplicationR
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationAPIService.CreateApplication")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps"
@@ -416,7 +422,7 @@ func (a *ApplicationAPIService) CreateApplicationExecute(r ApiCreateApplicationR
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -431,6 +437,7 @@ func (a *ApplicationAPIService) CreateApplicationExecute(r ApiCreateApplicationR
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -529,7 +536,7 @@ func (a *ApplicationAPIService) DeactivateApplicationExecute(r ApiDeactivateAppl
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationAPIService.DeactivateApplication")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/lifecycle/deactivate"
@@ -577,7 +584,7 @@ func (a *ApplicationAPIService) DeactivateApplicationExecute(r ApiDeactivateAppl
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -592,6 +599,7 @@ func (a *ApplicationAPIService) DeactivateApplicationExecute(r ApiDeactivateAppl
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -690,7 +698,7 @@ func (a *ApplicationAPIService) DeleteApplicationExecute(r ApiDeleteApplicationR
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationAPIService.DeleteApplication")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}"
@@ -738,7 +746,7 @@ func (a *ApplicationAPIService) DeleteApplicationExecute(r ApiDeleteApplicationR
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -753,6 +761,7 @@ func (a *ApplicationAPIService) DeleteApplicationExecute(r ApiDeleteApplicationR
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -819,6 +828,11 @@ func (r ApiGetApplicationRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetApplicationExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ListApplications200ResponseInner.
+func (r ApiGetApplicationRequest) ExecuteTyped() (ListApplications200ResponseInner, *APIResponse, error) {
+	return decodeResponse[ListApplications200ResponseInner](r.Execute())
+}
+
 /*
 GetApplication Retrieve an Application
 
@@ -858,7 +872,7 @@ func (a *ApplicationAPIService) GetApplicationExecute(r ApiGetApplicationRequest
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationAPIService.GetApplication")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}"
@@ -909,7 +923,7 @@ func (a *ApplicationAPIService) GetApplicationExecute(r ApiGetApplicationRequest
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -924,6 +938,7 @@ func (a *ApplicationAPIService) GetApplicationExecute(r ApiGetApplicationRequest
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1023,6 +1038,11 @@ func (r ApiListApplicationsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListApplicationsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []ListApplications200ResponseInner.
+func (r ApiListApplicationsRequest) ExecuteTyped() ([]ListApplications200ResponseInner, *APIResponse, error) {
+	return decodeResponse[[]ListApplications200ResponseInner](r.Execute())
+}
+
 /*
 ListApplications List all Applications
 
@@ -1060,7 +1080,7 @@ func (a *ApplicationAPIService) ListApplicationsExecute(r ApiListApplicationsReq
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationAPIService.ListApplications")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps"
@@ -1125,7 +1145,7 @@ func (a *ApplicationAPIService) ListApplicationsExecute(r ApiListApplicationsReq
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1140,6 +1160,7 @@ func (a *ApplicationAPIService) ListApplicationsExecute(r ApiListApplicationsReq
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1194,6 +1215,11 @@ func (r ApiReplaceApplicationRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ReplaceApplicationExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ListApplications200ResponseInner.
+func (r ApiReplaceApplicationRequest) ExecuteTyped() (ListApplications200ResponseInner, *APIResponse, error) {
+	return decodeResponse[ListApplications200ResponseInner](r.Execute())
+}
+
 /*
 ReplaceApplication Replace an Application
 
@@ -1233,7 +1259,7 @@ func (a *ApplicationAPIService) ReplaceApplicationExecute(r ApiReplaceApplicatio
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationAPIService.ReplaceApplication")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}"
@@ -1284,7 +1310,7 @@ func (a *ApplicationAPIService) ReplaceApplicationExecute(r ApiReplaceApplicatio
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1299,6 +1325,7 @@ func (a *ApplicationAPIService) ReplaceApplicationExecute(r ApiReplaceApplicatio
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -157,7 +157,7 @@ func (a *ApplicationConnectionsAPIService) ActivateDefaultProvisioningConnection
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationConnectionsAPIService.ActivateDefaultProvisioningConnectionForApplication")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/connections/default/lifecycle/activate"
@@ -205,7 +205,7 @@ func (a *ApplicationConnectionsAPIService) ActivateDefaultProvisioningConnection
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -220,6 +220,7 @@ func (a *ApplicationConnectionsAPIService) ActivateDefaultProvisioningConnection
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -318,7 +319,7 @@ func (a *ApplicationConnectionsAPIService) DeactivateDefaultProvisioningConnecti
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationConnectionsAPIService.DeactivateDefaultProvisioningConnectionForApplication")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/connections/default/lifecycle/deactivate"
@@ -366,7 +367,7 @@ func (a *ApplicationConnectionsAPIService) DeactivateDefaultProvisioningConnecti
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -381,6 +382,7 @@ func (a *ApplicationConnectionsAPIService) DeactivateDefaultProvisioningConnecti
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -441,6 +443,11 @@ func (r ApiGetDefaultProvisioningConnectionForApplicationRequest) Execute() (*AP
 	return r.ApiService.GetDefaultProvisioningConnectionForApplicationExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ProvisioningConnectionResponse.
+func (r ApiGetDefaultProvisioningConnectionForApplicationRequest) ExecuteTyped() (ProvisioningConnectionResponse, *APIResponse, error) {
+	return decodeResponse[ProvisioningConnectionResponse](r.Execute())
+}
+
 /*
 GetDefaultProvisioningConnectionForApplication Retrieve the default Provisioning Connection
 
@@ -480,7 +487,7 @@ func (a *ApplicationConnectionsAPIService) GetDefaultProvisioningConnectionForAp
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationConnectionsAPIService.GetDefaultProvisioningConnectionForApplication")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/connections/default"
@@ -528,7 +535,7 @@ func (a *ApplicationConnectionsAPIService) GetDefaultProvisioningConnectionForAp
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -543,6 +550,7 @@ func (a *ApplicationConnectionsAPIService) GetDefaultProvisioningConnectionForAp
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -616,6 +624,11 @@ func (r ApiUpdateDefaultProvisioningConnectionForApplicationRequest) Execute() (
 	return r.ApiService.UpdateDefaultProvisioningConnectionForApplicationExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ProvisioningConnectionResponse.
+func (r ApiUpdateDefaultProvisioningConnectionForApplicationRequest) ExecuteTyped() (ProvisioningConnectionResponse, *APIResponse, error) {
+	return decodeResponse[ProvisioningConnectionResponse](r.Execute())
+}
+
 /*
 UpdateDefaultProvisioningConnectionForApplication Update the default Provisioning Connection
 
@@ -655,7 +668,7 @@ func (a *ApplicationConnectionsAPIService) UpdateDefaultProvisioningConnectionFo
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationConnectionsAPIService.UpdateDefaultProvisioningConnectionForApplication")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/connections/default"
@@ -709,7 +722,7 @@ func (a *ApplicationConnectionsAPIService) UpdateDefaultProvisioningConnectionFo
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -724,6 +737,7 @@ func (a *ApplicationConnectionsAPIService) UpdateDefaultProvisioningConnectionFo
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -851,7 +865,7 @@ func (a *ApplicationConnectionsAPIService) VerifyProvisioningConnectionForApplic
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationConnectionsAPIService.VerifyProvisioningConnectionForApplication")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appName}/{appId}/oauth2/callback"
@@ -906,7 +920,7 @@ func (a *ApplicationConnectionsAPIService) VerifyProvisioningConnectionForApplic
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -921,6 +935,7 @@ func (a *ApplicationConnectionsAPIService) VerifyProvisioningConnectionForApplic
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -192,6 +192,11 @@ func (r ApiCloneApplicationKeyRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.CloneApplicationKeyExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into JsonWebKey.
+func (r ApiCloneApplicationKeyRequest) ExecuteTyped() (JsonWebKey, *APIResponse, error) {
+	return decodeResponse[JsonWebKey](r.Execute())
+}
+
 /*
 CloneApplicationKey Clone a Key Credential
 
@@ -233,7 +238,7 @@ func (a *ApplicationCredentialsAPIService) CloneApplicationKeyExecute(r ApiClone
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationCredentialsAPIService.CloneApplicationKey")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/credentials/keys/{keyId}/clone"
@@ -283,7 +288,7 @@ func (a *ApplicationCredentialsAPIService) CloneApplicationKeyExecute(r ApiClone
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -298,6 +303,7 @@ func (a *ApplicationCredentialsAPIService) CloneApplicationKeyExecute(r ApiClone
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -364,6 +370,11 @@ func (r ApiGenerateApplicationKeyRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GenerateApplicationKeyExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into JsonWebKey.
+func (r ApiGenerateApplicationKeyRequest) ExecuteTyped() (JsonWebKey, *APIResponse, error) {
+	return decodeResponse[JsonWebKey](r.Execute())
+}
+
 /*
 GenerateApplicationKey Generate a Key Credential
 
@@ -403,7 +414,7 @@ func (a *ApplicationCredentialsAPIService) GenerateApplicationKeyExecute(r ApiGe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationCredentialsAPIService.GenerateApplicationKey")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/credentials/keys/generate"
@@ -454,7 +465,7 @@ func (a *ApplicationCredentialsAPIService) GenerateApplicationKeyExecute(r ApiGe
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -469,6 +480,7 @@ func (a *ApplicationCredentialsAPIService) GenerateApplicationKeyExecute(r ApiGe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -535,6 +547,11 @@ func (r ApiGenerateCsrForApplicationRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GenerateCsrForApplicationExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into Csr.
+func (r ApiGenerateCsrForApplicationRequest) ExecuteTyped() (Csr, *APIResponse, error) {
+	return decodeResponse[Csr](r.Execute())
+}
+
 /*
 GenerateCsrForApplication Generate a Certificate Signing Request
 
@@ -574,7 +591,7 @@ func (a *ApplicationCredentialsAPIService) GenerateCsrForApplicationExecute(r Ap
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationCredentialsAPIService.GenerateCsrForApplication")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/credentials/csrs"
@@ -625,7 +642,7 @@ func (a *ApplicationCredentialsAPIService) GenerateCsrForApplicationExecute(r Ap
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -640,6 +657,7 @@ func (a *ApplicationCredentialsAPIService) GenerateCsrForApplicationExecute(r Ap
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -713,6 +731,11 @@ func (r ApiGetApplicationKeyRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetApplicationKeyExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into JsonWebKey.
+func (r ApiGetApplicationKeyRequest) ExecuteTyped() (JsonWebKey, *APIResponse, error) {
+	return decodeResponse[JsonWebKey](r.Execute())
+}
+
 /*
 GetApplicationKey Retrieve a Key Credential
 
@@ -754,7 +777,7 @@ func (a *ApplicationCredentialsAPIService) GetApplicationKeyExecute(r ApiGetAppl
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationCredentialsAPIService.GetApplicationKey")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/credentials/keys/{keyId}"
@@ -803,7 +826,7 @@ func (a *ApplicationCredentialsAPIService) GetApplicationKeyExecute(r ApiGetAppl
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -818,6 +841,7 @@ func (a *ApplicationCredentialsAPIService) GetApplicationKeyExecute(r ApiGetAppl
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -879,6 +903,11 @@ func (r ApiGetCsrForApplicationRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetCsrForApplicationExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into Csr.
+func (r ApiGetCsrForApplicationRequest) ExecuteTyped() (Csr, *APIResponse, error) {
+	return decodeResponse[Csr](r.Execute())
+}
+
 /*
 GetCsrForApplication Retrieve a Certificate Signing Request
 
@@ -920,7 +949,7 @@ func (a *ApplicationCredentialsAPIService) GetCsrForApplicationExecute(r ApiGetC
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationCredentialsAPIService.GetCsrForApplication")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/credentials/csrs/{csrId}"
@@ -969,7 +998,7 @@ func (a *ApplicationCredentialsAPIService) GetCsrForApplicationExecute(r ApiGetC
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -984,6 +1013,7 @@ func (a *ApplicationCredentialsAPIService) GetCsrForApplicationExecute(r ApiGetC
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1044,6 +1074,11 @@ func (r ApiListApplicationKeysRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListApplicationKeysExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []JsonWebKey.
+func (r ApiListApplicationKeysRequest) ExecuteTyped() ([]JsonWebKey, *APIResponse, error) {
+	return decodeResponse[[]JsonWebKey](r.Execute())
+}
+
 /*
 ListApplicationKeys List all Key Credentials
 
@@ -1083,7 +1118,7 @@ func (a *ApplicationCredentialsAPIService) ListApplicationKeysExecute(r ApiListA
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationCredentialsAPIService.ListApplicationKeys")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/credentials/keys"
@@ -1131,7 +1166,7 @@ func (a *ApplicationCredentialsAPIService) ListApplicationKeysExecute(r ApiListA
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1146,6 +1181,7 @@ func (a *ApplicationCredentialsAPIService) ListApplicationKeysExecute(r ApiListA
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1206,6 +1242,11 @@ func (r ApiListCsrsForApplicationRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListCsrsForApplicationExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []Csr.
+func (r ApiListCsrsForApplicationRequest) ExecuteTyped() ([]Csr, *APIResponse, error) {
+	return decodeResponse[[]Csr](r.Execute())
+}
+
 /*
 ListCsrsForApplication List all Certificate Signing Requests
 
@@ -1245,7 +1286,7 @@ func (a *ApplicationCredentialsAPIService) ListCsrsForApplicationExecute(r ApiLi
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationCredentialsAPIService.ListCsrsForApplication")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/credentials/csrs"
@@ -1293,7 +1334,7 @@ func (a *ApplicationCredentialsAPIService) ListCsrsForApplicationExecute(r ApiLi
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1308,6 +1349,7 @@ func (a *ApplicationCredentialsAPIService) ListCsrsForApplicationExecute(r ApiLi
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1375,6 +1417,11 @@ func (r ApiPublishCsrFromApplicationRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.PublishCsrFromApplicationExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into JsonWebKey.
+func (r ApiPublishCsrFromApplicationRequest) ExecuteTyped() (JsonWebKey, *APIResponse, error) {
+	return decodeResponse[JsonWebKey](r.Execute())
+}
+
 /*
 PublishCsrFromApplication Publish a Certificate Signing Request
 
@@ -1416,7 +1463,7 @@ func (a *ApplicationCredentialsAPIService) PublishCsrFromApplicationExecute(r Ap
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationCredentialsAPIService.PublishCsrFromApplication")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/credentials/csrs/{csrId}/lifecycle/publish"
@@ -1468,7 +1515,7 @@ func (a *ApplicationCredentialsAPIService) PublishCsrFromApplicationExecute(r Ap
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1483,6 +1530,7 @@ func (a *ApplicationCredentialsAPIService) PublishCsrFromApplicationExecute(r Ap
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1596,7 +1644,7 @@ func (a *ApplicationCredentialsAPIService) RevokeCsrFromApplicationExecute(r Api
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationCredentialsAPIService.RevokeCsrFromApplication")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/credentials/csrs/{csrId}"
@@ -1645,7 +1693,7 @@ func (a *ApplicationCredentialsAPIService) RevokeCsrFromApplicationExecute(r Api
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1660,6 +1708,7 @@ func (a *ApplicationCredentialsAPIService) RevokeCsrFromApplicationExecute(r Api
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
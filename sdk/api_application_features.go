@@ -97,6 +97,11 @@ func (r ApiGetFeatureForApplicationRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetFeatureForApplicationExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ListFeaturesForApplication200ResponseInner.
+func (r ApiGetFeatureForApplicationRequest) ExecuteTyped() (ListFeaturesForApplication200ResponseInner, *APIResponse, error) {
+	return decodeResponse[ListFeaturesForApplication200ResponseInner](r.Execute())
+}
+
 /*
 GetFeatureForApplication Retrieve a Feature
 
@@ -138,7 +143,7 @@ func (a *ApplicationFeaturesAPIService) GetFeatureForApplicationExecute(r ApiGet
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationFeaturesAPIService.GetFeatureForApplication")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/features/{featureName}"
@@ -187,7 +192,7 @@ func (a *ApplicationFeaturesAPIService) GetFeatureForApplicationExecute(r ApiGet
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -202,6 +207,7 @@ func (a *ApplicationFeaturesAPIService) GetFeatureForApplicationExecute(r ApiGet
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -262,6 +268,11 @@ func (r ApiListFeaturesForApplicationRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListFeaturesForApplicationExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []ListFeaturesForApplication200ResponseInner.
+func (r ApiListFeaturesForApplicationRequest) ExecuteTyped() ([]ListFeaturesForApplication200ResponseInner, *APIResponse, error) {
+	return decodeResponse[[]ListFeaturesForApplication200ResponseInner](r.Execute())
+}
+
 /*
 ListFeaturesForApplication List all Features
 
@@ -304,7 +315,7 @@ func (a *ApplicationFeaturesAPIService) ListFeaturesForApplicationExecute(r ApiL
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationFeaturesAPIService.ListFeaturesForApplication")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/features"
@@ -352,7 +363,7 @@ func (a *ApplicationFeaturesAPIService) ListFeaturesForApplicationExecute(r ApiL
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -367,6 +378,7 @@ func (a *ApplicationFeaturesAPIService) ListFeaturesForApplicationExecute(r ApiL
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -446,6 +458,11 @@ func (r ApiUpdateFeatureForApplicationRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.UpdateFeatureForApplicationExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ListFeaturesForApplication200ResponseInner.
+func (r ApiUpdateFeatureForApplicationRequest) ExecuteTyped() (ListFeaturesForApplication200ResponseInner, *APIResponse, error) {
+	return decodeResponse[ListFeaturesForApplication200ResponseInner](r.Execute())
+}
+
 /*
 UpdateFeatureForApplication Update a Feature
 
@@ -489,7 +506,7 @@ func (a *ApplicationFeaturesAPIService) UpdateFeatureForApplicationExecute(r Api
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationFeaturesAPIService.UpdateFeatureForApplication")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/features/{featureName}"
@@ -541,7 +558,7 @@ func (a *ApplicationFeaturesAPIService) UpdateFeatureForApplicationExecute(r Api
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -556,6 +573,7 @@ func (a *ApplicationFeaturesAPIService) UpdateFeatureForApplicationExecute(r Api
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
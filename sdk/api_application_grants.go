@@ -113,6 +113,11 @@ func (r ApiGetScopeConsentGrantRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetScopeConsentGrantExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into OAuth2ScopeConsentGrant.
+func (r ApiGetScopeConsentGrantRequest) ExecuteTyped() (OAuth2ScopeConsentGrant, *APIResponse, error) {
+	return decodeResponse[OAuth2ScopeConsentGrant](r.Execute())
+}
+
 /*
 GetScopeConsentGrant Retrieve an app Grant
 
@@ -154,7 +159,7 @@ func (a *ApplicationGrantsAPIService) GetScopeConsentGrantExecute(r ApiGetScopeC
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationGrantsAPIService.GetScopeConsentGrant")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/grants/{grantId}"
@@ -206,7 +211,7 @@ func (a *ApplicationGrantsAPIService) GetScopeConsentGrantExecute(r ApiGetScopeC
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -221,6 +226,7 @@ func (a *ApplicationGrantsAPIService) GetScopeConsentGrantExecute(r ApiGetScopeC
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -287,6 +293,11 @@ func (r ApiGrantConsentToScopeRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GrantConsentToScopeExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into OAuth2ScopeConsentGrant.
+func (r ApiGrantConsentToScopeRequest) ExecuteTyped() (OAuth2ScopeConsentGrant, *APIResponse, error) {
+	return decodeResponse[OAuth2ScopeConsentGrant](r.Execute())
+}
+
 /*
 GrantConsentToScope Grant consent to scope
 
@@ -326,7 +337,7 @@ func (a *ApplicationGrantsAPIService) GrantConsentToScopeExecute(r ApiGrantConse
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationGrantsAPIService.GrantConsentToScope")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/grants"
@@ -377,7 +388,7 @@ func (a *ApplicationGrantsAPIService) GrantConsentToScopeExecute(r ApiGrantConse
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -392,6 +403,7 @@ func (a *ApplicationGrantsAPIService) GrantConsentToScopeExecute(r ApiGrantConse
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -471,6 +483,11 @@ func (r ApiListScopeConsentGrantsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListScopeConsentGrantsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []OAuth2ScopeConsentGrant.
+func (r ApiListScopeConsentGrantsRequest) ExecuteTyped() ([]OAuth2ScopeConsentGrant, *APIResponse, error) {
+	return decodeResponse[[]OAuth2ScopeConsentGrant](r.Execute())
+}
+
 /*
 ListScopeConsentGrants List all app Grants
 
@@ -510,7 +527,7 @@ func (a *ApplicationGrantsAPIService) ListScopeConsentGrantsExecute(r ApiListSco
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationGrantsAPIService.ListScopeConsentGrants")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/grants"
@@ -561,7 +578,7 @@ func (a *ApplicationGrantsAPIService) ListScopeConsentGrantsExecute(r ApiListSco
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -576,6 +593,7 @@ func (a *ApplicationGrantsAPIService) ListScopeConsentGrantsExecute(r ApiListSco
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -677,7 +695,7 @@ func (a *ApplicationGrantsAPIService) RevokeScopeConsentGrantExecute(r ApiRevoke
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationGrantsAPIService.RevokeScopeConsentGrant")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/grants/{grantId}"
@@ -726,7 +744,7 @@ func (a *ApplicationGrantsAPIService) RevokeScopeConsentGrantExecute(r ApiRevoke
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -741,6 +759,7 @@ func (a *ApplicationGrantsAPIService) RevokeScopeConsentGrantExecute(r ApiRevoke
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -113,6 +113,11 @@ func (r ApiAssignGroupToApplicationRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.AssignGroupToApplicationExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ApplicationGroupAssignment.
+func (r ApiAssignGroupToApplicationRequest) ExecuteTyped() (ApplicationGroupAssignment, *APIResponse, error) {
+	return decodeResponse[ApplicationGroupAssignment](r.Execute())
+}
+
 /*
 AssignGroupToApplication Assign a Group
 
@@ -154,7 +159,7 @@ func (a *ApplicationGroupsAPIService) AssignGroupToApplicationExecute(r ApiAssig
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationGroupsAPIService.AssignGroupToApplication")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/groups/{groupId}"
@@ -206,7 +211,7 @@ func (a *ApplicationGroupsAPIService) AssignGroupToApplicationExecute(r ApiAssig
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -221,6 +226,7 @@ func (a *ApplicationGroupsAPIService) AssignGroupToApplicationExecute(r ApiAssig
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -300,6 +306,11 @@ func (r ApiGetApplicationGroupAssignmentRequest) Execute() (*APIResponse, error)
 	return r.ApiService.GetApplicationGroupAssignmentExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ApplicationGroupAssignment.
+func (r ApiGetApplicationGroupAssignmentRequest) ExecuteTyped() (ApplicationGroupAssignment, *APIResponse, error) {
+	return decodeResponse[ApplicationGroupAssignment](r.Execute())
+}
+
 /*
 GetApplicationGroupAssignment Retrieve an Assigned Group
 
@@ -341,7 +352,7 @@ func (a *ApplicationGroupsAPIService) GetApplicationGroupAssignmentExecute(r Api
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationGroupsAPIService.GetApplicationGroupAssignment")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/groups/{groupId}"
@@ -393,7 +404,7 @@ func (a *ApplicationGroupsAPIService) GetApplicationGroupAssignmentExecute(r Api
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -408,6 +419,7 @@ func (a *ApplicationGroupsAPIService) GetApplicationGroupAssignmentExecute(r Api
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -494,6 +506,11 @@ func (r ApiListApplicationGroupAssignmentsRequest) Execute() (*APIResponse, erro
 	return r.ApiService.ListApplicationGroupAssignmentsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []ApplicationGroupAssignment.
+func (r ApiListApplicationGroupAssignmentsRequest) ExecuteTyped() ([]ApplicationGroupAssignment, *APIResponse, error) {
+	return decodeResponse[[]ApplicationGroupAssignment](r.Execute())
+}
+
 /*
 ListApplicationGroupAssignments List all Assigned Groups
 
@@ -533,7 +550,7 @@ func (a *ApplicationGroupsAPIService) ListApplicationGroupAssignmentsExecute(r A
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationGroupsAPIService.ListApplicationGroupAssignments")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/groups"
@@ -593,7 +610,7 @@ func (a *ApplicationGroupsAPIService) ListApplicationGroupAssignmentsExecute(r A
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -608,6 +625,7 @@ func (a *ApplicationGroupsAPIService) ListApplicationGroupAssignmentsExecute(r A
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -709,7 +727,7 @@ func (a *ApplicationGroupsAPIService) UnassignApplicationFromGroupExecute(r ApiU
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationGroupsAPIService.UnassignApplicationFromGroup")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/groups/{groupId}"
@@ -758,7 +776,7 @@ func (a *ApplicationGroupsAPIService) UnassignApplicationFromGroupExecute(r ApiU
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -773,6 +791,7 @@ func (a *ApplicationGroupsAPIService) UnassignApplicationFromGroupExecute(r ApiU
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
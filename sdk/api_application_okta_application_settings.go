@@ -74,6 +74,11 @@ func (r ApiGetFirstPartyAppSettingsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetFirstPartyAppSettingsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into AdminConsoleSettings.
+func (r ApiGetFirstPartyAppSettingsRequest) ExecuteTyped() (AdminConsoleSettings, *APIResponse, error) {
+	return decodeResponse[AdminConsoleSettings](r.Execute())
+}
+
 /*
 GetFirstPartyAppSettings Retrieve the Okta app settings
 
@@ -113,7 +118,7 @@ func (a *ApplicationOktaApplicationSettingsAPIService) GetFirstPartyAppSettingsE
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationOktaApplicationSettingsAPIService.GetFirstPartyAppSettings")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/first-party-app-settings/{appName}"
@@ -161,7 +166,7 @@ func (a *ApplicationOktaApplicationSettingsAPIService) GetFirstPartyAppSettingsE
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -176,6 +181,7 @@ func (a *ApplicationOktaApplicationSettingsAPIService) GetFirstPartyAppSettingsE
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -230,6 +236,11 @@ func (r ApiReplaceFirstPartyAppSettingsRequest) Execute() (*APIResponse, error)
 	return r.ApiService.ReplaceFirstPartyAppSettingsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into AdminConsoleSettings.
+func (r ApiReplaceFirstPartyAppSettingsRequest) ExecuteTyped() (AdminConsoleSettings, *APIResponse, error) {
+	return decodeResponse[AdminConsoleSettings](r.Execute())
+}
+
 /*
 ReplaceFirstPartyAppSettings Replace the Okta app settings
 
@@ -269,7 +280,7 @@ func (a *ApplicationOktaApplicationSettingsAPIService) ReplaceFirstPartyAppSetti
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationOktaApplicationSettingsAPIService.ReplaceFirstPartyAppSettings")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/first-party-app-settings/{appName}"
@@ -320,7 +331,7 @@ func (a *ApplicationOktaApplicationSettingsAPIService) ReplaceFirstPartyAppSetti
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -335,6 +346,7 @@ func (a *ApplicationOktaApplicationSettingsAPIService) ReplaceFirstPartyAppSetti
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
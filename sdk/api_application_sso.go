@@ -59,6 +59,11 @@ func (r ApiPreviewSAMLmetadataForApplicationRequest) Execute() (*APIResponse, er
 	return r.ApiService.PreviewSAMLmetadataForApplicationExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into string.
+func (r ApiPreviewSAMLmetadataForApplicationRequest) ExecuteTyped() (string, *APIResponse, error) {
+	return decodeResponse[string](r.Execute())
+}
+
 /*
 PreviewSAMLmetadataForApplication Preview the application SAML metadata
 
@@ -98,7 +103,7 @@ func (a *ApplicationSSOAPIService) PreviewSAMLmetadataForApplicationExecute(r Ap
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationSSOAPIService.PreviewSAMLmetadataForApplication")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/sso/saml/metadata"
@@ -146,7 +151,7 @@ func (a *ApplicationSSOAPIService) PreviewSAMLmetadataForApplicationExecute(r Ap
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -161,6 +166,7 @@ func (a *ApplicationSSOAPIService) PreviewSAMLmetadataForApplicationExecute(r Ap
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
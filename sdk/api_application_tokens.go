@@ -116,6 +116,11 @@ func (r ApiGetOAuth2TokenForApplicationRequest) Execute() (*APIResponse, error)
 	return r.ApiService.GetOAuth2TokenForApplicationExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into OAuth2RefreshToken.
+func (r ApiGetOAuth2TokenForApplicationRequest) ExecuteTyped() (OAuth2RefreshToken, *APIResponse, error) {
+	return decodeResponse[OAuth2RefreshToken](r.Execute())
+}
+
 /*
 GetOAuth2TokenForApplication Retrieve an application Token
 
@@ -157,7 +162,7 @@ func (a *ApplicationTokensAPIService) GetOAuth2TokenForApplicationExecute(r ApiG
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationTokensAPIService.GetOAuth2TokenForApplication")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/tokens/{tokenId}"
@@ -209,7 +214,7 @@ func (a *ApplicationTokensAPIService) GetOAuth2TokenForApplicationExecute(r ApiG
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -224,6 +229,7 @@ func (a *ApplicationTokensAPIService) GetOAuth2TokenForApplicationExecute(r ApiG
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -305,6 +311,11 @@ func (r ApiListOAuth2TokensForApplicationRequest) Execute() (*APIResponse, error
 	return r.ApiService.ListOAuth2TokensForApplicationExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []OAuth2RefreshToken.
+func (r ApiListOAuth2TokensForApplicationRequest) ExecuteTyped() ([]OAuth2RefreshToken, *APIResponse, error) {
+	return decodeResponse[[]OAuth2RefreshToken](r.Execute())
+}
+
 /*
 ListOAuth2TokensForApplication List all application refresh Tokens
 
@@ -348,7 +359,7 @@ func (a *ApplicationTokensAPIService) ListOAuth2TokensForApplicationExecute(r Ap
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationTokensAPIService.ListOAuth2TokensForApplication")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/tokens"
@@ -405,7 +416,7 @@ func (a *ApplicationTokensAPIService) ListOAuth2TokensForApplicationExecute(r Ap
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -420,6 +431,7 @@ func (a *ApplicationTokensAPIService) ListOAuth2TokensForApplicationExecute(r Ap
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -521,7 +533,7 @@ func (a *ApplicationTokensAPIService) RevokeOAuth2TokenForApplicationExecute(r A
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationTokensAPIService.RevokeOAuth2TokenForApplication")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/tokens/{tokenId}"
@@ -570,7 +582,7 @@ func (a *ApplicationTokensAPIService) RevokeOAuth2TokenForApplicationExecute(r A
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -585,6 +597,7 @@ func (a *ApplicationTokensAPIService) RevokeOAuth2TokenForApplicationExecute(r A
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -683,7 +696,7 @@ func (a *ApplicationTokensAPIService) RevokeOAuth2TokensForApplicationExecute(r
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationTokensAPIService.RevokeOAuth2TokensForApplication")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/tokens"
@@ -731,7 +744,7 @@ func (a *ApplicationTokensAPIService) RevokeOAuth2TokensForApplicationExecute(r
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -746,6 +759,7 @@ func (a *ApplicationTokensAPIService) RevokeOAuth2TokensForApplicationExecute(r
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
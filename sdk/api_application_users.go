@@ -143,6 +143,11 @@ func (r ApiAssignUserToApplicationRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.AssignUserToApplicationExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into AppUser.
+func (r ApiAssignUserToApplicationRequest) ExecuteTyped() (AppUser, *APIResponse, error) {
+	return decodeResponse[AppUser](r.Execute())
+}
+
 /*
 AssignUserToApplication Assign an Application User
 
@@ -193,7 +198,7 @@ func (a *ApplicationUsersAPIService) AssignUserToApplicationExecute(r ApiAssignU
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationUsersAPIService.AssignUserToApplication")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/users"
@@ -244,7 +249,7 @@ func (a *ApplicationUsersAPIService) AssignUserToApplicationExecute(r ApiAssignU
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -259,6 +264,7 @@ func (a *ApplicationUsersAPIService) AssignUserToApplicationExecute(r ApiAssignU
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -339,6 +345,11 @@ func (r ApiGetApplicationUserRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetApplicationUserExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into AppUser.
+func (r ApiGetApplicationUserRequest) ExecuteTyped() (AppUser, *APIResponse, error) {
+	return decodeResponse[AppUser](r.Execute())
+}
+
 /*
 GetApplicationUser Retrieve an Application User
 
@@ -380,7 +391,7 @@ func (a *ApplicationUsersAPIService) GetApplicationUserExecute(r ApiGetApplicati
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationUsersAPIService.GetApplicationUser")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/users/{userId}"
@@ -432,7 +443,7 @@ func (a *ApplicationUsersAPIService) GetApplicationUserExecute(r ApiGetApplicati
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -447,6 +458,7 @@ func (a *ApplicationUsersAPIService) GetApplicationUserExecute(r ApiGetApplicati
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -535,6 +547,11 @@ func (r ApiListApplicationUsersRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListApplicationUsersExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []AppUser.
+func (r ApiListApplicationUsersRequest) ExecuteTyped() ([]AppUser, *APIResponse, error) {
+	return decodeResponse[[]AppUser](r.Execute())
+}
+
 /*
 ListApplicationUsers List all Application Users
 
@@ -574,7 +591,7 @@ func (a *ApplicationUsersAPIService) ListApplicationUsersExecute(r ApiListApplic
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationUsersAPIService.ListApplicationUsers")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/users"
@@ -634,7 +651,7 @@ func (a *ApplicationUsersAPIService) ListApplicationUsersExecute(r ApiListApplic
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -649,6 +666,7 @@ func (a *ApplicationUsersAPIService) ListApplicationUsersExecute(r ApiListApplic
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -762,7 +780,7 @@ func (a *ApplicationUsersAPIService) UnassignUserFromApplicationExecute(r ApiUna
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationUsersAPIService.UnassignUserFromApplication")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/users/{userId}"
@@ -814,7 +832,7 @@ func (a *ApplicationUsersAPIService) UnassignUserFromApplicationExecute(r ApiUna
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -829,6 +847,7 @@ func (a *ApplicationUsersAPIService) UnassignUserFromApplicationExecute(r ApiUna
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -896,6 +915,11 @@ func (r ApiUpdateApplicationUserRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.UpdateApplicationUserExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into AppUser.
+func (r ApiUpdateApplicationUserRequest) ExecuteTyped() (AppUser, *APIResponse, error) {
+	return decodeResponse[AppUser](r.Execute())
+}
+
 /*
 UpdateApplicationUser Update an Application User
 
@@ -937,7 +961,7 @@ func (a *ApplicationUsersAPIService) UpdateApplicationUserExecute(r ApiUpdateApp
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationUsersAPIService.UpdateApplicationUser")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/users/{userId}"
@@ -989,7 +1013,7 @@ func (a *ApplicationUsersAPIService) UpdateApplicationUserExecute(r ApiUpdateApp
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1004,6 +1028,7 @@ func (a *ApplicationUsersAPIService) UpdateApplicationUserExecute(r ApiUpdateApp
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
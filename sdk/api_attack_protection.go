@@ -98,6 +98,11 @@ func (r ApiGetAuthenticatorSettingsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetAuthenticatorSettingsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []AttackProtectionAuthenticatorSettings.
+func (r ApiGetAuthenticatorSettingsRequest) ExecuteTyped() ([]AttackProtectionAuthenticatorSettings, *APIResponse, error) {
+	return decodeResponse[[]AttackProtectionAuthenticatorSettings](r.Execute())
+}
+
 /*
 GetAuthenticatorSettings Retrieve the Authenticator Settings
 
@@ -135,7 +140,7 @@ func (a *AttackProtectionAPIService) GetAuthenticatorSettingsExecute(r ApiGetAut
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AttackProtectionAPIService.GetAuthenticatorSettings")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/attack-protection/api/v1/authenticator-settings"
@@ -182,7 +187,7 @@ func (a *AttackProtectionAPIService) GetAuthenticatorSettingsExecute(r ApiGetAut
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -197,6 +202,7 @@ func (a *AttackProtectionAPIService) GetAuthenticatorSettingsExecute(r ApiGetAut
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -244,6 +250,11 @@ func (r ApiGetUserLockoutSettingsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetUserLockoutSettingsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []UserLockoutSettings.
+func (r ApiGetUserLockoutSettingsRequest) ExecuteTyped() ([]UserLockoutSettings, *APIResponse, error) {
+	return decodeResponse[[]UserLockoutSettings](r.Execute())
+}
+
 /*
 GetUserLockoutSettings Retrieve the User Lockout Settings
 
@@ -281,7 +292,7 @@ func (a *AttackProtectionAPIService) GetUserLockoutSettingsExecute(r ApiGetUserL
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AttackProtectionAPIService.GetUserLockoutSettings")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/attack-protection/api/v1/user-lockout-settings"
@@ -328,7 +339,7 @@ func (a *AttackProtectionAPIService) GetUserLockoutSettingsExecute(r ApiGetUserL
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -343,6 +354,7 @@ func (a *AttackProtectionAPIService) GetUserLockoutSettingsExecute(r ApiGetUserL
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -396,6 +408,11 @@ func (r ApiReplaceAuthenticatorSettingsRequest) Execute() (*APIResponse, error)
 	return r.ApiService.ReplaceAuthenticatorSettingsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into AttackProtectionAuthenticatorSettings.
+func (r ApiReplaceAuthenticatorSettingsRequest) ExecuteTyped() (AttackProtectionAuthenticatorSettings, *APIResponse, error) {
+	return decodeResponse[AttackProtectionAuthenticatorSettings](r.Execute())
+}
+
 /*
 ReplaceAuthenticatorSettings Replace the Authenticator Settings
 
@@ -433,7 +450,7 @@ func (a *AttackProtectionAPIService) ReplaceAuthenticatorSettingsExecute(r ApiRe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AttackProtectionAPIService.ReplaceAuthenticatorSettings")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/attack-protection/api/v1/authenticator-settings"
@@ -483,7 +500,7 @@ func (a *AttackProtectionAPIService) ReplaceAuthenticatorSettingsExecute(r ApiRe
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -498,6 +515,7 @@ func (a *AttackProtectionAPIService) ReplaceAuthenticatorSettingsExecute(r ApiRe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -563,6 +581,11 @@ func (r ApiReplaceUserLockoutSettingsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ReplaceUserLockoutSettingsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into UserLockoutSettings.
+func (r ApiReplaceUserLockoutSettingsRequest) ExecuteTyped() (UserLockoutSettings, *APIResponse, error) {
+	return decodeResponse[UserLockoutSettings](r.Execute())
+}
+
 /*
 ReplaceUserLockoutSettings Replace the User Lockout Settings
 
@@ -600,7 +623,7 @@ func (a *AttackProtectionAPIService) ReplaceUserLockoutSettingsExecute(r ApiRepl
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AttackProtectionAPIService.ReplaceUserLockoutSettings")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/attack-protection/api/v1/user-lockout-settings"
@@ -650,7 +673,7 @@ func (a *AttackProtectionAPIService) ReplaceUserLockoutSettingsExecute(r ApiRepl
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -665,6 +688,7 @@ func (a *AttackProtectionAPIService) ReplaceUserLockoutSettingsExecute(r ApiRepl
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
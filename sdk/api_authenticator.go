@@ -225,6 +225,11 @@ func (r ApiActivateAuthenticatorRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ActivateAuthenticatorExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into Authenticator.
+func (r ApiActivateAuthenticatorRequest) ExecuteTyped() (Authenticator, *APIResponse, error) {
+	return decodeResponse[Authenticator](r.Execute())
+}
+
 /*
 ActivateAuthenticator Activate an Authenticator
 
@@ -264,7 +269,7 @@ func (a *AuthenticatorAPIService) ActivateAuthenticatorExecute(r ApiActivateAuth
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthenticatorAPIService.ActivateAuthenticator")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authenticators/{authenticatorId}/lifecycle/activate"
@@ -312,7 +317,7 @@ func (a *AuthenticatorAPIService) ActivateAuthenticatorExecute(r ApiActivateAuth
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -327,6 +332,7 @@ func (a *AuthenticatorAPIService) ActivateAuthenticatorExecute(r ApiActivateAuth
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -388,6 +394,11 @@ func (r ApiActivateAuthenticatorMethodRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ActivateAuthenticatorMethodExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ListAuthenticatorMethods200ResponseInner.
+func (r ApiActivateAuthenticatorMethodRequest) ExecuteTyped() (ListAuthenticatorMethods200ResponseInner, *APIResponse, error) {
+	return decodeResponse[ListAuthenticatorMethods200ResponseInner](r.Execute())
+}
+
 /*
 ActivateAuthenticatorMethod Activate an Authenticator Method
 
@@ -429,7 +440,7 @@ func (a *AuthenticatorAPIService) ActivateAuthenticatorMethodExecute(r ApiActiva
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthenticatorAPIService.ActivateAuthenticatorMethod")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authenticators/{authenticatorId}/methods/{methodType}/lifecycle/activate"
@@ -478,7 +489,7 @@ func (a *AuthenticatorAPIService) ActivateAuthenticatorMethodExecute(r ApiActiva
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -493,6 +504,7 @@ func (a *AuthenticatorAPIService) ActivateAuthenticatorMethodExecute(r ApiActiva
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -565,6 +577,11 @@ func (r ApiCreateAuthenticatorRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.CreateAuthenticatorExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into Authenticator.
+func (r ApiCreateAuthenticatorRequest) ExecuteTyped() (Authenticator, *APIResponse, error) {
+	return decodeResponse[Authenticator](r.Execute())
+}
+
 /*
 CreateAuthenticator Create an Authenticator
 
@@ -602,7 +619,7 @@ func (a *AuthenticatorAPIService) CreateAuthenticatorExecute(r ApiCreateAuthenti
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthenticatorAPIService.CreateAuthenticator")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authenticators"
@@ -655,7 +672,7 @@ func (a *AuthenticatorAPIService) CreateAuthenticatorExecute(r ApiCreateAuthenti
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -670,6 +687,7 @@ func (a *AuthenticatorAPIService) CreateAuthenticatorExecute(r ApiCreateAuthenti
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -730,6 +748,11 @@ func (r ApiDeactivateAuthenticatorRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.DeactivateAuthenticatorExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into Authenticator.
+func (r ApiDeactivateAuthenticatorRequest) ExecuteTyped() (Authenticator, *APIResponse, error) {
+	return decodeResponse[Authenticator](r.Execute())
+}
+
 /*
 DeactivateAuthenticator Deactivate an Authenticator
 
@@ -769,7 +792,7 @@ func (a *AuthenticatorAPIService) DeactivateAuthenticatorExecute(r ApiDeactivate
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthenticatorAPIService.DeactivateAuthenticator")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authenticators/{authenticatorId}/lifecycle/deactivate"
@@ -817,7 +840,7 @@ func (a *AuthenticatorAPIService) DeactivateAuthenticatorExecute(r ApiDeactivate
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -832,6 +855,7 @@ func (a *AuthenticatorAPIService) DeactivateAuthenticatorExecute(r ApiDeactivate
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -893,6 +917,11 @@ func (r ApiDeactivateAuthenticatorMethodRequest) Execute() (*APIResponse, error)
 	return r.ApiService.DeactivateAuthenticatorMethodExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ListAuthenticatorMethods200ResponseInner.
+func (r ApiDeactivateAuthenticatorMethodRequest) ExecuteTyped() (ListAuthenticatorMethods200ResponseInner, *APIResponse, error) {
+	return decodeResponse[ListAuthenticatorMethods200ResponseInner](r.Execute())
+}
+
 /*
 DeactivateAuthenticatorMethod Deactivate an Authenticator Method
 
@@ -934,7 +963,7 @@ func (a *AuthenticatorAPIService) DeactivateAuthenticatorMethodExecute(r ApiDeac
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthenticatorAPIService.DeactivateAuthenticatorMethod")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authenticators/{authenticatorId}/methods/{methodType}/lifecycle/deactivate"
@@ -983,7 +1012,7 @@ func (a *AuthenticatorAPIService) DeactivateAuthenticatorMethodExecute(r ApiDeac
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -998,6 +1027,7 @@ func (a *AuthenticatorAPIService) DeactivateAuthenticatorMethodExecute(r ApiDeac
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1065,6 +1095,11 @@ func (r ApiGetAuthenticatorRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetAuthenticatorExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into Authenticator.
+func (r ApiGetAuthenticatorRequest) ExecuteTyped() (Authenticator, *APIResponse, error) {
+	return decodeResponse[Authenticator](r.Execute())
+}
+
 /*
 GetAuthenticator Retrieve an Authenticator
 
@@ -1104,7 +1139,7 @@ func (a *AuthenticatorAPIService) GetAuthenticatorExecute(r ApiGetAuthenticatorR
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthenticatorAPIService.GetAuthenticator")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authenticators/{authenticatorId}"
@@ -1155,7 +1190,7 @@ func (a *AuthenticatorAPIService) GetAuthenticatorExecute(r ApiGetAuthenticatorR
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1170,6 +1205,7 @@ func (a *AuthenticatorAPIService) GetAuthenticatorExecute(r ApiGetAuthenticatorR
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1231,6 +1267,11 @@ func (r ApiGetAuthenticatorMethodRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetAuthenticatorMethodExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ListAuthenticatorMethods200ResponseInner.
+func (r ApiGetAuthenticatorMethodRequest) ExecuteTyped() (ListAuthenticatorMethods200ResponseInner, *APIResponse, error) {
+	return decodeResponse[ListAuthenticatorMethods200ResponseInner](r.Execute())
+}
+
 /*
 GetAuthenticatorMethod Retrieve a Method
 
@@ -1272,7 +1313,7 @@ func (a *AuthenticatorAPIService) GetAuthenticatorMethodExecute(r ApiGetAuthenti
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthenticatorAPIService.GetAuthenticatorMethod")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authenticators/{authenticatorId}/methods/{methodType}"
@@ -1321,7 +1362,7 @@ func (a *AuthenticatorAPIService) GetAuthenticatorMethodExecute(r ApiGetAuthenti
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1336,6 +1377,7 @@ func (a *AuthenticatorAPIService) GetAuthenticatorMethodExecute(r ApiGetAuthenti
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1402,6 +1444,11 @@ func (r ApiGetWellKnownAppAuthenticatorConfigurationRequest) Execute() (*APIResp
 	return r.ApiService.GetWellKnownAppAuthenticatorConfigurationExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []WellKnownAppAuthenticatorConfiguration.
+func (r ApiGetWellKnownAppAuthenticatorConfigurationRequest) ExecuteTyped() ([]WellKnownAppAuthenticatorConfiguration, *APIResponse, error) {
+	return decodeResponse[[]WellKnownAppAuthenticatorConfiguration](r.Execute())
+}
+
 /*
 GetWellKnownAppAuthenticatorConfiguration Retrieve the Well-Known App Authenticator Configuration
 
@@ -1439,7 +1486,7 @@ func (a *AuthenticatorAPIService) GetWellKnownAppAuthenticatorConfigurationExecu
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthenticatorAPIService.GetWellKnownAppAuthenticatorConfiguration")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/.well-known/app-authenticator-configuration"
@@ -1473,7 +1520,7 @@ func (a *AuthenticatorAPIService) GetWellKnownAppAuthenticatorConfigurationExecu
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1488,6 +1535,7 @@ func (a *AuthenticatorAPIService) GetWellKnownAppAuthenticatorConfigurationExecu
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1536,6 +1584,11 @@ func (r ApiListAuthenticatorMethodsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListAuthenticatorMethodsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []ListAuthenticatorMethods200ResponseInner.
+func (r ApiListAuthenticatorMethodsRequest) ExecuteTyped() ([]ListAuthenticatorMethods200ResponseInner, *APIResponse, error) {
+	return decodeResponse[[]ListAuthenticatorMethods200ResponseInner](r.Execute())
+}
+
 /*
 ListAuthenticatorMethods List all Methods of an Authenticator
 
@@ -1575,7 +1628,7 @@ func (a *AuthenticatorAPIService) ListAuthenticatorMethodsExecute(r ApiListAuthe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthenticatorAPIService.ListAuthenticatorMethods")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authenticators/{authenticatorId}/methods"
@@ -1623,7 +1676,7 @@ func (a *AuthenticatorAPIService) ListAuthenticatorMethodsExecute(r ApiListAuthe
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1638,6 +1691,7 @@ func (a *AuthenticatorAPIService) ListAuthenticatorMethodsExecute(r ApiListAuthe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1704,6 +1758,11 @@ func (r ApiListAuthenticatorsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListAuthenticatorsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []Authenticator.
+func (r ApiListAuthenticatorsRequest) ExecuteTyped() ([]Authenticator, *APIResponse, error) {
+	return decodeResponse[[]Authenticator](r.Execute())
+}
+
 /*
 ListAuthenticators List all Authenticators
 
@@ -1741,7 +1800,7 @@ func (a *AuthenticatorAPIService) ListAuthenticatorsExecute(r ApiListAuthenticat
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthenticatorAPIService.ListAuthenticators")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authenticators"
@@ -1791,7 +1850,7 @@ func (a *AuthenticatorAPIService) ListAuthenticatorsExecute(r ApiListAuthenticat
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1806,6 +1865,7 @@ func (a *AuthenticatorAPIService) ListAuthenticatorsExecute(r ApiListAuthenticat
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1860,6 +1920,11 @@ func (r ApiReplaceAuthenticatorRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ReplaceAuthenticatorExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into Authenticator.
+func (r ApiReplaceAuthenticatorRequest) ExecuteTyped() (Authenticator, *APIResponse, error) {
+	return decodeResponse[Authenticator](r.Execute())
+}
+
 /*
 ReplaceAuthenticator Replace an Authenticator
 
@@ -1899,7 +1964,7 @@ func (a *AuthenticatorAPIService) ReplaceAuthenticatorExecute(r ApiReplaceAuthen
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthenticatorAPIService.ReplaceAuthenticator")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authenticators/{authenticatorId}"
@@ -1950,7 +2015,7 @@ func (a *AuthenticatorAPIService) ReplaceAuthenticatorExecute(r ApiReplaceAuthen
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1965,6 +2030,7 @@ func (a *AuthenticatorAPIService) ReplaceAuthenticatorExecute(r ApiReplaceAuthen
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -2044,6 +2110,11 @@ func (r ApiReplaceAuthenticatorMethodRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ReplaceAuthenticatorMethodExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ListAuthenticatorMethods200ResponseInner.
+func (r ApiReplaceAuthenticatorMethodRequest) ExecuteTyped() (ListAuthenticatorMethods200ResponseInner, *APIResponse, error) {
+	return decodeResponse[ListAuthenticatorMethods200ResponseInner](r.Execute())
+}
+
 /*
 ReplaceAuthenticatorMethod Replace a Method
 
@@ -2085,7 +2156,7 @@ func (a *AuthenticatorAPIService) ReplaceAuthenticatorMethodExecute(r ApiReplace
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthenticatorAPIService.ReplaceAuthenticatorMethod")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authenticators/{authenticatorId}/methods/{methodType}"
@@ -2137,7 +2208,7 @@ func (a *AuthenticatorAPIService) ReplaceAuthenticatorMethodExecute(r ApiReplace
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2152,6 +2223,7 @@ func (a *AuthenticatorAPIService) ReplaceAuthenticatorMethodExecute(r ApiReplace
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
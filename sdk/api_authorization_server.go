@@ -182,7 +182,7 @@ func (a *AuthorizationServerAPIService) ActivateAuthorizationServerExecute(r Api
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerAPIService.ActivateAuthorizationServer")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers/{authServerId}/lifecycle/activate"
@@ -230,7 +230,7 @@ func (a *AuthorizationServerAPIService) ActivateAuthorizationServerExecute(r Api
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -245,6 +245,7 @@ func (a *AuthorizationServerAPIService) ActivateAuthorizationServerExecute(r Api
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -310,6 +311,11 @@ func (r ApiCreateAuthorizationServerRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.CreateAuthorizationServerExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into AuthorizationServer.
+func (r ApiCreateAuthorizationServerRequest) ExecuteTyped() (AuthorizationServer, *APIResponse, error) {
+	return decodeResponse[AuthorizationServer](r.Execute())
+}
+
 /*
 CreateAuthorizationServer Create an Authorization Server
 
@@ -347,7 +353,7 @@ func (a *AuthorizationServerAPIService) CreateAuthorizationServerExecute(r ApiCr
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerAPIService.CreateAuthorizationServer")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers"
@@ -397,7 +403,7 @@ func (a *AuthorizationServerAPIService) CreateAuthorizationServerExecute(r ApiCr
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -412,6 +418,7 @@ func (a *AuthorizationServerAPIService) CreateAuthorizationServerExecute(r ApiCr
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -510,7 +517,7 @@ func (a *AuthorizationServerAPIService) DeactivateAuthorizationServerExecute(r A
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerAPIService.DeactivateAuthorizationServer")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers/{authServerId}/lifecycle/deactivate"
@@ -558,7 +565,7 @@ func (a *AuthorizationServerAPIService) DeactivateAuthorizationServerExecute(r A
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -573,6 +580,7 @@ func (a *AuthorizationServerAPIService) DeactivateAuthorizationServerExecute(r A
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -671,7 +679,7 @@ func (a *AuthorizationServerAPIService) DeleteAuthorizationServerExecute(r ApiDe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerAPIService.DeleteAuthorizationServer")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers/{authServerId}"
@@ -719,7 +727,7 @@ func (a *AuthorizationServerAPIService) DeleteAuthorizationServerExecute(r ApiDe
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -734,6 +742,7 @@ func (a *AuthorizationServerAPIService) DeleteAuthorizationServerExecute(r ApiDe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -794,6 +803,11 @@ func (r ApiGetAuthorizationServerRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetAuthorizationServerExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into AuthorizationServer.
+func (r ApiGetAuthorizationServerRequest) ExecuteTyped() (AuthorizationServer, *APIResponse, error) {
+	return decodeResponse[AuthorizationServer](r.Execute())
+}
+
 /*
 GetAuthorizationServer Retrieve an Authorization Server
 
@@ -833,7 +847,7 @@ func (a *AuthorizationServerAPIService) GetAuthorizationServerExecute(r ApiGetAu
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerAPIService.GetAuthorizationServer")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers/{authServerId}"
@@ -881,7 +895,7 @@ func (a *AuthorizationServerAPIService) GetAuthorizationServerExecute(r ApiGetAu
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -896,6 +910,7 @@ func (a *AuthorizationServerAPIService) GetAuthorizationServerExecute(r ApiGetAu
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -976,6 +991,11 @@ func (r ApiListAuthorizationServersRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListAuthorizationServersExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []AuthorizationServer.
+func (r ApiListAuthorizationServersRequest) ExecuteTyped() ([]AuthorizationServer, *APIResponse, error) {
+	return decodeResponse[[]AuthorizationServer](r.Execute())
+}
+
 /*
 ListAuthorizationServers List all Authorization Servers
 
@@ -1013,7 +1033,7 @@ func (a *AuthorizationServerAPIService) ListAuthorizationServersExecute(r ApiLis
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerAPIService.ListAuthorizationServers")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers"
@@ -1069,7 +1089,7 @@ func (a *AuthorizationServerAPIService) ListAuthorizationServersExecute(r ApiLis
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1084,6 +1104,7 @@ func (a *AuthorizationServerAPIService) ListAuthorizationServersExecute(r ApiLis
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1138,6 +1159,11 @@ func (r ApiReplaceAuthorizationServerRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ReplaceAuthorizationServerExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into AuthorizationServer.
+func (r ApiReplaceAuthorizationServerRequest) ExecuteTyped() (AuthorizationServer, *APIResponse, error) {
+	return decodeResponse[AuthorizationServer](r.Execute())
+}
+
 /*
 ReplaceAuthorizationServer Replace an Authorization Server
 
@@ -1177,7 +1203,7 @@ func (a *AuthorizationServerAPIService) ReplaceAuthorizationServerExecute(r ApiR
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerAPIService.ReplaceAuthorizationServer")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers/{authServerId}"
@@ -1228,7 +1254,7 @@ func (a *AuthorizationServerAPIService) ReplaceAuthorizationServerExecute(r ApiR
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1243,6 +1269,7 @@ func (a *AuthorizationServerAPIService) ReplaceAuthorizationServerExecute(r ApiR
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -95,6 +95,11 @@ func (r ApiCreateAssociatedServersRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.CreateAssociatedServersExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []AuthorizationServer.
+func (r ApiCreateAssociatedServersRequest) ExecuteTyped() ([]AuthorizationServer, *APIResponse, error) {
+	return decodeResponse[[]AuthorizationServer](r.Execute())
+}
+
 /*
 CreateAssociatedServers Create an associated Authorization Server
 
@@ -134,7 +139,7 @@ func (a *AuthorizationServerAssocAPIService) CreateAssociatedServersExecute(r Ap
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerAssocAPIService.CreateAssociatedServers")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers/{authServerId}/associatedServers"
@@ -185,7 +190,7 @@ func (a *AuthorizationServerAssocAPIService) CreateAssociatedServersExecute(r Ap
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -200,6 +205,7 @@ func (a *AuthorizationServerAssocAPIService) CreateAssociatedServersExecute(r Ap
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -313,7 +319,7 @@ func (a *AuthorizationServerAssocAPIService) DeleteAssociatedServerExecute(r Api
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerAssocAPIService.DeleteAssociatedServer")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers/{authServerId}/associatedServers/{associatedServerId}"
@@ -362,7 +368,7 @@ func (a *AuthorizationServerAssocAPIService) DeleteAssociatedServerExecute(r Api
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -377,6 +383,7 @@ func (a *AuthorizationServerAssocAPIService) DeleteAssociatedServerExecute(r Api
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -465,6 +472,11 @@ func (r ApiListAssociatedServersByTrustedTypeRequest) Execute() (*APIResponse, e
 	return r.ApiService.ListAssociatedServersByTrustedTypeExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []AuthorizationServer.
+func (r ApiListAssociatedServersByTrustedTypeRequest) ExecuteTyped() ([]AuthorizationServer, *APIResponse, error) {
+	return decodeResponse[[]AuthorizationServer](r.Execute())
+}
+
 /*
 ListAssociatedServersByTrustedType List all associated Authorization Servers
 
@@ -504,7 +516,7 @@ func (a *AuthorizationServerAssocAPIService) ListAssociatedServersByTrustedTypeE
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerAssocAPIService.ListAssociatedServersByTrustedType")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers/{authServerId}/associatedServers"
@@ -564,7 +576,7 @@ func (a *AuthorizationServerAssocAPIService) ListAssociatedServersByTrustedTypeE
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -579,6 +591,7 @@ func (a *AuthorizationServerAssocAPIService) ListAssociatedServersByTrustedTypeE
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
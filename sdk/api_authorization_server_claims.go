@@ -127,6 +127,11 @@ func (r ApiCreateOAuth2ClaimRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.CreateOAuth2ClaimExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into OAuth2Claim.
+func (r ApiCreateOAuth2ClaimRequest) ExecuteTyped() (OAuth2Claim, *APIResponse, error) {
+	return decodeResponse[OAuth2Claim](r.Execute())
+}
+
 /*
 CreateOAuth2Claim Create a custom token Claim
 
@@ -166,7 +171,7 @@ func (a *AuthorizationServerClaimsAPIService) CreateOAuth2ClaimExecute(r ApiCrea
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerClaimsAPIService.CreateOAuth2Claim")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers/{authServerId}/claims"
@@ -217,7 +222,7 @@ func (a *AuthorizationServerClaimsAPIService) CreateOAuth2ClaimExecute(r ApiCrea
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -232,6 +237,7 @@ func (a *AuthorizationServerClaimsAPIService) CreateOAuth2ClaimExecute(r ApiCrea
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -345,7 +351,7 @@ func (a *AuthorizationServerClaimsAPIService) DeleteOAuth2ClaimExecute(r ApiDele
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerClaimsAPIService.DeleteOAuth2Claim")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers/{authServerId}/claims/{claimId}"
@@ -394,7 +400,7 @@ func (a *AuthorizationServerClaimsAPIService) DeleteOAuth2ClaimExecute(r ApiDele
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -409,6 +415,7 @@ func (a *AuthorizationServerClaimsAPIService) DeleteOAuth2ClaimExecute(r ApiDele
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -470,6 +477,11 @@ func (r ApiGetOAuth2ClaimRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetOAuth2ClaimExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into OAuth2Claim.
+func (r ApiGetOAuth2ClaimRequest) ExecuteTyped() (OAuth2Claim, *APIResponse, error) {
+	return decodeResponse[OAuth2Claim](r.Execute())
+}
+
 /*
 GetOAuth2Claim Retrieve a custom token Claim
 
@@ -511,7 +523,7 @@ func (a *AuthorizationServerClaimsAPIService) GetOAuth2ClaimExecute(r ApiGetOAut
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerClaimsAPIService.GetOAuth2Claim")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers/{authServerId}/claims/{claimId}"
@@ -560,7 +572,7 @@ func (a *AuthorizationServerClaimsAPIService) GetOAuth2ClaimExecute(r ApiGetOAut
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -575,6 +587,7 @@ func (a *AuthorizationServerClaimsAPIService) GetOAuth2ClaimExecute(r ApiGetOAut
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -635,6 +648,11 @@ func (r ApiListOAuth2ClaimsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListOAuth2ClaimsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []OAuth2Claim.
+func (r ApiListOAuth2ClaimsRequest) ExecuteTyped() ([]OAuth2Claim, *APIResponse, error) {
+	return decodeResponse[[]OAuth2Claim](r.Execute())
+}
+
 /*
 ListOAuth2Claims List all custom token Claims
 
@@ -674,7 +692,7 @@ func (a *AuthorizationServerClaimsAPIService) ListOAuth2ClaimsExecute(r ApiListO
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerClaimsAPIService.ListOAuth2Claims")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers/{authServerId}/claims"
@@ -722,7 +740,7 @@ func (a *AuthorizationServerClaimsAPIService) ListOAuth2ClaimsExecute(r ApiListO
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -737,6 +755,7 @@ func (a *AuthorizationServerClaimsAPIService) ListOAuth2ClaimsExecute(r ApiListO
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -804,6 +823,11 @@ func (r ApiReplaceOAuth2ClaimRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ReplaceOAuth2ClaimExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into OAuth2Claim.
+func (r ApiReplaceOAuth2ClaimRequest) ExecuteTyped() (OAuth2Claim, *APIResponse, error) {
+	return decodeResponse[OAuth2Claim](r.Execute())
+}
+
 /*
 ReplaceOAuth2Claim Replace a custom token Claim
 
@@ -845,7 +869,7 @@ func (a *AuthorizationServerClaimsAPIService) ReplaceOAuth2ClaimExecute(r ApiRep
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerClaimsAPIService.ReplaceOAuth2Claim")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers/{authServerId}/claims/{claimId}"
@@ -897,7 +921,7 @@ func (a *AuthorizationServerClaimsAPIService) ReplaceOAuth2ClaimExecute(r ApiRep
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -912,6 +936,7 @@ func (a *AuthorizationServerClaimsAPIService) ReplaceOAuth2ClaimExecute(r ApiRep
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -132,6 +132,11 @@ func (r ApiGetRefreshTokenForAuthorizationServerAndClientRequest) Execute() (*AP
 	return r.ApiService.GetRefreshTokenForAuthorizationServerAndClientExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into OAuth2RefreshToken.
+func (r ApiGetRefreshTokenForAuthorizationServerAndClientRequest) ExecuteTyped() (OAuth2RefreshToken, *APIResponse, error) {
+	return decodeResponse[OAuth2RefreshToken](r.Execute())
+}
+
 /*
 GetRefreshTokenForAuthorizationServerAndClient Retrieve a refresh token for a Client
 
@@ -175,7 +180,7 @@ func (a *AuthorizationServerClientsAPIService) GetRefreshTokenForAuthorizationSe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerClientsAPIService.GetRefreshTokenForAuthorizationServerAndClient")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers/{authServerId}/clients/{clientId}/tokens/{tokenId}"
@@ -228,7 +233,7 @@ func (a *AuthorizationServerClientsAPIService) GetRefreshTokenForAuthorizationSe
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -243,6 +248,7 @@ func (a *AuthorizationServerClientsAPIService) GetRefreshTokenForAuthorizationSe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -303,6 +309,11 @@ func (r ApiListOAuth2ClientsForAuthorizationServerRequest) Execute() (*APIRespon
 	return r.ApiService.ListOAuth2ClientsForAuthorizationServerExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []OAuth2Client.
+func (r ApiListOAuth2ClientsForAuthorizationServerRequest) ExecuteTyped() ([]OAuth2Client, *APIResponse, error) {
+	return decodeResponse[[]OAuth2Client](r.Execute())
+}
+
 /*
 ListOAuth2ClientsForAuthorizationServer List all Client resources for an authorization server
 
@@ -342,7 +353,7 @@ func (a *AuthorizationServerClientsAPIService) ListOAuth2ClientsForAuthorization
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerClientsAPIService.ListOAuth2ClientsForAuthorizationServer")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers/{authServerId}/clients"
@@ -390,7 +401,7 @@ func (a *AuthorizationServerClientsAPIService) ListOAuth2ClientsForAuthorization
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -405,6 +416,7 @@ func (a *AuthorizationServerClientsAPIService) ListOAuth2ClientsForAuthorization
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -487,6 +499,11 @@ func (r ApiListRefreshTokensForAuthorizationServerAndClientRequest) Execute() (*
 	return r.ApiService.ListRefreshTokensForAuthorizationServerAndClientExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []OAuth2RefreshToken.
+func (r ApiListRefreshTokensForAuthorizationServerAndClientRequest) ExecuteTyped() ([]OAuth2RefreshToken, *APIResponse, error) {
+	return decodeResponse[[]OAuth2RefreshToken](r.Execute())
+}
+
 /*
 ListRefreshTokensForAuthorizationServerAndClient List all refresh tokens for a Client
 
@@ -528,7 +545,7 @@ func (a *AuthorizationServerClientsAPIService) ListRefreshTokensForAuthorization
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerClientsAPIService.ListRefreshTokensForAuthorizationServerAndClient")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers/{authServerId}/clients/{clientId}/tokens"
@@ -586,7 +603,7 @@ func (a *AuthorizationServerClientsAPIService) ListRefreshTokensForAuthorization
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -601,6 +618,7 @@ func (a *AuthorizationServerClientsAPIService) ListRefreshTokensForAuthorization
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -705,7 +723,7 @@ func (a *AuthorizationServerClientsAPIService) RevokeRefreshTokenForAuthorizatio
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerClientsAPIService.RevokeRefreshTokenForAuthorizationServerAndClient")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers/{authServerId}/clients/{clientId}/tokens/{tokenId}"
@@ -755,7 +773,7 @@ func (a *AuthorizationServerClientsAPIService) RevokeRefreshTokenForAuthorizatio
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -770,6 +788,7 @@ func (a *AuthorizationServerClientsAPIService) RevokeRefreshTokenForAuthorizatio
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -871,7 +890,7 @@ func (a *AuthorizationServerClientsAPIService) RevokeRefreshTokensForAuthorizati
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerClientsAPIService.RevokeRefreshTokensForAuthorizationServerAndClient")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers/{authServerId}/clients/{clientId}/tokens"
@@ -920,7 +939,7 @@ func (a *AuthorizationServerClientsAPIService) RevokeRefreshTokensForAuthorizati
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -935,6 +954,7 @@ func (a *AuthorizationServerClientsAPIService) RevokeRefreshTokensForAuthorizati
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -74,6 +74,11 @@ func (r ApiListAuthorizationServerKeysRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListAuthorizationServerKeysExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []JsonWebKey.
+func (r ApiListAuthorizationServerKeysRequest) ExecuteTyped() ([]JsonWebKey, *APIResponse, error) {
+	return decodeResponse[[]JsonWebKey](r.Execute())
+}
+
 /*
 ListAuthorizationServerKeys List all Credential Keys
 
@@ -113,7 +118,7 @@ func (a *AuthorizationServerKeysAPIService) ListAuthorizationServerKeysExecute(r
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerKeysAPIService.ListAuthorizationServerKeys")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers/{authServerId}/credentials/keys"
@@ -161,7 +166,7 @@ func (a *AuthorizationServerKeysAPIService) ListAuthorizationServerKeysExecute(r
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -176,6 +181,7 @@ func (a *AuthorizationServerKeysAPIService) ListAuthorizationServerKeysExecute(r
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -242,6 +248,11 @@ func (r ApiRotateAuthorizationServerKeysRequest) Execute() (*APIResponse, error)
 	return r.ApiService.RotateAuthorizationServerKeysExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []JsonWebKey.
+func (r ApiRotateAuthorizationServerKeysRequest) ExecuteTyped() ([]JsonWebKey, *APIResponse, error) {
+	return decodeResponse[[]JsonWebKey](r.Execute())
+}
+
 /*
 RotateAuthorizationServerKeys Rotate all Credential Keys
 
@@ -281,7 +292,7 @@ func (a *AuthorizationServerKeysAPIService) RotateAuthorizationServerKeysExecute
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerKeysAPIService.RotateAuthorizationServerKeys")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers/{authServerId}/credentials/lifecycle/keyRotate"
@@ -332,7 +343,7 @@ func (a *AuthorizationServerKeysAPIService) RotateAuthorizationServerKeysExecute
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -347,6 +358,7 @@ func (a *AuthorizationServerKeysAPIService) RotateAuthorizationServerKeysExecute
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -192,7 +192,7 @@ func (a *AuthorizationServerPoliciesAPIService) ActivateAuthorizationServerPolic
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerPoliciesAPIService.ActivateAuthorizationServerPolicy")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers/{authServerId}/policies/{policyId}/lifecycle/activate"
@@ -241,7 +241,7 @@ func (a *AuthorizationServerPoliciesAPIService) ActivateAuthorizationServerPolic
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -256,6 +256,7 @@ func (a *AuthorizationServerPoliciesAPIService) ActivateAuthorizationServerPolic
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -322,6 +323,11 @@ func (r ApiCreateAuthorizationServerPolicyRequest) Execute() (*APIResponse, erro
 	return r.ApiService.CreateAuthorizationServerPolicyExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into AuthorizationServerPolicy.
+func (r ApiCreateAuthorizationServerPolicyRequest) ExecuteTyped() (AuthorizationServerPolicy, *APIResponse, error) {
+	return decodeResponse[AuthorizationServerPolicy](r.Execute())
+}
+
 /*
 CreateAuthorizationServerPolicy Create a Policy
 
@@ -361,7 +367,7 @@ func (a *AuthorizationServerPoliciesAPIService) CreateAuthorizationServerPolicyE
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerPoliciesAPIService.CreateAuthorizationServerPolicy")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers/{authServerId}/policies"
@@ -412,7 +418,7 @@ func (a *AuthorizationServerPoliciesAPIService) CreateAuthorizationServerPolicyE
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -427,6 +433,7 @@ func (a *AuthorizationServerPoliciesAPIService) CreateAuthorizationServerPolicyE
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -540,7 +547,7 @@ func (a *AuthorizationServerPoliciesAPIService) DeactivateAuthorizationServerPol
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerPoliciesAPIService.DeactivateAuthorizationServerPolicy")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers/{authServerId}/policies/{policyId}/lifecycle/deactivate"
@@ -589,7 +596,7 @@ func (a *AuthorizationServerPoliciesAPIService) DeactivateAuthorizationServerPol
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -604,6 +611,7 @@ func (a *AuthorizationServerPoliciesAPIService) DeactivateAuthorizationServerPol
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -705,7 +713,7 @@ func (a *AuthorizationServerPoliciesAPIService) DeleteAuthorizationServerPolicyE
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerPoliciesAPIService.DeleteAuthorizationServerPolicy")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers/{authServerId}/policies/{policyId}"
@@ -754,7 +762,7 @@ func (a *AuthorizationServerPoliciesAPIService) DeleteAuthorizationServerPolicyE
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -769,6 +777,7 @@ func (a *AuthorizationServerPoliciesAPIService) DeleteAuthorizationServerPolicyE
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -830,6 +839,11 @@ func (r ApiGetAuthorizationServerPolicyRequest) Execute() (*APIResponse, error)
 	return r.ApiService.GetAuthorizationServerPolicyExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into AuthorizationServerPolicy.
+func (r ApiGetAuthorizationServerPolicyRequest) ExecuteTyped() (AuthorizationServerPolicy, *APIResponse, error) {
+	return decodeResponse[AuthorizationServerPolicy](r.Execute())
+}
+
 /*
 GetAuthorizationServerPolicy Retrieve a Policy
 
@@ -871,7 +885,7 @@ func (a *AuthorizationServerPoliciesAPIService) GetAuthorizationServerPolicyExec
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerPoliciesAPIService.GetAuthorizationServerPolicy")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers/{authServerId}/policies/{policyId}"
@@ -920,7 +934,7 @@ func (a *AuthorizationServerPoliciesAPIService) GetAuthorizationServerPolicyExec
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -935,6 +949,7 @@ func (a *AuthorizationServerPoliciesAPIService) GetAuthorizationServerPolicyExec
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -995,6 +1010,11 @@ func (r ApiListAuthorizationServerPoliciesRequest) Execute() (*APIResponse, erro
 	return r.ApiService.ListAuthorizationServerPoliciesExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []AuthorizationServerPolicy.
+func (r ApiListAuthorizationServerPoliciesRequest) ExecuteTyped() ([]AuthorizationServerPolicy, *APIResponse, error) {
+	return decodeResponse[[]AuthorizationServerPolicy](r.Execute())
+}
+
 /*
 ListAuthorizationServerPolicies List all Policies
 
@@ -1034,7 +1054,7 @@ func (a *AuthorizationServerPoliciesAPIService) ListAuthorizationServerPoliciesE
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerPoliciesAPIService.ListAuthorizationServerPolicies")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers/{authServerId}/policies"
@@ -1082,7 +1102,7 @@ func (a *AuthorizationServerPoliciesAPIService) ListAuthorizationServerPoliciesE
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1097,6 +1117,7 @@ func (a *AuthorizationServerPoliciesAPIService) ListAuthorizationServerPoliciesE
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1164,6 +1185,11 @@ func (r ApiReplaceAuthorizationServerPolicyRequest) Execute() (*APIResponse, err
 	return r.ApiService.ReplaceAuthorizationServerPolicyExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into AuthorizationServerPolicy.
+func (r ApiReplaceAuthorizationServerPolicyRequest) ExecuteTyped() (AuthorizationServerPolicy, *APIResponse, error) {
+	return decodeResponse[AuthorizationServerPolicy](r.Execute())
+}
+
 /*
 ReplaceAuthorizationServerPolicy Replace a Policy
 
@@ -1205,7 +1231,7 @@ func (a *AuthorizationServerPoliciesAPIService) ReplaceAuthorizationServerPolicy
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerPoliciesAPIService.ReplaceAuthorizationServerPolicy")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers/{authServerId}/policies/{policyId}"
@@ -1257,7 +1283,7 @@ func (a *AuthorizationServerPoliciesAPIService) ReplaceAuthorizationServerPolicy
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1272,6 +1298,7 @@ func (a *AuthorizationServerPoliciesAPIService) ReplaceAuthorizationServerPolicy
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
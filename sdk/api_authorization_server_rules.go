@@ -202,7 +202,7 @@ func (a *AuthorizationServerRulesAPIService) ActivateAuthorizationServerPolicyRu
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerRulesAPIService.ActivateAuthorizationServerPolicyRule")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers/{authServerId}/policies/{policyId}/rules/{ruleId}/lifecycle/activate"
@@ -252,7 +252,7 @@ func (a *AuthorizationServerRulesAPIService) ActivateAuthorizationServerPolicyRu
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -267,6 +267,7 @@ func (a *AuthorizationServerRulesAPIService) ActivateAuthorizationServerPolicyRu
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -334,6 +335,11 @@ func (r ApiCreateAuthorizationServerPolicyRuleRequest) Execute() (*APIResponse,
 	return r.ApiService.CreateAuthorizationServerPolicyRuleExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into AuthorizationServerPolicyRule.
+func (r ApiCreateAuthorizationServerPolicyRuleRequest) ExecuteTyped() (AuthorizationServerPolicyRule, *APIResponse, error) {
+	return decodeResponse[AuthorizationServerPolicyRule](r.Execute())
+}
+
 /*
 CreateAuthorizationServerPolicyRule Create a Policy Rule
 
@@ -375,7 +381,7 @@ func (a *AuthorizationServerRulesAPIService) CreateAuthorizationServerPolicyRule
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerRulesAPIService.CreateAuthorizationServerPolicyRule")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers/{authServerId}/policies/{policyId}/rules"
@@ -427,7 +433,7 @@ func (a *AuthorizationServerRulesAPIService) CreateAuthorizationServerPolicyRule
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -442,6 +448,7 @@ func (a *AuthorizationServerRulesAPIService) CreateAuthorizationServerPolicyRule
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -558,7 +565,7 @@ func (a *AuthorizationServerRulesAPIService) DeactivateAuthorizationServerPolicy
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerRulesAPIService.DeactivateAuthorizationServerPolicyRule")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers/{authServerId}/policies/{policyId}/rules/{ruleId}/lifecycle/deactivate"
@@ -608,7 +615,7 @@ func (a *AuthorizationServerRulesAPIService) DeactivateAuthorizationServerPolicy
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -623,6 +630,7 @@ func (a *AuthorizationServerRulesAPIService) DeactivateAuthorizationServerPolicy
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -727,7 +735,7 @@ func (a *AuthorizationServerRulesAPIService) DeleteAuthorizationServerPolicyRule
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerRulesAPIService.DeleteAuthorizationServerPolicyRule")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers/{authServerId}/policies/{policyId}/rules/{ruleId}"
@@ -777,7 +785,7 @@ func (a *AuthorizationServerRulesAPIService) DeleteAuthorizationServerPolicyRule
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -792,6 +800,7 @@ func (a *AuthorizationServerRulesAPIService) DeleteAuthorizationServerPolicyRule
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -854,6 +863,11 @@ func (r ApiGetAuthorizationServerPolicyRuleRequest) Execute() (*APIResponse, err
 	return r.ApiService.GetAuthorizationServerPolicyRuleExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into AuthorizationServerPolicyRule.
+func (r ApiGetAuthorizationServerPolicyRuleRequest) ExecuteTyped() (AuthorizationServerPolicyRule, *APIResponse, error) {
+	return decodeResponse[AuthorizationServerPolicyRule](r.Execute())
+}
+
 /*
 GetAuthorizationServerPolicyRule Retrieve a Policy Rule
 
@@ -897,7 +911,7 @@ func (a *AuthorizationServerRulesAPIService) GetAuthorizationServerPolicyRuleExe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerRulesAPIService.GetAuthorizationServerPolicyRule")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers/{authServerId}/policies/{policyId}/rules/{ruleId}"
@@ -947,7 +961,7 @@ func (a *AuthorizationServerRulesAPIService) GetAuthorizationServerPolicyRuleExe
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -962,6 +976,7 @@ func (a *AuthorizationServerRulesAPIService) GetAuthorizationServerPolicyRuleExe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1023,6 +1038,11 @@ func (r ApiListAuthorizationServerPolicyRulesRequest) Execute() (*APIResponse, e
 	return r.ApiService.ListAuthorizationServerPolicyRulesExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []AuthorizationServerPolicyRule.
+func (r ApiListAuthorizationServerPolicyRulesRequest) ExecuteTyped() ([]AuthorizationServerPolicyRule, *APIResponse, error) {
+	return decodeResponse[[]AuthorizationServerPolicyRule](r.Execute())
+}
+
 /*
 ListAuthorizationServerPolicyRules List all Policy Rules
 
@@ -1064,7 +1084,7 @@ func (a *AuthorizationServerRulesAPIService) ListAuthorizationServerPolicyRulesE
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerRulesAPIService.ListAuthorizationServerPolicyRules")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers/{authServerId}/policies/{policyId}/rules"
@@ -1113,7 +1133,7 @@ func (a *AuthorizationServerRulesAPIService) ListAuthorizationServerPolicyRulesE
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1128,6 +1148,7 @@ func (a *AuthorizationServerRulesAPIService) ListAuthorizationServerPolicyRulesE
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1196,6 +1217,11 @@ func (r ApiReplaceAuthorizationServerPolicyRuleRequest) Execute() (*APIResponse,
 	return r.ApiService.ReplaceAuthorizationServerPolicyRuleExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into AuthorizationServerPolicyRule.
+func (r ApiReplaceAuthorizationServerPolicyRuleRequest) ExecuteTyped() (AuthorizationServerPolicyRule, *APIResponse, error) {
+	return decodeResponse[AuthorizationServerPolicyRule](r.Execute())
+}
+
 /*
 ReplaceAuthorizationServerPolicyRule Replace a Policy Rule
 
@@ -1239,7 +1265,7 @@ func (a *AuthorizationServerRulesAPIService) ReplaceAuthorizationServerPolicyRul
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerRulesAPIService.ReplaceAuthorizationServerPolicyRule")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers/{authServerId}/policies/{policyId}/rules/{ruleId}"
@@ -1292,7 +1318,7 @@ func (a *AuthorizationServerRulesAPIService) ReplaceAuthorizationServerPolicyRul
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1307,6 +1333,7 @@ func (a *AuthorizationServerRulesAPIService) ReplaceAuthorizationServerPolicyRul
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -127,6 +127,11 @@ func (r ApiCreateOAuth2ScopeRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.CreateOAuth2ScopeExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into OAuth2Scope.
+func (r ApiCreateOAuth2ScopeRequest) ExecuteTyped() (OAuth2Scope, *APIResponse, error) {
+	return decodeResponse[OAuth2Scope](r.Execute())
+}
+
 /*
 CreateOAuth2Scope Create a Custom Token Scope
 
@@ -166,7 +171,7 @@ func (a *AuthorizationServerScopesAPIService) CreateOAuth2ScopeExecute(r ApiCrea
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerScopesAPIService.CreateOAuth2Scope")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers/{authServerId}/scopes"
@@ -217,7 +222,7 @@ func (a *AuthorizationServerScopesAPIService) CreateOAuth2ScopeExecute(r ApiCrea
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -232,6 +237,7 @@ func (a *AuthorizationServerScopesAPIService) CreateOAuth2ScopeExecute(r ApiCrea
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -345,7 +351,7 @@ func (a *AuthorizationServerScopesAPIService) DeleteOAuth2ScopeExecute(r ApiDele
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerScopesAPIService.DeleteOAuth2Scope")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers/{authServerId}/scopes/{scopeId}"
@@ -394,7 +400,7 @@ func (a *AuthorizationServerScopesAPIService) DeleteOAuth2ScopeExecute(r ApiDele
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -409,6 +415,7 @@ func (a *AuthorizationServerScopesAPIService) DeleteOAuth2ScopeExecute(r ApiDele
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -470,6 +477,11 @@ func (r ApiGetOAuth2ScopeRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetOAuth2ScopeExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into OAuth2Scope.
+func (r ApiGetOAuth2ScopeRequest) ExecuteTyped() (OAuth2Scope, *APIResponse, error) {
+	return decodeResponse[OAuth2Scope](r.Execute())
+}
+
 /*
 GetOAuth2Scope Retrieve a Custom Token Scope
 
@@ -511,7 +523,7 @@ func (a *AuthorizationServerScopesAPIService) GetOAuth2ScopeExecute(r ApiGetOAut
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerScopesAPIService.GetOAuth2Scope")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers/{authServerId}/scopes/{scopeId}"
@@ -560,7 +572,7 @@ func (a *AuthorizationServerScopesAPIService) GetOAuth2ScopeExecute(r ApiGetOAut
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -575,6 +587,7 @@ func (a *AuthorizationServerScopesAPIService) GetOAuth2ScopeExecute(r ApiGetOAut
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -659,6 +672,11 @@ func (r ApiListOAuth2ScopesRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListOAuth2ScopesExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []OAuth2Scope.
+func (r ApiListOAuth2ScopesRequest) ExecuteTyped() ([]OAuth2Scope, *APIResponse, error) {
+	return decodeResponse[[]OAuth2Scope](r.Execute())
+}
+
 /*
 ListOAuth2Scopes List all Custom Token Scopes
 
@@ -698,7 +716,7 @@ func (a *AuthorizationServerScopesAPIService) ListOAuth2ScopesExecute(r ApiListO
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerScopesAPIService.ListOAuth2Scopes")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers/{authServerId}/scopes"
@@ -758,7 +776,7 @@ func (a *AuthorizationServerScopesAPIService) ListOAuth2ScopesExecute(r ApiListO
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -773,6 +791,7 @@ func (a *AuthorizationServerScopesAPIService) ListOAuth2ScopesExecute(r ApiListO
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -840,6 +859,11 @@ func (r ApiReplaceOAuth2ScopeRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ReplaceOAuth2ScopeExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into OAuth2Scope.
+func (r ApiReplaceOAuth2ScopeRequest) ExecuteTyped() (OAuth2Scope, *APIResponse, error) {
+	return decodeResponse[OAuth2Scope](r.Execute())
+}
+
 /*
 ReplaceOAuth2Scope Replace a Custom Token Scope
 
@@ -881,7 +905,7 @@ func (a *AuthorizationServerScopesAPIService) ReplaceOAuth2ScopeExecute(r ApiRep
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerScopesAPIService.ReplaceOAuth2Scope")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers/{authServerId}/scopes/{scopeId}"
@@ -933,7 +957,7 @@ func (a *AuthorizationServerScopesAPIService) ReplaceOAuth2ScopeExecute(r ApiRep
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -948,6 +972,7 @@ func (a *AuthorizationServerScopesAPIService) ReplaceOAuth2ScopeExecute(r ApiRep
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
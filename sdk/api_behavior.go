@@ -146,6 +146,11 @@ func (r ApiActivateBehaviorDetectionRuleRequest) Execute() (*APIResponse, error)
 	return r.ApiService.ActivateBehaviorDetectionRuleExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ListBehaviorDetectionRules200ResponseInner.
+func (r ApiActivateBehaviorDetectionRuleRequest) ExecuteTyped() (ListBehaviorDetectionRules200ResponseInner, *APIResponse, error) {
+	return decodeResponse[ListBehaviorDetectionRules200ResponseInner](r.Execute())
+}
+
 /*
 ActivateBehaviorDetectionRule Activate a Behavior Detection Rule
 
@@ -185,7 +190,7 @@ func (a *BehaviorAPIService) ActivateBehaviorDetectionRuleExecute(r ApiActivateB
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BehaviorAPIService.ActivateBehaviorDetectionRule")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/behaviors/{behaviorId}/lifecycle/activate"
@@ -233,7 +238,7 @@ func (a *BehaviorAPIService) ActivateBehaviorDetectionRuleExecute(r ApiActivateB
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -248,6 +253,7 @@ func (a *BehaviorAPIService) ActivateBehaviorDetectionRuleExecute(r ApiActivateB
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -313,6 +319,11 @@ func (r ApiCreateBehaviorDetectionRuleRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.CreateBehaviorDetectionRuleExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into BehaviorRule.
+func (r ApiCreateBehaviorDetectionRuleRequest) ExecuteTyped() (BehaviorRule, *APIResponse, error) {
+	return decodeResponse[BehaviorRule](r.Execute())
+}
+
 /*
 CreateBehaviorDetectionRule Create a Behavior Detection Rule
 
@@ -350,7 +361,7 @@ func (a *BehaviorAPIService) CreateBehaviorDetectionRuleExecute(r ApiCreateBehav
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BehaviorAPIService.CreateBehaviorDetectionRule")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/behaviors"
@@ -400,7 +411,7 @@ func (a *BehaviorAPIService) CreateBehaviorDetectionRuleExecute(r ApiCreateBehav
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -415,6 +426,7 @@ func (a *BehaviorAPIService) CreateBehaviorDetectionRuleExecute(r ApiCreateBehav
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -475,6 +487,11 @@ func (r ApiDeactivateBehaviorDetectionRuleRequest) Execute() (*APIResponse, erro
 	return r.ApiService.DeactivateBehaviorDetectionRuleExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ListBehaviorDetectionRules200ResponseInner.
+func (r ApiDeactivateBehaviorDetectionRuleRequest) ExecuteTyped() (ListBehaviorDetectionRules200ResponseInner, *APIResponse, error) {
+	return decodeResponse[ListBehaviorDetectionRules200ResponseInner](r.Execute())
+}
+
 /*
 DeactivateBehaviorDetectionRule Deactivate a Behavior Detection Rule
 
@@ -514,7 +531,7 @@ func (a *BehaviorAPIService) DeactivateBehaviorDetectionRuleExecute(r ApiDeactiv
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BehaviorAPIService.DeactivateBehaviorDetectionRule")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/behaviors/{behaviorId}/lifecycle/deactivate"
@@ -562,7 +579,7 @@ func (a *BehaviorAPIService) DeactivateBehaviorDetectionRuleExecute(r ApiDeactiv
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -577,6 +594,7 @@ func (a *BehaviorAPIService) DeactivateBehaviorDetectionRuleExecute(r ApiDeactiv
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -675,7 +693,7 @@ func (a *BehaviorAPIService) DeleteBehaviorDetectionRuleExecute(r ApiDeleteBehav
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BehaviorAPIService.DeleteBehaviorDetectionRule")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/behaviors/{behaviorId}"
@@ -723,7 +741,7 @@ func (a *BehaviorAPIService) DeleteBehaviorDetectionRuleExecute(r ApiDeleteBehav
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -738,6 +756,7 @@ func (a *BehaviorAPIService) DeleteBehaviorDetectionRuleExecute(r ApiDeleteBehav
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -798,6 +817,11 @@ func (r ApiGetBehaviorDetectionRuleRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetBehaviorDetectionRuleExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ListBehaviorDetectionRules200ResponseInner.
+func (r ApiGetBehaviorDetectionRuleRequest) ExecuteTyped() (ListBehaviorDetectionRules200ResponseInner, *APIResponse, error) {
+	return decodeResponse[ListBehaviorDetectionRules200ResponseInner](r.Execute())
+}
+
 /*
 GetBehaviorDetectionRule Retrieve a Behavior Detection Rule
 
@@ -837,7 +861,7 @@ func (a *BehaviorAPIService) GetBehaviorDetectionRuleExecute(r ApiGetBehaviorDet
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BehaviorAPIService.GetBehaviorDetectionRule")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/behaviors/{behaviorId}"
@@ -885,7 +909,7 @@ func (a *BehaviorAPIService) GetBehaviorDetectionRuleExecute(r ApiGetBehaviorDet
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -900,6 +924,7 @@ func (a *BehaviorAPIService) GetBehaviorDetectionRuleExecute(r ApiGetBehaviorDet
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -959,6 +984,11 @@ func (r ApiListBehaviorDetectionRulesRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListBehaviorDetectionRulesExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []ListBehaviorDetectionRules200ResponseInner.
+func (r ApiListBehaviorDetectionRulesRequest) ExecuteTyped() ([]ListBehaviorDetectionRules200ResponseInner, *APIResponse, error) {
+	return decodeResponse[[]ListBehaviorDetectionRules200ResponseInner](r.Execute())
+}
+
 /*
 ListBehaviorDetectionRules List all Behavior Detection Rules
 
@@ -996,7 +1026,7 @@ func (a *BehaviorAPIService) ListBehaviorDetectionRulesExecute(r ApiListBehavior
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BehaviorAPIService.ListBehaviorDetectionRules")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/behaviors"
@@ -1043,7 +1073,7 @@ func (a *BehaviorAPIService) ListBehaviorDetectionRulesExecute(r ApiListBehavior
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1058,6 +1088,7 @@ func (a *BehaviorAPIService) ListBehaviorDetectionRulesExecute(r ApiListBehavior
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1112,6 +1143,11 @@ func (r ApiReplaceBehaviorDetectionRuleRequest) Execute() (*APIResponse, error)
 	return r.ApiService.ReplaceBehaviorDetectionRuleExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ListBehaviorDetectionRules200ResponseInner.
+func (r ApiReplaceBehaviorDetectionRuleRequest) ExecuteTyped() (ListBehaviorDetectionRules200ResponseInner, *APIResponse, error) {
+	return decodeResponse[ListBehaviorDetectionRules200ResponseInner](r.Execute())
+}
+
 /*
 ReplaceBehaviorDetectionRule Replace a Behavior Detection Rule
 
@@ -1151,7 +1187,7 @@ func (a *BehaviorAPIService) ReplaceBehaviorDetectionRuleExecute(r ApiReplaceBeh
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BehaviorAPIService.ReplaceBehaviorDetectionRule")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/behaviors/{behaviorId}"
@@ -1202,7 +1238,7 @@ func (a *BehaviorAPIService) ReplaceBehaviorDetectionRuleExecute(r ApiReplaceBeh
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1217,6 +1253,7 @@ func (a *BehaviorAPIService) ReplaceBehaviorDetectionRuleExecute(r ApiReplaceBeh
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
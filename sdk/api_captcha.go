@@ -180,6 +180,11 @@ func (r ApiCreateCaptchaInstanceRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.CreateCaptchaInstanceExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into CAPTCHAInstance.
+func (r ApiCreateCaptchaInstanceRequest) ExecuteTyped() (CAPTCHAInstance, *APIResponse, error) {
+	return decodeResponse[CAPTCHAInstance](r.Execute())
+}
+
 /*
 CreateCaptchaInstance Create a CAPTCHA instance
 
@@ -217,7 +222,7 @@ func (a *CAPTCHAAPIService) CreateCaptchaInstanceExecute(r ApiCreateCaptchaInsta
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CAPTCHAAPIService.CreateCaptchaInstance")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/captchas"
@@ -267,7 +272,7 @@ func (a *CAPTCHAAPIService) CreateCaptchaInstanceExecute(r ApiCreateCaptchaInsta
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -282,6 +287,7 @@ func (a *CAPTCHAAPIService) CreateCaptchaInstanceExecute(r ApiCreateCaptchaInsta
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -381,7 +387,7 @@ func (a *CAPTCHAAPIService) DeleteCaptchaInstanceExecute(r ApiDeleteCaptchaInsta
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CAPTCHAAPIService.DeleteCaptchaInstance")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/captchas/{captchaId}"
@@ -429,7 +435,7 @@ func (a *CAPTCHAAPIService) DeleteCaptchaInstanceExecute(r ApiDeleteCaptchaInsta
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -444,6 +450,7 @@ func (a *CAPTCHAAPIService) DeleteCaptchaInstanceExecute(r ApiDeleteCaptchaInsta
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -539,7 +546,7 @@ func (a *CAPTCHAAPIService) DeleteOrgCaptchaSettingsExecute(r ApiDeleteOrgCaptch
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CAPTCHAAPIService.DeleteOrgCaptchaSettings")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org/captcha"
@@ -586,7 +593,7 @@ func (a *CAPTCHAAPIService) DeleteOrgCaptchaSettingsExecute(r ApiDeleteOrgCaptch
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -601,6 +608,7 @@ func (a *CAPTCHAAPIService) DeleteOrgCaptchaSettingsExecute(r ApiDeleteOrgCaptch
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -661,6 +669,11 @@ func (r ApiGetCaptchaInstanceRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetCaptchaInstanceExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into CAPTCHAInstance.
+func (r ApiGetCaptchaInstanceRequest) ExecuteTyped() (CAPTCHAInstance, *APIResponse, error) {
+	return decodeResponse[CAPTCHAInstance](r.Execute())
+}
+
 /*
 GetCaptchaInstance Retrieve a CAPTCHA Instance
 
@@ -700,7 +713,7 @@ func (a *CAPTCHAAPIService) GetCaptchaInstanceExecute(r ApiGetCaptchaInstanceReq
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CAPTCHAAPIService.GetCaptchaInstance")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/captchas/{captchaId}"
@@ -748,7 +761,7 @@ func (a *CAPTCHAAPIService) GetCaptchaInstanceExecute(r ApiGetCaptchaInstanceReq
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -763,6 +776,7 @@ func (a *CAPTCHAAPIService) GetCaptchaInstanceExecute(r ApiGetCaptchaInstanceReq
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -822,6 +836,11 @@ func (r ApiGetOrgCaptchaSettingsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetOrgCaptchaSettingsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into OrgCAPTCHASettings.
+func (r ApiGetOrgCaptchaSettingsRequest) ExecuteTyped() (OrgCAPTCHASettings, *APIResponse, error) {
+	return decodeResponse[OrgCAPTCHASettings](r.Execute())
+}
+
 /*
 GetOrgCaptchaSettings Retrieve the Org-wide CAPTCHA Settings
 
@@ -860,7 +879,7 @@ func (a *CAPTCHAAPIService) GetOrgCaptchaSettingsExecute(r ApiGetOrgCaptchaSetti
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CAPTCHAAPIService.GetOrgCaptchaSettings")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org/captcha"
@@ -907,7 +926,7 @@ func (a *CAPTCHAAPIService) GetOrgCaptchaSettingsExecute(r ApiGetOrgCaptchaSetti
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -922,6 +941,7 @@ func (a *CAPTCHAAPIService) GetOrgCaptchaSettingsExecute(r ApiGetOrgCaptchaSetti
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -969,6 +989,11 @@ func (r ApiListCaptchaInstancesRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListCaptchaInstancesExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []CAPTCHAInstance.
+func (r ApiListCaptchaInstancesRequest) ExecuteTyped() ([]CAPTCHAInstance, *APIResponse, error) {
+	return decodeResponse[[]CAPTCHAInstance](r.Execute())
+}
+
 /*
 ListCaptchaInstances List all CAPTCHA Instances
 
@@ -1006,7 +1031,7 @@ func (a *CAPTCHAAPIService) ListCaptchaInstancesExecute(r ApiListCaptchaInstance
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CAPTCHAAPIService.ListCaptchaInstances")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/captchas"
@@ -1053,7 +1078,7 @@ func (a *CAPTCHAAPIService) ListCaptchaInstancesExecute(r ApiListCaptchaInstance
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1068,6 +1093,7 @@ func (a *CAPTCHAAPIService) ListCaptchaInstancesExecute(r ApiListCaptchaInstance
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1122,6 +1148,11 @@ func (r ApiReplaceCaptchaInstanceRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ReplaceCaptchaInstanceExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into CAPTCHAInstance.
+func (r ApiReplaceCaptchaInstanceRequest) ExecuteTyped() (CAPTCHAInstance, *APIResponse, error) {
+	return decodeResponse[CAPTCHAInstance](r.Execute())
+}
+
 /*
 ReplaceCaptchaInstance Replace a CAPTCHA Instance
 
@@ -1161,7 +1192,7 @@ func (a *CAPTCHAAPIService) ReplaceCaptchaInstanceExecute(r ApiReplaceCaptchaIns
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CAPTCHAAPIService.ReplaceCaptchaInstance")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/captchas/{captchaId}"
@@ -1212,7 +1243,7 @@ func (a *CAPTCHAAPIService) ReplaceCaptchaInstanceExecute(r ApiReplaceCaptchaIns
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1227,6 +1258,7 @@ func (a *CAPTCHAAPIService) ReplaceCaptchaInstanceExecute(r ApiReplaceCaptchaIns
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1304,6 +1336,11 @@ func (r ApiReplacesOrgCaptchaSettingsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ReplacesOrgCaptchaSettingsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into OrgCAPTCHASettings.
+func (r ApiReplacesOrgCaptchaSettingsRequest) ExecuteTyped() (OrgCAPTCHASettings, *APIResponse, error) {
+	return decodeResponse[OrgCAPTCHASettings](r.Execute())
+}
+
 /*
 ReplacesOrgCaptchaSettings Replace the Org-wide CAPTCHA Settings
 
@@ -1342,7 +1379,7 @@ func (a *CAPTCHAAPIService) ReplacesOrgCaptchaSettingsExecute(r ApiReplacesOrgCa
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CAPTCHAAPIService.ReplacesOrgCaptchaSettings")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org/captcha"
@@ -1392,7 +1429,7 @@ func (a *CAPTCHAAPIService) ReplacesOrgCaptchaSettingsExecute(r ApiReplacesOrgCa
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1407,6 +1444,7 @@ func (a *CAPTCHAAPIService) ReplacesOrgCaptchaSettingsExecute(r ApiReplacesOrgCa
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1473,6 +1511,11 @@ func (r ApiUpdateCaptchaInstanceRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.UpdateCaptchaInstanceExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into CAPTCHAInstance.
+func (r ApiUpdateCaptchaInstanceRequest) ExecuteTyped() (CAPTCHAInstance, *APIResponse, error) {
+	return decodeResponse[CAPTCHAInstance](r.Execute())
+}
+
 /*
 UpdateCaptchaInstance Update a CAPTCHA Instance
 
@@ -1512,7 +1555,7 @@ func (a *CAPTCHAAPIService) UpdateCaptchaInstanceExecute(r ApiUpdateCaptchaInsta
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CAPTCHAAPIService.UpdateCaptchaInstance")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/captchas/{captchaId}"
@@ -1563,7 +1606,7 @@ func (a *CAPTCHAAPIService) UpdateCaptchaInstanceExecute(r ApiUpdateCaptchaInsta
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1578,6 +1621,7 @@ func (a *CAPTCHAAPIService) UpdateCaptchaInstanceExecute(r ApiUpdateCaptchaInsta
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -150,6 +150,11 @@ func (r ApiCreateCustomDomainRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.CreateCustomDomainExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into DomainResponse.
+func (r ApiCreateCustomDomainRequest) ExecuteTyped() (DomainResponse, *APIResponse, error) {
+	return decodeResponse[DomainResponse](r.Execute())
+}
+
 /*
 CreateCustomDomain Create a Custom Domain
 
@@ -187,7 +192,7 @@ func (a *CustomDomainAPIService) CreateCustomDomainExecute(r ApiCreateCustomDoma
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomDomainAPIService.CreateCustomDomain")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/domains"
@@ -237,7 +242,7 @@ func (a *CustomDomainAPIService) CreateCustomDomainExecute(r ApiCreateCustomDoma
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -252,6 +257,7 @@ func (a *CustomDomainAPIService) CreateCustomDomainExecute(r ApiCreateCustomDoma
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -350,7 +356,7 @@ func (a *CustomDomainAPIService) DeleteCustomDomainExecute(r ApiDeleteCustomDoma
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomDomainAPIService.DeleteCustomDomain")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/domains/{domainId}"
@@ -398,7 +404,7 @@ func (a *CustomDomainAPIService) DeleteCustomDomainExecute(r ApiDeleteCustomDoma
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -413,6 +419,7 @@ func (a *CustomDomainAPIService) DeleteCustomDomainExecute(r ApiDeleteCustomDoma
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -473,6 +480,11 @@ func (r ApiGetCustomDomainRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetCustomDomainExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into DomainResponse.
+func (r ApiGetCustomDomainRequest) ExecuteTyped() (DomainResponse, *APIResponse, error) {
+	return decodeResponse[DomainResponse](r.Execute())
+}
+
 /*
 GetCustomDomain Retrieve a Custom Domain
 
@@ -512,7 +524,7 @@ func (a *CustomDomainAPIService) GetCustomDomainExecute(r ApiGetCustomDomainRequ
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomDomainAPIService.GetCustomDomain")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/domains/{domainId}"
@@ -560,7 +572,7 @@ func (a *CustomDomainAPIService) GetCustomDomainExecute(r ApiGetCustomDomainRequ
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -575,6 +587,7 @@ func (a *CustomDomainAPIService) GetCustomDomainExecute(r ApiGetCustomDomainRequ
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -634,6 +647,11 @@ func (r ApiListCustomDomainsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListCustomDomainsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into DomainListResponse.
+func (r ApiListCustomDomainsRequest) ExecuteTyped() (DomainListResponse, *APIResponse, error) {
+	return decodeResponse[DomainListResponse](r.Execute())
+}
+
 /*
 ListCustomDomains List all Custom Domains
 
@@ -671,7 +689,7 @@ func (a *CustomDomainAPIService) ListCustomDomainsExecute(r ApiListCustomDomains
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomDomainAPIService.ListCustomDomains")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/domains"
@@ -718,7 +736,7 @@ func (a *CustomDomainAPIService) ListCustomDomainsExecute(r ApiListCustomDomains
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -733,6 +751,7 @@ func (a *CustomDomainAPIService) ListCustomDomainsExecute(r ApiListCustomDomains
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -787,6 +806,11 @@ func (r ApiReplaceCustomDomainRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ReplaceCustomDomainExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into DomainResponse.
+func (r ApiReplaceCustomDomainRequest) ExecuteTyped() (DomainResponse, *APIResponse, error) {
+	return decodeResponse[DomainResponse](r.Execute())
+}
+
 /*
 ReplaceCustomDomain Replace a Custom Domain's Brand
 
@@ -826,7 +850,7 @@ func (a *CustomDomainAPIService) ReplaceCustomDomainExecute(r ApiReplaceCustomDo
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomDomainAPIService.ReplaceCustomDomain")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/domains/{domainId}"
@@ -877,7 +901,7 @@ func (a *CustomDomainAPIService) ReplaceCustomDomainExecute(r ApiReplaceCustomDo
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -892,6 +916,7 @@ func (a *CustomDomainAPIService) ReplaceCustomDomainExecute(r ApiReplaceCustomDo
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1008,7 +1033,7 @@ func (a *CustomDomainAPIService) UpsertCertificateExecute(r ApiUpsertCertificate
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomDomainAPIService.UpsertCertificate")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/domains/{domainId}/certificate"
@@ -1059,7 +1084,7 @@ func (a *CustomDomainAPIService) UpsertCertificateExecute(r ApiUpsertCertificate
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1074,6 +1099,7 @@ func (a *CustomDomainAPIService) UpsertCertificateExecute(r ApiUpsertCertificate
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1146,6 +1172,11 @@ func (r ApiVerifyDomainRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.VerifyDomainExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into DomainResponse.
+func (r ApiVerifyDomainRequest) ExecuteTyped() (DomainResponse, *APIResponse, error) {
+	return decodeResponse[DomainResponse](r.Execute())
+}
+
 /*
 VerifyDomain Verify a Custom Domain
 
@@ -1185,7 +1216,7 @@ func (a *CustomDomainAPIService) VerifyDomainExecute(r ApiVerifyDomainRequest) (
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomDomainAPIService.VerifyDomain")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/domains/{domainId}/verify"
@@ -1233,7 +1264,7 @@ func (a *CustomDomainAPIService) VerifyDomainExecute(r ApiVerifyDomainRequest) (
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1248,6 +1279,7 @@ func (a *CustomDomainAPIService) VerifyDomainExecute(r ApiVerifyDomainRequest) (
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -844,6 +844,11 @@ func (r ApiCreateBrandRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.CreateBrandExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into Brand.
+func (r ApiCreateBrandRequest) ExecuteTyped() (Brand, *APIResponse, error) {
+	return decodeResponse[Brand](r.Execute())
+}
+
 /*
 CreateBrand Create a Brand
 
@@ -881,7 +886,7 @@ func (a *CustomizationAPIService) CreateBrandExecute(r ApiCreateBrandRequest) (*
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.CreateBrand")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands"
@@ -943,7 +948,7 @@ func (a *CustomizationAPIService) CreateBrandExecute(r ApiCreateBrandRequest) (*
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -958,6 +963,7 @@ func (a *CustomizationAPIService) CreateBrandExecute(r ApiCreateBrandRequest) (*
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1025,6 +1031,11 @@ func (r ApiCreateEmailCustomizationRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.CreateEmailCustomizationExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into EmailCustomization.
+func (r ApiCreateEmailCustomizationRequest) ExecuteTyped() (EmailCustomization, *APIResponse, error) {
+	return decodeResponse[EmailCustomization](r.Execute())
+}
+
 /*
 CreateEmailCustomization Create an Email Customization
 
@@ -1069,7 +1080,7 @@ func (a *CustomizationAPIService) CreateEmailCustomizationExecute(r ApiCreateEma
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.CreateEmailCustomization")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/templates/email/{templateName}/customizations"
@@ -1121,7 +1132,7 @@ func (a *CustomizationAPIService) CreateEmailCustomizationExecute(r ApiCreateEma
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1136,6 +1147,7 @@ func (a *CustomizationAPIService) CreateEmailCustomizationExecute(r ApiCreateEma
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1264,7 +1276,7 @@ func (a *CustomizationAPIService) DeleteAllCustomizationsExecute(r ApiDeleteAllC
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.DeleteAllCustomizations")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/templates/email/{templateName}/customizations"
@@ -1313,7 +1325,7 @@ func (a *CustomizationAPIService) DeleteAllCustomizationsExecute(r ApiDeleteAllC
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1328,6 +1340,7 @@ func (a *CustomizationAPIService) DeleteAllCustomizationsExecute(r ApiDeleteAllC
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1433,7 +1446,7 @@ func (a *CustomizationAPIService) DeleteBrandExecute(r ApiDeleteBrandRequest) (*
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.DeleteBrand")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}"
@@ -1484,7 +1497,7 @@ func (a *CustomizationAPIService) DeleteBrandExecute(r ApiDeleteBrandRequest) (*
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1499,6 +1512,7 @@ func (a *CustomizationAPIService) DeleteBrandExecute(r ApiDeleteBrandRequest) (*
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1612,7 +1626,7 @@ func (a *CustomizationAPIService) DeleteBrandThemeBackgroundImageExecute(r ApiDe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.DeleteBrandThemeBackgroundImage")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/themes/{themeId}/background-image"
@@ -1661,7 +1675,7 @@ func (a *CustomizationAPIService) DeleteBrandThemeBackgroundImageExecute(r ApiDe
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1676,6 +1690,7 @@ func (a *CustomizationAPIService) DeleteBrandThemeBackgroundImageExecute(r ApiDe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1777,7 +1792,7 @@ func (a *CustomizationAPIService) DeleteBrandThemeFaviconExecute(r ApiDeleteBran
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.DeleteBrandThemeFavicon")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/themes/{themeId}/favicon"
@@ -1826,7 +1841,7 @@ func (a *CustomizationAPIService) DeleteBrandThemeFaviconExecute(r ApiDeleteBran
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1841,6 +1856,7 @@ func (a *CustomizationAPIService) DeleteBrandThemeFaviconExecute(r ApiDeleteBran
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1942,7 +1958,7 @@ func (a *CustomizationAPIService) DeleteBrandThemeLogoExecute(r ApiDeleteBrandTh
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.DeleteBrandThemeLogo")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/themes/{themeId}/logo"
@@ -1991,7 +2007,7 @@ func (a *CustomizationAPIService) DeleteBrandThemeLogoExecute(r ApiDeleteBrandTh
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2006,6 +2022,7 @@ func (a *CustomizationAPIService) DeleteBrandThemeLogoExecute(r ApiDeleteBrandTh
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2104,7 +2121,7 @@ func (a *CustomizationAPIService) DeleteCustomizedErrorPageExecute(r ApiDeleteCu
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.DeleteCustomizedErrorPage")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/pages/error/customized"
@@ -2152,7 +2169,7 @@ func (a *CustomizationAPIService) DeleteCustomizedErrorPageExecute(r ApiDeleteCu
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2167,6 +2184,7 @@ func (a *CustomizationAPIService) DeleteCustomizedErrorPageExecute(r ApiDeleteCu
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2265,7 +2283,7 @@ func (a *CustomizationAPIService) DeleteCustomizedSignInPageExecute(r ApiDeleteC
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.DeleteCustomizedSignInPage")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/pages/sign-in/customized"
@@ -2313,7 +2331,7 @@ func (a *CustomizationAPIService) DeleteCustomizedSignInPageExecute(r ApiDeleteC
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2328,6 +2346,7 @@ func (a *CustomizationAPIService) DeleteCustomizedSignInPageExecute(r ApiDeleteC
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2435,7 +2454,7 @@ func (a *CustomizationAPIService) DeleteEmailCustomizationExecute(r ApiDeleteEma
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.DeleteEmailCustomization")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/templates/email/{templateName}/customizations/{customizationId}"
@@ -2485,7 +2504,7 @@ func (a *CustomizationAPIService) DeleteEmailCustomizationExecute(r ApiDeleteEma
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2500,6 +2519,7 @@ func (a *CustomizationAPIService) DeleteEmailCustomizationExecute(r ApiDeleteEma
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2610,7 +2630,7 @@ func (a *CustomizationAPIService) DeletePreviewErrorPageExecute(r ApiDeletePrevi
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.DeletePreviewErrorPage")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/pages/error/preview"
@@ -2658,7 +2678,7 @@ func (a *CustomizationAPIService) DeletePreviewErrorPageExecute(r ApiDeletePrevi
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2673,6 +2693,7 @@ func (a *CustomizationAPIService) DeletePreviewErrorPageExecute(r ApiDeletePrevi
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2771,7 +2792,7 @@ func (a *CustomizationAPIService) DeletePreviewSignInPageExecute(r ApiDeletePrev
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.DeletePreviewSignInPage")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/pages/sign-in/preview"
@@ -2819,7 +2840,7 @@ func (a *CustomizationAPIService) DeletePreviewSignInPageExecute(r ApiDeletePrev
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2834,6 +2855,7 @@ func (a *CustomizationAPIService) DeletePreviewSignInPageExecute(r ApiDeletePrev
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2901,6 +2923,11 @@ func (r ApiGetBrandRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetBrandExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into BrandWithEmbedded.
+func (r ApiGetBrandRequest) ExecuteTyped() (BrandWithEmbedded, *APIResponse, error) {
+	return decodeResponse[BrandWithEmbedded](r.Execute())
+}
+
 /*
 GetBrand Retrieve a Brand
 
@@ -2940,7 +2967,7 @@ func (a *CustomizationAPIService) GetBrandExecute(r ApiGetBrandRequest) (*APIRes
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.GetBrand")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}"
@@ -2991,7 +3018,7 @@ func (a *CustomizationAPIService) GetBrandExecute(r ApiGetBrandRequest) (*APIRes
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -3006,6 +3033,7 @@ func (a *CustomizationAPIService) GetBrandExecute(r ApiGetBrandRequest) (*APIRes
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -3067,6 +3095,11 @@ func (r ApiGetBrandThemeRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetBrandThemeExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ThemeResponse.
+func (r ApiGetBrandThemeRequest) ExecuteTyped() (ThemeResponse, *APIResponse, error) {
+	return decodeResponse[ThemeResponse](r.Execute())
+}
+
 /*
 GetBrandTheme Retrieve a Theme
 
@@ -3108,7 +3141,7 @@ func (a *CustomizationAPIService) GetBrandThemeExecute(r ApiGetBrandThemeRequest
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.GetBrandTheme")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/themes/{themeId}"
@@ -3157,7 +3190,7 @@ func (a *CustomizationAPIService) GetBrandThemeExecute(r ApiGetBrandThemeRequest
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -3172,6 +3205,7 @@ func (a *CustomizationAPIService) GetBrandThemeExecute(r ApiGetBrandThemeRequest
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -3234,6 +3268,11 @@ func (r ApiGetCustomizationPreviewRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetCustomizationPreviewExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into EmailPreview.
+func (r ApiGetCustomizationPreviewRequest) ExecuteTyped() (EmailPreview, *APIResponse, error) {
+	return decodeResponse[EmailPreview](r.Execute())
+}
+
 /*
 GetCustomizationPreview Retrieve a Preview of an Email Customization
 
@@ -3280,7 +3319,7 @@ func (a *CustomizationAPIService) GetCustomizationPreviewExecute(r ApiGetCustomi
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.GetCustomizationPreview")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/templates/email/{templateName}/customizations/{customizationId}/preview"
@@ -3330,7 +3369,7 @@ func (a *CustomizationAPIService) GetCustomizationPreviewExecute(r ApiGetCustomi
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -3345,6 +3384,7 @@ func (a *CustomizationAPIService) GetCustomizationPreviewExecute(r ApiGetCustomi
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -3405,6 +3445,11 @@ func (r ApiGetCustomizedErrorPageRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetCustomizedErrorPageExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ErrorPage.
+func (r ApiGetCustomizedErrorPageRequest) ExecuteTyped() (ErrorPage, *APIResponse, error) {
+	return decodeResponse[ErrorPage](r.Execute())
+}
+
 /*
 GetCustomizedErrorPage Retrieve the Customized Error Page
 
@@ -3444,7 +3489,7 @@ func (a *CustomizationAPIService) GetCustomizedErrorPageExecute(r ApiGetCustomiz
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.GetCustomizedErrorPage")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/pages/error/customized"
@@ -3492,7 +3537,7 @@ func (a *CustomizationAPIService) GetCustomizedErrorPageExecute(r ApiGetCustomiz
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -3507,6 +3552,7 @@ func (a *CustomizationAPIService) GetCustomizedErrorPageExecute(r ApiGetCustomiz
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -3567,6 +3613,11 @@ func (r ApiGetCustomizedSignInPageRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetCustomizedSignInPageExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into SignInPage.
+func (r ApiGetCustomizedSignInPageRequest) ExecuteTyped() (SignInPage, *APIResponse, error) {
+	return decodeResponse[SignInPage](r.Execute())
+}
+
 /*
 GetCustomizedSignInPage Retrieve the Customized Sign-in Page
 
@@ -3606,7 +3657,7 @@ func (a *CustomizationAPIService) GetCustomizedSignInPageExecute(r ApiGetCustomi
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.GetCustomizedSignInPage")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/pages/sign-in/customized"
@@ -3654,7 +3705,7 @@ func (a *CustomizationAPIService) GetCustomizedSignInPageExecute(r ApiGetCustomi
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -3669,6 +3720,7 @@ func (a *CustomizationAPIService) GetCustomizedSignInPageExecute(r ApiGetCustomi
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -3729,6 +3781,11 @@ func (r ApiGetDefaultErrorPageRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetDefaultErrorPageExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ErrorPage.
+func (r ApiGetDefaultErrorPageRequest) ExecuteTyped() (ErrorPage, *APIResponse, error) {
+	return decodeResponse[ErrorPage](r.Execute())
+}
+
 /*
 GetDefaultErrorPage Retrieve the Default Error Page
 
@@ -3768,7 +3825,7 @@ func (a *CustomizationAPIService) GetDefaultErrorPageExecute(r ApiGetDefaultErro
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.GetDefaultErrorPage")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/pages/error/default"
@@ -3816,7 +3873,7 @@ func (a *CustomizationAPIService) GetDefaultErrorPageExecute(r ApiGetDefaultErro
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -3831,6 +3888,7 @@ func (a *CustomizationAPIService) GetDefaultErrorPageExecute(r ApiGetDefaultErro
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -3891,6 +3949,11 @@ func (r ApiGetDefaultSignInPageRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetDefaultSignInPageExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into SignInPage.
+func (r ApiGetDefaultSignInPageRequest) ExecuteTyped() (SignInPage, *APIResponse, error) {
+	return decodeResponse[SignInPage](r.Execute())
+}
+
 /*
 GetDefaultSignInPage Retrieve the Default Sign-in Page
 
@@ -3930,7 +3993,7 @@ func (a *CustomizationAPIService) GetDefaultSignInPageExecute(r ApiGetDefaultSig
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.GetDefaultSignInPage")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/pages/sign-in/default"
@@ -3978,7 +4041,7 @@ func (a *CustomizationAPIService) GetDefaultSignInPageExecute(r ApiGetDefaultSig
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -3993,6 +4056,7 @@ func (a *CustomizationAPIService) GetDefaultSignInPageExecute(r ApiGetDefaultSig
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -4055,6 +4119,11 @@ func (r ApiGetEmailCustomizationRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetEmailCustomizationExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into EmailCustomization.
+func (r ApiGetEmailCustomizationRequest) ExecuteTyped() (EmailCustomization, *APIResponse, error) {
+	return decodeResponse[EmailCustomization](r.Execute())
+}
+
 /*
 GetEmailCustomization Retrieve an Email Customization
 
@@ -4101,7 +4170,7 @@ func (a *CustomizationAPIService) GetEmailCustomizationExecute(r ApiGetEmailCust
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.GetEmailCustomization")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/templates/email/{templateName}/customizations/{customizationId}"
@@ -4151,7 +4220,7 @@ func (a *CustomizationAPIService) GetEmailCustomizationExecute(r ApiGetEmailCust
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -4166,6 +4235,7 @@ func (a *CustomizationAPIService) GetEmailCustomizationExecute(r ApiGetEmailCust
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -4234,6 +4304,11 @@ func (r ApiGetEmailDefaultContentRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetEmailDefaultContentExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into EmailDefaultContent.
+func (r ApiGetEmailDefaultContentRequest) ExecuteTyped() (EmailDefaultContent, *APIResponse, error) {
+	return decodeResponse[EmailDefaultContent](r.Execute())
+}
+
 /*
 GetEmailDefaultContent Retrieve an Email Template Default Content
 
@@ -4280,7 +4355,7 @@ func (a *CustomizationAPIService) GetEmailDefaultContentExecute(r ApiGetEmailDef
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.GetEmailDefaultContent")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/templates/email/{templateName}/default-content"
@@ -4332,7 +4407,7 @@ func (a *CustomizationAPIService) GetEmailDefaultContentExecute(r ApiGetEmailDef
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -4347,6 +4422,7 @@ func (a *CustomizationAPIService) GetEmailDefaultContentExecute(r ApiGetEmailDef
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -4415,6 +4491,11 @@ func (r ApiGetEmailDefaultPreviewRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetEmailDefaultPreviewExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into EmailPreview.
+func (r ApiGetEmailDefaultPreviewRequest) ExecuteTyped() (EmailPreview, *APIResponse, error) {
+	return decodeResponse[EmailPreview](r.Execute())
+}
+
 /*
 GetEmailDefaultPreview Retrieve a Preview of the Email Template default content
 
@@ -4461,7 +4542,7 @@ func (a *CustomizationAPIService) GetEmailDefaultPreviewExecute(r ApiGetEmailDef
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.GetEmailDefaultPreview")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/templates/email/{templateName}/default-content/preview"
@@ -4513,7 +4594,7 @@ func (a *CustomizationAPIService) GetEmailDefaultPreviewExecute(r ApiGetEmailDef
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -4528,6 +4609,7 @@ func (a *CustomizationAPIService) GetEmailDefaultPreviewExecute(r ApiGetEmailDef
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -4589,6 +4671,11 @@ func (r ApiGetEmailSettingsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetEmailSettingsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into EmailSettings.
+func (r ApiGetEmailSettingsRequest) ExecuteTyped() (EmailSettings, *APIResponse, error) {
+	return decodeResponse[EmailSettings](r.Execute())
+}
+
 /*
 GetEmailSettings Retrieve the Email Template Settings
 
@@ -4630,7 +4717,7 @@ func (a *CustomizationAPIService) GetEmailSettingsExecute(r ApiGetEmailSettingsR
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.GetEmailSettings")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/templates/email/{templateName}/settings"
@@ -4679,7 +4766,7 @@ func (a *CustomizationAPIService) GetEmailSettingsExecute(r ApiGetEmailSettingsR
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -4694,6 +4781,7 @@ func (a *CustomizationAPIService) GetEmailSettingsExecute(r ApiGetEmailSettingsR
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -4762,6 +4850,11 @@ func (r ApiGetEmailTemplateRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetEmailTemplateExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into EmailTemplate.
+func (r ApiGetEmailTemplateRequest) ExecuteTyped() (EmailTemplate, *APIResponse, error) {
+	return decodeResponse[EmailTemplate](r.Execute())
+}
+
 /*
 GetEmailTemplate Retrieve an Email Template
 
@@ -4803,7 +4896,7 @@ func (a *CustomizationAPIService) GetEmailTemplateExecute(r ApiGetEmailTemplateR
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.GetEmailTemplate")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/templates/email/{templateName}"
@@ -4855,7 +4948,7 @@ func (a *CustomizationAPIService) GetEmailTemplateExecute(r ApiGetEmailTemplateR
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -4870,6 +4963,7 @@ func (a *CustomizationAPIService) GetEmailTemplateExecute(r ApiGetEmailTemplateR
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -4937,6 +5031,11 @@ func (r ApiGetErrorPageRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetErrorPageExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into PageRoot.
+func (r ApiGetErrorPageRequest) ExecuteTyped() (PageRoot, *APIResponse, error) {
+	return decodeResponse[PageRoot](r.Execute())
+}
+
 /*
 GetErrorPage Retrieve the Error Page Sub-Resources
 
@@ -4976,7 +5075,7 @@ func (a *CustomizationAPIService) GetErrorPageExecute(r ApiGetErrorPageRequest)
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.GetErrorPage")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/pages/error"
@@ -5027,7 +5126,7 @@ func (a *CustomizationAPIService) GetErrorPageExecute(r ApiGetErrorPageRequest)
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -5042,6 +5141,7 @@ func (a *CustomizationAPIService) GetErrorPageExecute(r ApiGetErrorPageRequest)
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -5102,6 +5202,11 @@ func (r ApiGetPreviewErrorPageRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetPreviewErrorPageExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ErrorPage.
+func (r ApiGetPreviewErrorPageRequest) ExecuteTyped() (ErrorPage, *APIResponse, error) {
+	return decodeResponse[ErrorPage](r.Execute())
+}
+
 /*
 GetPreviewErrorPage Retrieve the Preview Error Page Preview
 
@@ -5141,7 +5246,7 @@ func (a *CustomizationAPIService) GetPreviewErrorPageExecute(r ApiGetPreviewErro
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.GetPreviewErrorPage")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/pages/error/preview"
@@ -5189,7 +5294,7 @@ func (a *CustomizationAPIService) GetPreviewErrorPageExecute(r ApiGetPreviewErro
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -5204,6 +5309,7 @@ func (a *CustomizationAPIService) GetPreviewErrorPageExecute(r ApiGetPreviewErro
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -5264,6 +5370,11 @@ func (r ApiGetPreviewSignInPageRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetPreviewSignInPageExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into SignInPage.
+func (r ApiGetPreviewSignInPageRequest) ExecuteTyped() (SignInPage, *APIResponse, error) {
+	return decodeResponse[SignInPage](r.Execute())
+}
+
 /*
 GetPreviewSignInPage Retrieve the Preview Sign-in Page Preview
 
@@ -5303,7 +5414,7 @@ func (a *CustomizationAPIService) GetPreviewSignInPageExecute(r ApiGetPreviewSig
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.GetPreviewSignInPage")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/pages/sign-in/preview"
@@ -5351,7 +5462,7 @@ func (a *CustomizationAPIService) GetPreviewSignInPageExecute(r ApiGetPreviewSig
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -5366,6 +5477,7 @@ func (a *CustomizationAPIService) GetPreviewSignInPageExecute(r ApiGetPreviewSig
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -5433,6 +5545,11 @@ func (r ApiGetSignInPageRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetSignInPageExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into PageRoot.
+func (r ApiGetSignInPageRequest) ExecuteTyped() (PageRoot, *APIResponse, error) {
+	return decodeResponse[PageRoot](r.Execute())
+}
+
 /*
 GetSignInPage Retrieve the Sign-in Page Sub-Resources
 
@@ -5472,7 +5589,7 @@ func (a *CustomizationAPIService) GetSignInPageExecute(r ApiGetSignInPageRequest
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.GetSignInPage")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/pages/sign-in"
@@ -5523,7 +5640,7 @@ func (a *CustomizationAPIService) GetSignInPageExecute(r ApiGetSignInPageRequest
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -5538,6 +5655,7 @@ func (a *CustomizationAPIService) GetSignInPageExecute(r ApiGetSignInPageRequest
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -5598,6 +5716,11 @@ func (r ApiGetSignOutPageSettingsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetSignOutPageSettingsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into HostedPage.
+func (r ApiGetSignOutPageSettingsRequest) ExecuteTyped() (HostedPage, *APIResponse, error) {
+	return decodeResponse[HostedPage](r.Execute())
+}
+
 /*
 GetSignOutPageSettings Retrieve the Sign-out Page Settings
 
@@ -5637,7 +5760,7 @@ func (a *CustomizationAPIService) GetSignOutPageSettingsExecute(r ApiGetSignOutP
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.GetSignOutPageSettings")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/pages/sign-out/customized"
@@ -5685,7 +5808,7 @@ func (a *CustomizationAPIService) GetSignOutPageSettingsExecute(r ApiGetSignOutP
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -5700,6 +5823,7 @@ func (a *CustomizationAPIService) GetSignOutPageSettingsExecute(r ApiGetSignOutP
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -5760,6 +5884,11 @@ func (r ApiListAllSignInWidgetVersionsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListAllSignInWidgetVersionsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []string.
+func (r ApiListAllSignInWidgetVersionsRequest) ExecuteTyped() ([]string, *APIResponse, error) {
+	return decodeResponse[[]string](r.Execute())
+}
+
 /*
 ListAllSignInWidgetVersions List all Sign-in Widget Versions
 
@@ -5799,7 +5928,7 @@ func (a *CustomizationAPIService) ListAllSignInWidgetVersionsExecute(r ApiListAl
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.ListAllSignInWidgetVersions")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/pages/sign-in/widget-versions"
@@ -5847,7 +5976,7 @@ func (a *CustomizationAPIService) ListAllSignInWidgetVersionsExecute(r ApiListAl
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -5862,6 +5991,7 @@ func (a *CustomizationAPIService) ListAllSignInWidgetVersionsExecute(r ApiListAl
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -5922,6 +6052,11 @@ func (r ApiListBrandDomainsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListBrandDomainsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []DomainResponse.
+func (r ApiListBrandDomainsRequest) ExecuteTyped() ([]DomainResponse, *APIResponse, error) {
+	return decodeResponse[[]DomainResponse](r.Execute())
+}
+
 /*
 ListBrandDomains List all Domains associated with a Brand
 
@@ -5961,7 +6096,7 @@ func (a *CustomizationAPIService) ListBrandDomainsExecute(r ApiListBrandDomainsR
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.ListBrandDomains")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/domains"
@@ -6009,7 +6144,7 @@ func (a *CustomizationAPIService) ListBrandDomainsExecute(r ApiListBrandDomainsR
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -6024,6 +6159,7 @@ func (a *CustomizationAPIService) ListBrandDomainsExecute(r ApiListBrandDomainsR
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -6084,6 +6220,11 @@ func (r ApiListBrandThemesRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListBrandThemesExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []ThemeResponse.
+func (r ApiListBrandThemesRequest) ExecuteTyped() ([]ThemeResponse, *APIResponse, error) {
+	return decodeResponse[[]ThemeResponse](r.Execute())
+}
+
 /*
 ListBrandThemes List all Themes
 
@@ -6123,7 +6264,7 @@ func (a *CustomizationAPIService) ListBrandThemesExecute(r ApiListBrandThemesReq
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.ListBrandThemes")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/themes"
@@ -6171,7 +6312,7 @@ func (a *CustomizationAPIService) ListBrandThemesExecute(r ApiListBrandThemesReq
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -6186,6 +6327,7 @@ func (a *CustomizationAPIService) ListBrandThemesExecute(r ApiListBrandThemesReq
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -6273,6 +6415,11 @@ func (r ApiListBrandsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListBrandsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []BrandWithEmbedded.
+func (r ApiListBrandsRequest) ExecuteTyped() ([]BrandWithEmbedded, *APIResponse, error) {
+	return decodeResponse[[]BrandWithEmbedded](r.Execute())
+}
+
 /*
 ListBrands List all Brands
 
@@ -6310,7 +6457,7 @@ func (a *CustomizationAPIService) ListBrandsExecute(r ApiListBrandsRequest) (*AP
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.ListBrands")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands"
@@ -6369,7 +6516,7 @@ func (a *CustomizationAPIService) ListBrandsExecute(r ApiListBrandsRequest) (*AP
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -6384,6 +6531,7 @@ func (a *CustomizationAPIService) ListBrandsExecute(r ApiListBrandsRequest) (*AP
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -6447,6 +6595,11 @@ func (r ApiListEmailCustomizationsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListEmailCustomizationsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []EmailCustomization.
+func (r ApiListEmailCustomizationsRequest) ExecuteTyped() ([]EmailCustomization, *APIResponse, error) {
+	return decodeResponse[[]EmailCustomization](r.Execute())
+}
+
 /*
 ListEmailCustomizations List all Email Customizations
 
@@ -6491,7 +6644,7 @@ func (a *CustomizationAPIService) ListEmailCustomizationsExecute(r ApiListEmailC
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.ListEmailCustomizations")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/templates/email/{templateName}/customizations"
@@ -6546,7 +6699,7 @@ func (a *CustomizationAPIService) ListEmailCustomizationsExecute(r ApiListEmailC
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -6561,6 +6714,7 @@ func (a *CustomizationAPIService) ListEmailCustomizationsExecute(r ApiListEmailC
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -6642,6 +6796,11 @@ func (r ApiListEmailTemplatesRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListEmailTemplatesExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []EmailTemplate.
+func (r ApiListEmailTemplatesRequest) ExecuteTyped() ([]EmailTemplate, *APIResponse, error) {
+	return decodeResponse[[]EmailTemplate](r.Execute())
+}
+
 /*
 ListEmailTemplates List all Email Templates
 
@@ -6681,7 +6840,7 @@ func (a *CustomizationAPIService) ListEmailTemplatesExecute(r ApiListEmailTempla
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.ListEmailTemplates")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/templates/email"
@@ -6738,7 +6897,7 @@ func (a *CustomizationAPIService) ListEmailTemplatesExecute(r ApiListEmailTempla
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -6753,6 +6912,7 @@ func (a *CustomizationAPIService) ListEmailTemplatesExecute(r ApiListEmailTempla
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -6826,6 +6986,11 @@ func (r ApiReplaceBrandRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ReplaceBrandExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into Brand.
+func (r ApiReplaceBrandRequest) ExecuteTyped() (Brand, *APIResponse, error) {
+	return decodeResponse[Brand](r.Execute())
+}
+
 /*
 ReplaceBrand Replace a Brand
 
@@ -6865,7 +7030,7 @@ func (a *CustomizationAPIService) ReplaceBrandExecute(r ApiReplaceBrandRequest)
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.ReplaceBrand")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}"
@@ -6919,7 +7084,7 @@ func (a *CustomizationAPIService) ReplaceBrandExecute(r ApiReplaceBrandRequest)
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -6934,6 +7099,7 @@ func (a *CustomizationAPIService) ReplaceBrandExecute(r ApiReplaceBrandRequest)
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -7013,6 +7179,11 @@ func (r ApiReplaceBrandThemeRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ReplaceBrandThemeExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ThemeResponse.
+func (r ApiReplaceBrandThemeRequest) ExecuteTyped() (ThemeResponse, *APIResponse, error) {
+	return decodeResponse[ThemeResponse](r.Execute())
+}
+
 /*
 ReplaceBrandTheme Replace a Theme
 
@@ -7054,7 +7225,7 @@ func (a *CustomizationAPIService) ReplaceBrandThemeExecute(r ApiReplaceBrandThem
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.ReplaceBrandTheme")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/themes/{themeId}"
@@ -7106,7 +7277,7 @@ func (a *CustomizationAPIService) ReplaceBrandThemeExecute(r ApiReplaceBrandThem
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -7121,6 +7292,7 @@ func (a *CustomizationAPIService) ReplaceBrandThemeExecute(r ApiReplaceBrandThem
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -7199,6 +7371,11 @@ func (r ApiReplaceCustomizedErrorPageRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ReplaceCustomizedErrorPageExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ErrorPage.
+func (r ApiReplaceCustomizedErrorPageRequest) ExecuteTyped() (ErrorPage, *APIResponse, error) {
+	return decodeResponse[ErrorPage](r.Execute())
+}
+
 /*
 ReplaceCustomizedErrorPage Replace the Customized Error Page
 
@@ -7238,7 +7415,7 @@ func (a *CustomizationAPIService) ReplaceCustomizedErrorPageExecute(r ApiReplace
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.ReplaceCustomizedErrorPage")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/pages/error/customized"
@@ -7289,7 +7466,7 @@ func (a *CustomizationAPIService) ReplaceCustomizedErrorPageExecute(r ApiReplace
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -7304,6 +7481,7 @@ func (a *CustomizationAPIService) ReplaceCustomizedErrorPageExecute(r ApiReplace
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -7382,6 +7560,11 @@ func (r ApiReplaceCustomizedSignInPageRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ReplaceCustomizedSignInPageExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into SignInPage.
+func (r ApiReplaceCustomizedSignInPageRequest) ExecuteTyped() (SignInPage, *APIResponse, error) {
+	return decodeResponse[SignInPage](r.Execute())
+}
+
 /*
 ReplaceCustomizedSignInPage Replace the Customized Sign-in Page
 
@@ -7421,7 +7604,7 @@ func (a *CustomizationAPIService) ReplaceCustomizedSignInPageExecute(r ApiReplac
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.ReplaceCustomizedSignInPage")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/pages/sign-in/customized"
@@ -7472,7 +7655,7 @@ func (a *CustomizationAPIService) ReplaceCustomizedSignInPageExecute(r ApiReplac
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -7487,6 +7670,7 @@ func (a *CustomizationAPIService) ReplaceCustomizedSignInPageExecute(r ApiReplac
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -7568,6 +7752,11 @@ func (r ApiReplaceEmailCustomizationRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ReplaceEmailCustomizationExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into EmailCustomization.
+func (r ApiReplaceEmailCustomizationRequest) ExecuteTyped() (EmailCustomization, *APIResponse, error) {
+	return decodeResponse[EmailCustomization](r.Execute())
+}
+
 /*
 ReplaceEmailCustomization Replace an Email Customization
 
@@ -7614,7 +7803,7 @@ func (a *CustomizationAPIService) ReplaceEmailCustomizationExecute(r ApiReplaceE
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.ReplaceEmailCustomization")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/templates/email/{templateName}/customizations/{customizationId}"
@@ -7667,7 +7856,7 @@ func (a *CustomizationAPIService) ReplaceEmailCustomizationExecute(r ApiReplaceE
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -7682,6 +7871,7 @@ func (a *CustomizationAPIService) ReplaceEmailCustomizationExecute(r ApiReplaceE
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -7813,7 +8003,7 @@ func (a *CustomizationAPIService) ReplaceEmailSettingsExecute(r ApiReplaceEmailS
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.ReplaceEmailSettings")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/templates/email/{templateName}/settings"
@@ -7865,7 +8055,7 @@ func (a *CustomizationAPIService) ReplaceEmailSettingsExecute(r ApiReplaceEmailS
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -7880,6 +8070,7 @@ func (a *CustomizationAPIService) ReplaceEmailSettingsExecute(r ApiReplaceEmailS
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -7970,6 +8161,11 @@ func (r ApiReplacePreviewErrorPageRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ReplacePreviewErrorPageExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ErrorPage.
+func (r ApiReplacePreviewErrorPageRequest) ExecuteTyped() (ErrorPage, *APIResponse, error) {
+	return decodeResponse[ErrorPage](r.Execute())
+}
+
 /*
 ReplacePreviewErrorPage Replace the Preview Error Page
 
@@ -8009,7 +8205,7 @@ func (a *CustomizationAPIService) ReplacePreviewErrorPageExecute(r ApiReplacePre
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.ReplacePreviewErrorPage")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/pages/error/preview"
@@ -8060,7 +8256,7 @@ func (a *CustomizationAPIService) ReplacePreviewErrorPageExecute(r ApiReplacePre
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -8075,6 +8271,7 @@ func (a *CustomizationAPIService) ReplacePreviewErrorPageExecute(r ApiReplacePre
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -8153,6 +8350,11 @@ func (r ApiReplacePreviewSignInPageRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ReplacePreviewSignInPageExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into SignInPage.
+func (r ApiReplacePreviewSignInPageRequest) ExecuteTyped() (SignInPage, *APIResponse, error) {
+	return decodeResponse[SignInPage](r.Execute())
+}
+
 /*
 ReplacePreviewSignInPage Replace the Preview Sign-in Page
 
@@ -8192,7 +8394,7 @@ func (a *CustomizationAPIService) ReplacePreviewSignInPageExecute(r ApiReplacePr
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.ReplacePreviewSignInPage")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/pages/sign-in/preview"
@@ -8243,7 +8445,7 @@ func (a *CustomizationAPIService) ReplacePreviewSignInPageExecute(r ApiReplacePr
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -8258,6 +8460,7 @@ func (a *CustomizationAPIService) ReplacePreviewSignInPageExecute(r ApiReplacePr
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -8336,6 +8539,11 @@ func (r ApiReplaceSignOutPageSettingsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ReplaceSignOutPageSettingsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into HostedPage.
+func (r ApiReplaceSignOutPageSettingsRequest) ExecuteTyped() (HostedPage, *APIResponse, error) {
+	return decodeResponse[HostedPage](r.Execute())
+}
+
 /*
 ReplaceSignOutPageSettings Replace the Sign-out Page Settings
 
@@ -8375,7 +8583,7 @@ func (a *CustomizationAPIService) ReplaceSignOutPageSettingsExecute(r ApiReplace
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.ReplaceSignOutPageSettings")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/pages/sign-out/customized"
@@ -8426,7 +8634,7 @@ func (a *CustomizationAPIService) ReplaceSignOutPageSettingsExecute(r ApiReplace
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -8441,6 +8649,7 @@ func (a *CustomizationAPIService) ReplaceSignOutPageSettingsExecute(r ApiReplace
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -8565,7 +8774,7 @@ func (a *CustomizationAPIService) SendTestEmailExecute(r ApiSendTestEmailRequest
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.SendTestEmail")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/templates/email/{templateName}/test"
@@ -8617,7 +8826,7 @@ func (a *CustomizationAPIService) SendTestEmailExecute(r ApiSendTestEmailRequest
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -8632,6 +8841,7 @@ func (a *CustomizationAPIService) SendTestEmailExecute(r ApiSendTestEmailRequest
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -8699,6 +8909,11 @@ func (r ApiUploadBrandThemeBackgroundImageRequest) Execute() (*APIResponse, erro
 	return r.ApiService.UploadBrandThemeBackgroundImageExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ImageUploadResponse.
+func (r ApiUploadBrandThemeBackgroundImageRequest) ExecuteTyped() (ImageUploadResponse, *APIResponse, error) {
+	return decodeResponse[ImageUploadResponse](r.Execute())
+}
+
 /*
 UploadBrandThemeBackgroundImage Upload the Background Image
 
@@ -8740,7 +8955,7 @@ func (a *CustomizationAPIService) UploadBrandThemeBackgroundImageExecute(r ApiUp
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.UploadBrandThemeBackgroundImage")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/themes/{themeId}/background-image"
@@ -8803,7 +9018,7 @@ func (a *CustomizationAPIService) UploadBrandThemeBackgroundImageExecute(r ApiUp
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -8818,6 +9033,7 @@ func (a *CustomizationAPIService) UploadBrandThemeBackgroundImageExecute(r ApiUp
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -8897,6 +9113,11 @@ func (r ApiUploadBrandThemeFaviconRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.UploadBrandThemeFaviconExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ImageUploadResponse.
+func (r ApiUploadBrandThemeFaviconRequest) ExecuteTyped() (ImageUploadResponse, *APIResponse, error) {
+	return decodeResponse[ImageUploadResponse](r.Execute())
+}
+
 /*
 UploadBrandThemeFavicon Upload the Favicon
 
@@ -8938,7 +9159,7 @@ func (a *CustomizationAPIService) UploadBrandThemeFaviconExecute(r ApiUploadBran
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.UploadBrandThemeFavicon")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/themes/{themeId}/favicon"
@@ -9001,7 +9222,7 @@ func (a *CustomizationAPIService) UploadBrandThemeFaviconExecute(r ApiUploadBran
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -9016,6 +9237,7 @@ func (a *CustomizationAPIService) UploadBrandThemeFaviconExecute(r ApiUploadBran
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -9095,6 +9317,11 @@ func (r ApiUploadBrandThemeLogoRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.UploadBrandThemeLogoExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ImageUploadResponse.
+func (r ApiUploadBrandThemeLogoRequest) ExecuteTyped() (ImageUploadResponse, *APIResponse, error) {
+	return decodeResponse[ImageUploadResponse](r.Execute())
+}
+
 /*
 UploadBrandThemeLogo Upload the Logo
 
@@ -9136,7 +9363,7 @@ func (a *CustomizationAPIService) UploadBrandThemeLogoExecute(r ApiUploadBrandTh
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomizationAPIService.UploadBrandThemeLogo")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/themes/{themeId}/logo"
@@ -9199,7 +9426,7 @@ func (a *CustomizationAPIService) UploadBrandThemeLogoExecute(r ApiUploadBrandTh
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -9214,6 +9441,7 @@ func (a *CustomizationAPIService) UploadBrandThemeLogoExecute(r ApiUploadBrandTh
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -213,7 +213,7 @@ func (a *DeviceAPIService) ActivateDeviceExecute(r ApiActivateDeviceRequest) (*A
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DeviceAPIService.ActivateDevice")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/devices/{deviceId}/lifecycle/activate"
@@ -261,7 +261,7 @@ func (a *DeviceAPIService) ActivateDeviceExecute(r ApiActivateDeviceRequest) (*A
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -276,6 +276,7 @@ func (a *DeviceAPIService) ActivateDeviceExecute(r ApiActivateDeviceRequest) (*A
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -379,7 +380,7 @@ func (a *DeviceAPIService) DeactivateDeviceExecute(r ApiDeactivateDeviceRequest)
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DeviceAPIService.DeactivateDevice")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/devices/{deviceId}/lifecycle/deactivate"
@@ -427,7 +428,7 @@ func (a *DeviceAPIService) DeactivateDeviceExecute(r ApiDeactivateDeviceRequest)
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -442,6 +443,7 @@ func (a *DeviceAPIService) DeactivateDeviceExecute(r ApiDeactivateDeviceRequest)
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -542,7 +544,7 @@ func (a *DeviceAPIService) DeleteDeviceExecute(r ApiDeleteDeviceRequest) (*APIRe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DeviceAPIService.DeleteDevice")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/devices/{deviceId}"
@@ -590,7 +592,7 @@ func (a *DeviceAPIService) DeleteDeviceExecute(r ApiDeleteDeviceRequest) (*APIRe
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -605,6 +607,7 @@ func (a *DeviceAPIService) DeleteDeviceExecute(r ApiDeleteDeviceRequest) (*APIRe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -665,6 +668,11 @@ func (r ApiGetDeviceRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetDeviceExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into Device.
+func (r ApiGetDeviceRequest) ExecuteTyped() (Device, *APIResponse, error) {
+	return decodeResponse[Device](r.Execute())
+}
+
 /*
 GetDevice Retrieve a Device
 
@@ -704,7 +712,7 @@ func (a *DeviceAPIService) GetDeviceExecute(r ApiGetDeviceRequest) (*APIResponse
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DeviceAPIService.GetDevice")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/devices/{deviceId}"
@@ -752,7 +760,7 @@ func (a *DeviceAPIService) GetDeviceExecute(r ApiGetDeviceRequest) (*APIResponse
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -767,6 +775,7 @@ func (a *DeviceAPIService) GetDeviceExecute(r ApiGetDeviceRequest) (*APIResponse
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -827,6 +836,11 @@ func (r ApiListDeviceUsersRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListDeviceUsersExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []DeviceUser.
+func (r ApiListDeviceUsersRequest) ExecuteTyped() ([]DeviceUser, *APIResponse, error) {
+	return decodeResponse[[]DeviceUser](r.Execute())
+}
+
 /*
 ListDeviceUsers List all Users for a Device
 
@@ -866,7 +880,7 @@ func (a *DeviceAPIService) ListDeviceUsersExecute(r ApiListDeviceUsersRequest) (
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DeviceAPIService.ListDeviceUsers")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/devices/{deviceId}/users"
@@ -914,7 +928,7 @@ func (a *DeviceAPIService) ListDeviceUsersExecute(r ApiListDeviceUsersRequest) (
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -929,6 +943,7 @@ func (a *DeviceAPIService) ListDeviceUsersExecute(r ApiListDeviceUsersRequest) (
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1015,6 +1030,11 @@ func (r ApiListDevicesRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListDevicesExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []DeviceList.
+func (r ApiListDevicesRequest) ExecuteTyped() ([]DeviceList, *APIResponse, error) {
+	return decodeResponse[[]DeviceList](r.Execute())
+}
+
 /*
 ListDevices List all Devices
 
@@ -1056,7 +1076,7 @@ func (a *DeviceAPIService) ListDevicesExecute(r ApiListDevicesRequest) (*APIResp
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DeviceAPIService.ListDevices")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/devices"
@@ -1115,7 +1135,7 @@ func (a *DeviceAPIService) ListDevicesExecute(r ApiListDevicesRequest) (*APIResp
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1130,6 +1150,7 @@ func (a *DeviceAPIService) ListDevicesExecute(r ApiListDevicesRequest) (*APIResp
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1219,7 +1240,7 @@ func (a *DeviceAPIService) SuspendDeviceExecute(r ApiSuspendDeviceRequest) (*API
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DeviceAPIService.SuspendDevice")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/devices/{deviceId}/lifecycle/suspend"
@@ -1267,7 +1288,7 @@ func (a *DeviceAPIService) SuspendDeviceExecute(r ApiSuspendDeviceRequest) (*API
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1282,6 +1303,7 @@ func (a *DeviceAPIService) SuspendDeviceExecute(r ApiSuspendDeviceRequest) (*API
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1381,7 +1403,7 @@ func (a *DeviceAPIService) UnsuspendDeviceExecute(r ApiUnsuspendDeviceRequest) (
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DeviceAPIService.UnsuspendDevice")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/devices/{deviceId}/lifecycle/unsuspend"
@@ -1429,7 +1451,7 @@ func (a *DeviceAPIService) UnsuspendDeviceExecute(r ApiUnsuspendDeviceRequest) (
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1444,6 +1466,7 @@ func (a *DeviceAPIService) UnsuspendDeviceExecute(r ApiUnsuspendDeviceRequest) (
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -121,6 +121,11 @@ func (r ApiCreateDeviceAssurancePolicyRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.CreateDeviceAssurancePolicyExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ListDeviceAssurancePolicies200ResponseInner.
+func (r ApiCreateDeviceAssurancePolicyRequest) ExecuteTyped() (ListDeviceAssurancePolicies200ResponseInner, *APIResponse, error) {
+	return decodeResponse[ListDeviceAssurancePolicies200ResponseInner](r.Execute())
+}
+
 /*
 CreateDeviceAssurancePolicy Create a Device Assurance Policy
 
@@ -158,7 +163,7 @@ func (a *DeviceAssuranceAPIService) CreateDeviceAssurancePolicyExecute(r ApiCrea
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DeviceAssuranceAPIService.CreateDeviceAssurancePolicy")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/device-assurances"
@@ -208,7 +213,7 @@ func (a *DeviceAssuranceAPIService) CreateDeviceAssurancePolicyExecute(r ApiCrea
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -223,6 +228,7 @@ func (a *DeviceAssuranceAPIService) CreateDeviceAssurancePolicyExecute(r ApiCrea
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -321,7 +327,7 @@ func (a *DeviceAssuranceAPIService) DeleteDeviceAssurancePolicyExecute(r ApiDele
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DeviceAssuranceAPIService.DeleteDeviceAssurancePolicy")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/device-assurances/{deviceAssuranceId}"
@@ -369,7 +375,7 @@ func (a *DeviceAssuranceAPIService) DeleteDeviceAssurancePolicyExecute(r ApiDele
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -384,6 +390,7 @@ func (a *DeviceAssuranceAPIService) DeleteDeviceAssurancePolicyExecute(r ApiDele
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -456,6 +463,11 @@ func (r ApiGetDeviceAssurancePolicyRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetDeviceAssurancePolicyExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ListDeviceAssurancePolicies200ResponseInner.
+func (r ApiGetDeviceAssurancePolicyRequest) ExecuteTyped() (ListDeviceAssurancePolicies200ResponseInner, *APIResponse, error) {
+	return decodeResponse[ListDeviceAssurancePolicies200ResponseInner](r.Execute())
+}
+
 /*
 GetDeviceAssurancePolicy Retrieve a Device Assurance Policy
 
@@ -495,7 +507,7 @@ func (a *DeviceAssuranceAPIService) GetDeviceAssurancePolicyExecute(r ApiGetDevi
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DeviceAssuranceAPIService.GetDeviceAssurancePolicy")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/device-assurances/{deviceAssuranceId}"
@@ -543,7 +555,7 @@ func (a *DeviceAssuranceAPIService) GetDeviceAssurancePolicyExecute(r ApiGetDevi
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -558,6 +570,7 @@ func (a *DeviceAssuranceAPIService) GetDeviceAssurancePolicyExecute(r ApiGetDevi
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -617,6 +630,11 @@ func (r ApiListDeviceAssurancePoliciesRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListDeviceAssurancePoliciesExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []ListDeviceAssurancePolicies200ResponseInner.
+func (r ApiListDeviceAssurancePoliciesRequest) ExecuteTyped() ([]ListDeviceAssurancePolicies200ResponseInner, *APIResponse, error) {
+	return decodeResponse[[]ListDeviceAssurancePolicies200ResponseInner](r.Execute())
+}
+
 /*
 ListDeviceAssurancePolicies List all Device Assurance Policies
 
@@ -654,7 +672,7 @@ func (a *DeviceAssuranceAPIService) ListDeviceAssurancePoliciesExecute(r ApiList
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DeviceAssuranceAPIService.ListDeviceAssurancePolicies")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/device-assurances"
@@ -701,7 +719,7 @@ func (a *DeviceAssuranceAPIService) ListDeviceAssurancePoliciesExecute(r ApiList
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -716,6 +734,7 @@ func (a *DeviceAssuranceAPIService) ListDeviceAssurancePoliciesExecute(r ApiList
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -770,6 +789,11 @@ func (r ApiReplaceDeviceAssurancePolicyRequest) Execute() (*APIResponse, error)
 	return r.ApiService.ReplaceDeviceAssurancePolicyExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ListDeviceAssurancePolicies200ResponseInner.
+func (r ApiReplaceDeviceAssurancePolicyRequest) ExecuteTyped() (ListDeviceAssurancePolicies200ResponseInner, *APIResponse, error) {
+	return decodeResponse[ListDeviceAssurancePolicies200ResponseInner](r.Execute())
+}
+
 /*
 ReplaceDeviceAssurancePolicy Replace a Device Assurance Policy
 
@@ -809,7 +833,7 @@ func (a *DeviceAssuranceAPIService) ReplaceDeviceAssurancePolicyExecute(r ApiRep
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DeviceAssuranceAPIService.ReplaceDeviceAssurancePolicy")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/device-assurances/{deviceAssuranceId}"
@@ -860,7 +884,7 @@ func (a *DeviceAssuranceAPIService) ReplaceDeviceAssurancePolicyExecute(r ApiRep
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -875,6 +899,7 @@ func (a *DeviceAssuranceAPIService) ReplaceDeviceAssurancePolicyExecute(r ApiRep
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -143,6 +143,11 @@ func (r ApiCreateEmailDomainRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.CreateEmailDomainExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into EmailDomainResponse.
+func (r ApiCreateEmailDomainRequest) ExecuteTyped() (EmailDomainResponse, *APIResponse, error) {
+	return decodeResponse[EmailDomainResponse](r.Execute())
+}
+
 /*
 CreateEmailDomain Create an Email Domain
 
@@ -180,7 +185,7 @@ func (a *EmailDomainAPIService) CreateEmailDomainExecute(r ApiCreateEmailDomainR
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "EmailDomainAPIService.CreateEmailDomain")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/email-domains"
@@ -233,7 +238,7 @@ func (a *EmailDomainAPIService) CreateEmailDomainExecute(r ApiCreateEmailDomainR
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -248,6 +253,7 @@ func (a *EmailDomainAPIService) CreateEmailDomainExecute(r ApiCreateEmailDomainR
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -377,7 +383,7 @@ func (a *EmailDomainAPIService) DeleteEmailDomainExecute(r ApiDeleteEmailDomainR
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "EmailDomainAPIService.DeleteEmailDomain")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/email-domains/{emailDomainId}"
@@ -428,7 +434,7 @@ func (a *EmailDomainAPIService) DeleteEmailDomainExecute(r ApiDeleteEmailDomainR
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -443,6 +449,7 @@ func (a *EmailDomainAPIService) DeleteEmailDomainExecute(r ApiDeleteEmailDomainR
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -522,6 +529,11 @@ func (r ApiGetEmailDomainRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetEmailDomainExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into EmailDomainResponseWithEmbedded.
+func (r ApiGetEmailDomainRequest) ExecuteTyped() (EmailDomainResponseWithEmbedded, *APIResponse, error) {
+	return decodeResponse[EmailDomainResponseWithEmbedded](r.Execute())
+}
+
 /*
 GetEmailDomain Retrieve an Email Domain
 
@@ -561,7 +573,7 @@ func (a *EmailDomainAPIService) GetEmailDomainExecute(r ApiGetEmailDomainRequest
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "EmailDomainAPIService.GetEmailDomain")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/email-domains/{emailDomainId}"
@@ -612,7 +624,7 @@ func (a *EmailDomainAPIService) GetEmailDomainExecute(r ApiGetEmailDomainRequest
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -627,6 +639,7 @@ func (a *EmailDomainAPIService) GetEmailDomainExecute(r ApiGetEmailDomainRequest
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -693,6 +706,11 @@ func (r ApiListEmailDomainsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListEmailDomainsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []EmailDomainResponseWithEmbedded.
+func (r ApiListEmailDomainsRequest) ExecuteTyped() ([]EmailDomainResponseWithEmbedded, *APIResponse, error) {
+	return decodeResponse[[]EmailDomainResponseWithEmbedded](r.Execute())
+}
+
 /*
 ListEmailDomains List all Email Domains
 
@@ -730,7 +748,7 @@ func (a *EmailDomainAPIService) ListEmailDomainsExecute(r ApiListEmailDomainsReq
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "EmailDomainAPIService.ListEmailDomains")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/email-domains"
@@ -780,7 +798,7 @@ func (a *EmailDomainAPIService) ListEmailDomainsExecute(r ApiListEmailDomainsReq
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -795,6 +813,7 @@ func (a *EmailDomainAPIService) ListEmailDomainsExecute(r ApiListEmailDomainsReq
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -856,6 +875,11 @@ func (r ApiReplaceEmailDomainRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ReplaceEmailDomainExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into EmailDomainResponse.
+func (r ApiReplaceEmailDomainRequest) ExecuteTyped() (EmailDomainResponse, *APIResponse, error) {
+	return decodeResponse[EmailDomainResponse](r.Execute())
+}
+
 /*
 ReplaceEmailDomain Replace an Email Domain
 
@@ -895,7 +919,7 @@ func (a *EmailDomainAPIService) ReplaceEmailDomainExecute(r ApiReplaceEmailDomai
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "EmailDomainAPIService.ReplaceEmailDomain")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/email-domains/{emailDomainId}"
@@ -949,7 +973,7 @@ func (a *EmailDomainAPIService) ReplaceEmailDomainExecute(r ApiReplaceEmailDomai
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -964,6 +988,7 @@ func (a *EmailDomainAPIService) ReplaceEmailDomainExecute(r ApiReplaceEmailDomai
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1036,6 +1061,11 @@ func (r ApiVerifyEmailDomainRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.VerifyEmailDomainExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into EmailDomainResponse.
+func (r ApiVerifyEmailDomainRequest) ExecuteTyped() (EmailDomainResponse, *APIResponse, error) {
+	return decodeResponse[EmailDomainResponse](r.Execute())
+}
+
 /*
 VerifyEmailDomain Verify an Email Domain
 
@@ -1075,7 +1105,7 @@ func (a *EmailDomainAPIService) VerifyEmailDomainExecute(r ApiVerifyEmailDomainR
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "EmailDomainAPIService.VerifyEmailDomain")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/email-domains/{emailDomainId}/verify"
@@ -1123,7 +1153,7 @@ func (a *EmailDomainAPIService) VerifyEmailDomainExecute(r ApiVerifyEmailDomainR
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1138,6 +1168,7 @@ func (a *EmailDomainAPIService) VerifyEmailDomainExecute(r ApiVerifyEmailDomainR
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
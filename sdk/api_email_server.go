@@ -135,6 +135,11 @@ func (r ApiCreateEmailServerRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.CreateEmailServerExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into EmailServerResponse.
+func (r ApiCreateEmailServerRequest) ExecuteTyped() (EmailServerResponse, *APIResponse, error) {
+	return decodeResponse[EmailServerResponse](r.Execute())
+}
+
 /*
 CreateEmailServer Create a custom SMTP server
 
@@ -172,7 +177,7 @@ func (a *EmailServerAPIService) CreateEmailServerExecute(r ApiCreateEmailServerR
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "EmailServerAPIService.CreateEmailServer")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/email-servers"
@@ -222,7 +227,7 @@ func (a *EmailServerAPIService) CreateEmailServerExecute(r ApiCreateEmailServerR
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -237,6 +242,7 @@ func (a *EmailServerAPIService) CreateEmailServerExecute(r ApiCreateEmailServerR
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -335,7 +341,7 @@ func (a *EmailServerAPIService) DeleteEmailServerExecute(r ApiDeleteEmailServerR
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "EmailServerAPIService.DeleteEmailServer")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/email-servers/{emailServerId}"
@@ -383,7 +389,7 @@ func (a *EmailServerAPIService) DeleteEmailServerExecute(r ApiDeleteEmailServerR
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -398,6 +404,7 @@ func (a *EmailServerAPIService) DeleteEmailServerExecute(r ApiDeleteEmailServerR
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -458,6 +465,11 @@ func (r ApiGetEmailServerRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetEmailServerExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into EmailServerListResponse.
+func (r ApiGetEmailServerRequest) ExecuteTyped() (EmailServerListResponse, *APIResponse, error) {
+	return decodeResponse[EmailServerListResponse](r.Execute())
+}
+
 /*
 GetEmailServer Retrieve an SMTP Server configuration
 
@@ -497,7 +509,7 @@ func (a *EmailServerAPIService) GetEmailServerExecute(r ApiGetEmailServerRequest
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "EmailServerAPIService.GetEmailServer")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/email-servers/{emailServerId}"
@@ -545,7 +557,7 @@ func (a *EmailServerAPIService) GetEmailServerExecute(r ApiGetEmailServerRequest
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -560,6 +572,7 @@ func (a *EmailServerAPIService) GetEmailServerExecute(r ApiGetEmailServerRequest
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -619,6 +632,11 @@ func (r ApiListEmailServersRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListEmailServersExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into EmailServerListResponse.
+func (r ApiListEmailServersRequest) ExecuteTyped() (EmailServerListResponse, *APIResponse, error) {
+	return decodeResponse[EmailServerListResponse](r.Execute())
+}
+
 /*
 ListEmailServers List all enrolled SMTP servers
 
@@ -656,7 +674,7 @@ func (a *EmailServerAPIService) ListEmailServersExecute(r ApiListEmailServersReq
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "EmailServerAPIService.ListEmailServers")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/email-servers"
@@ -703,7 +721,7 @@ func (a *EmailServerAPIService) ListEmailServersExecute(r ApiListEmailServersReq
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -718,6 +736,7 @@ func (a *EmailServerAPIService) ListEmailServersExecute(r ApiListEmailServersReq
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -810,7 +829,7 @@ func (a *EmailServerAPIService) TestEmailServerExecute(r ApiTestEmailServerReque
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "EmailServerAPIService.TestEmailServer")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/email-servers/{emailServerId}/test"
@@ -861,7 +880,7 @@ func (a *EmailServerAPIService) TestEmailServerExecute(r ApiTestEmailServerReque
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -876,6 +895,7 @@ func (a *EmailServerAPIService) TestEmailServerExecute(r ApiTestEmailServerReque
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -954,6 +974,11 @@ func (r ApiUpdateEmailServerRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.UpdateEmailServerExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into EmailServerResponse.
+func (r ApiUpdateEmailServerRequest) ExecuteTyped() (EmailServerResponse, *APIResponse, error) {
+	return decodeResponse[EmailServerResponse](r.Execute())
+}
+
 /*
 UpdateEmailServer Update an SMTP Server configuration
 
@@ -993,7 +1018,7 @@ func (a *EmailServerAPIService) UpdateEmailServerExecute(r ApiUpdateEmailServerR
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "EmailServerAPIService.UpdateEmailServer")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/email-servers/{emailServerId}"
@@ -1044,7 +1069,7 @@ func (a *EmailServerAPIService) UpdateEmailServerExecute(r ApiUpdateEmailServerR
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1059,6 +1084,7 @@ func (a *EmailServerAPIService) UpdateEmailServerExecute(r ApiUpdateEmailServerR
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
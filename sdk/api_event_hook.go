@@ -180,6 +180,11 @@ func (r ApiActivateEventHookRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ActivateEventHookExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into EventHook.
+func (r ApiActivateEventHookRequest) ExecuteTyped() (EventHook, *APIResponse, error) {
+	return decodeResponse[EventHook](r.Execute())
+}
+
 /*
 ActivateEventHook Activate an Event Hook
 
@@ -219,7 +224,7 @@ func (a *EventHookAPIService) ActivateEventHookExecute(r ApiActivateEventHookReq
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "EventHookAPIService.ActivateEventHook")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/eventHooks/{eventHookId}/lifecycle/activate"
@@ -267,7 +272,7 @@ func (a *EventHookAPIService) ActivateEventHookExecute(r ApiActivateEventHookReq
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -282,6 +287,7 @@ func (a *EventHookAPIService) ActivateEventHookExecute(r ApiActivateEventHookReq
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -347,6 +353,11 @@ func (r ApiCreateEventHookRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.CreateEventHookExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into EventHook.
+func (r ApiCreateEventHookRequest) ExecuteTyped() (EventHook, *APIResponse, error) {
+	return decodeResponse[EventHook](r.Execute())
+}
+
 /*
 CreateEventHook Create an Event Hook
 
@@ -394,7 +405,7 @@ func (a *EventHookAPIService) CreateEventHookExecute(r ApiCreateEventHookRequest
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "EventHookAPIService.CreateEventHook")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/eventHooks"
@@ -444,7 +455,7 @@ func (a *EventHookAPIService) CreateEventHookExecute(r ApiCreateEventHookRequest
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -459,6 +470,7 @@ func (a *EventHookAPIService) CreateEventHookExecute(r ApiCreateEventHookRequest
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -519,6 +531,11 @@ func (r ApiDeactivateEventHookRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.DeactivateEventHookExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into EventHook.
+func (r ApiDeactivateEventHookRequest) ExecuteTyped() (EventHook, *APIResponse, error) {
+	return decodeResponse[EventHook](r.Execute())
+}
+
 /*
 DeactivateEventHook Deactivate an Event Hook
 
@@ -558,7 +575,7 @@ func (a *EventHookAPIService) DeactivateEventHookExecute(r ApiDeactivateEventHoo
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "EventHookAPIService.DeactivateEventHook")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/eventHooks/{eventHookId}/lifecycle/deactivate"
@@ -606,7 +623,7 @@ func (a *EventHookAPIService) DeactivateEventHookExecute(r ApiDeactivateEventHoo
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -621,6 +638,7 @@ func (a *EventHookAPIService) DeactivateEventHookExecute(r ApiDeactivateEventHoo
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -720,7 +738,7 @@ func (a *EventHookAPIService) DeleteEventHookExecute(r ApiDeleteEventHookRequest
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "EventHookAPIService.DeleteEventHook")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/eventHooks/{eventHookId}"
@@ -768,7 +786,7 @@ func (a *EventHookAPIService) DeleteEventHookExecute(r ApiDeleteEventHookRequest
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -783,6 +801,7 @@ func (a *EventHookAPIService) DeleteEventHookExecute(r ApiDeleteEventHookRequest
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -843,6 +862,11 @@ func (r ApiGetEventHookRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetEventHookExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into EventHook.
+func (r ApiGetEventHookRequest) ExecuteTyped() (EventHook, *APIResponse, error) {
+	return decodeResponse[EventHook](r.Execute())
+}
+
 /*
 GetEventHook Retrieve an Event Hook
 
@@ -882,7 +906,7 @@ func (a *EventHookAPIService) GetEventHookExecute(r ApiGetEventHookRequest) (*AP
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "EventHookAPIService.GetEventHook")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/eventHooks/{eventHookId}"
@@ -930,7 +954,7 @@ func (a *EventHookAPIService) GetEventHookExecute(r ApiGetEventHookRequest) (*AP
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -945,6 +969,7 @@ func (a *EventHookAPIService) GetEventHookExecute(r ApiGetEventHookRequest) (*AP
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1004,6 +1029,11 @@ func (r ApiListEventHooksRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListEventHooksExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []EventHook.
+func (r ApiListEventHooksRequest) ExecuteTyped() ([]EventHook, *APIResponse, error) {
+	return decodeResponse[[]EventHook](r.Execute())
+}
+
 /*
 ListEventHooks List all Event Hooks
 
@@ -1041,7 +1071,7 @@ func (a *EventHookAPIService) ListEventHooksExecute(r ApiListEventHooksRequest)
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "EventHookAPIService.ListEventHooks")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/eventHooks"
@@ -1088,7 +1118,7 @@ func (a *EventHookAPIService) ListEventHooksExecute(r ApiListEventHooksRequest)
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1103,6 +1133,7 @@ func (a *EventHookAPIService) ListEventHooksExecute(r ApiListEventHooksRequest)
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1157,6 +1188,11 @@ func (r ApiReplaceEventHookRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ReplaceEventHookExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into EventHook.
+func (r ApiReplaceEventHookRequest) ExecuteTyped() (EventHook, *APIResponse, error) {
+	return decodeResponse[EventHook](r.Execute())
+}
+
 /*
 ReplaceEventHook Replace an Event Hook
 
@@ -1199,7 +1235,7 @@ func (a *EventHookAPIService) ReplaceEventHookExecute(r ApiReplaceEventHookReque
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "EventHookAPIService.ReplaceEventHook")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/eventHooks/{eventHookId}"
@@ -1250,7 +1286,7 @@ func (a *EventHookAPIService) ReplaceEventHookExecute(r ApiReplaceEventHookReque
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1265,6 +1301,7 @@ func (a *EventHookAPIService) ReplaceEventHookExecute(r ApiReplaceEventHookReque
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1337,6 +1374,11 @@ func (r ApiVerifyEventHookRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.VerifyEventHookExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into EventHook.
+func (r ApiVerifyEventHookRequest) ExecuteTyped() (EventHook, *APIResponse, error) {
+	return decodeResponse[EventHook](r.Execute())
+}
+
 /*
 VerifyEventHook Verify an Event Hook
 
@@ -1381,7 +1423,7 @@ func (a *EventHookAPIService) VerifyEventHookExecute(r ApiVerifyEventHookRequest
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "EventHookAPIService.VerifyEventHook")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/eventHooks/{eventHookId}/lifecycle/verify"
@@ -1429,7 +1471,7 @@ func (a *EventHookAPIService) VerifyEventHookExecute(r ApiVerifyEventHookRequest
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1444,6 +1486,7 @@ func (a *EventHookAPIService) VerifyEventHookExecute(r ApiVerifyEventHookRequest
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
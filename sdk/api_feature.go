@@ -133,6 +133,11 @@ func (r ApiGetFeatureRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetFeatureExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into Feature.
+func (r ApiGetFeatureRequest) ExecuteTyped() (Feature, *APIResponse, error) {
+	return decodeResponse[Feature](r.Execute())
+}
+
 /*
 GetFeature Retrieve a Feature
 
@@ -172,7 +177,7 @@ func (a *FeatureAPIService) GetFeatureExecute(r ApiGetFeatureRequest) (*APIRespo
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "FeatureAPIService.GetFeature")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/features/{featureId}"
@@ -220,7 +225,7 @@ func (a *FeatureAPIService) GetFeatureExecute(r ApiGetFeatureRequest) (*APIRespo
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -235,6 +240,7 @@ func (a *FeatureAPIService) GetFeatureExecute(r ApiGetFeatureRequest) (*APIRespo
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -295,6 +301,11 @@ func (r ApiListFeatureDependenciesRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListFeatureDependenciesExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []Feature.
+func (r ApiListFeatureDependenciesRequest) ExecuteTyped() ([]Feature, *APIResponse, error) {
+	return decodeResponse[[]Feature](r.Execute())
+}
+
 /*
 ListFeatureDependencies List all dependencies
 
@@ -336,7 +347,7 @@ func (a *FeatureAPIService) ListFeatureDependenciesExecute(r ApiListFeatureDepen
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "FeatureAPIService.ListFeatureDependencies")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/features/{featureId}/dependencies"
@@ -384,7 +395,7 @@ func (a *FeatureAPIService) ListFeatureDependenciesExecute(r ApiListFeatureDepen
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -399,6 +410,7 @@ func (a *FeatureAPIService) ListFeatureDependenciesExecute(r ApiListFeatureDepen
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -459,6 +471,11 @@ func (r ApiListFeatureDependentsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListFeatureDependentsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []Feature.
+func (r ApiListFeatureDependentsRequest) ExecuteTyped() ([]Feature, *APIResponse, error) {
+	return decodeResponse[[]Feature](r.Execute())
+}
+
 /*
 ListFeatureDependents List all dependents
 
@@ -500,7 +517,7 @@ func (a *FeatureAPIService) ListFeatureDependentsExecute(r ApiListFeatureDepende
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "FeatureAPIService.ListFeatureDependents")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/features/{featureId}/dependents"
@@ -548,7 +565,7 @@ func (a *FeatureAPIService) ListFeatureDependentsExecute(r ApiListFeatureDepende
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -563,6 +580,7 @@ func (a *FeatureAPIService) ListFeatureDependentsExecute(r ApiListFeatureDepende
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -622,6 +640,11 @@ func (r ApiListFeaturesRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListFeaturesExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []Feature.
+func (r ApiListFeaturesRequest) ExecuteTyped() ([]Feature, *APIResponse, error) {
+	return decodeResponse[[]Feature](r.Execute())
+}
+
 /*
 ListFeatures List all Features
 
@@ -659,7 +682,7 @@ func (a *FeatureAPIService) ListFeaturesExecute(r ApiListFeaturesRequest) (*APIR
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "FeatureAPIService.ListFeatures")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/features"
@@ -706,7 +729,7 @@ func (a *FeatureAPIService) ListFeaturesExecute(r ApiListFeaturesRequest) (*APIR
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -721,6 +744,7 @@ func (a *FeatureAPIService) ListFeaturesExecute(r ApiListFeaturesRequest) (*APIR
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -777,6 +801,11 @@ func (r ApiUpdateFeatureLifecycleRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.UpdateFeatureLifecycleExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into Feature.
+func (r ApiUpdateFeatureLifecycleRequest) ExecuteTyped() (Feature, *APIResponse, error) {
+	return decodeResponse[Feature](r.Execute())
+}
+
 /*
 UpdateFeatureLifecycle Update a Feature lifecycle
 
@@ -828,7 +857,7 @@ func (a *FeatureAPIService) UpdateFeatureLifecycleExecute(r ApiUpdateFeatureLife
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "FeatureAPIService.UpdateFeatureLifecycle")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/features/{featureId}/{lifecycle}"
@@ -880,7 +909,7 @@ func (a *FeatureAPIService) UpdateFeatureLifecycleExecute(r ApiUpdateFeatureLife
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -895,6 +924,7 @@ func (a *FeatureAPIService) UpdateFeatureLifecycleExecute(r ApiUpdateFeatureLife
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
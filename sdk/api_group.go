@@ -314,7 +314,7 @@ func (a *GroupAPIService) ActivateGroupRuleExecute(r ApiActivateGroupRuleRequest
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "GroupAPIService.ActivateGroupRule")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/groups/rules/{groupRuleId}/lifecycle/activate"
@@ -362,7 +362,7 @@ func (a *GroupAPIService) ActivateGroupRuleExecute(r ApiActivateGroupRuleRequest
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -377,6 +377,7 @@ func (a *GroupAPIService) ActivateGroupRuleExecute(r ApiActivateGroupRuleRequest
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -478,7 +479,7 @@ func (a *GroupAPIService) AssignUserToGroupExecute(r ApiAssignUserToGroupRequest
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "GroupAPIService.AssignUserToGroup")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/groups/{groupId}/users/{userId}"
@@ -527,7 +528,7 @@ func (a *GroupAPIService) AssignUserToGroupExecute(r ApiAssignUserToGroupRequest
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -542,6 +543,7 @@ func (a *GroupAPIService) AssignUserToGroupExecute(r ApiAssignUserToGroupRequest
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -607,6 +609,11 @@ func (r ApiCreateGroupRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.CreateGroupExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into Group.
+func (r ApiCreateGroupRequest) ExecuteTyped() (Group, *APIResponse, error) {
+	return decodeResponse[Group](r.Execute())
+}
+
 /*
 CreateGroup Create a Group
 
@@ -644,7 +651,7 @@ func (a *GroupAPIService) CreateGroupExecute(r ApiCreateGroupRequest) (*APIRespo
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "GroupAPIService.CreateGroup")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/groups"
@@ -694,7 +701,7 @@ func (a *GroupAPIService) CreateGroupExecute(r ApiCreateGroupRequest) (*APIRespo
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -709,6 +716,7 @@ func (a *GroupAPIService) CreateGroupExecute(r ApiCreateGroupRequest) (*APIRespo
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -774,6 +782,11 @@ func (r ApiCreateGroupRuleRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.CreateGroupRuleExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into GroupRule.
+func (r ApiCreateGroupRuleRequest) ExecuteTyped() (GroupRule, *APIResponse, error) {
+	return decodeResponse[GroupRule](r.Execute())
+}
+
 /*
 CreateGroupRule Create a Group Rule
 
@@ -811,7 +824,7 @@ func (a *GroupAPIService) CreateGroupRuleExecute(r ApiCreateGroupRuleRequest) (*
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "GroupAPIService.CreateGroupRule")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/groups/rules"
@@ -861,7 +874,7 @@ func (a *GroupAPIService) CreateGroupRuleExecute(r ApiCreateGroupRuleRequest) (*
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -876,6 +889,7 @@ func (a *GroupAPIService) CreateGroupRuleExecute(r ApiCreateGroupRuleRequest) (*
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -974,7 +988,7 @@ func (a *GroupAPIService) DeactivateGroupRuleExecute(r ApiDeactivateGroupRuleReq
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "GroupAPIService.DeactivateGroupRule")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/groups/rules/{groupRuleId}/lifecycle/deactivate"
@@ -1022,7 +1036,7 @@ func (a *GroupAPIService) DeactivateGroupRuleExecute(r ApiDeactivateGroupRuleReq
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1037,6 +1051,7 @@ func (a *GroupAPIService) DeactivateGroupRuleExecute(r ApiDeactivateGroupRuleReq
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1135,7 +1150,7 @@ func (a *GroupAPIService) DeleteGroupExecute(r ApiDeleteGroupRequest) (*APIRespo
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "GroupAPIService.DeleteGroup")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/groups/{groupId}"
@@ -1183,7 +1198,7 @@ func (a *GroupAPIService) DeleteGroupExecute(r ApiDeleteGroupRequest) (*APIRespo
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1198,6 +1213,7 @@ func (a *GroupAPIService) DeleteGroupExecute(r ApiDeleteGroupRequest) (*APIRespo
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1303,7 +1319,7 @@ func (a *GroupAPIService) DeleteGroupRuleExecute(r ApiDeleteGroupRuleRequest) (*
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "GroupAPIService.DeleteGroupRule")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/groups/rules/{groupRuleId}"
@@ -1354,7 +1370,7 @@ func (a *GroupAPIService) DeleteGroupRuleExecute(r ApiDeleteGroupRuleRequest) (*
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1369,6 +1385,7 @@ func (a *GroupAPIService) DeleteGroupRuleExecute(r ApiDeleteGroupRuleRequest) (*
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1429,6 +1446,11 @@ func (r ApiGetGroupRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetGroupExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into Group.
+func (r ApiGetGroupRequest) ExecuteTyped() (Group, *APIResponse, error) {
+	return decodeResponse[Group](r.Execute())
+}
+
 /*
 GetGroup Retrieve a Group
 
@@ -1468,7 +1490,7 @@ func (a *GroupAPIService) GetGroupExecute(r ApiGetGroupRequest) (*APIResponse, e
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "GroupAPIService.GetGroup")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/groups/{groupId}"
@@ -1516,7 +1538,7 @@ func (a *GroupAPIService) GetGroupExecute(r ApiGetGroupRequest) (*APIResponse, e
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1531,6 +1553,7 @@ func (a *GroupAPIService) GetGroupExecute(r ApiGetGroupRequest) (*APIResponse, e
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1597,6 +1620,11 @@ func (r ApiGetGroupRuleRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetGroupRuleExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into GroupRule.
+func (r ApiGetGroupRuleRequest) ExecuteTyped() (GroupRule, *APIResponse, error) {
+	return decodeResponse[GroupRule](r.Execute())
+}
+
 /*
 GetGroupRule Retrieve a Group Rule
 
@@ -1636,7 +1664,7 @@ func (a *GroupAPIService) GetGroupRuleExecute(r ApiGetGroupRuleRequest) (*APIRes
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "GroupAPIService.GetGroupRule")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/groups/rules/{groupRuleId}"
@@ -1687,7 +1715,7 @@ func (a *GroupAPIService) GetGroupRuleExecute(r ApiGetGroupRuleRequest) (*APIRes
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1702,6 +1730,7 @@ func (a *GroupAPIService) GetGroupRuleExecute(r ApiGetGroupRuleRequest) (*APIRes
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1776,6 +1805,11 @@ func (r ApiListAssignedApplicationsForGroupRequest) Execute() (*APIResponse, err
 	return r.ApiService.ListAssignedApplicationsForGroupExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []ListApplications200ResponseInner.
+func (r ApiListAssignedApplicationsForGroupRequest) ExecuteTyped() ([]ListApplications200ResponseInner, *APIResponse, error) {
+	return decodeResponse[[]ListApplications200ResponseInner](r.Execute())
+}
+
 /*
 ListAssignedApplicationsForGroup List all Assigned Applications
 
@@ -1815,7 +1849,7 @@ func (a *GroupAPIService) ListAssignedApplicationsForGroupExecute(r ApiListAssig
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "GroupAPIService.ListAssignedApplicationsForGroup")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/groups/{groupId}/apps"
@@ -1869,7 +1903,7 @@ func (a *GroupAPIService) ListAssignedApplicationsForGroupExecute(r ApiListAssig
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1884,6 +1918,7 @@ func (a *GroupAPIService) ListAssignedApplicationsForGroupExecute(r ApiListAssig
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1971,6 +2006,11 @@ func (r ApiListGroupRulesRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListGroupRulesExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []GroupRule.
+func (r ApiListGroupRulesRequest) ExecuteTyped() ([]GroupRule, *APIResponse, error) {
+	return decodeResponse[[]GroupRule](r.Execute())
+}
+
 /*
 ListGroupRules List all Group Rules
 
@@ -2008,7 +2048,7 @@ func (a *GroupAPIService) ListGroupRulesExecute(r ApiListGroupRulesRequest) (*AP
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "GroupAPIService.ListGroupRules")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/groups/rules"
@@ -2067,7 +2107,7 @@ func (a *GroupAPIService) ListGroupRulesExecute(r ApiListGroupRulesRequest) (*AP
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2082,6 +2122,7 @@ func (a *GroupAPIService) ListGroupRulesExecute(r ApiListGroupRulesRequest) (*AP
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2144,6 +2185,11 @@ func (r ApiListGroupUsersRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListGroupUsersExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []User.
+func (r ApiListGroupUsersRequest) ExecuteTyped() ([]User, *APIResponse, error) {
+	return decodeResponse[[]User](r.Execute())
+}
+
 /*
 ListGroupUsers List all Member Users
 
@@ -2183,7 +2229,7 @@ func (a *GroupAPIService) ListGroupUsersExecute(r ApiListGroupUsersRequest) (*AP
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "GroupAPIService.ListGroupUsers")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/groups/{groupId}/users"
@@ -2237,7 +2283,7 @@ func (a *GroupAPIService) ListGroupUsersExecute(r ApiListGroupUsersRequest) (*AP
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2252,6 +2298,7 @@ func (a *GroupAPIService) ListGroupUsersExecute(r ApiListGroupUsersRequest) (*AP
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2367,6 +2414,11 @@ func (r ApiListGroupsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListGroupsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []Group.
+func (r ApiListGroupsRequest) ExecuteTyped() ([]Group, *APIResponse, error) {
+	return decodeResponse[[]Group](r.Execute())
+}
+
 /*
 ListGroups List all Groups
 
@@ -2404,7 +2456,7 @@ func (a *GroupAPIService) ListGroupsExecute(r ApiListGroupsRequest) (*APIRespons
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "GroupAPIService.ListGroups")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/groups"
@@ -2475,7 +2527,7 @@ func (a *GroupAPIService) ListGroupsExecute(r ApiListGroupsRequest) (*APIRespons
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2490,6 +2542,7 @@ func (a *GroupAPIService) ListGroupsExecute(r ApiListGroupsRequest) (*APIRespons
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2544,6 +2597,11 @@ func (r ApiReplaceGroupRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ReplaceGroupExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into Group.
+func (r ApiReplaceGroupRequest) ExecuteTyped() (Group, *APIResponse, error) {
+	return decodeResponse[Group](r.Execute())
+}
+
 /*
 ReplaceGroup Replace a Group
 
@@ -2583,7 +2641,7 @@ func (a *GroupAPIService) ReplaceGroupExecute(r ApiReplaceGroupRequest) (*APIRes
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "GroupAPIService.ReplaceGroup")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/groups/{groupId}"
@@ -2634,7 +2692,7 @@ func (a *GroupAPIService) ReplaceGroupExecute(r ApiReplaceGroupRequest) (*APIRes
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2649,6 +2707,7 @@ func (a *GroupAPIService) ReplaceGroupExecute(r ApiReplaceGroupRequest) (*APIRes
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -2727,6 +2786,11 @@ func (r ApiReplaceGroupRuleRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ReplaceGroupRuleExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into GroupRule.
+func (r ApiReplaceGroupRuleRequest) ExecuteTyped() (GroupRule, *APIResponse, error) {
+	return decodeResponse[GroupRule](r.Execute())
+}
+
 /*
 ReplaceGroupRule Replace a Group Rule
 
@@ -2766,7 +2830,7 @@ func (a *GroupAPIService) ReplaceGroupRuleExecute(r ApiReplaceGroupRuleRequest)
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "GroupAPIService.ReplaceGroupRule")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/groups/rules/{groupRuleId}"
@@ -2817,7 +2881,7 @@ func (a *GroupAPIService) ReplaceGroupRuleExecute(r ApiReplaceGroupRuleRequest)
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2832,6 +2896,7 @@ func (a *GroupAPIService) ReplaceGroupRuleExecute(r ApiReplaceGroupRuleRequest)
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -2945,7 +3010,7 @@ func (a *GroupAPIService) UnassignUserFromGroupExecute(r ApiUnassignUserFromGrou
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "GroupAPIService.UnassignUserFromGroup")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/groups/{groupId}/users/{userId}"
@@ -2994,7 +3059,7 @@ func (a *GroupAPIService) UnassignUserFromGroupExecute(r ApiUnassignUserFromGrou
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -3009,6 +3074,7 @@ func (a *GroupAPIService) UnassignUserFromGroupExecute(r ApiUnassignUserFromGrou
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
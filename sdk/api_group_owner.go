@@ -95,6 +95,11 @@ func (r ApiAssignGroupOwnerRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.AssignGroupOwnerExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into GroupOwner.
+func (r ApiAssignGroupOwnerRequest) ExecuteTyped() (GroupOwner, *APIResponse, error) {
+	return decodeResponse[GroupOwner](r.Execute())
+}
+
 /*
 AssignGroupOwner Assign a Group Owner
 
@@ -134,7 +139,7 @@ func (a *GroupOwnerAPIService) AssignGroupOwnerExecute(r ApiAssignGroupOwnerRequ
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "GroupOwnerAPIService.AssignGroupOwner")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/groups/{groupId}/owners"
@@ -185,7 +190,7 @@ func (a *GroupOwnerAPIService) AssignGroupOwnerExecute(r ApiAssignGroupOwnerRequ
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -200,6 +205,7 @@ func (a *GroupOwnerAPIService) AssignGroupOwnerExecute(r ApiAssignGroupOwnerRequ
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -313,7 +319,7 @@ func (a *GroupOwnerAPIService) DeleteGroupOwnerExecute(r ApiDeleteGroupOwnerRequ
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "GroupOwnerAPIService.DeleteGroupOwner")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/groups/{groupId}/owners/{ownerId}"
@@ -362,7 +368,7 @@ func (a *GroupOwnerAPIService) DeleteGroupOwnerExecute(r ApiDeleteGroupOwnerRequ
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -377,6 +383,7 @@ func (a *GroupOwnerAPIService) DeleteGroupOwnerExecute(r ApiDeleteGroupOwnerRequ
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -458,6 +465,11 @@ func (r ApiListGroupOwnersRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListGroupOwnersExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []GroupOwner.
+func (r ApiListGroupOwnersRequest) ExecuteTyped() ([]GroupOwner, *APIResponse, error) {
+	return decodeResponse[[]GroupOwner](r.Execute())
+}
+
 /*
 ListGroupOwners List all Group Owners
 
@@ -497,7 +509,7 @@ func (a *GroupOwnerAPIService) ListGroupOwnersExecute(r ApiListGroupOwnersReques
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "GroupOwnerAPIService.ListGroupOwners")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/groups/{groupId}/owners"
@@ -554,7 +566,7 @@ func (a *GroupOwnerAPIService) ListGroupOwnersExecute(r ApiListGroupOwnersReques
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -569,6 +581,7 @@ func (a *GroupOwnerAPIService) ListGroupOwnersExecute(r ApiListGroupOwnersReques
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
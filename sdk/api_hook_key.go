@@ -149,6 +149,11 @@ func (r ApiCreateHookKeyRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.CreateHookKeyExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into HookKey.
+func (r ApiCreateHookKeyRequest) ExecuteTyped() (HookKey, *APIResponse, error) {
+	return decodeResponse[HookKey](r.Execute())
+}
+
 /*
 CreateHookKey Create a key
 
@@ -191,7 +196,7 @@ func (a *HookKeyAPIService) CreateHookKeyExecute(r ApiCreateHookKeyRequest) (*AP
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "HookKeyAPIService.CreateHookKey")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/hook-keys"
@@ -241,7 +246,7 @@ func (a *HookKeyAPIService) CreateHookKeyExecute(r ApiCreateHookKeyRequest) (*AP
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -256,6 +261,7 @@ func (a *HookKeyAPIService) CreateHookKeyExecute(r ApiCreateHookKeyRequest) (*AP
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -357,7 +363,7 @@ func (a *HookKeyAPIService) DeleteHookKeyExecute(r ApiDeleteHookKeyRequest) (*AP
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "HookKeyAPIService.DeleteHookKey")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/hook-keys/{hookKeyId}"
@@ -405,7 +411,7 @@ func (a *HookKeyAPIService) DeleteHookKeyExecute(r ApiDeleteHookKeyRequest) (*AP
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -420,6 +426,7 @@ func (a *HookKeyAPIService) DeleteHookKeyExecute(r ApiDeleteHookKeyRequest) (*AP
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -480,6 +487,11 @@ func (r ApiGetHookKeyRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetHookKeyExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into HookKey.
+func (r ApiGetHookKeyRequest) ExecuteTyped() (HookKey, *APIResponse, error) {
+	return decodeResponse[HookKey](r.Execute())
+}
+
 /*
 GetHookKey Retrieve a key
 
@@ -519,7 +531,7 @@ func (a *HookKeyAPIService) GetHookKeyExecute(r ApiGetHookKeyRequest) (*APIRespo
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "HookKeyAPIService.GetHookKey")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/hook-keys/{hookKeyId}"
@@ -567,7 +579,7 @@ func (a *HookKeyAPIService) GetHookKeyExecute(r ApiGetHookKeyRequest) (*APIRespo
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -582,6 +594,7 @@ func (a *HookKeyAPIService) GetHookKeyExecute(r ApiGetHookKeyRequest) (*APIRespo
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -642,6 +655,11 @@ func (r ApiGetPublicKeyRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetPublicKeyExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into JsonWebKey.
+func (r ApiGetPublicKeyRequest) ExecuteTyped() (JsonWebKey, *APIResponse, error) {
+	return decodeResponse[JsonWebKey](r.Execute())
+}
+
 /*
 GetPublicKey Retrieve a public key
 
@@ -681,7 +699,7 @@ func (a *HookKeyAPIService) GetPublicKeyExecute(r ApiGetPublicKeyRequest) (*APIR
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "HookKeyAPIService.GetPublicKey")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/hook-keys/public/{publicKeyId}"
@@ -729,7 +747,7 @@ func (a *HookKeyAPIService) GetPublicKeyExecute(r ApiGetPublicKeyRequest) (*APIR
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -744,6 +762,7 @@ func (a *HookKeyAPIService) GetPublicKeyExecute(r ApiGetPublicKeyRequest) (*APIR
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -803,6 +822,11 @@ func (r ApiListHookKeysRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListHookKeysExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []HookKey.
+func (r ApiListHookKeysRequest) ExecuteTyped() ([]HookKey, *APIResponse, error) {
+	return decodeResponse[[]HookKey](r.Execute())
+}
+
 /*
 ListHookKeys List all keys
 
@@ -840,7 +864,7 @@ func (a *HookKeyAPIService) ListHookKeysExecute(r ApiListHookKeysRequest) (*APIR
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "HookKeyAPIService.ListHookKeys")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/hook-keys"
@@ -887,7 +911,7 @@ func (a *HookKeyAPIService) ListHookKeysExecute(r ApiListHookKeysRequest) (*APIR
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -902,6 +926,7 @@ func (a *HookKeyAPIService) ListHookKeysExecute(r ApiListHookKeysRequest) (*APIR
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -956,6 +981,11 @@ func (r ApiReplaceHookKeyRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ReplaceHookKeyExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into HookKey.
+func (r ApiReplaceHookKeyRequest) ExecuteTyped() (HookKey, *APIResponse, error) {
+	return decodeResponse[HookKey](r.Execute())
+}
+
 /*
 ReplaceHookKey Replace a key
 
@@ -1000,7 +1030,7 @@ func (a *HookKeyAPIService) ReplaceHookKeyExecute(r ApiReplaceHookKeyRequest) (*
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "HookKeyAPIService.ReplaceHookKey")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/hook-keys/{hookKeyId}"
@@ -1051,7 +1081,7 @@ func (a *HookKeyAPIService) ReplaceHookKeyExecute(r ApiReplaceHookKeyRequest) (*
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1066,6 +1096,7 @@ func (a *HookKeyAPIService) ReplaceHookKeyExecute(r ApiReplaceHookKeyRequest) (*
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -72,6 +72,11 @@ func (r ApiCreatePasswordImportInlineHookRequest) Execute() (*APIResponse, error
 	return r.ApiService.CreatePasswordImportInlineHookExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into PasswordImportResponse.
+func (r ApiCreatePasswordImportInlineHookRequest) ExecuteTyped() (PasswordImportResponse, *APIResponse, error) {
+	return decodeResponse[PasswordImportResponse](r.Execute())
+}
+
 /*
 CreatePasswordImportInlineHook Create an Okta Password Import Inline Hook
 
@@ -118,7 +123,7 @@ func (a *HookPasswordAPIService) CreatePasswordImportInlineHookExecute(r ApiCrea
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "HookPasswordAPIService.CreatePasswordImportInlineHook")
 	if err != nil {
 		// TODU
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/your-endpoint"
@@ -171,7 +176,7 @@ func (a *HookPasswordAPIService) CreatePasswordImportInlineHookExecute(r ApiCrea
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
 		// TODU
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -187,6 +192,7 @@ func (a *HookPasswordAPIService) CreatePasswordImportInlineHookExecute(r ApiCrea
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
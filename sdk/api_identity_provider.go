@@ -421,6 +421,11 @@ func (r ApiActivateIdentityProviderRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ActivateIdentityProviderExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into IdentityProvider.
+func (r ApiActivateIdentityProviderRequest) ExecuteTyped() (IdentityProvider, *APIResponse, error) {
+	return decodeResponse[IdentityProvider](r.Execute())
+}
+
 /*
 ActivateIdentityProvider Activate an Identity Provider
 
@@ -460,7 +465,7 @@ func (a *IdentityProviderAPIService) ActivateIdentityProviderExecute(r ApiActiva
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.ActivateIdentityProvider")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/{idpId}/lifecycle/activate"
@@ -508,7 +513,7 @@ func (a *IdentityProviderAPIService) ActivateIdentityProviderExecute(r ApiActiva
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -523,6 +528,7 @@ func (a *IdentityProviderAPIService) ActivateIdentityProviderExecute(r ApiActiva
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -590,6 +596,11 @@ func (r ApiCloneIdentityProviderKeyRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.CloneIdentityProviderKeyExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into JsonWebKey.
+func (r ApiCloneIdentityProviderKeyRequest) ExecuteTyped() (JsonWebKey, *APIResponse, error) {
+	return decodeResponse[JsonWebKey](r.Execute())
+}
+
 /*
 CloneIdentityProviderKey Clone a Signing Credential Key
 
@@ -631,7 +642,7 @@ func (a *IdentityProviderAPIService) CloneIdentityProviderKeyExecute(r ApiCloneI
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.CloneIdentityProviderKey")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/{idpId}/credentials/keys/{idpKeyId}/clone"
@@ -681,7 +692,7 @@ func (a *IdentityProviderAPIService) CloneIdentityProviderKeyExecute(r ApiCloneI
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -696,6 +707,7 @@ func (a *IdentityProviderAPIService) CloneIdentityProviderKeyExecute(r ApiCloneI
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -761,6 +773,11 @@ func (r ApiCreateIdentityProviderRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.CreateIdentityProviderExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into IdentityProvider.
+func (r ApiCreateIdentityProviderRequest) ExecuteTyped() (IdentityProvider, *APIResponse, error) {
+	return decodeResponse[IdentityProvider](r.Execute())
+}
+
 /*
 CreateIdentityProvider Create an Identity Provider
 
@@ -798,7 +815,7 @@ func (a *IdentityProviderAPIService) CreateIdentityProviderExecute(r ApiCreateId
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.CreateIdentityProvider")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps"
@@ -848,7 +865,7 @@ func (a *IdentityProviderAPIService) CreateIdentityProviderExecute(r ApiCreateId
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -863,6 +880,7 @@ func (a *IdentityProviderAPIService) CreateIdentityProviderExecute(r ApiCreateId
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -928,6 +946,11 @@ func (r ApiCreateIdentityProviderKeyRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.CreateIdentityProviderKeyExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into JsonWebKey.
+func (r ApiCreateIdentityProviderKeyRequest) ExecuteTyped() (JsonWebKey, *APIResponse, error) {
+	return decodeResponse[JsonWebKey](r.Execute())
+}
+
 /*
 CreateIdentityProviderKey Create an X.509 Certificate Public Key
 
@@ -965,7 +988,7 @@ func (a *IdentityProviderAPIService) CreateIdentityProviderKeyExecute(r ApiCreat
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.CreateIdentityProviderKey")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/credentials/keys"
@@ -1015,7 +1038,7 @@ func (a *IdentityProviderAPIService) CreateIdentityProviderKeyExecute(r ApiCreat
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1030,6 +1053,7 @@ func (a *IdentityProviderAPIService) CreateIdentityProviderKeyExecute(r ApiCreat
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1090,6 +1114,11 @@ func (r ApiDeactivateIdentityProviderRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.DeactivateIdentityProviderExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into IdentityProvider.
+func (r ApiDeactivateIdentityProviderRequest) ExecuteTyped() (IdentityProvider, *APIResponse, error) {
+	return decodeResponse[IdentityProvider](r.Execute())
+}
+
 /*
 DeactivateIdentityProvider Deactivate an Identity Provider
 
@@ -1129,7 +1158,7 @@ func (a *IdentityProviderAPIService) DeactivateIdentityProviderExecute(r ApiDeac
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.DeactivateIdentityProvider")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/{idpId}/lifecycle/deactivate"
@@ -1177,7 +1206,7 @@ func (a *IdentityProviderAPIService) DeactivateIdentityProviderExecute(r ApiDeac
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1192,6 +1221,7 @@ func (a *IdentityProviderAPIService) DeactivateIdentityProviderExecute(r ApiDeac
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1290,7 +1320,7 @@ func (a *IdentityProviderAPIService) DeleteIdentityProviderExecute(r ApiDeleteId
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.DeleteIdentityProvider")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/{idpId}"
@@ -1338,7 +1368,7 @@ func (a *IdentityProviderAPIService) DeleteIdentityProviderExecute(r ApiDeleteId
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1353,6 +1383,7 @@ func (a *IdentityProviderAPIService) DeleteIdentityProviderExecute(r ApiDeleteId
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1451,7 +1482,7 @@ func (a *IdentityProviderAPIService) DeleteIdentityProviderKeyExecute(r ApiDelet
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.DeleteIdentityProviderKey")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/credentials/keys/{idpKeyId}"
@@ -1499,7 +1530,7 @@ func (a *IdentityProviderAPIService) DeleteIdentityProviderKeyExecute(r ApiDelet
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1514,6 +1545,7 @@ func (a *IdentityProviderAPIService) DeleteIdentityProviderKeyExecute(r ApiDelet
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1580,6 +1612,11 @@ func (r ApiGenerateCsrForIdentityProviderRequest) Execute() (*APIResponse, error
 	return r.ApiService.GenerateCsrForIdentityProviderExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into Csr.
+func (r ApiGenerateCsrForIdentityProviderRequest) ExecuteTyped() (Csr, *APIResponse, error) {
+	return decodeResponse[Csr](r.Execute())
+}
+
 /*
 GenerateCsrForIdentityProvider Generate a Certificate Signing Request
 
@@ -1619,7 +1656,7 @@ func (a *IdentityProviderAPIService) GenerateCsrForIdentityProviderExecute(r Api
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.GenerateCsrForIdentityProvider")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/{idpId}/credentials/csrs"
@@ -1670,7 +1707,7 @@ func (a *IdentityProviderAPIService) GenerateCsrForIdentityProviderExecute(r Api
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1685,6 +1722,7 @@ func (a *IdentityProviderAPIService) GenerateCsrForIdentityProviderExecute(r Api
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1764,6 +1802,11 @@ func (r ApiGenerateIdentityProviderSigningKeyRequest) Execute() (*APIResponse, e
 	return r.ApiService.GenerateIdentityProviderSigningKeyExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into JsonWebKey.
+func (r ApiGenerateIdentityProviderSigningKeyRequest) ExecuteTyped() (JsonWebKey, *APIResponse, error) {
+	return decodeResponse[JsonWebKey](r.Execute())
+}
+
 /*
 GenerateIdentityProviderSigningKey Generate a new Signing Credential Key
 
@@ -1803,7 +1846,7 @@ func (a *IdentityProviderAPIService) GenerateIdentityProviderSigningKeyExecute(r
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.GenerateIdentityProviderSigningKey")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/{idpId}/credentials/keys/generate"
@@ -1852,7 +1895,7 @@ func (a *IdentityProviderAPIService) GenerateIdentityProviderSigningKeyExecute(r
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1867,6 +1910,7 @@ func (a *IdentityProviderAPIService) GenerateIdentityProviderSigningKeyExecute(r
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1928,6 +1972,11 @@ func (r ApiGetCsrForIdentityProviderRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetCsrForIdentityProviderExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into Csr.
+func (r ApiGetCsrForIdentityProviderRequest) ExecuteTyped() (Csr, *APIResponse, error) {
+	return decodeResponse[Csr](r.Execute())
+}
+
 /*
 GetCsrForIdentityProvider Retrieve a Certificate Signing Request
 
@@ -1969,7 +2018,7 @@ func (a *IdentityProviderAPIService) GetCsrForIdentityProviderExecute(r ApiGetCs
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.GetCsrForIdentityProvider")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/{idpId}/credentials/csrs/{idpCsrId}"
@@ -2018,7 +2067,7 @@ func (a *IdentityProviderAPIService) GetCsrForIdentityProviderExecute(r ApiGetCs
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2033,6 +2082,7 @@ func (a *IdentityProviderAPIService) GetCsrForIdentityProviderExecute(r ApiGetCs
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2093,6 +2143,11 @@ func (r ApiGetIdentityProviderRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetIdentityProviderExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into IdentityProvider.
+func (r ApiGetIdentityProviderRequest) ExecuteTyped() (IdentityProvider, *APIResponse, error) {
+	return decodeResponse[IdentityProvider](r.Execute())
+}
+
 /*
 GetIdentityProvider Retrieve an Identity Provider
 
@@ -2132,7 +2187,7 @@ func (a *IdentityProviderAPIService) GetIdentityProviderExecute(r ApiGetIdentity
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.GetIdentityProvider")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/{idpId}"
@@ -2180,7 +2235,7 @@ func (a *IdentityProviderAPIService) GetIdentityProviderExecute(r ApiGetIdentity
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2195,6 +2250,7 @@ func (a *IdentityProviderAPIService) GetIdentityProviderExecute(r ApiGetIdentity
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2256,6 +2312,11 @@ func (r ApiGetIdentityProviderApplicationUserRequest) Execute() (*APIResponse, e
 	return r.ApiService.GetIdentityProviderApplicationUserExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into IdentityProviderApplicationUser.
+func (r ApiGetIdentityProviderApplicationUserRequest) ExecuteTyped() (IdentityProviderApplicationUser, *APIResponse, error) {
+	return decodeResponse[IdentityProviderApplicationUser](r.Execute())
+}
+
 /*
 GetIdentityProviderApplicationUser Retrieve a User
 
@@ -2297,7 +2358,7 @@ func (a *IdentityProviderAPIService) GetIdentityProviderApplicationUserExecute(r
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.GetIdentityProviderApplicationUser")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/{idpId}/users/{userId}"
@@ -2346,7 +2407,7 @@ func (a *IdentityProviderAPIService) GetIdentityProviderApplicationUserExecute(r
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2361,6 +2422,7 @@ func (a *IdentityProviderAPIService) GetIdentityProviderApplicationUserExecute(r
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2421,6 +2483,11 @@ func (r ApiGetIdentityProviderKeyRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetIdentityProviderKeyExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into JsonWebKey.
+func (r ApiGetIdentityProviderKeyRequest) ExecuteTyped() (JsonWebKey, *APIResponse, error) {
+	return decodeResponse[JsonWebKey](r.Execute())
+}
+
 /*
 GetIdentityProviderKey Retrieve an Credential Key
 
@@ -2460,7 +2527,7 @@ func (a *IdentityProviderAPIService) GetIdentityProviderKeyExecute(r ApiGetIdent
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.GetIdentityProviderKey")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/credentials/keys/{idpKeyId}"
@@ -2508,7 +2575,7 @@ func (a *IdentityProviderAPIService) GetIdentityProviderKeyExecute(r ApiGetIdent
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2523,6 +2590,7 @@ func (a *IdentityProviderAPIService) GetIdentityProviderKeyExecute(r ApiGetIdent
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2584,6 +2652,11 @@ func (r ApiGetIdentityProviderSigningKeyRequest) Execute() (*APIResponse, error)
 	return r.ApiService.GetIdentityProviderSigningKeyExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into JsonWebKey.
+func (r ApiGetIdentityProviderSigningKeyRequest) ExecuteTyped() (JsonWebKey, *APIResponse, error) {
+	return decodeResponse[JsonWebKey](r.Execute())
+}
+
 /*
 GetIdentityProviderSigningKey Retrieve a Signing Credential Key
 
@@ -2625,7 +2698,7 @@ func (a *IdentityProviderAPIService) GetIdentityProviderSigningKeyExecute(r ApiG
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.GetIdentityProviderSigningKey")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/{idpId}/credentials/keys/{idpKeyId}"
@@ -2674,7 +2747,7 @@ func (a *IdentityProviderAPIService) GetIdentityProviderSigningKeyExecute(r ApiG
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2689,6 +2762,7 @@ func (a *IdentityProviderAPIService) GetIdentityProviderSigningKeyExecute(r ApiG
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2756,6 +2830,11 @@ func (r ApiLinkUserToIdentityProviderRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.LinkUserToIdentityProviderExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into IdentityProviderApplicationUser.
+func (r ApiLinkUserToIdentityProviderRequest) ExecuteTyped() (IdentityProviderApplicationUser, *APIResponse, error) {
+	return decodeResponse[IdentityProviderApplicationUser](r.Execute())
+}
+
 /*
 LinkUserToIdentityProvider Link a User to a Social IdP
 
@@ -2797,7 +2876,7 @@ func (a *IdentityProviderAPIService) LinkUserToIdentityProviderExecute(r ApiLink
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.LinkUserToIdentityProvider")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/{idpId}/users/{userId}"
@@ -2849,7 +2928,7 @@ func (a *IdentityProviderAPIService) LinkUserToIdentityProviderExecute(r ApiLink
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2864,6 +2943,7 @@ func (a *IdentityProviderAPIService) LinkUserToIdentityProviderExecute(r ApiLink
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -2936,6 +3016,11 @@ func (r ApiListCsrsForIdentityProviderRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListCsrsForIdentityProviderExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []Csr.
+func (r ApiListCsrsForIdentityProviderRequest) ExecuteTyped() ([]Csr, *APIResponse, error) {
+	return decodeResponse[[]Csr](r.Execute())
+}
+
 /*
 ListCsrsForIdentityProvider List all Certificate Signing Requests
 
@@ -2975,7 +3060,7 @@ func (a *IdentityProviderAPIService) ListCsrsForIdentityProviderExecute(r ApiLis
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.ListCsrsForIdentityProvider")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/{idpId}/credentials/csrs"
@@ -3023,7 +3108,7 @@ func (a *IdentityProviderAPIService) ListCsrsForIdentityProviderExecute(r ApiLis
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -3038,6 +3123,7 @@ func (a *IdentityProviderAPIService) ListCsrsForIdentityProviderExecute(r ApiLis
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -3098,6 +3184,11 @@ func (r ApiListIdentityProviderApplicationUsersRequest) Execute() (*APIResponse,
 	return r.ApiService.ListIdentityProviderApplicationUsersExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []IdentityProviderApplicationUser.
+func (r ApiListIdentityProviderApplicationUsersRequest) ExecuteTyped() ([]IdentityProviderApplicationUser, *APIResponse, error) {
+	return decodeResponse[[]IdentityProviderApplicationUser](r.Execute())
+}
+
 /*
 ListIdentityProviderApplicationUsers List all Users
 
@@ -3137,7 +3228,7 @@ func (a *IdentityProviderAPIService) ListIdentityProviderApplicationUsersExecute
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.ListIdentityProviderApplicationUsers")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/{idpId}/users"
@@ -3185,7 +3276,7 @@ func (a *IdentityProviderAPIService) ListIdentityProviderApplicationUsersExecute
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -3200,6 +3291,7 @@ func (a *IdentityProviderAPIService) ListIdentityProviderApplicationUsersExecute
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -3273,6 +3365,11 @@ func (r ApiListIdentityProviderKeysRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListIdentityProviderKeysExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []JsonWebKey.
+func (r ApiListIdentityProviderKeysRequest) ExecuteTyped() ([]JsonWebKey, *APIResponse, error) {
+	return decodeResponse[[]JsonWebKey](r.Execute())
+}
+
 /*
 ListIdentityProviderKeys List all Credential Keys
 
@@ -3310,7 +3407,7 @@ func (a *IdentityProviderAPIService) ListIdentityProviderKeysExecute(r ApiListId
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.ListIdentityProviderKeys")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/credentials/keys"
@@ -3363,7 +3460,7 @@ func (a *IdentityProviderAPIService) ListIdentityProviderKeysExecute(r ApiListId
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -3378,6 +3475,7 @@ func (a *IdentityProviderAPIService) ListIdentityProviderKeysExecute(r ApiListId
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -3426,6 +3524,11 @@ func (r ApiListIdentityProviderSigningKeysRequest) Execute() (*APIResponse, erro
 	return r.ApiService.ListIdentityProviderSigningKeysExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []JsonWebKey.
+func (r ApiListIdentityProviderSigningKeysRequest) ExecuteTyped() ([]JsonWebKey, *APIResponse, error) {
+	return decodeResponse[[]JsonWebKey](r.Execute())
+}
+
 /*
 ListIdentityProviderSigningKeys List all Signing Credential Keys
 
@@ -3465,7 +3568,7 @@ func (a *IdentityProviderAPIService) ListIdentityProviderSigningKeysExecute(r Ap
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.ListIdentityProviderSigningKeys")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/{idpId}/credentials/keys"
@@ -3513,7 +3616,7 @@ func (a *IdentityProviderAPIService) ListIdentityProviderSigningKeysExecute(r Ap
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -3528,6 +3631,7 @@ func (a *IdentityProviderAPIService) ListIdentityProviderSigningKeysExecute(r Ap
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -3615,6 +3719,11 @@ func (r ApiListIdentityProvidersRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListIdentityProvidersExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []IdentityProvider.
+func (r ApiListIdentityProvidersRequest) ExecuteTyped() ([]IdentityProvider, *APIResponse, error) {
+	return decodeResponse[[]IdentityProvider](r.Execute())
+}
+
 /*
 ListIdentityProviders List all Identity Providers
 
@@ -3652,7 +3761,7 @@ func (a *IdentityProviderAPIService) ListIdentityProvidersExecute(r ApiListIdent
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.ListIdentityProviders")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps"
@@ -3711,7 +3820,7 @@ func (a *IdentityProviderAPIService) ListIdentityProvidersExecute(r ApiListIdent
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -3726,6 +3835,7 @@ func (a *IdentityProviderAPIService) ListIdentityProvidersExecute(r ApiListIdent
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -3775,6 +3885,11 @@ func (r ApiListSocialAuthTokensRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListSocialAuthTokensExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []SocialAuthToken.
+func (r ApiListSocialAuthTokensRequest) ExecuteTyped() ([]SocialAuthToken, *APIResponse, error) {
+	return decodeResponse[[]SocialAuthToken](r.Execute())
+}
+
 /*
 ListSocialAuthTokens List all Tokens from a OIDC Identity Provider
 
@@ -3816,7 +3931,7 @@ func (a *IdentityProviderAPIService) ListSocialAuthTokensExecute(r ApiListSocial
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.ListSocialAuthTokens")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/{idpId}/users/{userId}/credentials/tokens"
@@ -3865,7 +3980,7 @@ func (a *IdentityProviderAPIService) ListSocialAuthTokensExecute(r ApiListSocial
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -3880,6 +3995,7 @@ func (a *IdentityProviderAPIService) ListSocialAuthTokensExecute(r ApiListSocial
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -3947,6 +4063,11 @@ func (r ApiPublishCsrForIdentityProviderRequest) Execute() (*APIResponse, error)
 	return r.ApiService.PublishCsrForIdentityProviderExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into JsonWebKey.
+func (r ApiPublishCsrForIdentityProviderRequest) ExecuteTyped() (JsonWebKey, *APIResponse, error) {
+	return decodeResponse[JsonWebKey](r.Execute())
+}
+
 /*
 PublishCsrForIdentityProvider Publish a Certificate Signing Request
 
@@ -3988,7 +4109,7 @@ func (a *IdentityProviderAPIService) PublishCsrForIdentityProviderExecute(r ApiP
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.PublishCsrForIdentityProvider")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/{idpId}/credentials/csrs/{idpCsrId}/lifecycle/publish"
@@ -4040,7 +4161,7 @@ func (a *IdentityProviderAPIService) PublishCsrForIdentityProviderExecute(r ApiP
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -4055,6 +4176,7 @@ func (a *IdentityProviderAPIService) PublishCsrForIdentityProviderExecute(r ApiP
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -4133,6 +4255,11 @@ func (r ApiReplaceIdentityProviderRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ReplaceIdentityProviderExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into IdentityProvider.
+func (r ApiReplaceIdentityProviderRequest) ExecuteTyped() (IdentityProvider, *APIResponse, error) {
+	return decodeResponse[IdentityProvider](r.Execute())
+}
+
 /*
 ReplaceIdentityProvider Replace an Identity Provider
 
@@ -4172,7 +4299,7 @@ func (a *IdentityProviderAPIService) ReplaceIdentityProviderExecute(r ApiReplace
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.ReplaceIdentityProvider")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/{idpId}"
@@ -4223,7 +4350,7 @@ func (a *IdentityProviderAPIService) ReplaceIdentityProviderExecute(r ApiReplace
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -4238,6 +4365,7 @@ func (a *IdentityProviderAPIService) ReplaceIdentityProviderExecute(r ApiReplace
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -4351,7 +4479,7 @@ func (a *IdentityProviderAPIService) RevokeCsrForIdentityProviderExecute(r ApiRe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.RevokeCsrForIdentityProvider")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/{idpId}/credentials/csrs/{idpCsrId}"
@@ -4400,7 +4528,7 @@ func (a *IdentityProviderAPIService) RevokeCsrForIdentityProviderExecute(r ApiRe
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -4415,6 +4543,7 @@ func (a *IdentityProviderAPIService) RevokeCsrForIdentityProviderExecute(r ApiRe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -4516,7 +4645,7 @@ func (a *IdentityProviderAPIService) UnlinkUserFromIdentityProviderExecute(r Api
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.UnlinkUserFromIdentityProvider")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/{idpId}/users/{userId}"
@@ -4565,7 +4694,7 @@ func (a *IdentityProviderAPIService) UnlinkUserFromIdentityProviderExecute(r Api
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -4580,6 +4709,7 @@ func (a *IdentityProviderAPIService) UnlinkUserFromIdentityProviderExecute(r Api
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -106,6 +106,11 @@ func (r ApiCreateLinkedObjectDefinitionRequest) Execute() (*APIResponse, error)
 	return r.ApiService.CreateLinkedObjectDefinitionExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into LinkedObject.
+func (r ApiCreateLinkedObjectDefinitionRequest) ExecuteTyped() (LinkedObject, *APIResponse, error) {
+	return decodeResponse[LinkedObject](r.Execute())
+}
+
 /*
 CreateLinkedObjectDefinition Create a Linked Object Definition
 
@@ -143,7 +148,7 @@ func (a *LinkedObjectAPIService) CreateLinkedObjectDefinitionExecute(r ApiCreate
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LinkedObjectAPIService.CreateLinkedObjectDefinition")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/meta/schemas/user/linkedObjects"
@@ -193,7 +198,7 @@ func (a *LinkedObjectAPIService) CreateLinkedObjectDefinitionExecute(r ApiCreate
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -208,6 +213,7 @@ func (a *LinkedObjectAPIService) CreateLinkedObjectDefinitionExecute(r ApiCreate
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -306,7 +312,7 @@ func (a *LinkedObjectAPIService) DeleteLinkedObjectDefinitionExecute(r ApiDelete
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LinkedObjectAPIService.DeleteLinkedObjectDefinition")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/meta/schemas/user/linkedObjects/{linkedObjectName}"
@@ -354,7 +360,7 @@ func (a *LinkedObjectAPIService) DeleteLinkedObjectDefinitionExecute(r ApiDelete
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -369,6 +375,7 @@ func (a *LinkedObjectAPIService) DeleteLinkedObjectDefinitionExecute(r ApiDelete
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -429,6 +436,11 @@ func (r ApiGetLinkedObjectDefinitionRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetLinkedObjectDefinitionExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into LinkedObject.
+func (r ApiGetLinkedObjectDefinitionRequest) ExecuteTyped() (LinkedObject, *APIResponse, error) {
+	return decodeResponse[LinkedObject](r.Execute())
+}
+
 /*
 GetLinkedObjectDefinition Retrieve a Linked Object Definition
 
@@ -468,7 +480,7 @@ func (a *LinkedObjectAPIService) GetLinkedObjectDefinitionExecute(r ApiGetLinked
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LinkedObjectAPIService.GetLinkedObjectDefinition")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/meta/schemas/user/linkedObjects/{linkedObjectName}"
@@ -516,7 +528,7 @@ func (a *LinkedObjectAPIService) GetLinkedObjectDefinitionExecute(r ApiGetLinked
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -531,6 +543,7 @@ func (a *LinkedObjectAPIService) GetLinkedObjectDefinitionExecute(r ApiGetLinked
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -590,6 +603,11 @@ func (r ApiListLinkedObjectDefinitionsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListLinkedObjectDefinitionsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []LinkedObject.
+func (r ApiListLinkedObjectDefinitionsRequest) ExecuteTyped() ([]LinkedObject, *APIResponse, error) {
+	return decodeResponse[[]LinkedObject](r.Execute())
+}
+
 /*
 ListLinkedObjectDefinitions List all Linked Object Definitions
 
@@ -627,7 +645,7 @@ func (a *LinkedObjectAPIService) ListLinkedObjectDefinitionsExecute(r ApiListLin
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LinkedObjectAPIService.ListLinkedObjectDefinitions")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/meta/schemas/user/linkedObjects"
@@ -674,7 +692,7 @@ func (a *LinkedObjectAPIService) ListLinkedObjectDefinitionsExecute(r ApiListLin
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -689,6 +707,7 @@ func (a *LinkedObjectAPIService) ListLinkedObjectDefinitionsExecute(r ApiListLin
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -154,6 +154,11 @@ func (r ApiActivateLogStreamRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ActivateLogStreamExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ListLogStreams200ResponseInner.
+func (r ApiActivateLogStreamRequest) ExecuteTyped() (ListLogStreams200ResponseInner, *APIResponse, error) {
+	return decodeResponse[ListLogStreams200ResponseInner](r.Execute())
+}
+
 /*
 ActivateLogStream Activate a Log Stream
 
@@ -193,7 +198,7 @@ func (a *LogStreamAPIService) ActivateLogStreamExecute(r ApiActivateLogStreamReq
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LogStreamAPIService.ActivateLogStream")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/logStreams/{logStreamId}/lifecycle/activate"
@@ -241,7 +246,7 @@ func (a *LogStreamAPIService) ActivateLogStreamExecute(r ApiActivateLogStreamReq
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -256,6 +261,7 @@ func (a *LogStreamAPIService) ActivateLogStreamExecute(r ApiActivateLogStreamReq
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -321,6 +327,11 @@ func (r ApiCreateLogStreamRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.CreateLogStreamExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ListLogStreams200ResponseInner.
+func (r ApiCreateLogStreamRequest) ExecuteTyped() (ListLogStreams200ResponseInner, *APIResponse, error) {
+	return decodeResponse[ListLogStreams200ResponseInner](r.Execute())
+}
+
 /*
 CreateLogStream Create a Log Stream
 
@@ -358,7 +369,7 @@ func (a *LogStreamAPIService) CreateLogStreamExecute(r ApiCreateLogStreamRequest
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LogStreamAPIService.CreateLogStream")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/logStreams"
@@ -408,7 +419,7 @@ func (a *LogStreamAPIService) CreateLogStreamExecute(r ApiCreateLogStreamRequest
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -423,6 +434,7 @@ func (a *LogStreamAPIService) CreateLogStreamExecute(r ApiCreateLogStreamRequest
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -483,6 +495,11 @@ func (r ApiDeactivateLogStreamRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.DeactivateLogStreamExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ListLogStreams200ResponseInner.
+func (r ApiDeactivateLogStreamRequest) ExecuteTyped() (ListLogStreams200ResponseInner, *APIResponse, error) {
+	return decodeResponse[ListLogStreams200ResponseInner](r.Execute())
+}
+
 /*
 DeactivateLogStream Deactivate a Log Stream
 
@@ -522,7 +539,7 @@ func (a *LogStreamAPIService) DeactivateLogStreamExecute(r ApiDeactivateLogStrea
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LogStreamAPIService.DeactivateLogStream")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/logStreams/{logStreamId}/lifecycle/deactivate"
@@ -570,7 +587,7 @@ func (a *LogStreamAPIService) DeactivateLogStreamExecute(r ApiDeactivateLogStrea
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -585,6 +602,7 @@ func (a *LogStreamAPIService) DeactivateLogStreamExecute(r ApiDeactivateLogStrea
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -683,7 +701,7 @@ func (a *LogStreamAPIService) DeleteLogStreamExecute(r ApiDeleteLogStreamRequest
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LogStreamAPIService.DeleteLogStream")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/logStreams/{logStreamId}"
@@ -731,7 +749,7 @@ func (a *LogStreamAPIService) DeleteLogStreamExecute(r ApiDeleteLogStreamRequest
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -746,6 +764,7 @@ func (a *LogStreamAPIService) DeleteLogStreamExecute(r ApiDeleteLogStreamRequest
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -806,6 +825,11 @@ func (r ApiGetLogStreamRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetLogStreamExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ListLogStreams200ResponseInner.
+func (r ApiGetLogStreamRequest) ExecuteTyped() (ListLogStreams200ResponseInner, *APIResponse, error) {
+	return decodeResponse[ListLogStreams200ResponseInner](r.Execute())
+}
+
 /*
 GetLogStream Retrieve a Log Stream
 
@@ -845,7 +869,7 @@ func (a *LogStreamAPIService) GetLogStreamExecute(r ApiGetLogStreamRequest) (*AP
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LogStreamAPIService.GetLogStream")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/logStreams/{logStreamId}"
@@ -893,7 +917,7 @@ func (a *LogStreamAPIService) GetLogStreamExecute(r ApiGetLogStreamRequest) (*AP
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -908,6 +932,7 @@ func (a *LogStreamAPIService) GetLogStreamExecute(r ApiGetLogStreamRequest) (*AP
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -988,6 +1013,11 @@ func (r ApiListLogStreamsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListLogStreamsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []ListLogStreams200ResponseInner.
+func (r ApiListLogStreamsRequest) ExecuteTyped() ([]ListLogStreams200ResponseInner, *APIResponse, error) {
+	return decodeResponse[[]ListLogStreams200ResponseInner](r.Execute())
+}
+
 /*
 ListLogStreams List all Log Streams
 
@@ -1025,7 +1055,7 @@ func (a *LogStreamAPIService) ListLogStreamsExecute(r ApiListLogStreamsRequest)
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LogStreamAPIService.ListLogStreams")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/logStreams"
@@ -1081,7 +1111,7 @@ func (a *LogStreamAPIService) ListLogStreamsExecute(r ApiListLogStreamsRequest)
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1096,6 +1126,7 @@ func (a *LogStreamAPIService) ListLogStreamsExecute(r ApiListLogStreamsRequest)
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1150,6 +1181,11 @@ func (r ApiReplaceLogStreamRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ReplaceLogStreamExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ListLogStreams200ResponseInner.
+func (r ApiReplaceLogStreamRequest) ExecuteTyped() (ListLogStreams200ResponseInner, *APIResponse, error) {
+	return decodeResponse[ListLogStreams200ResponseInner](r.Execute())
+}
+
 /*
 ReplaceLogStream Replace a Log Stream
 
@@ -1197,7 +1233,7 @@ func (a *LogStreamAPIService) ReplaceLogStreamExecute(r ApiReplaceLogStreamReque
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LogStreamAPIService.ReplaceLogStream")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/logStreams/{logStreamId}"
@@ -1248,7 +1284,7 @@ func (a *LogStreamAPIService) ReplaceLogStreamExecute(r ApiReplaceLogStreamReque
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1263,6 +1299,7 @@ func (a *LogStreamAPIService) ReplaceLogStreamExecute(r ApiReplaceLogStreamReque
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
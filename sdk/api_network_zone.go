@@ -153,6 +153,11 @@ func (r ApiActivateNetworkZoneRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ActivateNetworkZoneExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into NetworkZone.
+func (r ApiActivateNetworkZoneRequest) ExecuteTyped() (NetworkZone, *APIResponse, error) {
+	return decodeResponse[NetworkZone](r.Execute())
+}
+
 /*
 ActivateNetworkZone Activate a Network Zone
 
@@ -192,7 +197,7 @@ func (a *NetworkZoneAPIService) ActivateNetworkZoneExecute(r ApiActivateNetworkZ
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "NetworkZoneAPIService.ActivateNetworkZone")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/zones/{zoneId}/lifecycle/activate"
@@ -240,7 +245,7 @@ func (a *NetworkZoneAPIService) ActivateNetworkZoneExecute(r ApiActivateNetworkZ
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -255,6 +260,7 @@ func (a *NetworkZoneAPIService) ActivateNetworkZoneExecute(r ApiActivateNetworkZ
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -320,6 +326,11 @@ func (r ApiCreateNetworkZoneRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.CreateNetworkZoneExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into NetworkZone.
+func (r ApiCreateNetworkZoneRequest) ExecuteTyped() (NetworkZone, *APIResponse, error) {
+	return decodeResponse[NetworkZone](r.Execute())
+}
+
 /*
 CreateNetworkZone Create a Network Zone
 
@@ -359,7 +370,7 @@ func (a *NetworkZoneAPIService) CreateNetworkZoneExecute(r ApiCreateNetworkZoneR
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "NetworkZoneAPIService.CreateNetworkZone")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/zones"
@@ -409,7 +420,7 @@ func (a *NetworkZoneAPIService) CreateNetworkZoneExecute(r ApiCreateNetworkZoneR
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -424,6 +435,7 @@ func (a *NetworkZoneAPIService) CreateNetworkZoneExecute(r ApiCreateNetworkZoneR
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -484,6 +496,11 @@ func (r ApiDeactivateNetworkZoneRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.DeactivateNetworkZoneExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into NetworkZone.
+func (r ApiDeactivateNetworkZoneRequest) ExecuteTyped() (NetworkZone, *APIResponse, error) {
+	return decodeResponse[NetworkZone](r.Execute())
+}
+
 /*
 DeactivateNetworkZone Deactivate a Network Zone
 
@@ -523,7 +540,7 @@ func (a *NetworkZoneAPIService) DeactivateNetworkZoneExecute(r ApiDeactivateNetw
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "NetworkZoneAPIService.DeactivateNetworkZone")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/zones/{zoneId}/lifecycle/deactivate"
@@ -571,7 +588,7 @@ func (a *NetworkZoneAPIService) DeactivateNetworkZoneExecute(r ApiDeactivateNetw
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -586,6 +603,7 @@ func (a *NetworkZoneAPIService) DeactivateNetworkZoneExecute(r ApiDeactivateNetw
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -684,7 +702,7 @@ func (a *NetworkZoneAPIService) DeleteNetworkZoneExecute(r ApiDeleteNetworkZoneR
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "NetworkZoneAPIService.DeleteNetworkZone")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/zones/{zoneId}"
@@ -732,7 +750,7 @@ func (a *NetworkZoneAPIService) DeleteNetworkZoneExecute(r ApiDeleteNetworkZoneR
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -747,6 +765,7 @@ func (a *NetworkZoneAPIService) DeleteNetworkZoneExecute(r ApiDeleteNetworkZoneR
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -807,6 +826,11 @@ func (r ApiGetNetworkZoneRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetNetworkZoneExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into NetworkZone.
+func (r ApiGetNetworkZoneRequest) ExecuteTyped() (NetworkZone, *APIResponse, error) {
+	return decodeResponse[NetworkZone](r.Execute())
+}
+
 /*
 GetNetworkZone Retrieve a Network Zone
 
@@ -846,7 +870,7 @@ func (a *NetworkZoneAPIService) GetNetworkZoneExecute(r ApiGetNetworkZoneRequest
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "NetworkZoneAPIService.GetNetworkZone")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/zones/{zoneId}"
@@ -894,7 +918,7 @@ func (a *NetworkZoneAPIService) GetNetworkZoneExecute(r ApiGetNetworkZoneRequest
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -909,6 +933,7 @@ func (a *NetworkZoneAPIService) GetNetworkZoneExecute(r ApiGetNetworkZoneRequest
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -989,6 +1014,11 @@ func (r ApiListNetworkZonesRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListNetworkZonesExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []NetworkZone.
+func (r ApiListNetworkZonesRequest) ExecuteTyped() ([]NetworkZone, *APIResponse, error) {
+	return decodeResponse[[]NetworkZone](r.Execute())
+}
+
 /*
 ListNetworkZones List all Network Zones
 
@@ -1030,7 +1060,7 @@ func (a *NetworkZoneAPIService) ListNetworkZonesExecute(r ApiListNetworkZonesReq
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "NetworkZoneAPIService.ListNetworkZones")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/zones"
@@ -1086,7 +1116,7 @@ func (a *NetworkZoneAPIService) ListNetworkZonesExecute(r ApiListNetworkZonesReq
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1101,6 +1131,7 @@ func (a *NetworkZoneAPIService) ListNetworkZonesExecute(r ApiListNetworkZonesReq
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1155,6 +1186,11 @@ func (r ApiReplaceNetworkZoneRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ReplaceNetworkZoneExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into NetworkZone.
+func (r ApiReplaceNetworkZoneRequest) ExecuteTyped() (NetworkZone, *APIResponse, error) {
+	return decodeResponse[NetworkZone](r.Execute())
+}
+
 /*
 ReplaceNetworkZone Replace a Network Zone
 
@@ -1195,7 +1231,7 @@ func (a *NetworkZoneAPIService) ReplaceNetworkZoneExecute(r ApiReplaceNetworkZon
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "NetworkZoneAPIService.ReplaceNetworkZone")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/zones/{zoneId}"
@@ -1246,7 +1282,7 @@ func (a *NetworkZoneAPIService) ReplaceNetworkZoneExecute(r ApiReplaceNetworkZon
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1261,6 +1297,7 @@ func (a *NetworkZoneAPIService) ReplaceNetworkZoneExecute(r ApiReplaceNetworkZon
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
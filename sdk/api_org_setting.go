@@ -373,6 +373,11 @@ func (r ApiAssignClientPrivilegesSettingRequest) Execute() (*APIResponse, error)
 	return r.ApiService.AssignClientPrivilegesSettingExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ClientPrivilegesSetting.
+func (r ApiAssignClientPrivilegesSettingRequest) ExecuteTyped() (ClientPrivilegesSetting, *APIResponse, error) {
+	return decodeResponse[ClientPrivilegesSetting](r.Execute())
+}
+
 /*
 AssignClientPrivilegesSetting Assign the Super Admin role to a public client app
 
@@ -410,7 +415,7 @@ func (a *OrgSettingAPIService) AssignClientPrivilegesSettingExecute(r ApiAssignC
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.AssignClientPrivilegesSetting")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org/settings/clientPrivilegesSetting"
@@ -460,7 +465,7 @@ func (a *OrgSettingAPIService) AssignClientPrivilegesSettingExecute(r ApiAssignC
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -475,6 +480,7 @@ func (a *OrgSettingAPIService) AssignClientPrivilegesSettingExecute(r ApiAssignC
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -528,6 +534,11 @@ func (r ApiBulkRemoveEmailAddressBouncesRequest) Execute() (*APIResponse, error)
 	return r.ApiService.BulkRemoveEmailAddressBouncesExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into BouncesRemoveListResult.
+func (r ApiBulkRemoveEmailAddressBouncesRequest) ExecuteTyped() (BouncesRemoveListResult, *APIResponse, error) {
+	return decodeResponse[BouncesRemoveListResult](r.Execute())
+}
+
 /*
 BulkRemoveEmailAddressBounces Remove Emails from Email Provider Bounce List
 
@@ -565,7 +576,7 @@ func (a *OrgSettingAPIService) BulkRemoveEmailAddressBouncesExecute(r ApiBulkRem
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.BulkRemoveEmailAddressBounces")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org/email/bounces/remove-list"
@@ -615,7 +626,7 @@ func (a *OrgSettingAPIService) BulkRemoveEmailAddressBouncesExecute(r ApiBulkRem
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -630,6 +641,7 @@ func (a *OrgSettingAPIService) BulkRemoveEmailAddressBouncesExecute(r ApiBulkRem
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -689,6 +701,11 @@ func (r ApiExtendOktaSupportRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ExtendOktaSupportExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into OrgOktaSupportSettingsObj.
+func (r ApiExtendOktaSupportRequest) ExecuteTyped() (OrgOktaSupportSettingsObj, *APIResponse, error) {
+	return decodeResponse[OrgOktaSupportSettingsObj](r.Execute())
+}
+
 /*
 ExtendOktaSupport Extend Okta Support Access
 
@@ -726,7 +743,7 @@ func (a *OrgSettingAPIService) ExtendOktaSupportExecute(r ApiExtendOktaSupportRe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.ExtendOktaSupport")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org/privacy/oktaSupport/extend"
@@ -773,7 +790,7 @@ func (a *OrgSettingAPIService) ExtendOktaSupportExecute(r ApiExtendOktaSupportRe
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -788,6 +805,7 @@ func (a *OrgSettingAPIService) ExtendOktaSupportExecute(r ApiExtendOktaSupportRe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -835,6 +853,11 @@ func (r ApiGetClientPrivilegesSettingRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetClientPrivilegesSettingExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ClientPrivilegesSetting.
+func (r ApiGetClientPrivilegesSettingRequest) ExecuteTyped() (ClientPrivilegesSetting, *APIResponse, error) {
+	return decodeResponse[ClientPrivilegesSetting](r.Execute())
+}
+
 /*
 GetClientPrivilegesSetting Retrieve the Org settings to assign the Super Admin role
 
@@ -872,7 +895,7 @@ func (a *OrgSettingAPIService) GetClientPrivilegesSettingExecute(r ApiGetClientP
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.GetClientPrivilegesSetting")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org/settings/clientPrivilegesSetting"
@@ -919,7 +942,7 @@ func (a *OrgSettingAPIService) GetClientPrivilegesSettingExecute(r ApiGetClientP
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -934,6 +957,7 @@ func (a *OrgSettingAPIService) GetClientPrivilegesSettingExecute(r ApiGetClientP
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -981,6 +1005,11 @@ func (r ApiGetOktaCommunicationSettingsRequest) Execute() (*APIResponse, error)
 	return r.ApiService.GetOktaCommunicationSettingsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into OrgOktaCommunicationSetting.
+func (r ApiGetOktaCommunicationSettingsRequest) ExecuteTyped() (OrgOktaCommunicationSetting, *APIResponse, error) {
+	return decodeResponse[OrgOktaCommunicationSetting](r.Execute())
+}
+
 /*
 GetOktaCommunicationSettings Retrieve the Okta Communication Settings
 
@@ -1018,7 +1047,7 @@ func (a *OrgSettingAPIService) GetOktaCommunicationSettingsExecute(r ApiGetOktaC
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.GetOktaCommunicationSettings")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org/privacy/oktaCommunication"
@@ -1065,7 +1094,7 @@ func (a *OrgSettingAPIService) GetOktaCommunicationSettingsExecute(r ApiGetOktaC
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1080,6 +1109,7 @@ func (a *OrgSettingAPIService) GetOktaCommunicationSettingsExecute(r ApiGetOktaC
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1127,6 +1157,11 @@ func (r ApiGetOrgContactTypesRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetOrgContactTypesExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []OrgContactTypeObj.
+func (r ApiGetOrgContactTypesRequest) ExecuteTyped() ([]OrgContactTypeObj, *APIResponse, error) {
+	return decodeResponse[[]OrgContactTypeObj](r.Execute())
+}
+
 /*
 GetOrgContactTypes Retrieve the Org Contact Types
 
@@ -1164,7 +1199,7 @@ func (a *OrgSettingAPIService) GetOrgContactTypesExecute(r ApiGetOrgContactTypes
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.GetOrgContactTypes")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org/contacts"
@@ -1211,7 +1246,7 @@ func (a *OrgSettingAPIService) GetOrgContactTypesExecute(r ApiGetOrgContactTypes
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1226,6 +1261,7 @@ func (a *OrgSettingAPIService) GetOrgContactTypesExecute(r ApiGetOrgContactTypes
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1274,6 +1310,11 @@ func (r ApiGetOrgContactUserRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetOrgContactUserExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into OrgContactUser.
+func (r ApiGetOrgContactUserRequest) ExecuteTyped() (OrgContactUser, *APIResponse, error) {
+	return decodeResponse[OrgContactUser](r.Execute())
+}
+
 /*
 GetOrgContactUser Retrieve the User of the Contact Type
 
@@ -1313,7 +1354,7 @@ func (a *OrgSettingAPIService) GetOrgContactUserExecute(r ApiGetOrgContactUserRe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.GetOrgContactUser")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org/contacts/{contactType}"
@@ -1361,7 +1402,7 @@ func (a *OrgSettingAPIService) GetOrgContactUserExecute(r ApiGetOrgContactUserRe
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1376,6 +1417,7 @@ func (a *OrgSettingAPIService) GetOrgContactUserExecute(r ApiGetOrgContactUserRe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1435,6 +1477,11 @@ func (r ApiGetOrgOktaSupportSettingsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetOrgOktaSupportSettingsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into OrgOktaSupportSettingsObj.
+func (r ApiGetOrgOktaSupportSettingsRequest) ExecuteTyped() (OrgOktaSupportSettingsObj, *APIResponse, error) {
+	return decodeResponse[OrgOktaSupportSettingsObj](r.Execute())
+}
+
 /*
 GetOrgOktaSupportSettings Retrieve the Okta Support Settings
 
@@ -1472,7 +1519,7 @@ func (a *OrgSettingAPIService) GetOrgOktaSupportSettingsExecute(r ApiGetOrgOktaS
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.GetOrgOktaSupportSettings")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org/privacy/oktaSupport"
@@ -1519,7 +1566,7 @@ func (a *OrgSettingAPIService) GetOrgOktaSupportSettingsExecute(r ApiGetOrgOktaS
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1534,6 +1581,7 @@ func (a *OrgSettingAPIService) GetOrgOktaSupportSettingsExecute(r ApiGetOrgOktaS
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1581,6 +1629,11 @@ func (r ApiGetOrgPreferencesRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetOrgPreferencesExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into OrgPreferences.
+func (r ApiGetOrgPreferencesRequest) ExecuteTyped() (OrgPreferences, *APIResponse, error) {
+	return decodeResponse[OrgPreferences](r.Execute())
+}
+
 /*
 GetOrgPreferences Retrieve the Org Preferences
 
@@ -1618,7 +1671,7 @@ func (a *OrgSettingAPIService) GetOrgPreferencesExecute(r ApiGetOrgPreferencesRe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.GetOrgPreferences")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org/preferences"
@@ -1665,7 +1718,7 @@ func (a *OrgSettingAPIService) GetOrgPreferencesExecute(r ApiGetOrgPreferencesRe
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1680,6 +1733,7 @@ func (a *OrgSettingAPIService) GetOrgPreferencesExecute(r ApiGetOrgPreferencesRe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1727,6 +1781,11 @@ func (r ApiGetOrgSettingsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetOrgSettingsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into OrgSetting.
+func (r ApiGetOrgSettingsRequest) ExecuteTyped() (OrgSetting, *APIResponse, error) {
+	return decodeResponse[OrgSetting](r.Execute())
+}
+
 /*
 GetOrgSettings Retrieve the Org Settings
 
@@ -1764,7 +1823,7 @@ func (a *OrgSettingAPIService) GetOrgSettingsExecute(r ApiGetOrgSettingsRequest)
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.GetOrgSettings")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org"
@@ -1811,7 +1870,7 @@ func (a *OrgSettingAPIService) GetOrgSettingsExecute(r ApiGetOrgSettingsRequest)
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1826,6 +1885,7 @@ func (a *OrgSettingAPIService) GetOrgSettingsExecute(r ApiGetOrgSettingsRequest)
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1873,6 +1933,11 @@ func (r ApiGetThirdPartyAdminSettingRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetThirdPartyAdminSettingExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ThirdPartyAdminSetting.
+func (r ApiGetThirdPartyAdminSettingRequest) ExecuteTyped() (ThirdPartyAdminSetting, *APIResponse, error) {
+	return decodeResponse[ThirdPartyAdminSetting](r.Execute())
+}
+
 /*
 GetThirdPartyAdminSetting Retrieve the Org Third-Party Admin setting
 
@@ -1910,7 +1975,7 @@ func (a *OrgSettingAPIService) GetThirdPartyAdminSettingExecute(r ApiGetThirdPar
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.GetThirdPartyAdminSetting")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org/orgSettings/thirdPartyAdminSetting"
@@ -1957,7 +2022,7 @@ func (a *OrgSettingAPIService) GetThirdPartyAdminSettingExecute(r ApiGetThirdPar
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1972,6 +2037,7 @@ func (a *OrgSettingAPIService) GetThirdPartyAdminSettingExecute(r ApiGetThirdPar
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2019,6 +2085,11 @@ func (r ApiGetWellknownOrgMetadataRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetWellknownOrgMetadataExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into WellKnownOrgMetadata.
+func (r ApiGetWellknownOrgMetadataRequest) ExecuteTyped() (WellKnownOrgMetadata, *APIResponse, error) {
+	return decodeResponse[WellKnownOrgMetadata](r.Execute())
+}
+
 /*
 GetWellknownOrgMetadata Retrieve the Well-Known Org Metadata
 
@@ -2056,7 +2127,7 @@ func (a *OrgSettingAPIService) GetWellknownOrgMetadataExecute(r ApiGetWellknownO
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.GetWellknownOrgMetadata")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/.well-known/okta-organization"
@@ -2089,7 +2160,7 @@ func (a *OrgSettingAPIService) GetWellknownOrgMetadataExecute(r ApiGetWellknownO
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2104,6 +2175,7 @@ func (a *OrgSettingAPIService) GetWellknownOrgMetadataExecute(r ApiGetWellknownO
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 429 {
 			var v Error
@@ -2139,6 +2211,11 @@ func (r ApiGrantOktaSupportRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GrantOktaSupportExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into OrgOktaSupportSettingsObj.
+func (r ApiGrantOktaSupportRequest) ExecuteTyped() (OrgOktaSupportSettingsObj, *APIResponse, error) {
+	return decodeResponse[OrgOktaSupportSettingsObj](r.Execute())
+}
+
 /*
 GrantOktaSupport Grant Okta Support Access to your Org
 
@@ -2176,7 +2253,7 @@ func (a *OrgSettingAPIService) GrantOktaSupportExecute(r ApiGrantOktaSupportRequ
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.GrantOktaSupport")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org/privacy/oktaSupport/grant"
@@ -2223,7 +2300,7 @@ func (a *OrgSettingAPIService) GrantOktaSupportExecute(r ApiGrantOktaSupportRequ
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2238,6 +2315,7 @@ func (a *OrgSettingAPIService) GrantOktaSupportExecute(r ApiGrantOktaSupportRequ
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2285,6 +2363,11 @@ func (r ApiOptInUsersToOktaCommunicationEmailsRequest) Execute() (*APIResponse,
 	return r.ApiService.OptInUsersToOktaCommunicationEmailsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into OrgOktaCommunicationSetting.
+func (r ApiOptInUsersToOktaCommunicationEmailsRequest) ExecuteTyped() (OrgOktaCommunicationSetting, *APIResponse, error) {
+	return decodeResponse[OrgOktaCommunicationSetting](r.Execute())
+}
+
 /*
 OptInUsersToOktaCommunicationEmails Opt in all Users to Okta Communication emails
 
@@ -2322,7 +2405,7 @@ func (a *OrgSettingAPIService) OptInUsersToOktaCommunicationEmailsExecute(r ApiO
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.OptInUsersToOktaCommunicationEmails")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org/privacy/oktaCommunication/optIn"
@@ -2369,7 +2452,7 @@ func (a *OrgSettingAPIService) OptInUsersToOktaCommunicationEmailsExecute(r ApiO
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2384,6 +2467,7 @@ func (a *OrgSettingAPIService) OptInUsersToOktaCommunicationEmailsExecute(r ApiO
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2431,6 +2515,11 @@ func (r ApiOptOutUsersFromOktaCommunicationEmailsRequest) Execute() (*APIRespons
 	return r.ApiService.OptOutUsersFromOktaCommunicationEmailsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into OrgOktaCommunicationSetting.
+func (r ApiOptOutUsersFromOktaCommunicationEmailsRequest) ExecuteTyped() (OrgOktaCommunicationSetting, *APIResponse, error) {
+	return decodeResponse[OrgOktaCommunicationSetting](r.Execute())
+}
+
 /*
 OptOutUsersFromOktaCommunicationEmails Opt out all Users from Okta Communication emails
 
@@ -2468,7 +2557,7 @@ func (a *OrgSettingAPIService) OptOutUsersFromOktaCommunicationEmailsExecute(r A
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.OptOutUsersFromOktaCommunicationEmails")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org/privacy/oktaCommunication/optOut"
@@ -2515,7 +2604,7 @@ func (a *OrgSettingAPIService) OptOutUsersFromOktaCommunicationEmailsExecute(r A
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2530,6 +2619,7 @@ func (a *OrgSettingAPIService) OptOutUsersFromOktaCommunicationEmailsExecute(r A
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2584,6 +2674,11 @@ func (r ApiReplaceOrgContactUserRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ReplaceOrgContactUserExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into OrgContactUser.
+func (r ApiReplaceOrgContactUserRequest) ExecuteTyped() (OrgContactUser, *APIResponse, error) {
+	return decodeResponse[OrgContactUser](r.Execute())
+}
+
 /*
 ReplaceOrgContactUser Replace the User of the Contact Type
 
@@ -2623,7 +2718,7 @@ func (a *OrgSettingAPIService) ReplaceOrgContactUserExecute(r ApiReplaceOrgConta
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.ReplaceOrgContactUser")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org/contacts/{contactType}"
@@ -2674,7 +2769,7 @@ func (a *OrgSettingAPIService) ReplaceOrgContactUserExecute(r ApiReplaceOrgConta
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2689,6 +2784,7 @@ func (a *OrgSettingAPIService) ReplaceOrgContactUserExecute(r ApiReplaceOrgConta
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -2766,6 +2862,11 @@ func (r ApiReplaceOrgSettingsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ReplaceOrgSettingsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into OrgSetting.
+func (r ApiReplaceOrgSettingsRequest) ExecuteTyped() (OrgSetting, *APIResponse, error) {
+	return decodeResponse[OrgSetting](r.Execute())
+}
+
 /*
 ReplaceOrgSettings Replace the Org Settings
 
@@ -2803,7 +2904,7 @@ func (a *OrgSettingAPIService) ReplaceOrgSettingsExecute(r ApiReplaceOrgSettings
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.ReplaceOrgSettings")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org"
@@ -2853,7 +2954,7 @@ func (a *OrgSettingAPIService) ReplaceOrgSettingsExecute(r ApiReplaceOrgSettings
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2868,6 +2969,7 @@ func (a *OrgSettingAPIService) ReplaceOrgSettingsExecute(r ApiReplaceOrgSettings
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -2927,6 +3029,11 @@ func (r ApiRevokeOktaSupportRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.RevokeOktaSupportExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into OrgOktaSupportSettingsObj.
+func (r ApiRevokeOktaSupportRequest) ExecuteTyped() (OrgOktaSupportSettingsObj, *APIResponse, error) {
+	return decodeResponse[OrgOktaSupportSettingsObj](r.Execute())
+}
+
 /*
 RevokeOktaSupport Revoke Okta Support Access
 
@@ -2964,7 +3071,7 @@ func (a *OrgSettingAPIService) RevokeOktaSupportExecute(r ApiRevokeOktaSupportRe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.RevokeOktaSupport")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org/privacy/oktaSupport/revoke"
@@ -3011,7 +3118,7 @@ func (a *OrgSettingAPIService) RevokeOktaSupportExecute(r ApiRevokeOktaSupportRe
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -3026,6 +3133,7 @@ func (a *OrgSettingAPIService) RevokeOktaSupportExecute(r ApiRevokeOktaSupportRe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -3073,6 +3181,11 @@ func (r ApiUpdateOrgHideOktaUIFooterRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.UpdateOrgHideOktaUIFooterExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into OrgPreferences.
+func (r ApiUpdateOrgHideOktaUIFooterRequest) ExecuteTyped() (OrgPreferences, *APIResponse, error) {
+	return decodeResponse[OrgPreferences](r.Execute())
+}
+
 /*
 UpdateOrgHideOktaUIFooter Update the Preference to Hide the Okta Dashboard Footer
 
@@ -3110,7 +3223,7 @@ func (a *OrgSettingAPIService) UpdateOrgHideOktaUIFooterExecute(r ApiUpdateOrgHi
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.UpdateOrgHideOktaUIFooter")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org/preferences/hideEndUserFooter"
@@ -3157,7 +3270,7 @@ func (a *OrgSettingAPIService) UpdateOrgHideOktaUIFooterExecute(r ApiUpdateOrgHi
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -3172,6 +3285,7 @@ func (a *OrgSettingAPIService) UpdateOrgHideOktaUIFooterExecute(r ApiUpdateOrgHi
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -3225,6 +3339,11 @@ func (r ApiUpdateOrgSettingsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.UpdateOrgSettingsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into OrgSetting.
+func (r ApiUpdateOrgSettingsRequest) ExecuteTyped() (OrgSetting, *APIResponse, error) {
+	return decodeResponse[OrgSetting](r.Execute())
+}
+
 /*
 UpdateOrgSettings Update the Org Settings
 
@@ -3262,7 +3381,7 @@ func (a *OrgSettingAPIService) UpdateOrgSettingsExecute(r ApiUpdateOrgSettingsRe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.UpdateOrgSettings")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org"
@@ -3312,7 +3431,7 @@ func (a *OrgSettingAPIService) UpdateOrgSettingsExecute(r ApiUpdateOrgSettingsRe
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -3327,6 +3446,7 @@ func (a *OrgSettingAPIService) UpdateOrgSettingsExecute(r ApiUpdateOrgSettingsRe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -3386,6 +3506,11 @@ func (r ApiUpdateOrgShowOktaUIFooterRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.UpdateOrgShowOktaUIFooterExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into OrgPreferences.
+func (r ApiUpdateOrgShowOktaUIFooterRequest) ExecuteTyped() (OrgPreferences, *APIResponse, error) {
+	return decodeResponse[OrgPreferences](r.Execute())
+}
+
 /*
 UpdateOrgShowOktaUIFooter Update the Preference to Show the Okta Dashboard Footer
 
@@ -3423,7 +3548,7 @@ func (a *OrgSettingAPIService) UpdateOrgShowOktaUIFooterExecute(r ApiUpdateOrgSh
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.UpdateOrgShowOktaUIFooter")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org/preferences/showEndUserFooter"
@@ -3470,7 +3595,7 @@ func (a *OrgSettingAPIService) UpdateOrgShowOktaUIFooterExecute(r ApiUpdateOrgSh
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -3485,6 +3610,7 @@ func (a *OrgSettingAPIService) UpdateOrgShowOktaUIFooterExecute(r ApiUpdateOrgSh
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -3532,6 +3658,11 @@ func (r ApiUpdateThirdPartyAdminSettingRequest) Execute() (*APIResponse, error)
 	return r.ApiService.UpdateThirdPartyAdminSettingExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ThirdPartyAdminSetting.
+func (r ApiUpdateThirdPartyAdminSettingRequest) ExecuteTyped() (ThirdPartyAdminSetting, *APIResponse, error) {
+	return decodeResponse[ThirdPartyAdminSetting](r.Execute())
+}
+
 /*
 UpdateThirdPartyAdminSetting Update the Org Third-Party Admin setting
 
@@ -3569,7 +3700,7 @@ func (a *OrgSettingAPIService) UpdateThirdPartyAdminSettingExecute(r ApiUpdateTh
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.UpdateThirdPartyAdminSetting")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org/orgSettings/thirdPartyAdminSetting"
@@ -3616,7 +3747,7 @@ func (a *OrgSettingAPIService) UpdateThirdPartyAdminSettingExecute(r ApiUpdateTh
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -3631,6 +3762,7 @@ func (a *OrgSettingAPIService) UpdateThirdPartyAdminSettingExecute(r ApiUpdateTh
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -3720,7 +3852,7 @@ func (a *OrgSettingAPIService) UploadOrgLogoExecute(r ApiUploadOrgLogoRequest) (
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.UploadOrgLogo")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org/logo"
@@ -3781,7 +3913,7 @@ func (a *OrgSettingAPIService) UploadOrgLogoExecute(r ApiUploadOrgLogoRequest) (
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -3796,6 +3928,7 @@ func (a *OrgSettingAPIService) UploadOrgLogoExecute(r ApiUploadOrgLogoRequest) (
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
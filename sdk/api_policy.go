@@ -400,7 +400,7 @@ func (a *PolicyAPIService) ActivatePolicyExecute(r ApiActivatePolicyRequest) (*A
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "PolicyAPIService.ActivatePolicy")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/policies/{policyId}/lifecycle/activate"
@@ -448,7 +448,7 @@ func (a *PolicyAPIService) ActivatePolicyExecute(r ApiActivatePolicyRequest) (*A
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -463,6 +463,7 @@ func (a *PolicyAPIService) ActivatePolicyExecute(r ApiActivatePolicyRequest) (*A
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -564,7 +565,7 @@ func (a *PolicyAPIService) ActivatePolicyRuleExecute(r ApiActivatePolicyRuleRequ
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "PolicyAPIService.ActivatePolicyRule")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/policies/{policyId}/rules/{ruleId}/lifecycle/activate"
@@ -613,7 +614,7 @@ func (a *PolicyAPIService) ActivatePolicyRuleExecute(r ApiActivatePolicyRuleRequ
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -628,6 +629,7 @@ func (a *PolicyAPIService) ActivatePolicyRuleExecute(r ApiActivatePolicyRuleRequ
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -688,6 +690,11 @@ func (r ApiClonePolicyRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ClonePolicyExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ListPolicies200ResponseInner.
+func (r ApiClonePolicyRequest) ExecuteTyped() (ListPolicies200ResponseInner, *APIResponse, error) {
+	return decodeResponse[ListPolicies200ResponseInner](r.Execute())
+}
+
 /*
 ClonePolicy Clone an existing Policy
 
@@ -727,7 +734,7 @@ func (a *PolicyAPIService) ClonePolicyExecute(r ApiClonePolicyRequest) (*APIResp
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "PolicyAPIService.ClonePolicy")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/policies/{policyId}/clone"
@@ -775,7 +782,7 @@ func (a *PolicyAPIService) ClonePolicyExecute(r ApiClonePolicyRequest) (*APIResp
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -790,6 +797,7 @@ func (a *PolicyAPIService) ClonePolicyExecute(r ApiClonePolicyRequest) (*APIResp
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -873,6 +881,11 @@ func (r ApiCreatePolicyRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.CreatePolicyExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into CreatePolicyRequest.
+func (r ApiCreatePolicyRequest) ExecuteTyped() (CreatePolicyRequest, *APIResponse, error) {
+	return decodeResponse[CreatePolicyRequest](r.Execute())
+}
+
 /*
 CreatePolicy Create a Policy
 
@@ -910,7 +923,7 @@ func (a *PolicyAPIService) CreatePolicyExecute(r ApiCreatePolicyRequest) (*APIRe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "PolicyAPIService.CreatePolicy")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/policies"
@@ -963,7 +976,7 @@ func (a *PolicyAPIService) CreatePolicyExecute(r ApiCreatePolicyRequest) (*APIRe
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -978,6 +991,7 @@ func (a *PolicyAPIService) CreatePolicyExecute(r ApiCreatePolicyRequest) (*APIRe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1044,6 +1058,11 @@ func (r ApiCreatePolicyRuleRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.CreatePolicyRuleExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ListPolicyRules200ResponseInner.
+func (r ApiCreatePolicyRuleRequest) ExecuteTyped() (ListPolicyRules200ResponseInner, *APIResponse, error) {
+	return decodeResponse[ListPolicyRules200ResponseInner](r.Execute())
+}
+
 /*
 CreatePolicyRule Create a Policy Rule
 
@@ -1083,7 +1102,7 @@ func (a *PolicyAPIService) CreatePolicyRuleExecute(r ApiCreatePolicyRuleRequest)
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "PolicyAPIService.CreatePolicyRule")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/policies/{policyId}/rules"
@@ -1134,7 +1153,7 @@ func (a *PolicyAPIService) CreatePolicyRuleExecute(r ApiCreatePolicyRuleRequest)
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1149,6 +1168,7 @@ func (a *PolicyAPIService) CreatePolicyRuleExecute(r ApiCreatePolicyRuleRequest)
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1233,6 +1253,11 @@ func (r ApiCreatePolicySimulationRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.CreatePolicySimulationExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []SimulatePolicyEvaluations.
+func (r ApiCreatePolicySimulationRequest) ExecuteTyped() ([]SimulatePolicyEvaluations, *APIResponse, error) {
+	return decodeResponse[[]SimulatePolicyEvaluations](r.Execute())
+}
+
 /*
 CreatePolicySimulation Create a Policy Simulation
 
@@ -1271,7 +1296,7 @@ func (a *PolicyAPIService) CreatePolicySimulationExecute(r ApiCreatePolicySimula
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "PolicyAPIService.CreatePolicySimulation")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/policies/simulate"
@@ -1324,7 +1349,7 @@ func (a *PolicyAPIService) CreatePolicySimulationExecute(r ApiCreatePolicySimula
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1339,6 +1364,7 @@ func (a *PolicyAPIService) CreatePolicySimulationExecute(r ApiCreatePolicySimula
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1437,7 +1463,7 @@ func (a *PolicyAPIService) DeactivatePolicyExecute(r ApiDeactivatePolicyRequest)
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "PolicyAPIService.DeactivatePolicy")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/policies/{policyId}/lifecycle/deactivate"
@@ -1485,7 +1511,7 @@ func (a *PolicyAPIService) DeactivatePolicyExecute(r ApiDeactivatePolicyRequest)
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1500,6 +1526,7 @@ func (a *PolicyAPIService) DeactivatePolicyExecute(r ApiDeactivatePolicyRequest)
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1601,7 +1628,7 @@ func (a *PolicyAPIService) DeactivatePolicyRuleExecute(r ApiDeactivatePolicyRule
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "PolicyAPIService.DeactivatePolicyRule")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/policies/{policyId}/rules/{ruleId}/lifecycle/deactivate"
@@ -1650,7 +1677,7 @@ func (a *PolicyAPIService) DeactivatePolicyRuleExecute(r ApiDeactivatePolicyRule
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1665,6 +1692,7 @@ func (a *PolicyAPIService) DeactivatePolicyRuleExecute(r ApiDeactivatePolicyRule
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1763,7 +1791,7 @@ func (a *PolicyAPIService) DeletePolicyExecute(r ApiDeletePolicyRequest) (*APIRe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "PolicyAPIService.DeletePolicy")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/policies/{policyId}"
@@ -1811,7 +1839,7 @@ func (a *PolicyAPIService) DeletePolicyExecute(r ApiDeletePolicyRequest) (*APIRe
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1826,6 +1854,7 @@ func (a *PolicyAPIService) DeletePolicyExecute(r ApiDeletePolicyRequest) (*APIRe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1927,7 +1956,7 @@ func (a *PolicyAPIService) DeletePolicyResourceMappingExecute(r ApiDeletePolicyR
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "PolicyAPIService.DeletePolicyResourceMapping")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/policies/{policyId}/mappings/{mappingId}"
@@ -1976,7 +2005,7 @@ func (a *PolicyAPIService) DeletePolicyResourceMappingExecute(r ApiDeletePolicyR
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1991,6 +2020,7 @@ func (a *PolicyAPIService) DeletePolicyResourceMappingExecute(r ApiDeletePolicyR
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2092,7 +2122,7 @@ func (a *PolicyAPIService) DeletePolicyRuleExecute(r ApiDeletePolicyRuleRequest)
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "PolicyAPIService.DeletePolicyRule")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/policies/{policyId}/rules/{ruleId}"
@@ -2141,7 +2171,7 @@ func (a *PolicyAPIService) DeletePolicyRuleExecute(r ApiDeletePolicyRuleRequest)
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2156,6 +2186,7 @@ func (a *PolicyAPIService) DeletePolicyRuleExecute(r ApiDeletePolicyRuleRequest)
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2222,6 +2253,11 @@ func (r ApiGetPolicyRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetPolicyExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ListPolicies200ResponseInner.
+func (r ApiGetPolicyRequest) ExecuteTyped() (ListPolicies200ResponseInner, *APIResponse, error) {
+	return decodeResponse[ListPolicies200ResponseInner](r.Execute())
+}
+
 /*
 GetPolicy Retrieve a Policy
 
@@ -2261,7 +2297,7 @@ func (a *PolicyAPIService) GetPolicyExecute(r ApiGetPolicyRequest) (*APIResponse
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "PolicyAPIService.GetPolicy")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/policies/{policyId}"
@@ -2312,7 +2348,7 @@ func (a *PolicyAPIService) GetPolicyExecute(r ApiGetPolicyRequest) (*APIResponse
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2327,6 +2363,7 @@ func (a *PolicyAPIService) GetPolicyExecute(r ApiGetPolicyRequest) (*APIResponse
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2388,6 +2425,11 @@ func (r ApiGetPolicyMappingRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetPolicyMappingExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into PolicyMapping.
+func (r ApiGetPolicyMappingRequest) ExecuteTyped() (PolicyMapping, *APIResponse, error) {
+	return decodeResponse[PolicyMapping](r.Execute())
+}
+
 /*
 GetPolicyMapping Retrieve a policy resource Mapping
 
@@ -2429,7 +2471,7 @@ func (a *PolicyAPIService) GetPolicyMappingExecute(r ApiGetPolicyMappingRequest)
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "PolicyAPIService.GetPolicyMapping")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/policies/{policyId}/mappings/{mappingId}"
@@ -2478,7 +2520,7 @@ func (a *PolicyAPIService) GetPolicyMappingExecute(r ApiGetPolicyMappingRequest)
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2493,6 +2535,7 @@ func (a *PolicyAPIService) GetPolicyMappingExecute(r ApiGetPolicyMappingRequest)
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2554,6 +2597,11 @@ func (r ApiGetPolicyRuleRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetPolicyRuleExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ListPolicyRules200ResponseInner.
+func (r ApiGetPolicyRuleRequest) ExecuteTyped() (ListPolicyRules200ResponseInner, *APIResponse, error) {
+	return decodeResponse[ListPolicyRules200ResponseInner](r.Execute())
+}
+
 /*
 GetPolicyRule Retrieve a Policy Rule
 
@@ -2595,7 +2643,7 @@ func (a *PolicyAPIService) GetPolicyRuleExecute(r ApiGetPolicyRuleRequest) (*API
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "PolicyAPIService.GetPolicyRule")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/policies/{policyId}/rules/{ruleId}"
@@ -2644,7 +2692,7 @@ func (a *PolicyAPIService) GetPolicyRuleExecute(r ApiGetPolicyRuleRequest) (*API
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2659,6 +2707,7 @@ func (a *PolicyAPIService) GetPolicyRuleExecute(r ApiGetPolicyRuleRequest) (*API
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2736,6 +2785,11 @@ func (r ApiListPoliciesRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListPoliciesExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []ListPolicies200ResponseInner.
+func (r ApiListPoliciesRequest) ExecuteTyped() ([]ListPolicies200ResponseInner, *APIResponse, error) {
+	return decodeResponse[[]ListPolicies200ResponseInner](r.Execute())
+}
+
 /*
 ListPolicies List all Policies
 
@@ -2773,7 +2827,7 @@ func (a *PolicyAPIService) ListPoliciesExecute(r ApiListPoliciesRequest) (*APIRe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "PolicyAPIService.ListPolicies")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/policies"
@@ -2827,7 +2881,7 @@ func (a *PolicyAPIService) ListPoliciesExecute(r ApiListPoliciesRequest) (*APIRe
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2842,6 +2896,7 @@ func (a *PolicyAPIService) ListPoliciesExecute(r ApiListPoliciesRequest) (*APIRe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2890,6 +2945,11 @@ func (r ApiListPolicyAppsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListPolicyAppsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []ListApplications200ResponseInner.
+func (r ApiListPolicyAppsRequest) ExecuteTyped() ([]ListApplications200ResponseInner, *APIResponse, error) {
+	return decodeResponse[[]ListApplications200ResponseInner](r.Execute())
+}
+
 /*
 ListPolicyApps List all Applications mapped to a Policy
 
@@ -2934,7 +2994,7 @@ func (a *PolicyAPIService) ListPolicyAppsExecute(r ApiListPolicyAppsRequest) (*A
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "PolicyAPIService.ListPolicyApps")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/policies/{policyId}/app"
@@ -2982,7 +3042,7 @@ func (a *PolicyAPIService) ListPolicyAppsExecute(r ApiListPolicyAppsRequest) (*A
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2997,6 +3057,7 @@ func (a *PolicyAPIService) ListPolicyAppsExecute(r ApiListPolicyAppsRequest) (*A
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -3057,6 +3118,11 @@ func (r ApiListPolicyMappingsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListPolicyMappingsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []PolicyMapping.
+func (r ApiListPolicyMappingsRequest) ExecuteTyped() ([]PolicyMapping, *APIResponse, error) {
+	return decodeResponse[[]PolicyMapping](r.Execute())
+}
+
 /*
 ListPolicyMappings List all resources mapped to a Policy
 
@@ -3096,7 +3162,7 @@ func (a *PolicyAPIService) ListPolicyMappingsExecute(r ApiListPolicyMappingsRequ
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "PolicyAPIService.ListPolicyMappings")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/policies/{policyId}/mappings"
@@ -3144,7 +3210,7 @@ func (a *PolicyAPIService) ListPolicyMappingsExecute(r ApiListPolicyMappingsRequ
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -3159,6 +3225,7 @@ func (a *PolicyAPIService) ListPolicyMappingsExecute(r ApiListPolicyMappingsRequ
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -3219,6 +3286,11 @@ func (r ApiListPolicyRulesRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListPolicyRulesExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []ListPolicyRules200ResponseInner.
+func (r ApiListPolicyRulesRequest) ExecuteTyped() ([]ListPolicyRules200ResponseInner, *APIResponse, error) {
+	return decodeResponse[[]ListPolicyRules200ResponseInner](r.Execute())
+}
+
 /*
 ListPolicyRules List all Policy Rules
 
@@ -3258,7 +3330,7 @@ func (a *PolicyAPIService) ListPolicyRulesExecute(r ApiListPolicyRulesRequest) (
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "PolicyAPIService.ListPolicyRules")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/policies/{policyId}/rules"
@@ -3306,7 +3378,7 @@ func (a *PolicyAPIService) ListPolicyRulesExecute(r ApiListPolicyRulesRequest) (
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -3321,6 +3393,7 @@ func (a *PolicyAPIService) ListPolicyRulesExecute(r ApiListPolicyRulesRequest) (
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -3387,6 +3460,11 @@ func (r ApiMapResourceToPolicyRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.MapResourceToPolicyExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into PolicyMapping.
+func (r ApiMapResourceToPolicyRequest) ExecuteTyped() (PolicyMapping, *APIResponse, error) {
+	return decodeResponse[PolicyMapping](r.Execute())
+}
+
 /*
 MapResourceToPolicy Map a resource to a Policy
 
@@ -3426,7 +3504,7 @@ func (a *PolicyAPIService) MapResourceToPolicyExecute(r ApiMapResourceToPolicyRe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "PolicyAPIService.MapResourceToPolicy")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/policies/{policyId}/mappings"
@@ -3477,7 +3555,7 @@ func (a *PolicyAPIService) MapResourceToPolicyExecute(r ApiMapResourceToPolicyRe
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -3492,6 +3570,7 @@ func (a *PolicyAPIService) MapResourceToPolicyExecute(r ApiMapResourceToPolicyRe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -3570,6 +3649,11 @@ func (r ApiReplacePolicyRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ReplacePolicyExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into CreatePolicyRequest.
+func (r ApiReplacePolicyRequest) ExecuteTyped() (CreatePolicyRequest, *APIResponse, error) {
+	return decodeResponse[CreatePolicyRequest](r.Execute())
+}
+
 /*
 ReplacePolicy Replace a Policy
 
@@ -3609,7 +3693,7 @@ func (a *PolicyAPIService) ReplacePolicyExecute(r ApiReplacePolicyRequest) (*API
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "PolicyAPIService.ReplacePolicy")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/policies/{policyId}"
@@ -3660,7 +3744,7 @@ func (a *PolicyAPIService) ReplacePolicyExecute(r ApiReplacePolicyRequest) (*API
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -3675,6 +3759,7 @@ func (a *PolicyAPIService) ReplacePolicyExecute(r ApiReplacePolicyRequest) (*API
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -3754,6 +3839,11 @@ func (r ApiReplacePolicyRuleRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ReplacePolicyRuleExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ListPolicyRules200ResponseInner.
+func (r ApiReplacePolicyRuleRequest) ExecuteTyped() (ListPolicyRules200ResponseInner, *APIResponse, error) {
+	return decodeResponse[ListPolicyRules200ResponseInner](r.Execute())
+}
+
 /*
 ReplacePolicyRule Replace a Policy Rule
 
@@ -3795,7 +3885,7 @@ func (a *PolicyAPIService) ReplacePolicyRuleExecute(r ApiReplacePolicyRuleReques
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "PolicyAPIService.ReplacePolicyRule")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/policies/{policyId}/rules/{ruleId}"
@@ -3847,7 +3937,7 @@ func (a *PolicyAPIService) ReplacePolicyRuleExecute(r ApiReplacePolicyRuleReques
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -3862,6 +3952,7 @@ func (a *PolicyAPIService) ReplacePolicyRuleExecute(r ApiReplacePolicyRuleReques
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -107,6 +107,11 @@ func (r ApiCreatePrincipalRateLimitEntityRequest) Execute() (*APIResponse, error
 	return r.ApiService.CreatePrincipalRateLimitEntityExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into PrincipalRateLimitEntity.
+func (r ApiCreatePrincipalRateLimitEntityRequest) ExecuteTyped() (PrincipalRateLimitEntity, *APIResponse, error) {
+	return decodeResponse[PrincipalRateLimitEntity](r.Execute())
+}
+
 /*
 CreatePrincipalRateLimitEntity Create a Principal Rate Limit
 
@@ -144,7 +149,7 @@ func (a *PrincipalRateLimitAPIService) CreatePrincipalRateLimitEntityExecute(r A
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "PrincipalRateLimitAPIService.CreatePrincipalRateLimitEntity")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/principal-rate-limits"
@@ -194,7 +199,7 @@ func (a *PrincipalRateLimitAPIService) CreatePrincipalRateLimitEntityExecute(r A
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -209,6 +214,7 @@ func (a *PrincipalRateLimitAPIService) CreatePrincipalRateLimitEntityExecute(r A
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -281,6 +287,11 @@ func (r ApiGetPrincipalRateLimitEntityRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetPrincipalRateLimitEntityExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into PrincipalRateLimitEntity.
+func (r ApiGetPrincipalRateLimitEntityRequest) ExecuteTyped() (PrincipalRateLimitEntity, *APIResponse, error) {
+	return decodeResponse[PrincipalRateLimitEntity](r.Execute())
+}
+
 /*
 GetPrincipalRateLimitEntity Retrieve a Principal Rate Limit
 
@@ -320,7 +331,7 @@ func (a *PrincipalRateLimitAPIService) GetPrincipalRateLimitEntityExecute(r ApiG
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "PrincipalRateLimitAPIService.GetPrincipalRateLimitEntity")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/principal-rate-limits/{principalRateLimitId}"
@@ -368,7 +379,7 @@ func (a *PrincipalRateLimitAPIService) GetPrincipalRateLimitEntityExecute(r ApiG
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -383,6 +394,7 @@ func (a *PrincipalRateLimitAPIService) GetPrincipalRateLimitEntityExecute(r ApiG
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -460,6 +472,11 @@ func (r ApiListPrincipalRateLimitEntitiesRequest) Execute() (*APIResponse, error
 	return r.ApiService.ListPrincipalRateLimitEntitiesExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []PrincipalRateLimitEntity.
+func (r ApiListPrincipalRateLimitEntitiesRequest) ExecuteTyped() ([]PrincipalRateLimitEntity, *APIResponse, error) {
+	return decodeResponse[[]PrincipalRateLimitEntity](r.Execute())
+}
+
 /*
 ListPrincipalRateLimitEntities List all Principal Rate Limits
 
@@ -497,7 +514,7 @@ func (a *PrincipalRateLimitAPIService) ListPrincipalRateLimitEntitiesExecute(r A
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "PrincipalRateLimitAPIService.ListPrincipalRateLimitEntities")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/principal-rate-limits"
@@ -553,7 +570,7 @@ func (a *PrincipalRateLimitAPIService) ListPrincipalRateLimitEntitiesExecute(r A
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -568,6 +585,7 @@ func (a *PrincipalRateLimitAPIService) ListPrincipalRateLimitEntitiesExecute(r A
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -634,6 +652,11 @@ func (r ApiReplacePrincipalRateLimitEntityRequest) Execute() (*APIResponse, erro
 	return r.ApiService.ReplacePrincipalRateLimitEntityExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into PrincipalRateLimitEntity.
+func (r ApiReplacePrincipalRateLimitEntityRequest) ExecuteTyped() (PrincipalRateLimitEntity, *APIResponse, error) {
+	return decodeResponse[PrincipalRateLimitEntity](r.Execute())
+}
+
 /*
 ReplacePrincipalRateLimitEntity Replace a Principal Rate Limit
 
@@ -673,7 +696,7 @@ func (a *PrincipalRateLimitAPIService) ReplacePrincipalRateLimitEntityExecute(r
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "PrincipalRateLimitAPIService.ReplacePrincipalRateLimitEntity")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/principal-rate-limits/{principalRateLimitId}"
@@ -724,7 +747,7 @@ func (a *PrincipalRateLimitAPIService) ReplacePrincipalRateLimitEntityExecute(r
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -739,6 +762,7 @@ func (a *PrincipalRateLimitAPIService) ReplacePrincipalRateLimitEntityExecute(r
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
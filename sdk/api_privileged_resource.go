@@ -126,6 +126,11 @@ func (r ApiClaimPrivilegedResourceRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ClaimPrivilegedResourceExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into CreatePrivilegedResourceRequest.
+func (r ApiClaimPrivilegedResourceRequest) ExecuteTyped() (CreatePrivilegedResourceRequest, *APIResponse, error) {
+	return decodeResponse[CreatePrivilegedResourceRequest](r.Execute())
+}
+
 /*
 ClaimPrivilegedResource Claim a privileged resource for management
 
@@ -168,7 +173,7 @@ func (a *PrivilegedResourceAPIService) ClaimPrivilegedResourceExecute(r ApiClaim
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "PrivilegedResourceAPIService.ClaimPrivilegedResource")
 	if err != nil {
 		// TODU
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/privileged-resource/{id}/claim"
@@ -219,7 +224,7 @@ func (a *PrivilegedResourceAPIService) ClaimPrivilegedResourceExecute(r ApiClaim
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
 		// TODU
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -235,6 +240,7 @@ func (a *PrivilegedResourceAPIService) ClaimPrivilegedResourceExecute(r ApiClaim
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -323,6 +329,11 @@ func (r ApiCreatePrivilegedResourceRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.CreatePrivilegedResourceExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into CreatePrivilegedResourceRequest.
+func (r ApiCreatePrivilegedResourceRequest) ExecuteTyped() (CreatePrivilegedResourceRequest, *APIResponse, error) {
+	return decodeResponse[CreatePrivilegedResourceRequest](r.Execute())
+}
+
 /*
 CreatePrivilegedResource Create a privileged resource
 
@@ -363,7 +374,7 @@ func (a *PrivilegedResourceAPIService) CreatePrivilegedResourceExecute(r ApiCrea
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "PrivilegedResourceAPIService.CreatePrivilegedResource")
 	if err != nil {
 		// TODU
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/privileged-resource"
@@ -416,7 +427,7 @@ func (a *PrivilegedResourceAPIService) CreatePrivilegedResourceExecute(r ApiCrea
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
 		// TODU
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -432,6 +443,7 @@ func (a *PrivilegedResourceAPIService) CreatePrivilegedResourceExecute(r ApiCrea
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -515,6 +527,11 @@ func (r ApiDeletePrivilegedResourceRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.DeletePrivilegedResourceExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into CreatePrivilegedResourceRequest.
+func (r ApiDeletePrivilegedResourceRequest) ExecuteTyped() (CreatePrivilegedResourceRequest, *APIResponse, error) {
+	return decodeResponse[CreatePrivilegedResourceRequest](r.Execute())
+}
+
 /*
 DeletePrivilegedResource Delete a privileged resource
 
@@ -557,7 +574,7 @@ func (a *PrivilegedResourceAPIService) DeletePrivilegedResourceExecute(r ApiDele
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "PrivilegedResourceAPIService.DeletePrivilegedResource")
 	if err != nil {
 		// TODU
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/privileged-resource/{id}"
@@ -608,7 +625,7 @@ func (a *PrivilegedResourceAPIService) DeletePrivilegedResourceExecute(r ApiDele
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
 		// TODU
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -624,6 +641,7 @@ func (a *PrivilegedResourceAPIService) DeletePrivilegedResourceExecute(r ApiDele
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -707,6 +725,11 @@ func (r ApiGetPrivilegedResourceRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetPrivilegedResourceExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into CreatePrivilegedResourceRequest.
+func (r ApiGetPrivilegedResourceRequest) ExecuteTyped() (CreatePrivilegedResourceRequest, *APIResponse, error) {
+	return decodeResponse[CreatePrivilegedResourceRequest](r.Execute())
+}
+
 /*
 GetPrivilegedResource Retrieve a privileged resource
 
@@ -749,7 +772,7 @@ func (a *PrivilegedResourceAPIService) GetPrivilegedResourceExecute(r ApiGetPriv
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "PrivilegedResourceAPIService.GetPrivilegedResource")
 	if err != nil {
 		// TODU
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/privileged-resource/{id}"
@@ -800,7 +823,7 @@ func (a *PrivilegedResourceAPIService) GetPrivilegedResourceExecute(r ApiGetPriv
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
 		// TODU
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -816,6 +839,7 @@ func (a *PrivilegedResourceAPIService) GetPrivilegedResourceExecute(r ApiGetPriv
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -905,6 +929,11 @@ func (r ApiReplacePrivilegedResourceRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ReplacePrivilegedResourceExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into CreatePrivilegedResourceRequest.
+func (r ApiReplacePrivilegedResourceRequest) ExecuteTyped() (CreatePrivilegedResourceRequest, *APIResponse, error) {
+	return decodeResponse[CreatePrivilegedResourceRequest](r.Execute())
+}
+
 /*
 ReplacePrivilegedResource Replace a privileged resource
 
@@ -947,7 +976,7 @@ func (a *PrivilegedResourceAPIService) ReplacePrivilegedResourceExecute(r ApiRep
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "PrivilegedResourceAPIService.ReplacePrivilegedResource")
 	if err != nil {
 		// TODU
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/privileged-resource/{id}"
@@ -1001,7 +1030,7 @@ func (a *PrivilegedResourceAPIService) ReplacePrivilegedResourceExecute(r ApiRep
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
 		// TODU
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1017,6 +1046,7 @@ func (a *PrivilegedResourceAPIService) ReplacePrivilegedResourceExecute(r ApiRep
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
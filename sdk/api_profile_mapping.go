@@ -92,6 +92,11 @@ func (r ApiGetProfileMappingRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetProfileMappingExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ProfileMapping.
+func (r ApiGetProfileMappingRequest) ExecuteTyped() (ProfileMapping, *APIResponse, error) {
+	return decodeResponse[ProfileMapping](r.Execute())
+}
+
 /*
 GetProfileMapping Retrieve a Profile Mapping
 
@@ -131,7 +136,7 @@ func (a *ProfileMappingAPIService) GetProfileMappingExecute(r ApiGetProfileMappi
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ProfileMappingAPIService.GetProfileMapping")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/mappings/{mappingId}"
@@ -179,7 +184,7 @@ func (a *ProfileMappingAPIService) GetProfileMappingExecute(r ApiGetProfileMappi
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -194,6 +199,7 @@ func (a *ProfileMappingAPIService) GetProfileMappingExecute(r ApiGetProfileMappi
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -281,6 +287,11 @@ func (r ApiListProfileMappingsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListProfileMappingsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []ListProfileMappings.
+func (r ApiListProfileMappingsRequest) ExecuteTyped() ([]ListProfileMappings, *APIResponse, error) {
+	return decodeResponse[[]ListProfileMappings](r.Execute())
+}
+
 /*
 ListProfileMappings List all Profile Mappings
 
@@ -322,7 +333,7 @@ func (a *ProfileMappingAPIService) ListProfileMappingsExecute(r ApiListProfileMa
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ProfileMappingAPIService.ListProfileMappings")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/mappings"
@@ -381,7 +392,7 @@ func (a *ProfileMappingAPIService) ListProfileMappingsExecute(r ApiListProfileMa
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -396,6 +407,7 @@ func (a *ProfileMappingAPIService) ListProfileMappingsExecute(r ApiListProfileMa
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -450,6 +462,11 @@ func (r ApiUpdateProfileMappingRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.UpdateProfileMappingExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ProfileMapping.
+func (r ApiUpdateProfileMappingRequest) ExecuteTyped() (ProfileMapping, *APIResponse, error) {
+	return decodeResponse[ProfileMapping](r.Execute())
+}
+
 /*
 UpdateProfileMapping Update a Profile Mapping
 
@@ -489,7 +506,7 @@ func (a *ProfileMappingAPIService) UpdateProfileMappingExecute(r ApiUpdateProfil
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ProfileMappingAPIService.UpdateProfileMapping")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/mappings/{mappingId}"
@@ -540,7 +557,7 @@ func (a *ProfileMappingAPIService) UpdateProfileMappingExecute(r ApiUpdateProfil
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -555,6 +572,7 @@ func (a *ProfileMappingAPIService) UpdateProfileMappingExecute(r ApiUpdateProfil
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
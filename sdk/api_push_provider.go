@@ -121,6 +121,11 @@ func (r ApiCreatePushProviderRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.CreatePushProviderExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ListPushProviders200ResponseInner.
+func (r ApiCreatePushProviderRequest) ExecuteTyped() (ListPushProviders200ResponseInner, *APIResponse, error) {
+	return decodeResponse[ListPushProviders200ResponseInner](r.Execute())
+}
+
 /*
 CreatePushProvider Create a Push Provider
 
@@ -158,7 +163,7 @@ func (a *PushProviderAPIService) CreatePushProviderExecute(r ApiCreatePushProvid
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "PushProviderAPIService.CreatePushProvider")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/push-providers"
@@ -208,7 +213,7 @@ func (a *PushProviderAPIService) CreatePushProviderExecute(r ApiCreatePushProvid
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -223,6 +228,7 @@ func (a *PushProviderAPIService) CreatePushProviderExecute(r ApiCreatePushProvid
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -321,7 +327,7 @@ func (a *PushProviderAPIService) DeletePushProviderExecute(r ApiDeletePushProvid
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "PushProviderAPIService.DeletePushProvider")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/push-providers/{pushProviderId}"
@@ -369,7 +375,7 @@ func (a *PushProviderAPIService) DeletePushProviderExecute(r ApiDeletePushProvid
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -384,6 +390,7 @@ func (a *PushProviderAPIService) DeletePushProviderExecute(r ApiDeletePushProvid
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -456,6 +463,11 @@ func (r ApiGetPushProviderRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetPushProviderExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ListPushProviders200ResponseInner.
+func (r ApiGetPushProviderRequest) ExecuteTyped() (ListPushProviders200ResponseInner, *APIResponse, error) {
+	return decodeResponse[ListPushProviders200ResponseInner](r.Execute())
+}
+
 /*
 GetPushProvider Retrieve a Push Provider
 
@@ -495,7 +507,7 @@ func (a *PushProviderAPIService) GetPushProviderExecute(r ApiGetPushProviderRequ
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "PushProviderAPIService.GetPushProvider")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/push-providers/{pushProviderId}"
@@ -543,7 +555,7 @@ func (a *PushProviderAPIService) GetPushProviderExecute(r ApiGetPushProviderRequ
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -558,6 +570,7 @@ func (a *PushProviderAPIService) GetPushProviderExecute(r ApiGetPushProviderRequ
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -624,6 +637,11 @@ func (r ApiListPushProvidersRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListPushProvidersExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []ListPushProviders200ResponseInner.
+func (r ApiListPushProvidersRequest) ExecuteTyped() ([]ListPushProviders200ResponseInner, *APIResponse, error) {
+	return decodeResponse[[]ListPushProviders200ResponseInner](r.Execute())
+}
+
 /*
 ListPushProviders List all Push Providers
 
@@ -661,7 +679,7 @@ func (a *PushProviderAPIService) ListPushProvidersExecute(r ApiListPushProviders
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "PushProviderAPIService.ListPushProviders")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/push-providers"
@@ -711,7 +729,7 @@ func (a *PushProviderAPIService) ListPushProvidersExecute(r ApiListPushProviders
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -726,6 +744,7 @@ func (a *PushProviderAPIService) ListPushProvidersExecute(r ApiListPushProviders
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -780,6 +799,11 @@ func (r ApiReplacePushProviderRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ReplacePushProviderExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ListPushProviders200ResponseInner.
+func (r ApiReplacePushProviderRequest) ExecuteTyped() (ListPushProviders200ResponseInner, *APIResponse, error) {
+	return decodeResponse[ListPushProviders200ResponseInner](r.Execute())
+}
+
 /*
 ReplacePushProvider Replace a Push Provider
 
@@ -819,7 +843,7 @@ func (a *PushProviderAPIService) ReplacePushProviderExecute(r ApiReplacePushProv
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "PushProviderAPIService.ReplacePushProvider")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/push-providers/{pushProviderId}"
@@ -870,7 +894,7 @@ func (a *PushProviderAPIService) ReplacePushProviderExecute(r ApiReplacePushProv
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -885,6 +909,7 @@ func (a *PushProviderAPIService) ReplacePushProviderExecute(r ApiReplacePushProv
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -126,6 +126,11 @@ func (r ApiGetRateLimitSettingsAdminNotificationsRequest) Execute() (*APIRespons
 	return r.ApiService.GetRateLimitSettingsAdminNotificationsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into RateLimitAdminNotifications.
+func (r ApiGetRateLimitSettingsAdminNotificationsRequest) ExecuteTyped() (RateLimitAdminNotifications, *APIResponse, error) {
+	return decodeResponse[RateLimitAdminNotifications](r.Execute())
+}
+
 /*
 GetRateLimitSettingsAdminNotifications Retrieve the Rate Limit Admin Notification Settings
 
@@ -163,7 +168,7 @@ func (a *RateLimitSettingsAPIService) GetRateLimitSettingsAdminNotificationsExec
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RateLimitSettingsAPIService.GetRateLimitSettingsAdminNotifications")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/rate-limit-settings/admin-notifications"
@@ -210,7 +215,7 @@ func (a *RateLimitSettingsAPIService) GetRateLimitSettingsAdminNotificationsExec
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -225,6 +230,7 @@ func (a *RateLimitSettingsAPIService) GetRateLimitSettingsAdminNotificationsExec
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -272,6 +278,11 @@ func (r ApiGetRateLimitSettingsPerClientRequest) Execute() (*APIResponse, error)
 	return r.ApiService.GetRateLimitSettingsPerClientExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into PerClientRateLimitSettings.
+func (r ApiGetRateLimitSettingsPerClientRequest) ExecuteTyped() (PerClientRateLimitSettings, *APIResponse, error) {
+	return decodeResponse[PerClientRateLimitSettings](r.Execute())
+}
+
 /*
 GetRateLimitSettingsPerClient Retrieve the Per-Client Rate Limit Settings
 
@@ -309,7 +320,7 @@ func (a *RateLimitSettingsAPIService) GetRateLimitSettingsPerClientExecute(r Api
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RateLimitSettingsAPIService.GetRateLimitSettingsPerClient")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/rate-limit-settings/per-client"
@@ -356,7 +367,7 @@ func (a *RateLimitSettingsAPIService) GetRateLimitSettingsPerClientExecute(r Api
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -371,6 +382,7 @@ func (a *RateLimitSettingsAPIService) GetRateLimitSettingsPerClientExecute(r Api
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -418,6 +430,11 @@ func (r ApiGetRateLimitSettingsWarningThresholdRequest) Execute() (*APIResponse,
 	return r.ApiService.GetRateLimitSettingsWarningThresholdExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into RateLimitWarningThresholdResponse.
+func (r ApiGetRateLimitSettingsWarningThresholdRequest) ExecuteTyped() (RateLimitWarningThresholdResponse, *APIResponse, error) {
+	return decodeResponse[RateLimitWarningThresholdResponse](r.Execute())
+}
+
 /*
 GetRateLimitSettingsWarningThreshold Retrieve the Rate Limit Warning Threshold Percentage
 
@@ -455,7 +472,7 @@ func (a *RateLimitSettingsAPIService) GetRateLimitSettingsWarningThresholdExecut
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RateLimitSettingsAPIService.GetRateLimitSettingsWarningThreshold")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/rate-limit-settings/warning-threshold"
@@ -502,7 +519,7 @@ func (a *RateLimitSettingsAPIService) GetRateLimitSettingsWarningThresholdExecut
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -517,6 +534,7 @@ func (a *RateLimitSettingsAPIService) GetRateLimitSettingsWarningThresholdExecut
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -570,6 +588,11 @@ func (r ApiReplaceRateLimitSettingsAdminNotificationsRequest) Execute() (*APIRes
 	return r.ApiService.ReplaceRateLimitSettingsAdminNotificationsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into RateLimitAdminNotifications.
+func (r ApiReplaceRateLimitSettingsAdminNotificationsRequest) ExecuteTyped() (RateLimitAdminNotifications, *APIResponse, error) {
+	return decodeResponse[RateLimitAdminNotifications](r.Execute())
+}
+
 /*
 ReplaceRateLimitSettingsAdminNotifications Replace the Rate Limit Admin Notification Settings
 
@@ -607,7 +630,7 @@ func (a *RateLimitSettingsAPIService) ReplaceRateLimitSettingsAdminNotifications
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RateLimitSettingsAPIService.ReplaceRateLimitSettingsAdminNotifications")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/rate-limit-settings/admin-notifications"
@@ -657,7 +680,7 @@ func (a *RateLimitSettingsAPIService) ReplaceRateLimitSettingsAdminNotifications
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -672,6 +695,7 @@ func (a *RateLimitSettingsAPIService) ReplaceRateLimitSettingsAdminNotifications
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -737,6 +761,11 @@ func (r ApiReplaceRateLimitSettingsPerClientRequest) Execute() (*APIResponse, er
 	return r.ApiService.ReplaceRateLimitSettingsPerClientExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into PerClientRateLimitSettings.
+func (r ApiReplaceRateLimitSettingsPerClientRequest) ExecuteTyped() (PerClientRateLimitSettings, *APIResponse, error) {
+	return decodeResponse[PerClientRateLimitSettings](r.Execute())
+}
+
 /*
 ReplaceRateLimitSettingsPerClient Replace the Per-Client Rate Limit Settings
 
@@ -774,7 +803,7 @@ func (a *RateLimitSettingsAPIService) ReplaceRateLimitSettingsPerClientExecute(r
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RateLimitSettingsAPIService.ReplaceRateLimitSettingsPerClient")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/rate-limit-settings/per-client"
@@ -824,7 +853,7 @@ func (a *RateLimitSettingsAPIService) ReplaceRateLimitSettingsPerClientExecute(r
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -839,6 +868,7 @@ func (a *RateLimitSettingsAPIService) ReplaceRateLimitSettingsPerClientExecute(r
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -904,6 +934,11 @@ func (r ApiReplaceRateLimitSettingsWarningThresholdRequest) Execute() (*APIRespo
 	return r.ApiService.ReplaceRateLimitSettingsWarningThresholdExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into RateLimitWarningThresholdResponse.
+func (r ApiReplaceRateLimitSettingsWarningThresholdRequest) ExecuteTyped() (RateLimitWarningThresholdResponse, *APIResponse, error) {
+	return decodeResponse[RateLimitWarningThresholdResponse](r.Execute())
+}
+
 /*
 ReplaceRateLimitSettingsWarningThreshold Replace the Rate Limit Warning Threshold Percentage
 
@@ -941,7 +976,7 @@ func (a *RateLimitSettingsAPIService) ReplaceRateLimitSettingsWarningThresholdEx
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RateLimitSettingsAPIService.ReplaceRateLimitSettingsWarningThreshold")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/rate-limit-settings/warning-threshold"
@@ -991,7 +1026,7 @@ func (a *RateLimitSettingsAPIService) ReplaceRateLimitSettingsWarningThresholdEx
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1006,6 +1041,7 @@ func (a *RateLimitSettingsAPIService) ReplaceRateLimitSettingsWarningThresholdEx
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -121,6 +121,11 @@ func (r ApiCreateRealmRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.CreateRealmExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into Realm.
+func (r ApiCreateRealmRequest) ExecuteTyped() (Realm, *APIResponse, error) {
+	return decodeResponse[Realm](r.Execute())
+}
+
 /*
 CreateRealm Create a Realm
 
@@ -158,7 +163,7 @@ func (a *RealmAPIService) CreateRealmExecute(r ApiCreateRealmRequest) (*APIRespo
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RealmAPIService.CreateRealm")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/realms"
@@ -208,7 +213,7 @@ func (a *RealmAPIService) CreateRealmExecute(r ApiCreateRealmRequest) (*APIRespo
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -223,6 +228,7 @@ func (a *RealmAPIService) CreateRealmExecute(r ApiCreateRealmRequest) (*APIRespo
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -321,7 +327,7 @@ func (a *RealmAPIService) DeleteRealmExecute(r ApiDeleteRealmRequest) (*APIRespo
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RealmAPIService.DeleteRealm")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/realms/{realmId}"
@@ -369,7 +375,7 @@ func (a *RealmAPIService) DeleteRealmExecute(r ApiDeleteRealmRequest) (*APIRespo
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -384,6 +390,7 @@ func (a *RealmAPIService) DeleteRealmExecute(r ApiDeleteRealmRequest) (*APIRespo
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -456,6 +463,11 @@ func (r ApiGetRealmRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetRealmExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into Realm.
+func (r ApiGetRealmRequest) ExecuteTyped() (Realm, *APIResponse, error) {
+	return decodeResponse[Realm](r.Execute())
+}
+
 /*
 GetRealm Retrieve a Realm
 
@@ -495,7 +507,7 @@ func (a *RealmAPIService) GetRealmExecute(r ApiGetRealmRequest) (*APIResponse, e
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RealmAPIService.GetRealm")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/realms/{realmId}"
@@ -543,7 +555,7 @@ func (a *RealmAPIService) GetRealmExecute(r ApiGetRealmRequest) (*APIResponse, e
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -558,6 +570,7 @@ func (a *RealmAPIService) GetRealmExecute(r ApiGetRealmRequest) (*APIResponse, e
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -664,6 +677,11 @@ func (r ApiListRealmsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListRealmsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []Realm.
+func (r ApiListRealmsRequest) ExecuteTyped() ([]Realm, *APIResponse, error) {
+	return decodeResponse[[]Realm](r.Execute())
+}
+
 /*
 ListRealms List all Realms
 
@@ -701,7 +719,7 @@ func (a *RealmAPIService) ListRealmsExecute(r ApiListRealmsRequest) (*APIRespons
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RealmAPIService.ListRealms")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/realms"
@@ -763,7 +781,7 @@ func (a *RealmAPIService) ListRealmsExecute(r ApiListRealmsRequest) (*APIRespons
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -778,6 +796,7 @@ func (a *RealmAPIService) ListRealmsExecute(r ApiListRealmsRequest) (*APIRespons
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -832,6 +851,11 @@ func (r ApiReplaceRealmRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ReplaceRealmExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into Realm.
+func (r ApiReplaceRealmRequest) ExecuteTyped() (Realm, *APIResponse, error) {
+	return decodeResponse[Realm](r.Execute())
+}
+
 /*
 ReplaceRealm Replace the realm profile
 
@@ -871,7 +895,7 @@ func (a *RealmAPIService) ReplaceRealmExecute(r ApiReplaceRealmRequest) (*APIRes
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RealmAPIService.ReplaceRealm")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/realms/{realmId}"
@@ -922,7 +946,7 @@ func (a *RealmAPIService) ReplaceRealmExecute(r ApiReplaceRealmRequest) (*APIRes
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -937,6 +961,7 @@ func (a *RealmAPIService) ReplaceRealmExecute(r ApiReplaceRealmRequest) (*APIRes
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -210,7 +210,7 @@ func (a *RealmAssignmentAPIService) ActivateRealmAssignmentExecute(r ApiActivate
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RealmAssignmentAPIService.ActivateRealmAssignment")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/realm-assignments/{assignmentId}/lifecycle/activate"
@@ -258,7 +258,7 @@ func (a *RealmAssignmentAPIService) ActivateRealmAssignmentExecute(r ApiActivate
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -273,6 +273,7 @@ func (a *RealmAssignmentAPIService) ActivateRealmAssignmentExecute(r ApiActivate
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -350,6 +351,11 @@ func (r ApiCreateRealmAssignmentRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.CreateRealmAssignmentExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into RealmAssignment.
+func (r ApiCreateRealmAssignmentRequest) ExecuteTyped() (RealmAssignment, *APIResponse, error) {
+	return decodeResponse[RealmAssignment](r.Execute())
+}
+
 /*
 CreateRealmAssignment Create a Realm Assignment
 
@@ -387,7 +393,7 @@ func (a *RealmAssignmentAPIService) CreateRealmAssignmentExecute(r ApiCreateReal
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RealmAssignmentAPIService.CreateRealmAssignment")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/realm-assignments"
@@ -437,7 +443,7 @@ func (a *RealmAssignmentAPIService) CreateRealmAssignmentExecute(r ApiCreateReal
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -452,6 +458,7 @@ func (a *RealmAssignmentAPIService) CreateRealmAssignmentExecute(r ApiCreateReal
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -550,7 +557,7 @@ func (a *RealmAssignmentAPIService) DeactivateRealmAssignmentExecute(r ApiDeacti
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RealmAssignmentAPIService.DeactivateRealmAssignment")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/realm-assignments/{assignmentId}/lifecycle/deactivate"
@@ -598,7 +605,7 @@ func (a *RealmAssignmentAPIService) DeactivateRealmAssignmentExecute(r ApiDeacti
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -613,6 +620,7 @@ func (a *RealmAssignmentAPIService) DeactivateRealmAssignmentExecute(r ApiDeacti
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -723,7 +731,7 @@ func (a *RealmAssignmentAPIService) DeleteRealmAssignmentExecute(r ApiDeleteReal
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RealmAssignmentAPIService.DeleteRealmAssignment")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/realm-assignments/{assignmentId}"
@@ -771,7 +779,7 @@ func (a *RealmAssignmentAPIService) DeleteRealmAssignmentExecute(r ApiDeleteReal
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -786,6 +794,7 @@ func (a *RealmAssignmentAPIService) DeleteRealmAssignmentExecute(r ApiDeleteReal
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -863,6 +872,11 @@ func (r ApiExecuteRealmAssignmentRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ExecuteRealmAssignmentExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into OperationResponse.
+func (r ApiExecuteRealmAssignmentRequest) ExecuteTyped() (OperationResponse, *APIResponse, error) {
+	return decodeResponse[OperationResponse](r.Execute())
+}
+
 /*
 ExecuteRealmAssignment Execute a Realm Assignment
 
@@ -900,7 +914,7 @@ func (a *RealmAssignmentAPIService) ExecuteRealmAssignmentExecute(r ApiExecuteRe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RealmAssignmentAPIService.ExecuteRealmAssignment")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/realm-assignments/operations"
@@ -950,7 +964,7 @@ func (a *RealmAssignmentAPIService) ExecuteRealmAssignmentExecute(r ApiExecuteRe
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -965,6 +979,7 @@ func (a *RealmAssignmentAPIService) ExecuteRealmAssignmentExecute(r ApiExecuteRe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1025,6 +1040,11 @@ func (r ApiGetRealmAssignmentRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetRealmAssignmentExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into RealmAssignment.
+func (r ApiGetRealmAssignmentRequest) ExecuteTyped() (RealmAssignment, *APIResponse, error) {
+	return decodeResponse[RealmAssignment](r.Execute())
+}
+
 /*
 GetRealmAssignment Retrieve a Realm Assignment
 
@@ -1064,7 +1084,7 @@ func (a *RealmAssignmentAPIService) GetRealmAssignmentExecute(r ApiGetRealmAssig
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RealmAssignmentAPIService.GetRealmAssignment")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/realm-assignments/{assignmentId}"
@@ -1112,7 +1132,7 @@ func (a *RealmAssignmentAPIService) GetRealmAssignmentExecute(r ApiGetRealmAssig
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1127,6 +1147,7 @@ func (a *RealmAssignmentAPIService) GetRealmAssignmentExecute(r ApiGetRealmAssig
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1212,6 +1233,11 @@ func (r ApiListRealmAssignmentOperationsRequest) Execute() (*APIResponse, error)
 	return r.ApiService.ListRealmAssignmentOperationsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []OperationResponse.
+func (r ApiListRealmAssignmentOperationsRequest) ExecuteTyped() ([]OperationResponse, *APIResponse, error) {
+	return decodeResponse[[]OperationResponse](r.Execute())
+}
+
 /*
 ListRealmAssignmentOperations List all Realm Assignment operations
 
@@ -1249,7 +1275,7 @@ func (a *RealmAssignmentAPIService) ListRealmAssignmentOperationsExecute(r ApiLi
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RealmAssignmentAPIService.ListRealmAssignmentOperations")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/realm-assignments/operations"
@@ -1302,7 +1328,7 @@ func (a *RealmAssignmentAPIService) ListRealmAssignmentOperationsExecute(r ApiLi
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1317,6 +1343,7 @@ func (a *RealmAssignmentAPIService) ListRealmAssignmentOperationsExecute(r ApiLi
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1378,6 +1405,11 @@ func (r ApiListRealmAssignmentsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListRealmAssignmentsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []RealmAssignment.
+func (r ApiListRealmAssignmentsRequest) ExecuteTyped() ([]RealmAssignment, *APIResponse, error) {
+	return decodeResponse[[]RealmAssignment](r.Execute())
+}
+
 /*
 ListRealmAssignments List all Realm Assignments
 
@@ -1415,7 +1447,7 @@ func (a *RealmAssignmentAPIService) ListRealmAssignmentsExecute(r ApiListRealmAs
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RealmAssignmentAPIService.ListRealmAssignments")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/realm-assignments"
@@ -1468,7 +1500,7 @@ func (a *RealmAssignmentAPIService) ListRealmAssignmentsExecute(r ApiListRealmAs
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1483,6 +1515,7 @@ func (a *RealmAssignmentAPIService) ListRealmAssignmentsExecute(r ApiListRealmAs
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1537,6 +1570,11 @@ func (r ApiReplaceRealmAssignmentRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ReplaceRealmAssignmentExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into RealmAssignment.
+func (r ApiReplaceRealmAssignmentRequest) ExecuteTyped() (RealmAssignment, *APIResponse, error) {
+	return decodeResponse[RealmAssignment](r.Execute())
+}
+
 /*
 ReplaceRealmAssignment Replace a Realm Assignment
 
@@ -1576,7 +1614,7 @@ func (a *RealmAssignmentAPIService) ReplaceRealmAssignmentExecute(r ApiReplaceRe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RealmAssignmentAPIService.ReplaceRealmAssignment")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/realm-assignments/{assignmentId}"
@@ -1627,7 +1665,7 @@ func (a *RealmAssignmentAPIService) ReplaceRealmAssignmentExecute(r ApiReplaceRe
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1642,6 +1680,7 @@ func (a *RealmAssignmentAPIService) ReplaceRealmAssignmentExecute(r ApiReplaceRe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -129,6 +129,11 @@ func (r ApiCreateResourceSelectorRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.CreateResourceSelectorExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ResourceSelectorResponseSchema.
+func (r ApiCreateResourceSelectorRequest) ExecuteTyped() (ResourceSelectorResponseSchema, *APIResponse, error) {
+	return decodeResponse[ResourceSelectorResponseSchema](r.Execute())
+}
+
 /*
 CreateResourceSelector Create a Resource Selector
 
@@ -169,7 +174,7 @@ func (a *ResourceSelectorsAPIService) CreateResourceSelectorExecute(r ApiCreateR
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ResourceSelectorsAPIService.CreateResourceSelector")
 	if err != nil {
 		// TODU
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/resource-selectors"
@@ -222,7 +227,7 @@ func (a *ResourceSelectorsAPIService) CreateResourceSelectorExecute(r ApiCreateR
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
 		// TODU
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -238,6 +243,7 @@ func (a *ResourceSelectorsAPIService) CreateResourceSelectorExecute(r ApiCreateR
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -347,7 +353,7 @@ func (a *ResourceSelectorsAPIService) DeleteResourceSelectorExecute(r ApiDeleteR
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ResourceSelectorsAPIService.DeleteResourceSelector")
 	if err != nil {
 		// TODU
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/resource-selectors/{resourceSelectorId}"
@@ -398,7 +404,7 @@ func (a *ResourceSelectorsAPIService) DeleteResourceSelectorExecute(r ApiDeleteR
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
 		// TODU
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -414,6 +420,7 @@ func (a *ResourceSelectorsAPIService) DeleteResourceSelectorExecute(r ApiDeleteR
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -483,6 +490,11 @@ func (r ApiGetResourceSelectorRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetResourceSelectorExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ResourceSelectorResponseSchema.
+func (r ApiGetResourceSelectorRequest) ExecuteTyped() (ResourceSelectorResponseSchema, *APIResponse, error) {
+	return decodeResponse[ResourceSelectorResponseSchema](r.Execute())
+}
+
 /*
 GetResourceSelector Retrieve a Resource Selector
 
@@ -525,7 +537,7 @@ func (a *ResourceSelectorsAPIService) GetResourceSelectorExecute(r ApiGetResourc
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ResourceSelectorsAPIService.GetResourceSelector")
 	if err != nil {
 		// TODU
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/resource-selectors/{resourceSelectorId}"
@@ -576,7 +588,7 @@ func (a *ResourceSelectorsAPIService) GetResourceSelectorExecute(r ApiGetResourc
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
 		// TODU
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -592,6 +604,7 @@ func (a *ResourceSelectorsAPIService) GetResourceSelectorExecute(r ApiGetResourc
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -660,6 +673,11 @@ func (r ApiListResourceSelectorsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListResourceSelectorsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ResourceSelectorsSchema.
+func (r ApiListResourceSelectorsRequest) ExecuteTyped() (ResourceSelectorsSchema, *APIResponse, error) {
+	return decodeResponse[ResourceSelectorsSchema](r.Execute())
+}
+
 /*
 ListResourceSelectors List all Resource Selectors
 
@@ -700,7 +718,7 @@ func (a *ResourceSelectorsAPIService) ListResourceSelectorsExecute(r ApiListReso
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ResourceSelectorsAPIService.ListResourceSelectors")
 	if err != nil {
 		// TODU
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/resource-selectors"
@@ -756,7 +774,7 @@ func (a *ResourceSelectorsAPIService) ListResourceSelectorsExecute(r ApiListReso
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
 		// TODU
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -772,6 +790,7 @@ func (a *ResourceSelectorsAPIService) ListResourceSelectorsExecute(r ApiListReso
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -833,6 +852,11 @@ func (r ApiUpdateResourceSelectorRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.UpdateResourceSelectorExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ResourceSelectorResponseSchema.
+func (r ApiUpdateResourceSelectorRequest) ExecuteTyped() (ResourceSelectorResponseSchema, *APIResponse, error) {
+	return decodeResponse[ResourceSelectorResponseSchema](r.Execute())
+}
+
 /*
 UpdateResourceSelector Update a Resource Selector
 
@@ -875,7 +899,7 @@ func (a *ResourceSelectorsAPIService) UpdateResourceSelectorExecute(r ApiUpdateR
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ResourceSelectorsAPIService.UpdateResourceSelector")
 	if err != nil {
 		// TODU
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/resource-selectors/{resourceSelectorId}"
@@ -929,7 +953,7 @@ func (a *ResourceSelectorsAPIService) UpdateResourceSelectorExecute(r ApiUpdateR
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
 		// TODU
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -945,6 +969,7 @@ func (a *ResourceSelectorsAPIService) UpdateResourceSelectorExecute(r ApiUpdateR
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -294,6 +294,11 @@ func (r ApiAddMembersToBindingRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.AddMembersToBindingExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ResourceSetBindingResponse.
+func (r ApiAddMembersToBindingRequest) ExecuteTyped() (ResourceSetBindingResponse, *APIResponse, error) {
+	return decodeResponse[ResourceSetBindingResponse](r.Execute())
+}
+
 /*
 AddMembersToBinding Add more Members to a binding
 
@@ -335,7 +340,7 @@ func (a *ResourceSetAPIService) AddMembersToBindingExecute(r ApiAddMembersToBind
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ResourceSetAPIService.AddMembersToBinding")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/resource-sets/{resourceSetId}/bindings/{roleIdOrLabel}/members"
@@ -387,7 +392,7 @@ func (a *ResourceSetAPIService) AddMembersToBindingExecute(r ApiAddMembersToBind
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -402,6 +407,7 @@ func (a *ResourceSetAPIService) AddMembersToBindingExecute(r ApiAddMembersToBind
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -480,6 +486,11 @@ func (r ApiAddResourceSetResourceRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.AddResourceSetResourceExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ResourceSet.
+func (r ApiAddResourceSetResourceRequest) ExecuteTyped() (ResourceSet, *APIResponse, error) {
+	return decodeResponse[ResourceSet](r.Execute())
+}
+
 /*
 AddResourceSetResource Add more Resource to a Resource Set
 
@@ -519,7 +530,7 @@ func (a *ResourceSetAPIService) AddResourceSetResourceExecute(r ApiAddResourceSe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ResourceSetAPIService.AddResourceSetResource")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/resource-sets/{resourceSetId}/resources"
@@ -570,7 +581,7 @@ func (a *ResourceSetAPIService) AddResourceSetResourceExecute(r ApiAddResourceSe
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -585,6 +596,7 @@ func (a *ResourceSetAPIService) AddResourceSetResourceExecute(r ApiAddResourceSe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -662,6 +674,11 @@ func (r ApiCreateResourceSetRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.CreateResourceSetExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ResourceSet.
+func (r ApiCreateResourceSetRequest) ExecuteTyped() (ResourceSet, *APIResponse, error) {
+	return decodeResponse[ResourceSet](r.Execute())
+}
+
 /*
 CreateResourceSet Create a Resource Set
 
@@ -699,7 +716,7 @@ func (a *ResourceSetAPIService) CreateResourceSetExecute(r ApiCreateResourceSetR
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ResourceSetAPIService.CreateResourceSet")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/resource-sets"
@@ -749,7 +766,7 @@ func (a *ResourceSetAPIService) CreateResourceSetExecute(r ApiCreateResourceSetR
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -764,6 +781,7 @@ func (a *ResourceSetAPIService) CreateResourceSetExecute(r ApiCreateResourceSetR
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -830,6 +848,11 @@ func (r ApiCreateResourceSetBindingRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.CreateResourceSetBindingExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ResourceSetBindingResponse.
+func (r ApiCreateResourceSetBindingRequest) ExecuteTyped() (ResourceSetBindingResponse, *APIResponse, error) {
+	return decodeResponse[ResourceSetBindingResponse](r.Execute())
+}
+
 /*
 CreateResourceSetBinding Create a Resource Set Binding
 
@@ -869,7 +892,7 @@ func (a *ResourceSetAPIService) CreateResourceSetBindingExecute(r ApiCreateResou
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ResourceSetAPIService.CreateResourceSetBinding")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/resource-sets/{resourceSetId}/bindings"
@@ -920,7 +943,7 @@ func (a *ResourceSetAPIService) CreateResourceSetBindingExecute(r ApiCreateResou
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -935,6 +958,7 @@ func (a *ResourceSetAPIService) CreateResourceSetBindingExecute(r ApiCreateResou
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1048,7 +1072,7 @@ func (a *ResourceSetAPIService) DeleteBindingExecute(r ApiDeleteBindingRequest)
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ResourceSetAPIService.DeleteBinding")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/resource-sets/{resourceSetId}/bindings/{roleIdOrLabel}"
@@ -1097,7 +1121,7 @@ func (a *ResourceSetAPIService) DeleteBindingExecute(r ApiDeleteBindingRequest)
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1112,6 +1136,7 @@ func (a *ResourceSetAPIService) DeleteBindingExecute(r ApiDeleteBindingRequest)
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1210,7 +1235,7 @@ func (a *ResourceSetAPIService) DeleteResourceSetExecute(r ApiDeleteResourceSetR
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ResourceSetAPIService.DeleteResourceSet")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/resource-sets/{resourceSetId}"
@@ -1258,7 +1283,7 @@ func (a *ResourceSetAPIService) DeleteResourceSetExecute(r ApiDeleteResourceSetR
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1273,6 +1298,7 @@ func (a *ResourceSetAPIService) DeleteResourceSetExecute(r ApiDeleteResourceSetR
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1374,7 +1400,7 @@ func (a *ResourceSetAPIService) DeleteResourceSetResourceExecute(r ApiDeleteReso
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ResourceSetAPIService.DeleteResourceSetResource")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/resource-sets/{resourceSetId}/resources/{resourceId}"
@@ -1423,7 +1449,7 @@ func (a *ResourceSetAPIService) DeleteResourceSetResourceExecute(r ApiDeleteReso
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1438,6 +1464,7 @@ func (a *ResourceSetAPIService) DeleteResourceSetResourceExecute(r ApiDeleteReso
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1499,6 +1526,11 @@ func (r ApiGetBindingRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetBindingExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ResourceSetBindingResponse.
+func (r ApiGetBindingRequest) ExecuteTyped() (ResourceSetBindingResponse, *APIResponse, error) {
+	return decodeResponse[ResourceSetBindingResponse](r.Execute())
+}
+
 /*
 GetBinding Retrieve a Binding
 
@@ -1540,7 +1572,7 @@ func (a *ResourceSetAPIService) GetBindingExecute(r ApiGetBindingRequest) (*APIR
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ResourceSetAPIService.GetBinding")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/resource-sets/{resourceSetId}/bindings/{roleIdOrLabel}"
@@ -1589,7 +1621,7 @@ func (a *ResourceSetAPIService) GetBindingExecute(r ApiGetBindingRequest) (*APIR
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1604,6 +1636,7 @@ func (a *ResourceSetAPIService) GetBindingExecute(r ApiGetBindingRequest) (*APIR
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1666,6 +1699,11 @@ func (r ApiGetMemberOfBindingRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetMemberOfBindingExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ResourceSetBindingMember.
+func (r ApiGetMemberOfBindingRequest) ExecuteTyped() (ResourceSetBindingMember, *APIResponse, error) {
+	return decodeResponse[ResourceSetBindingMember](r.Execute())
+}
+
 /*
 GetMemberOfBinding Retrieve a Member of a binding
 
@@ -1709,7 +1747,7 @@ func (a *ResourceSetAPIService) GetMemberOfBindingExecute(r ApiGetMemberOfBindin
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ResourceSetAPIService.GetMemberOfBinding")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/resource-sets/{resourceSetId}/bindings/{roleIdOrLabel}/members/{memberId}"
@@ -1759,7 +1797,7 @@ func (a *ResourceSetAPIService) GetMemberOfBindingExecute(r ApiGetMemberOfBindin
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1774,6 +1812,7 @@ func (a *ResourceSetAPIService) GetMemberOfBindingExecute(r ApiGetMemberOfBindin
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1834,6 +1873,11 @@ func (r ApiGetResourceSetRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetResourceSetExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ResourceSet.
+func (r ApiGetResourceSetRequest) ExecuteTyped() (ResourceSet, *APIResponse, error) {
+	return decodeResponse[ResourceSet](r.Execute())
+}
+
 /*
 GetResourceSet Retrieve a Resource Set
 
@@ -1873,7 +1917,7 @@ func (a *ResourceSetAPIService) GetResourceSetExecute(r ApiGetResourceSetRequest
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ResourceSetAPIService.GetResourceSet")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/resource-sets/{resourceSetId}"
@@ -1921,7 +1965,7 @@ func (a *ResourceSetAPIService) GetResourceSetExecute(r ApiGetResourceSetRequest
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1936,6 +1980,7 @@ func (a *ResourceSetAPIService) GetResourceSetExecute(r ApiGetResourceSetRequest
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2003,6 +2048,11 @@ func (r ApiListBindingsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListBindingsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ResourceSetBindings.
+func (r ApiListBindingsRequest) ExecuteTyped() (ResourceSetBindings, *APIResponse, error) {
+	return decodeResponse[ResourceSetBindings](r.Execute())
+}
+
 /*
 ListBindings List all Bindings
 
@@ -2042,7 +2092,7 @@ func (a *ResourceSetAPIService) ListBindingsExecute(r ApiListBindingsRequest) (*
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ResourceSetAPIService.ListBindings")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/resource-sets/{resourceSetId}/bindings"
@@ -2093,7 +2143,7 @@ func (a *ResourceSetAPIService) ListBindingsExecute(r ApiListBindingsRequest) (*
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2108,6 +2158,7 @@ func (a *ResourceSetAPIService) ListBindingsExecute(r ApiListBindingsRequest) (*
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2176,6 +2227,11 @@ func (r ApiListMembersOfBindingRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListMembersOfBindingExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ResourceSetBindingMembers.
+func (r ApiListMembersOfBindingRequest) ExecuteTyped() (ResourceSetBindingMembers, *APIResponse, error) {
+	return decodeResponse[ResourceSetBindingMembers](r.Execute())
+}
+
 /*
 ListMembersOfBinding List all Members of a binding
 
@@ -2217,7 +2273,7 @@ func (a *ResourceSetAPIService) ListMembersOfBindingExecute(r ApiListMembersOfBi
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ResourceSetAPIService.ListMembersOfBinding")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/resource-sets/{resourceSetId}/bindings/{roleIdOrLabel}/members"
@@ -2269,7 +2325,7 @@ func (a *ResourceSetAPIService) ListMembersOfBindingExecute(r ApiListMembersOfBi
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2284,6 +2340,7 @@ func (a *ResourceSetAPIService) ListMembersOfBindingExecute(r ApiListMembersOfBi
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2344,6 +2401,11 @@ func (r ApiListResourceSetResourcesRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListResourceSetResourcesExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ResourceSetResources.
+func (r ApiListResourceSetResourcesRequest) ExecuteTyped() (ResourceSetResources, *APIResponse, error) {
+	return decodeResponse[ResourceSetResources](r.Execute())
+}
+
 /*
 ListResourceSetResources List all Resources of a Resource Set
 
@@ -2383,7 +2445,7 @@ func (a *ResourceSetAPIService) ListResourceSetResourcesExecute(r ApiListResourc
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ResourceSetAPIService.ListResourceSetResources")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/resource-sets/{resourceSetId}/resources"
@@ -2431,7 +2493,7 @@ func (a *ResourceSetAPIService) ListResourceSetResourcesExecute(r ApiListResourc
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2446,6 +2508,7 @@ func (a *ResourceSetAPIService) ListResourceSetResourcesExecute(r ApiListResourc
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2512,6 +2575,11 @@ func (r ApiListResourceSetsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListResourceSetsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ResourceSets.
+func (r ApiListResourceSetsRequest) ExecuteTyped() (ResourceSets, *APIResponse, error) {
+	return decodeResponse[ResourceSets](r.Execute())
+}
+
 /*
 ListResourceSets List all Resource Sets
 
@@ -2549,7 +2617,7 @@ func (a *ResourceSetAPIService) ListResourceSetsExecute(r ApiListResourceSetsReq
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ResourceSetAPIService.ListResourceSets")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/resource-sets"
@@ -2599,7 +2667,7 @@ func (a *ResourceSetAPIService) ListResourceSetsExecute(r ApiListResourceSetsReq
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2614,6 +2682,7 @@ func (a *ResourceSetAPIService) ListResourceSetsExecute(r ApiListResourceSetsReq
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2668,6 +2737,11 @@ func (r ApiReplaceResourceSetRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ReplaceResourceSetExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ResourceSet.
+func (r ApiReplaceResourceSetRequest) ExecuteTyped() (ResourceSet, *APIResponse, error) {
+	return decodeResponse[ResourceSet](r.Execute())
+}
+
 /*
 ReplaceResourceSet Replace a Resource Set
 
@@ -2707,7 +2781,7 @@ func (a *ResourceSetAPIService) ReplaceResourceSetExecute(r ApiReplaceResourceSe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ResourceSetAPIService.ReplaceResourceSet")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/resource-sets/{resourceSetId}"
@@ -2758,7 +2832,7 @@ func (a *ResourceSetAPIService) ReplaceResourceSetExecute(r ApiReplaceResourceSe
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2773,6 +2847,7 @@ func (a *ResourceSetAPIService) ReplaceResourceSetExecute(r ApiReplaceResourceSe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -2889,7 +2964,7 @@ func (a *ResourceSetAPIService) UnassignMemberFromBindingExecute(r ApiUnassignMe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ResourceSetAPIService.UnassignMemberFromBinding")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/resource-sets/{resourceSetId}/bindings/{roleIdOrLabel}/members/{memberId}"
@@ -2939,7 +3014,7 @@ func (a *ResourceSetAPIService) UnassignMemberFromBindingExecute(r ApiUnassignMe
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2954,6 +3029,7 @@ func (a *ResourceSetAPIService) UnassignMemberFromBindingExecute(r ApiUnassignMe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -198,6 +198,11 @@ func (r ApiCreateRoleRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.CreateRoleExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into IamRole.
+func (r ApiCreateRoleRequest) ExecuteTyped() (IamRole, *APIResponse, error) {
+	return decodeResponse[IamRole](r.Execute())
+}
+
 /*
 CreateRole Create a Role
 
@@ -235,7 +240,7 @@ func (a *RoleAPIService) CreateRoleExecute(r ApiCreateRoleRequest) (*APIResponse
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleAPIService.CreateRole")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/roles"
@@ -285,7 +290,7 @@ func (a *RoleAPIService) CreateRoleExecute(r ApiCreateRoleRequest) (*APIResponse
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -300,6 +305,7 @@ func (a *RoleAPIService) CreateRoleExecute(r ApiCreateRoleRequest) (*APIResponse
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -407,7 +413,7 @@ func (a *RoleAPIService) CreateRolePermissionExecute(r ApiCreateRolePermissionRe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleAPIService.CreateRolePermission")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/roles/{roleIdOrLabel}/permissions/{permissionType}"
@@ -459,7 +465,7 @@ func (a *RoleAPIService) CreateRolePermissionExecute(r ApiCreateRolePermissionRe
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -474,6 +480,7 @@ func (a *RoleAPIService) CreateRolePermissionExecute(r ApiCreateRolePermissionRe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -584,7 +591,7 @@ func (a *RoleAPIService) DeleteRoleExecute(r ApiDeleteRoleRequest) (*APIResponse
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleAPIService.DeleteRole")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/roles/{roleIdOrLabel}"
@@ -632,7 +639,7 @@ func (a *RoleAPIService) DeleteRoleExecute(r ApiDeleteRoleRequest) (*APIResponse
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -647,6 +654,7 @@ func (a *RoleAPIService) DeleteRoleExecute(r ApiDeleteRoleRequest) (*APIResponse
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -748,7 +756,7 @@ func (a *RoleAPIService) DeleteRolePermissionExecute(r ApiDeleteRolePermissionRe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleAPIService.DeleteRolePermission")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/roles/{roleIdOrLabel}/permissions/{permissionType}"
@@ -797,7 +805,7 @@ func (a *RoleAPIService) DeleteRolePermissionExecute(r ApiDeleteRolePermissionRe
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -812,6 +820,7 @@ func (a *RoleAPIService) DeleteRolePermissionExecute(r ApiDeleteRolePermissionRe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -872,6 +881,11 @@ func (r ApiGetRoleRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetRoleExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into IamRole.
+func (r ApiGetRoleRequest) ExecuteTyped() (IamRole, *APIResponse, error) {
+	return decodeResponse[IamRole](r.Execute())
+}
+
 /*
 GetRole Retrieve a Role
 
@@ -911,7 +925,7 @@ func (a *RoleAPIService) GetRoleExecute(r ApiGetRoleRequest) (*APIResponse, erro
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleAPIService.GetRole")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/roles/{roleIdOrLabel}"
@@ -959,7 +973,7 @@ func (a *RoleAPIService) GetRoleExecute(r ApiGetRoleRequest) (*APIResponse, erro
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -974,6 +988,7 @@ func (a *RoleAPIService) GetRoleExecute(r ApiGetRoleRequest) (*APIResponse, erro
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1035,6 +1050,11 @@ func (r ApiGetRolePermissionRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetRolePermissionExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into Permission.
+func (r ApiGetRolePermissionRequest) ExecuteTyped() (Permission, *APIResponse, error) {
+	return decodeResponse[Permission](r.Execute())
+}
+
 /*
 GetRolePermission Retrieve a Permission
 
@@ -1076,7 +1096,7 @@ func (a *RoleAPIService) GetRolePermissionExecute(r ApiGetRolePermissionRequest)
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleAPIService.GetRolePermission")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/roles/{roleIdOrLabel}/permissions/{permissionType}"
@@ -1125,7 +1145,7 @@ func (a *RoleAPIService) GetRolePermissionExecute(r ApiGetRolePermissionRequest)
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1140,6 +1160,7 @@ func (a *RoleAPIService) GetRolePermissionExecute(r ApiGetRolePermissionRequest)
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1200,6 +1221,11 @@ func (r ApiListRolePermissionsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListRolePermissionsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into Permissions.
+func (r ApiListRolePermissionsRequest) ExecuteTyped() (Permissions, *APIResponse, error) {
+	return decodeResponse[Permissions](r.Execute())
+}
+
 /*
 ListRolePermissions List all Permissions
 
@@ -1239,7 +1265,7 @@ func (a *RoleAPIService) ListRolePermissionsExecute(r ApiListRolePermissionsRequ
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleAPIService.ListRolePermissions")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/roles/{roleIdOrLabel}/permissions"
@@ -1287,7 +1313,7 @@ func (a *RoleAPIService) ListRolePermissionsExecute(r ApiListRolePermissionsRequ
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1302,6 +1328,7 @@ func (a *RoleAPIService) ListRolePermissionsExecute(r ApiListRolePermissionsRequ
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1368,6 +1395,11 @@ func (r ApiListRolesRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListRolesExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into IamRoles.
+func (r ApiListRolesRequest) ExecuteTyped() (IamRoles, *APIResponse, error) {
+	return decodeResponse[IamRoles](r.Execute())
+}
+
 /*
 ListRoles List all Roles
 
@@ -1405,7 +1437,7 @@ func (a *RoleAPIService) ListRolesExecute(r ApiListRolesRequest) (*APIResponse,
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleAPIService.ListRoles")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/roles"
@@ -1455,7 +1487,7 @@ func (a *RoleAPIService) ListRolesExecute(r ApiListRolesRequest) (*APIResponse,
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1470,6 +1502,7 @@ func (a *RoleAPIService) ListRolesExecute(r ApiListRolesRequest) (*APIResponse,
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1524,6 +1557,11 @@ func (r ApiReplaceRoleRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ReplaceRoleExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into IamRole.
+func (r ApiReplaceRoleRequest) ExecuteTyped() (IamRole, *APIResponse, error) {
+	return decodeResponse[IamRole](r.Execute())
+}
+
 /*
 ReplaceRole Replace a Role
 
@@ -1563,7 +1601,7 @@ func (a *RoleAPIService) ReplaceRoleExecute(r ApiReplaceRoleRequest) (*APIRespon
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleAPIService.ReplaceRole")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/roles/{roleIdOrLabel}"
@@ -1614,7 +1652,7 @@ func (a *RoleAPIService) ReplaceRoleExecute(r ApiReplaceRoleRequest) (*APIRespon
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1629,6 +1667,7 @@ func (a *RoleAPIService) ReplaceRoleExecute(r ApiReplaceRoleRequest) (*APIRespon
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1708,6 +1747,11 @@ func (r ApiReplaceRolePermissionRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ReplaceRolePermissionExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into Permission.
+func (r ApiReplaceRolePermissionRequest) ExecuteTyped() (Permission, *APIResponse, error) {
+	return decodeResponse[Permission](r.Execute())
+}
+
 /*
 ReplaceRolePermission Replace a Permission
 
@@ -1749,7 +1793,7 @@ func (a *RoleAPIService) ReplaceRolePermissionExecute(r ApiReplaceRolePermission
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleAPIService.ReplaceRolePermission")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/roles/{roleIdOrLabel}/permissions/{permissionType}"
@@ -1801,7 +1845,7 @@ func (a *RoleAPIService) ReplaceRolePermissionExecute(r ApiReplaceRolePermission
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1816,6 +1860,7 @@ func (a *RoleAPIService) ReplaceRolePermissionExecute(r ApiReplaceRolePermission
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -193,6 +193,11 @@ func (r ApiAssignRoleToGroupRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.AssignRoleToGroupExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into Role.
+func (r ApiAssignRoleToGroupRequest) ExecuteTyped() (Role, *APIResponse, error) {
+	return decodeResponse[Role](r.Execute())
+}
+
 /*
 AssignRoleToGroup Assign a Role to a Group
 
@@ -232,7 +237,7 @@ func (a *RoleAssignmentAPIService) AssignRoleToGroupExecute(r ApiAssignRoleToGro
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleAssignmentAPIService.AssignRoleToGroup")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/groups/{groupId}/roles"
@@ -286,7 +291,7 @@ func (a *RoleAssignmentAPIService) AssignRoleToGroupExecute(r ApiAssignRoleToGro
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -301,6 +306,7 @@ func (a *RoleAssignmentAPIService) AssignRoleToGroupExecute(r ApiAssignRoleToGro
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -386,6 +392,11 @@ func (r ApiAssignRoleToUserRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.AssignRoleToUserExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into Role.
+func (r ApiAssignRoleToUserRequest) ExecuteTyped() (Role, *APIResponse, error) {
+	return decodeResponse[Role](r.Execute())
+}
+
 /*
 AssignRoleToUser Assign a Role to a User
 
@@ -425,7 +436,7 @@ func (a *RoleAssignmentAPIService) AssignRoleToUserExecute(r ApiAssignRoleToUser
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleAssignmentAPIService.AssignRoleToUser")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/roles"
@@ -479,7 +490,7 @@ func (a *RoleAssignmentAPIService) AssignRoleToUserExecute(r ApiAssignRoleToUser
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -494,6 +505,7 @@ func (a *RoleAssignmentAPIService) AssignRoleToUserExecute(r ApiAssignRoleToUser
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -567,6 +579,11 @@ func (r ApiGetGroupAssignedRoleRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetGroupAssignedRoleExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into Role.
+func (r ApiGetGroupAssignedRoleRequest) ExecuteTyped() (Role, *APIResponse, error) {
+	return decodeResponse[Role](r.Execute())
+}
+
 /*
 GetGroupAssignedRole Retrieve a Role assigned to Group
 
@@ -608,7 +625,7 @@ func (a *RoleAssignmentAPIService) GetGroupAssignedRoleExecute(r ApiGetGroupAssi
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleAssignmentAPIService.GetGroupAssignedRole")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/groups/{groupId}/roles/{roleId}"
@@ -657,7 +674,7 @@ func (a *RoleAssignmentAPIService) GetGroupAssignedRoleExecute(r ApiGetGroupAssi
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -672,6 +689,7 @@ func (a *RoleAssignmentAPIService) GetGroupAssignedRoleExecute(r ApiGetGroupAssi
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -733,6 +751,11 @@ func (r ApiGetUserAssignedRoleRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetUserAssignedRoleExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into Role.
+func (r ApiGetUserAssignedRoleRequest) ExecuteTyped() (Role, *APIResponse, error) {
+	return decodeResponse[Role](r.Execute())
+}
+
 /*
 GetUserAssignedRole Retrieve a Role assigned to a User
 
@@ -774,7 +797,7 @@ func (a *RoleAssignmentAPIService) GetUserAssignedRoleExecute(r ApiGetUserAssign
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleAssignmentAPIService.GetUserAssignedRole")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/roles/{roleId}"
@@ -823,7 +846,7 @@ func (a *RoleAssignmentAPIService) GetUserAssignedRoleExecute(r ApiGetUserAssign
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -838,6 +861,7 @@ func (a *RoleAssignmentAPIService) GetUserAssignedRoleExecute(r ApiGetUserAssign
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -904,6 +928,11 @@ func (r ApiListAssignedRolesForUserRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListAssignedRolesForUserExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []Role.
+func (r ApiListAssignedRolesForUserRequest) ExecuteTyped() ([]Role, *APIResponse, error) {
+	return decodeResponse[[]Role](r.Execute())
+}
+
 /*
 ListAssignedRolesForUser List all Roles assigned to a User
 
@@ -943,7 +972,7 @@ func (a *RoleAssignmentAPIService) ListAssignedRolesForUserExecute(r ApiListAssi
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleAssignmentAPIService.ListAssignedRolesForUser")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/roles"
@@ -994,7 +1023,7 @@ func (a *RoleAssignmentAPIService) ListAssignedRolesForUserExecute(r ApiListAssi
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1009,6 +1038,7 @@ func (a *RoleAssignmentAPIService) ListAssignedRolesForUserExecute(r ApiListAssi
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1075,6 +1105,11 @@ func (r ApiListGroupAssignedRolesRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListGroupAssignedRolesExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []Role.
+func (r ApiListGroupAssignedRolesRequest) ExecuteTyped() ([]Role, *APIResponse, error) {
+	return decodeResponse[[]Role](r.Execute())
+}
+
 /*
 ListGroupAssignedRoles List all Assigned Roles of Group
 
@@ -1114,7 +1149,7 @@ func (a *RoleAssignmentAPIService) ListGroupAssignedRolesExecute(r ApiListGroupA
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleAssignmentAPIService.ListGroupAssignedRoles")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/groups/{groupId}/roles"
@@ -1165,7 +1200,7 @@ func (a *RoleAssignmentAPIService) ListGroupAssignedRolesExecute(r ApiListGroupA
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1180,6 +1215,7 @@ func (a *RoleAssignmentAPIService) ListGroupAssignedRolesExecute(r ApiListGroupA
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1252,6 +1288,11 @@ func (r ApiListUsersWithRoleAssignmentsRequest) Execute() (*APIResponse, error)
 	return r.ApiService.ListUsersWithRoleAssignmentsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into RoleAssignedUsers.
+func (r ApiListUsersWithRoleAssignmentsRequest) ExecuteTyped() (RoleAssignedUsers, *APIResponse, error) {
+	return decodeResponse[RoleAssignedUsers](r.Execute())
+}
+
 /*
 ListUsersWithRoleAssignments List all Users with Role Assignments
 
@@ -1289,7 +1330,7 @@ func (a *RoleAssignmentAPIService) ListUsersWithRoleAssignmentsExecute(r ApiList
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleAssignmentAPIService.ListUsersWithRoleAssignments")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/assignees/users"
@@ -1342,7 +1383,7 @@ func (a *RoleAssignmentAPIService) ListUsersWithRoleAssignmentsExecute(r ApiList
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1357,6 +1398,7 @@ func (a *RoleAssignmentAPIService) ListUsersWithRoleAssignmentsExecute(r ApiList
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1446,7 +1488,7 @@ func (a *RoleAssignmentAPIService) UnassignRoleFromGroupExecute(r ApiUnassignRol
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleAssignmentAPIService.UnassignRoleFromGroup")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/groups/{groupId}/roles/{roleId}"
@@ -1495,7 +1537,7 @@ func (a *RoleAssignmentAPIService) UnassignRoleFromGroupExecute(r ApiUnassignRol
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1510,6 +1552,7 @@ func (a *RoleAssignmentAPIService) UnassignRoleFromGroupExecute(r ApiUnassignRol
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1611,7 +1654,7 @@ func (a *RoleAssignmentAPIService) UnassignRoleFromUserExecute(r ApiUnassignRole
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleAssignmentAPIService.UnassignRoleFromUser")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/roles/{roleId}"
@@ -1660,7 +1703,7 @@ func (a *RoleAssignmentAPIService) UnassignRoleFromUserExecute(r ApiUnassignRole
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1675,6 +1718,7 @@ func (a *RoleAssignmentAPIService) UnassignRoleFromUserExecute(r ApiUnassignRole
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
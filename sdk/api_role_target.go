@@ -360,7 +360,7 @@ func (a *RoleTargetAPIService) AssignAllAppsAsTargetToRoleForUserExecute(r ApiAs
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleTargetAPIService.AssignAllAppsAsTargetToRoleForUser")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/roles/{roleId}/targets/catalog/apps"
@@ -409,7 +409,7 @@ func (a *RoleTargetAPIService) AssignAllAppsAsTargetToRoleForUserExecute(r ApiAs
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -424,6 +424,7 @@ func (a *RoleTargetAPIService) AssignAllAppsAsTargetToRoleForUserExecute(r ApiAs
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -531,7 +532,7 @@ func (a *RoleTargetAPIService) AssignAppInstanceTargetToAppAdminRoleForGroupExec
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleTargetAPIService.AssignAppInstanceTargetToAppAdminRoleForGroup")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/groups/{groupId}/roles/{roleId}/targets/catalog/apps/{appName}/{appId}"
@@ -582,7 +583,7 @@ func (a *RoleTargetAPIService) AssignAppInstanceTargetToAppAdminRoleForGroupExec
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -597,6 +598,7 @@ func (a *RoleTargetAPIService) AssignAppInstanceTargetToAppAdminRoleForGroupExec
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -704,7 +706,7 @@ func (a *RoleTargetAPIService) AssignAppInstanceTargetToAppAdminRoleForUserExecu
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleTargetAPIService.AssignAppInstanceTargetToAppAdminRoleForUser")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/roles/{roleId}/targets/catalog/apps/{appName}/{appId}"
@@ -755,7 +757,7 @@ func (a *RoleTargetAPIService) AssignAppInstanceTargetToAppAdminRoleForUserExecu
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -770,6 +772,7 @@ func (a *RoleTargetAPIService) AssignAppInstanceTargetToAppAdminRoleForUserExecu
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -874,7 +877,7 @@ func (a *RoleTargetAPIService) AssignAppTargetToAdminRoleForGroupExecute(r ApiAs
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleTargetAPIService.AssignAppTargetToAdminRoleForGroup")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/groups/{groupId}/roles/{roleId}/targets/catalog/apps/{appName}"
@@ -924,7 +927,7 @@ func (a *RoleTargetAPIService) AssignAppTargetToAdminRoleForGroupExecute(r ApiAs
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -939,6 +942,7 @@ func (a *RoleTargetAPIService) AssignAppTargetToAdminRoleForGroupExecute(r ApiAs
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1043,7 +1047,7 @@ func (a *RoleTargetAPIService) AssignAppTargetToAdminRoleForUserExecute(r ApiAss
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleTargetAPIService.AssignAppTargetToAdminRoleForUser")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/roles/{roleId}/targets/catalog/apps/{appName}"
@@ -1093,7 +1097,7 @@ func (a *RoleTargetAPIService) AssignAppTargetToAdminRoleForUserExecute(r ApiAss
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1108,6 +1112,7 @@ func (a *RoleTargetAPIService) AssignAppTargetToAdminRoleForUserExecute(r ApiAss
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1212,7 +1217,7 @@ func (a *RoleTargetAPIService) AssignGroupTargetToGroupAdminRoleExecute(r ApiAss
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleTargetAPIService.AssignGroupTargetToGroupAdminRole")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/groups/{groupId}/roles/{roleId}/targets/groups/{targetGroupId}"
@@ -1262,7 +1267,7 @@ func (a *RoleTargetAPIService) AssignGroupTargetToGroupAdminRoleExecute(r ApiAss
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1277,6 +1282,7 @@ func (a *RoleTargetAPIService) AssignGroupTargetToGroupAdminRoleExecute(r ApiAss
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1381,7 +1387,7 @@ func (a *RoleTargetAPIService) AssignGroupTargetToUserRoleExecute(r ApiAssignGro
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleTargetAPIService.AssignGroupTargetToUserRole")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/roles/{roleId}/targets/groups/{groupId}"
@@ -1431,7 +1437,7 @@ func (a *RoleTargetAPIService) AssignGroupTargetToUserRoleExecute(r ApiAssignGro
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1446,6 +1452,7 @@ func (a *RoleTargetAPIService) AssignGroupTargetToUserRoleExecute(r ApiAssignGro
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1519,6 +1526,11 @@ func (r ApiListApplicationTargetsForApplicationAdministratorRoleForGroupRequest)
 	return r.ApiService.ListApplicationTargetsForApplicationAdministratorRoleForGroupExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []CatalogApplication.
+func (r ApiListApplicationTargetsForApplicationAdministratorRoleForGroupRequest) ExecuteTyped() ([]CatalogApplication, *APIResponse, error) {
+	return decodeResponse[[]CatalogApplication](r.Execute())
+}
+
 /*
 ListApplicationTargetsForApplicationAdministratorRoleForGroup List all Application Targets for an Application Administrator Role
 
@@ -1560,7 +1572,7 @@ func (a *RoleTargetAPIService) ListApplicationTargetsForApplicationAdministrator
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleTargetAPIService.ListApplicationTargetsForApplicationAdministratorRoleForGroup")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/groups/{groupId}/roles/{roleId}/targets/catalog/apps"
@@ -1615,7 +1627,7 @@ func (a *RoleTargetAPIService) ListApplicationTargetsForApplicationAdministrator
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1630,6 +1642,7 @@ func (a *RoleTargetAPIService) ListApplicationTargetsForApplicationAdministrator
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1703,6 +1716,11 @@ func (r ApiListApplicationTargetsForApplicationAdministratorRoleForUserRequest)
 	return r.ApiService.ListApplicationTargetsForApplicationAdministratorRoleForUserExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []CatalogApplication.
+func (r ApiListApplicationTargetsForApplicationAdministratorRoleForUserRequest) ExecuteTyped() ([]CatalogApplication, *APIResponse, error) {
+	return decodeResponse[[]CatalogApplication](r.Execute())
+}
+
 /*
 ListApplicationTargetsForApplicationAdministratorRoleForUser List all Application Targets for Application Administrator Role
 
@@ -1744,7 +1762,7 @@ func (a *RoleTargetAPIService) ListApplicationTargetsForApplicationAdministrator
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleTargetAPIService.ListApplicationTargetsForApplicationAdministratorRoleForUser")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/roles/{roleId}/targets/catalog/apps"
@@ -1799,7 +1817,7 @@ func (a *RoleTargetAPIService) ListApplicationTargetsForApplicationAdministrator
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1814,6 +1832,7 @@ func (a *RoleTargetAPIService) ListApplicationTargetsForApplicationAdministrator
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1887,6 +1906,11 @@ func (r ApiListGroupTargetsForGroupRoleRequest) Execute() (*APIResponse, error)
 	return r.ApiService.ListGroupTargetsForGroupRoleExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []Group.
+func (r ApiListGroupTargetsForGroupRoleRequest) ExecuteTyped() ([]Group, *APIResponse, error) {
+	return decodeResponse[[]Group](r.Execute())
+}
+
 /*
 ListGroupTargetsForGroupRole List all Group Targets for a Group Role
 
@@ -1928,7 +1952,7 @@ func (a *RoleTargetAPIService) ListGroupTargetsForGroupRoleExecute(r ApiListGrou
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleTargetAPIService.ListGroupTargetsForGroupRole")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/groups/{groupId}/roles/{roleId}/targets/groups"
@@ -1983,7 +2007,7 @@ func (a *RoleTargetAPIService) ListGroupTargetsForGroupRoleExecute(r ApiListGrou
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1998,6 +2022,7 @@ func (a *RoleTargetAPIService) ListGroupTargetsForGroupRoleExecute(r ApiListGrou
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2071,6 +2096,11 @@ func (r ApiListGroupTargetsForRoleRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListGroupTargetsForRoleExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []Group.
+func (r ApiListGroupTargetsForRoleRequest) ExecuteTyped() ([]Group, *APIResponse, error) {
+	return decodeResponse[[]Group](r.Execute())
+}
+
 /*
 ListGroupTargetsForRole List all Group Targets for Role
 
@@ -2112,7 +2142,7 @@ func (a *RoleTargetAPIService) ListGroupTargetsForRoleExecute(r ApiListGroupTarg
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleTargetAPIService.ListGroupTargetsForRole")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/roles/{roleId}/targets/groups"
@@ -2167,7 +2197,7 @@ func (a *RoleTargetAPIService) ListGroupTargetsForRoleExecute(r ApiListGroupTarg
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2182,6 +2212,7 @@ func (a *RoleTargetAPIService) ListGroupTargetsForRoleExecute(r ApiListGroupTarg
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2289,7 +2320,7 @@ func (a *RoleTargetAPIService) UnassignAppInstanceTargetFromAdminRoleForUserExec
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleTargetAPIService.UnassignAppInstanceTargetFromAdminRoleForUser")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/roles/{roleId}/targets/catalog/apps/{appName}/{appId}"
@@ -2340,7 +2371,7 @@ func (a *RoleTargetAPIService) UnassignAppInstanceTargetFromAdminRoleForUserExec
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2355,6 +2386,7 @@ func (a *RoleTargetAPIService) UnassignAppInstanceTargetFromAdminRoleForUserExec
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2462,7 +2494,7 @@ func (a *RoleTargetAPIService) UnassignAppInstanceTargetToAppAdminRoleForGroupEx
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleTargetAPIService.UnassignAppInstanceTargetToAppAdminRoleForGroup")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/groups/{groupId}/roles/{roleId}/targets/catalog/apps/{appName}/{appId}"
@@ -2513,7 +2545,7 @@ func (a *RoleTargetAPIService) UnassignAppInstanceTargetToAppAdminRoleForGroupEx
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2528,6 +2560,7 @@ func (a *RoleTargetAPIService) UnassignAppInstanceTargetToAppAdminRoleForGroupEx
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2632,7 +2665,7 @@ func (a *RoleTargetAPIService) UnassignAppTargetFromAppAdminRoleForUserExecute(r
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleTargetAPIService.UnassignAppTargetFromAppAdminRoleForUser")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/roles/{roleId}/targets/catalog/apps/{appName}"
@@ -2682,7 +2715,7 @@ func (a *RoleTargetAPIService) UnassignAppTargetFromAppAdminRoleForUserExecute(r
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2697,6 +2730,7 @@ func (a *RoleTargetAPIService) UnassignAppTargetFromAppAdminRoleForUserExecute(r
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2801,7 +2835,7 @@ func (a *RoleTargetAPIService) UnassignAppTargetToAdminRoleForGroupExecute(r Api
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleTargetAPIService.UnassignAppTargetToAdminRoleForGroup")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/groups/{groupId}/roles/{roleId}/targets/catalog/apps/{appName}"
@@ -2851,7 +2885,7 @@ func (a *RoleTargetAPIService) UnassignAppTargetToAdminRoleForGroupExecute(r Api
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2866,6 +2900,7 @@ func (a *RoleTargetAPIService) UnassignAppTargetToAdminRoleForGroupExecute(r Api
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2970,7 +3005,7 @@ func (a *RoleTargetAPIService) UnassignGroupTargetFromGroupAdminRoleExecute(r Ap
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleTargetAPIService.UnassignGroupTargetFromGroupAdminRole")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/groups/{groupId}/roles/{roleId}/targets/groups/{targetGroupId}"
@@ -3020,7 +3055,7 @@ func (a *RoleTargetAPIService) UnassignGroupTargetFromGroupAdminRoleExecute(r Ap
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -3035,6 +3070,7 @@ func (a *RoleTargetAPIService) UnassignGroupTargetFromGroupAdminRoleExecute(r Ap
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -3139,7 +3175,7 @@ func (a *RoleTargetAPIService) UnassignGroupTargetFromUserAdminRoleExecute(r Api
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleTargetAPIService.UnassignGroupTargetFromUserAdminRole")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/roles/{roleId}/targets/groups/{groupId}"
@@ -3189,7 +3225,7 @@ func (a *RoleTargetAPIService) UnassignGroupTargetFromUserAdminRoleExecute(r Api
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -3204,6 +3240,7 @@ func (a *RoleTargetAPIService) UnassignGroupTargetFromUserAdminRoleExecute(r Api
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
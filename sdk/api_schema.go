@@ -161,6 +161,11 @@ func (r ApiGetApplicationUserSchemaRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetApplicationUserSchemaExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into UserSchema.
+func (r ApiGetApplicationUserSchemaRequest) ExecuteTyped() (UserSchema, *APIResponse, error) {
+	return decodeResponse[UserSchema](r.Execute())
+}
+
 /*
 GetApplicationUserSchema Retrieve the default Application User Schema for an Application
 
@@ -200,7 +205,7 @@ func (a *SchemaAPIService) GetApplicationUserSchemaExecute(r ApiGetApplicationUs
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SchemaAPIService.GetApplicationUserSchema")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/meta/schemas/apps/{appId}/default"
@@ -248,7 +253,7 @@ func (a *SchemaAPIService) GetApplicationUserSchemaExecute(r ApiGetApplicationUs
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -263,6 +268,7 @@ func (a *SchemaAPIService) GetApplicationUserSchemaExecute(r ApiGetApplicationUs
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -322,6 +328,11 @@ func (r ApiGetGroupSchemaRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetGroupSchemaExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into GroupSchema.
+func (r ApiGetGroupSchemaRequest) ExecuteTyped() (GroupSchema, *APIResponse, error) {
+	return decodeResponse[GroupSchema](r.Execute())
+}
+
 /*
 GetGroupSchema Retrieve the default Group Schema
 
@@ -359,7 +370,7 @@ func (a *SchemaAPIService) GetGroupSchemaExecute(r ApiGetGroupSchemaRequest) (*A
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SchemaAPIService.GetGroupSchema")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/meta/schemas/group/default"
@@ -406,7 +417,7 @@ func (a *SchemaAPIService) GetGroupSchemaExecute(r ApiGetGroupSchemaRequest) (*A
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -421,6 +432,7 @@ func (a *SchemaAPIService) GetGroupSchemaExecute(r ApiGetGroupSchemaRequest) (*A
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -469,6 +481,11 @@ func (r ApiGetLogStreamSchemaRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetLogStreamSchemaExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into LogStreamSchema.
+func (r ApiGetLogStreamSchemaRequest) ExecuteTyped() (LogStreamSchema, *APIResponse, error) {
+	return decodeResponse[LogStreamSchema](r.Execute())
+}
+
 /*
 GetLogStreamSchema Retrieve the Log Stream Schema for the schema type
 
@@ -508,7 +525,7 @@ func (a *SchemaAPIService) GetLogStreamSchemaExecute(r ApiGetLogStreamSchemaRequ
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SchemaAPIService.GetLogStreamSchema")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/meta/schemas/logStream/{logStreamType}"
@@ -556,7 +573,7 @@ func (a *SchemaAPIService) GetLogStreamSchemaExecute(r ApiGetLogStreamSchemaRequ
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -571,6 +588,7 @@ func (a *SchemaAPIService) GetLogStreamSchemaExecute(r ApiGetLogStreamSchemaRequ
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -631,6 +649,11 @@ func (r ApiGetUserSchemaRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetUserSchemaExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into UserSchema.
+func (r ApiGetUserSchemaRequest) ExecuteTyped() (UserSchema, *APIResponse, error) {
+	return decodeResponse[UserSchema](r.Execute())
+}
+
 /*
 GetUserSchema Retrieve a User Schema
 
@@ -670,7 +693,7 @@ func (a *SchemaAPIService) GetUserSchemaExecute(r ApiGetUserSchemaRequest) (*API
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SchemaAPIService.GetUserSchema")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/meta/schemas/user/{schemaId}"
@@ -718,7 +741,7 @@ func (a *SchemaAPIService) GetUserSchemaExecute(r ApiGetUserSchemaRequest) (*API
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -733,6 +756,7 @@ func (a *SchemaAPIService) GetUserSchemaExecute(r ApiGetUserSchemaRequest) (*API
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -792,6 +816,11 @@ func (r ApiListLogStreamSchemasRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListLogStreamSchemasExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []LogStreamSchema.
+func (r ApiListLogStreamSchemasRequest) ExecuteTyped() ([]LogStreamSchema, *APIResponse, error) {
+	return decodeResponse[[]LogStreamSchema](r.Execute())
+}
+
 /*
 ListLogStreamSchemas List the Log Stream Schemas
 
@@ -829,7 +858,7 @@ func (a *SchemaAPIService) ListLogStreamSchemasExecute(r ApiListLogStreamSchemas
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SchemaAPIService.ListLogStreamSchemas")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/meta/schemas/logStream"
@@ -876,7 +905,7 @@ func (a *SchemaAPIService) ListLogStreamSchemasExecute(r ApiListLogStreamSchemas
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -891,6 +920,7 @@ func (a *SchemaAPIService) ListLogStreamSchemasExecute(r ApiListLogStreamSchemas
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -945,6 +975,11 @@ func (r ApiUpdateApplicationUserProfileRequest) Execute() (*APIResponse, error)
 	return r.ApiService.UpdateApplicationUserProfileExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into UserSchema.
+func (r ApiUpdateApplicationUserProfileRequest) ExecuteTyped() (UserSchema, *APIResponse, error) {
+	return decodeResponse[UserSchema](r.Execute())
+}
+
 /*
 UpdateApplicationUserProfile Update the default Application User Schema for an Application
 
@@ -984,7 +1019,7 @@ func (a *SchemaAPIService) UpdateApplicationUserProfileExecute(r ApiUpdateApplic
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SchemaAPIService.UpdateApplicationUserProfile")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/meta/schemas/apps/{appId}/default"
@@ -1035,7 +1070,7 @@ func (a *SchemaAPIService) UpdateApplicationUserProfileExecute(r ApiUpdateApplic
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1050,6 +1085,7 @@ func (a *SchemaAPIService) UpdateApplicationUserProfileExecute(r ApiUpdateApplic
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1127,6 +1163,11 @@ func (r ApiUpdateGroupSchemaRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.UpdateGroupSchemaExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into GroupSchema.
+func (r ApiUpdateGroupSchemaRequest) ExecuteTyped() (GroupSchema, *APIResponse, error) {
+	return decodeResponse[GroupSchema](r.Execute())
+}
+
 /*
 UpdateGroupSchema Update the default Group Schema
 
@@ -1164,7 +1205,7 @@ func (a *SchemaAPIService) UpdateGroupSchemaExecute(r ApiUpdateGroupSchemaReques
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SchemaAPIService.UpdateGroupSchema")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/meta/schemas/group/default"
@@ -1214,7 +1255,7 @@ func (a *SchemaAPIService) UpdateGroupSchemaExecute(r ApiUpdateGroupSchemaReques
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1229,6 +1270,7 @@ func (a *SchemaAPIService) UpdateGroupSchemaExecute(r ApiUpdateGroupSchemaReques
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1295,6 +1337,11 @@ func (r ApiUpdateUserProfileRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.UpdateUserProfileExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into UserSchema.
+func (r ApiUpdateUserProfileRequest) ExecuteTyped() (UserSchema, *APIResponse, error) {
+	return decodeResponse[UserSchema](r.Execute())
+}
+
 /*
 UpdateUserProfile Update a User Schema
 
@@ -1334,7 +1381,7 @@ func (a *SchemaAPIService) UpdateUserProfileExecute(r ApiUpdateUserProfileReques
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SchemaAPIService.UpdateUserProfile")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/meta/schemas/user/{schemaId}"
@@ -1385,7 +1432,7 @@ func (a *SchemaAPIService) UpdateUserProfileExecute(r ApiUpdateUserProfileReques
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1400,6 +1447,7 @@ func (a *SchemaAPIService) UpdateUserProfileExecute(r ApiUpdateUserProfileReques
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
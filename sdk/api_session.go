@@ -192,7 +192,7 @@ func (a *SessionAPIService) CloseCurrentSessionExecute(r ApiCloseCurrentSessionR
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SessionAPIService.CloseCurrentSession")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/sessions/me"
@@ -228,7 +228,7 @@ func (a *SessionAPIService) CloseCurrentSessionExecute(r ApiCloseCurrentSessionR
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -243,6 +243,7 @@ func (a *SessionAPIService) CloseCurrentSessionExecute(r ApiCloseCurrentSessionR
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
@@ -284,6 +285,11 @@ func (r ApiCreateSessionRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.CreateSessionExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into Session.
+func (r ApiCreateSessionRequest) ExecuteTyped() (Session, *APIResponse, error) {
+	return decodeResponse[Session](r.Execute())
+}
+
 /*
 CreateSession Create a Session with session token
 
@@ -321,7 +327,7 @@ func (a *SessionAPIService) CreateSessionExecute(r ApiCreateSessionRequest) (*AP
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SessionAPIService.CreateSession")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/sessions"
@@ -371,7 +377,7 @@ func (a *SessionAPIService) CreateSessionExecute(r ApiCreateSessionRequest) (*AP
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -386,6 +392,7 @@ func (a *SessionAPIService) CreateSessionExecute(r ApiCreateSessionRequest) (*AP
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -439,6 +446,11 @@ func (r ApiGetCurrentSessionRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetCurrentSessionExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into Session.
+func (r ApiGetCurrentSessionRequest) ExecuteTyped() (Session, *APIResponse, error) {
+	return decodeResponse[Session](r.Execute())
+}
+
 /*
 GetCurrentSession Retrieve the current Session
 
@@ -478,7 +490,7 @@ func (a *SessionAPIService) GetCurrentSessionExecute(r ApiGetCurrentSessionReque
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SessionAPIService.GetCurrentSession")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/sessions/me"
@@ -514,7 +526,7 @@ func (a *SessionAPIService) GetCurrentSessionExecute(r ApiGetCurrentSessionReque
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -529,6 +541,7 @@ func (a *SessionAPIService) GetCurrentSessionExecute(r ApiGetCurrentSessionReque
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
 		return localAPIResponse, newErr
@@ -555,6 +568,11 @@ func (r ApiGetSessionRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetSessionExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into Session.
+func (r ApiGetSessionRequest) ExecuteTyped() (Session, *APIResponse, error) {
+	return decodeResponse[Session](r.Execute())
+}
+
 /*
 GetSession Retrieve a Session
 
@@ -594,7 +612,7 @@ func (a *SessionAPIService) GetSessionExecute(r ApiGetSessionRequest) (*APIRespo
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SessionAPIService.GetSession")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/sessions/{sessionId}"
@@ -642,7 +660,7 @@ func (a *SessionAPIService) GetSessionExecute(r ApiGetSessionRequest) (*APIRespo
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -657,6 +675,7 @@ func (a *SessionAPIService) GetSessionExecute(r ApiGetSessionRequest) (*APIRespo
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -710,6 +729,11 @@ func (r ApiRefreshCurrentSessionRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.RefreshCurrentSessionExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into Session.
+func (r ApiRefreshCurrentSessionRequest) ExecuteTyped() (Session, *APIResponse, error) {
+	return decodeResponse[Session](r.Execute())
+}
+
 /*
 RefreshCurrentSession Refresh the current Session
 
@@ -749,7 +773,7 @@ func (a *SessionAPIService) RefreshCurrentSessionExecute(r ApiRefreshCurrentSess
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SessionAPIService.RefreshCurrentSession")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/sessions/me/lifecycle/refresh"
@@ -785,7 +809,7 @@ func (a *SessionAPIService) RefreshCurrentSessionExecute(r ApiRefreshCurrentSess
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -800,6 +824,7 @@ func (a *SessionAPIService) RefreshCurrentSessionExecute(r ApiRefreshCurrentSess
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
 		return localAPIResponse, newErr
@@ -826,6 +851,11 @@ func (r ApiRefreshSessionRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.RefreshSessionExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into Session.
+func (r ApiRefreshSessionRequest) ExecuteTyped() (Session, *APIResponse, error) {
+	return decodeResponse[Session](r.Execute())
+}
+
 /*
 RefreshSession Refresh a Session
 
@@ -865,7 +895,7 @@ func (a *SessionAPIService) RefreshSessionExecute(r ApiRefreshSessionRequest) (*
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SessionAPIService.RefreshSession")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/sessions/{sessionId}/lifecycle/refresh"
@@ -913,7 +943,7 @@ func (a *SessionAPIService) RefreshSessionExecute(r ApiRefreshSessionRequest) (*
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -928,6 +958,7 @@ func (a *SessionAPIService) RefreshSessionExecute(r ApiRefreshSessionRequest) (*
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1014,7 +1045,7 @@ func (a *SessionAPIService) RevokeSessionExecute(r ApiRevokeSessionRequest) (*AP
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SessionAPIService.RevokeSession")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/sessions/{sessionId}"
@@ -1062,7 +1093,7 @@ func (a *SessionAPIService) RevokeSessionExecute(r ApiRevokeSessionRequest) (*AP
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1077,6 +1108,7 @@ func (a *SessionAPIService) RevokeSessionExecute(r ApiRevokeSessionRequest) (*AP
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -167,6 +167,11 @@ func (r ApiGetSubscriptionsNotificationTypeRoleRequest) Execute() (*APIResponse,
 	return r.ApiService.GetSubscriptionsNotificationTypeRoleExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into Subscription.
+func (r ApiGetSubscriptionsNotificationTypeRoleRequest) ExecuteTyped() (Subscription, *APIResponse, error) {
+	return decodeResponse[Subscription](r.Execute())
+}
+
 /*
 GetSubscriptionsNotificationTypeRole Retrieve a Subscription for a Role
 
@@ -208,7 +213,7 @@ func (a *SubscriptionAPIService) GetSubscriptionsNotificationTypeRoleExecute(r A
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SubscriptionAPIService.GetSubscriptionsNotificationTypeRole")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/roles/{roleRef}/subscriptions/{notificationType}"
@@ -257,7 +262,7 @@ func (a *SubscriptionAPIService) GetSubscriptionsNotificationTypeRoleExecute(r A
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -272,6 +277,7 @@ func (a *SubscriptionAPIService) GetSubscriptionsNotificationTypeRoleExecute(r A
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -321,6 +327,11 @@ func (r ApiGetSubscriptionsNotificationTypeUserRequest) Execute() (*APIResponse,
 	return r.ApiService.GetSubscriptionsNotificationTypeUserExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into Subscription.
+func (r ApiGetSubscriptionsNotificationTypeUserRequest) ExecuteTyped() (Subscription, *APIResponse, error) {
+	return decodeResponse[Subscription](r.Execute())
+}
+
 /*
 GetSubscriptionsNotificationTypeUser Retrieve a Subscription for a User
 
@@ -362,7 +373,7 @@ func (a *SubscriptionAPIService) GetSubscriptionsNotificationTypeUserExecute(r A
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SubscriptionAPIService.GetSubscriptionsNotificationTypeUser")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/subscriptions/{notificationType}"
@@ -411,7 +422,7 @@ func (a *SubscriptionAPIService) GetSubscriptionsNotificationTypeUserExecute(r A
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -426,6 +437,7 @@ func (a *SubscriptionAPIService) GetSubscriptionsNotificationTypeUserExecute(r A
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -474,6 +486,11 @@ func (r ApiListSubscriptionsRoleRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListSubscriptionsRoleExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []Subscription.
+func (r ApiListSubscriptionsRoleRequest) ExecuteTyped() ([]Subscription, *APIResponse, error) {
+	return decodeResponse[[]Subscription](r.Execute())
+}
+
 /*
 ListSubscriptionsRole List all Subscriptions for a Role
 
@@ -513,7 +530,7 @@ func (a *SubscriptionAPIService) ListSubscriptionsRoleExecute(r ApiListSubscript
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SubscriptionAPIService.ListSubscriptionsRole")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/roles/{roleRef}/subscriptions"
@@ -561,7 +578,7 @@ func (a *SubscriptionAPIService) ListSubscriptionsRoleExecute(r ApiListSubscript
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -576,6 +593,7 @@ func (a *SubscriptionAPIService) ListSubscriptionsRoleExecute(r ApiListSubscript
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -624,6 +642,11 @@ func (r ApiListSubscriptionsUserRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListSubscriptionsUserExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []Subscription.
+func (r ApiListSubscriptionsUserRequest) ExecuteTyped() ([]Subscription, *APIResponse, error) {
+	return decodeResponse[[]Subscription](r.Execute())
+}
+
 /*
 ListSubscriptionsUser List all Subscriptions for a User
 
@@ -663,7 +686,7 @@ func (a *SubscriptionAPIService) ListSubscriptionsUserExecute(r ApiListSubscript
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SubscriptionAPIService.ListSubscriptionsUser")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/subscriptions"
@@ -711,7 +734,7 @@ func (a *SubscriptionAPIService) ListSubscriptionsUserExecute(r ApiListSubscript
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -726,6 +749,7 @@ func (a *SubscriptionAPIService) ListSubscriptionsUserExecute(r ApiListSubscript
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -815,7 +839,7 @@ func (a *SubscriptionAPIService) SubscribeByNotificationTypeRoleExecute(r ApiSub
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SubscriptionAPIService.SubscribeByNotificationTypeRole")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/roles/{roleRef}/subscriptions/{notificationType}/subscribe"
@@ -864,7 +888,7 @@ func (a *SubscriptionAPIService) SubscribeByNotificationTypeRoleExecute(r ApiSub
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -879,6 +903,7 @@ func (a *SubscriptionAPIService) SubscribeByNotificationTypeRoleExecute(r ApiSub
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -968,7 +993,7 @@ func (a *SubscriptionAPIService) SubscribeByNotificationTypeUserExecute(r ApiSub
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SubscriptionAPIService.SubscribeByNotificationTypeUser")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/subscriptions/{notificationType}/subscribe"
@@ -1017,7 +1042,7 @@ func (a *SubscriptionAPIService) SubscribeByNotificationTypeUserExecute(r ApiSub
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1032,6 +1057,7 @@ func (a *SubscriptionAPIService) SubscribeByNotificationTypeUserExecute(r ApiSub
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1121,7 +1147,7 @@ func (a *SubscriptionAPIService) UnsubscribeByNotificationTypeRoleExecute(r ApiU
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SubscriptionAPIService.UnsubscribeByNotificationTypeRole")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/roles/{roleRef}/subscriptions/{notificationType}/unsubscribe"
@@ -1170,7 +1196,7 @@ func (a *SubscriptionAPIService) UnsubscribeByNotificationTypeRoleExecute(r ApiU
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1185,6 +1211,7 @@ func (a *SubscriptionAPIService) UnsubscribeByNotificationTypeRoleExecute(r ApiU
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1274,7 +1301,7 @@ func (a *SubscriptionAPIService) UnsubscribeByNotificationTypeUserExecute(r ApiU
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SubscriptionAPIService.UnsubscribeByNotificationTypeUser")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/subscriptions/{notificationType}/unsubscribe"
@@ -1323,7 +1350,7 @@ func (a *SubscriptionAPIService) UnsubscribeByNotificationTypeUserExecute(r ApiU
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1338,6 +1365,7 @@ func (a *SubscriptionAPIService) UnsubscribeByNotificationTypeUserExecute(r ApiU
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -98,6 +98,11 @@ func (r ApiListLogEventsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListLogEventsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []LogEvent.
+func (r ApiListLogEventsRequest) ExecuteTyped() ([]LogEvent, *APIResponse, error) {
+	return decodeResponse[[]LogEvent](r.Execute())
+}
+
 /*
 ListLogEvents List all System Log Events
 
@@ -135,7 +140,7 @@ func (a *SystemLogAPIService) ListLogEventsExecute(r ApiListLogEventsRequest) (*
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SystemLogAPIService.ListLogEvents")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/logs"
@@ -203,7 +208,7 @@ func (a *SystemLogAPIService) ListLogEventsExecute(r ApiListLogEventsRequest) (*
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -218,6 +223,7 @@ func (a *SystemLogAPIService) ListLogEventsExecute(r ApiListLogEventsRequest) (*
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
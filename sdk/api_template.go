@@ -136,6 +136,11 @@ func (r ApiCreateSmsTemplateRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.CreateSmsTemplateExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into SmsTemplate.
+func (r ApiCreateSmsTemplateRequest) ExecuteTyped() (SmsTemplate, *APIResponse, error) {
+	return decodeResponse[SmsTemplate](r.Execute())
+}
+
 /*
 CreateSmsTemplate Create an SMS Template
 
@@ -173,7 +178,7 @@ func (a *TemplateAPIService) CreateSmsTemplateExecute(r ApiCreateSmsTemplateRequ
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "TemplateAPIService.CreateSmsTemplate")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/templates/sms"
@@ -223,7 +228,7 @@ func (a *TemplateAPIService) CreateSmsTemplateExecute(r ApiCreateSmsTemplateRequ
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -238,6 +243,7 @@ func (a *TemplateAPIService) CreateSmsTemplateExecute(r ApiCreateSmsTemplateRequ
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -336,7 +342,7 @@ func (a *TemplateAPIService) DeleteSmsTemplateExecute(r ApiDeleteSmsTemplateRequ
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "TemplateAPIService.DeleteSmsTemplate")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/templates/sms/{templateId}"
@@ -384,7 +390,7 @@ func (a *TemplateAPIService) DeleteSmsTemplateExecute(r ApiDeleteSmsTemplateRequ
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -399,6 +405,7 @@ func (a *TemplateAPIService) DeleteSmsTemplateExecute(r ApiDeleteSmsTemplateRequ
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -459,6 +466,11 @@ func (r ApiGetSmsTemplateRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetSmsTemplateExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into SmsTemplate.
+func (r ApiGetSmsTemplateRequest) ExecuteTyped() (SmsTemplate, *APIResponse, error) {
+	return decodeResponse[SmsTemplate](r.Execute())
+}
+
 /*
 GetSmsTemplate Retrieve an SMS Template
 
@@ -498,7 +510,7 @@ func (a *TemplateAPIService) GetSmsTemplateExecute(r ApiGetSmsTemplateRequest) (
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "TemplateAPIService.GetSmsTemplate")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/templates/sms/{templateId}"
@@ -546,7 +558,7 @@ func (a *TemplateAPIService) GetSmsTemplateExecute(r ApiGetSmsTemplateRequest) (
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -561,6 +573,7 @@ func (a *TemplateAPIService) GetSmsTemplateExecute(r ApiGetSmsTemplateRequest) (
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -626,6 +639,11 @@ func (r ApiListSmsTemplatesRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListSmsTemplatesExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []SmsTemplate.
+func (r ApiListSmsTemplatesRequest) ExecuteTyped() ([]SmsTemplate, *APIResponse, error) {
+	return decodeResponse[[]SmsTemplate](r.Execute())
+}
+
 /*
 ListSmsTemplates List all SMS Templates
 
@@ -663,7 +681,7 @@ func (a *TemplateAPIService) ListSmsTemplatesExecute(r ApiListSmsTemplatesReques
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "TemplateAPIService.ListSmsTemplates")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/templates/sms"
@@ -713,7 +731,7 @@ func (a *TemplateAPIService) ListSmsTemplatesExecute(r ApiListSmsTemplatesReques
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -728,6 +746,7 @@ func (a *TemplateAPIService) ListSmsTemplatesExecute(r ApiListSmsTemplatesReques
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -782,6 +801,11 @@ func (r ApiReplaceSmsTemplateRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ReplaceSmsTemplateExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into SmsTemplate.
+func (r ApiReplaceSmsTemplateRequest) ExecuteTyped() (SmsTemplate, *APIResponse, error) {
+	return decodeResponse[SmsTemplate](r.Execute())
+}
+
 /*
 ReplaceSmsTemplate Replace an SMS Template
 
@@ -821,7 +845,7 @@ func (a *TemplateAPIService) ReplaceSmsTemplateExecute(r ApiReplaceSmsTemplateRe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "TemplateAPIService.ReplaceSmsTemplate")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/templates/sms/{templateId}"
@@ -872,7 +896,7 @@ func (a *TemplateAPIService) ReplaceSmsTemplateExecute(r ApiReplaceSmsTemplateRe
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -887,6 +911,7 @@ func (a *TemplateAPIService) ReplaceSmsTemplateExecute(r ApiReplaceSmsTemplateRe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -965,6 +990,11 @@ func (r ApiUpdateSmsTemplateRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.UpdateSmsTemplateExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into SmsTemplate.
+func (r ApiUpdateSmsTemplateRequest) ExecuteTyped() (SmsTemplate, *APIResponse, error) {
+	return decodeResponse[SmsTemplate](r.Execute())
+}
+
 /*
 UpdateSmsTemplate Update an SMS Template
 
@@ -1004,7 +1034,7 @@ func (a *TemplateAPIService) UpdateSmsTemplateExecute(r ApiUpdateSmsTemplateRequ
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "TemplateAPIService.UpdateSmsTemplate")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/templates/sms/{templateId}"
@@ -1055,7 +1085,7 @@ func (a *TemplateAPIService) UpdateSmsTemplateExecute(r ApiUpdateSmsTemplateRequ
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1070,6 +1100,7 @@ func (a *TemplateAPIService) UpdateSmsTemplateExecute(r ApiUpdateSmsTemplateRequ
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -70,6 +70,11 @@ func (r ApiGetCurrentConfigurationRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetCurrentConfigurationExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ThreatInsightConfiguration.
+func (r ApiGetCurrentConfigurationRequest) ExecuteTyped() (ThreatInsightConfiguration, *APIResponse, error) {
+	return decodeResponse[ThreatInsightConfiguration](r.Execute())
+}
+
 /*
 GetCurrentConfiguration Retrieve the ThreatInsight Configuration
 
@@ -107,7 +112,7 @@ func (a *ThreatInsightAPIService) GetCurrentConfigurationExecute(r ApiGetCurrent
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ThreatInsightAPIService.GetCurrentConfiguration")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/threats/configuration"
@@ -154,7 +159,7 @@ func (a *ThreatInsightAPIService) GetCurrentConfigurationExecute(r ApiGetCurrent
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -169,6 +174,7 @@ func (a *ThreatInsightAPIService) GetCurrentConfigurationExecute(r ApiGetCurrent
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -222,6 +228,11 @@ func (r ApiUpdateConfigurationRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.UpdateConfigurationExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ThreatInsightConfiguration.
+func (r ApiUpdateConfigurationRequest) ExecuteTyped() (ThreatInsightConfiguration, *APIResponse, error) {
+	return decodeResponse[ThreatInsightConfiguration](r.Execute())
+}
+
 /*
 UpdateConfiguration Update the ThreatInsight Configuration
 
@@ -259,7 +270,7 @@ func (a *ThreatInsightAPIService) UpdateConfigurationExecute(r ApiUpdateConfigur
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ThreatInsightAPIService.UpdateConfiguration")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/threats/configuration"
@@ -309,7 +320,7 @@ func (a *ThreatInsightAPIService) UpdateConfigurationExecute(r ApiUpdateConfigur
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -324,6 +335,7 @@ func (a *ThreatInsightAPIService) UpdateConfigurationExecute(r ApiUpdateConfigur
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
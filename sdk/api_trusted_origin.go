@@ -146,6 +146,11 @@ func (r ApiActivateTrustedOriginRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ActivateTrustedOriginExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into TrustedOrigin.
+func (r ApiActivateTrustedOriginRequest) ExecuteTyped() (TrustedOrigin, *APIResponse, error) {
+	return decodeResponse[TrustedOrigin](r.Execute())
+}
+
 /*
 ActivateTrustedOrigin Activate a Trusted Origin
 
@@ -185,7 +190,7 @@ func (a *TrustedOriginAPIService) ActivateTrustedOriginExecute(r ApiActivateTrus
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "TrustedOriginAPIService.ActivateTrustedOrigin")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/trustedOrigins/{trustedOriginId}/lifecycle/activate"
@@ -233,7 +238,7 @@ func (a *TrustedOriginAPIService) ActivateTrustedOriginExecute(r ApiActivateTrus
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -248,6 +253,7 @@ func (a *TrustedOriginAPIService) ActivateTrustedOriginExecute(r ApiActivateTrus
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -313,6 +319,11 @@ func (r ApiCreateTrustedOriginRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.CreateTrustedOriginExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into TrustedOrigin.
+func (r ApiCreateTrustedOriginRequest) ExecuteTyped() (TrustedOrigin, *APIResponse, error) {
+	return decodeResponse[TrustedOrigin](r.Execute())
+}
+
 /*
 CreateTrustedOrigin Create a Trusted Origin
 
@@ -350,7 +361,7 @@ func (a *TrustedOriginAPIService) CreateTrustedOriginExecute(r ApiCreateTrustedO
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "TrustedOriginAPIService.CreateTrustedOrigin")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/trustedOrigins"
@@ -400,7 +411,7 @@ func (a *TrustedOriginAPIService) CreateTrustedOriginExecute(r ApiCreateTrustedO
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -415,6 +426,7 @@ func (a *TrustedOriginAPIService) CreateTrustedOriginExecute(r ApiCreateTrustedO
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -475,6 +487,11 @@ func (r ApiDeactivateTrustedOriginRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.DeactivateTrustedOriginExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into TrustedOrigin.
+func (r ApiDeactivateTrustedOriginRequest) ExecuteTyped() (TrustedOrigin, *APIResponse, error) {
+	return decodeResponse[TrustedOrigin](r.Execute())
+}
+
 /*
 DeactivateTrustedOrigin Deactivate a Trusted Origin
 
@@ -514,7 +531,7 @@ func (a *TrustedOriginAPIService) DeactivateTrustedOriginExecute(r ApiDeactivate
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "TrustedOriginAPIService.DeactivateTrustedOrigin")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/trustedOrigins/{trustedOriginId}/lifecycle/deactivate"
@@ -562,7 +579,7 @@ func (a *TrustedOriginAPIService) DeactivateTrustedOriginExecute(r ApiDeactivate
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -577,6 +594,7 @@ func (a *TrustedOriginAPIService) DeactivateTrustedOriginExecute(r ApiDeactivate
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -675,7 +693,7 @@ func (a *TrustedOriginAPIService) DeleteTrustedOriginExecute(r ApiDeleteTrustedO
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "TrustedOriginAPIService.DeleteTrustedOrigin")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/trustedOrigins/{trustedOriginId}"
@@ -723,7 +741,7 @@ func (a *TrustedOriginAPIService) DeleteTrustedOriginExecute(r ApiDeleteTrustedO
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -738,6 +756,7 @@ func (a *TrustedOriginAPIService) DeleteTrustedOriginExecute(r ApiDeleteTrustedO
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -798,6 +817,11 @@ func (r ApiGetTrustedOriginRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetTrustedOriginExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into TrustedOrigin.
+func (r ApiGetTrustedOriginRequest) ExecuteTyped() (TrustedOrigin, *APIResponse, error) {
+	return decodeResponse[TrustedOrigin](r.Execute())
+}
+
 /*
 GetTrustedOrigin Retrieve a Trusted Origin
 
@@ -837,7 +861,7 @@ func (a *TrustedOriginAPIService) GetTrustedOriginExecute(r ApiGetTrustedOriginR
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "TrustedOriginAPIService.GetTrustedOrigin")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/trustedOrigins/{trustedOriginId}"
@@ -885,7 +909,7 @@ func (a *TrustedOriginAPIService) GetTrustedOriginExecute(r ApiGetTrustedOriginR
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -900,6 +924,7 @@ func (a *TrustedOriginAPIService) GetTrustedOriginExecute(r ApiGetTrustedOriginR
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -983,6 +1008,11 @@ func (r ApiListTrustedOriginsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListTrustedOriginsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []TrustedOrigin.
+func (r ApiListTrustedOriginsRequest) ExecuteTyped() ([]TrustedOrigin, *APIResponse, error) {
+	return decodeResponse[[]TrustedOrigin](r.Execute())
+}
+
 /*
 ListTrustedOrigins List all Trusted Origins
 
@@ -1020,7 +1050,7 @@ func (a *TrustedOriginAPIService) ListTrustedOriginsExecute(r ApiListTrustedOrig
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "TrustedOriginAPIService.ListTrustedOrigins")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/trustedOrigins"
@@ -1079,7 +1109,7 @@ func (a *TrustedOriginAPIService) ListTrustedOriginsExecute(r ApiListTrustedOrig
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1094,6 +1124,7 @@ func (a *TrustedOriginAPIService) ListTrustedOriginsExecute(r ApiListTrustedOrig
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1148,6 +1179,11 @@ func (r ApiReplaceTrustedOriginRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ReplaceTrustedOriginExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into TrustedOrigin.
+func (r ApiReplaceTrustedOriginRequest) ExecuteTyped() (TrustedOrigin, *APIResponse, error) {
+	return decodeResponse[TrustedOrigin](r.Execute())
+}
+
 /*
 ReplaceTrustedOrigin Replace a Trusted Origin
 
@@ -1187,7 +1223,7 @@ func (a *TrustedOriginAPIService) ReplaceTrustedOriginExecute(r ApiReplaceTruste
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "TrustedOriginAPIService.ReplaceTrustedOrigin")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/trustedOrigins/{trustedOriginId}"
@@ -1238,7 +1274,7 @@ func (a *TrustedOriginAPIService) ReplaceTrustedOriginExecute(r ApiReplaceTruste
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1253,6 +1289,7 @@ func (a *TrustedOriginAPIService) ReplaceTrustedOriginExecute(r ApiReplaceTruste
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -121,6 +121,11 @@ func (r ApiCreateUISchemaRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.CreateUISchemaExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into UISchemasResponseObject.
+func (r ApiCreateUISchemaRequest) ExecuteTyped() (UISchemasResponseObject, *APIResponse, error) {
+	return decodeResponse[UISchemasResponseObject](r.Execute())
+}
+
 /*
 CreateUISchema Create a UI Schema
 
@@ -158,7 +163,7 @@ func (a *UISchemaAPIService) CreateUISchemaExecute(r ApiCreateUISchemaRequest) (
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UISchemaAPIService.CreateUISchema")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/meta/uischemas"
@@ -208,7 +213,7 @@ func (a *UISchemaAPIService) CreateUISchemaExecute(r ApiCreateUISchemaRequest) (
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -223,6 +228,7 @@ func (a *UISchemaAPIService) CreateUISchemaExecute(r ApiCreateUISchemaRequest) (
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -321,7 +327,7 @@ func (a *UISchemaAPIService) DeleteUISchemasExecute(r ApiDeleteUISchemasRequest)
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UISchemaAPIService.DeleteUISchemas")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/meta/uischemas/{id}"
@@ -369,7 +375,7 @@ func (a *UISchemaAPIService) DeleteUISchemasExecute(r ApiDeleteUISchemasRequest)
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -384,6 +390,7 @@ func (a *UISchemaAPIService) DeleteUISchemasExecute(r ApiDeleteUISchemasRequest)
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -456,6 +463,11 @@ func (r ApiGetUISchemaRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetUISchemaExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into UISchemasResponseObject.
+func (r ApiGetUISchemaRequest) ExecuteTyped() (UISchemasResponseObject, *APIResponse, error) {
+	return decodeResponse[UISchemasResponseObject](r.Execute())
+}
+
 /*
 GetUISchema Retrieve a UI Schema
 
@@ -495,7 +507,7 @@ func (a *UISchemaAPIService) GetUISchemaExecute(r ApiGetUISchemaRequest) (*APIRe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UISchemaAPIService.GetUISchema")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/meta/uischemas/{id}"
@@ -543,7 +555,7 @@ func (a *UISchemaAPIService) GetUISchemaExecute(r ApiGetUISchemaRequest) (*APIRe
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -558,6 +570,7 @@ func (a *UISchemaAPIService) GetUISchemaExecute(r ApiGetUISchemaRequest) (*APIRe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -617,6 +630,11 @@ func (r ApiListUISchemasRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListUISchemasExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []UISchemasResponseObject.
+func (r ApiListUISchemasRequest) ExecuteTyped() ([]UISchemasResponseObject, *APIResponse, error) {
+	return decodeResponse[[]UISchemasResponseObject](r.Execute())
+}
+
 /*
 ListUISchemas List all UI Schemas
 
@@ -654,7 +672,7 @@ func (a *UISchemaAPIService) ListUISchemasExecute(r ApiListUISchemasRequest) (*A
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UISchemaAPIService.ListUISchemas")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/meta/uischemas"
@@ -701,7 +719,7 @@ func (a *UISchemaAPIService) ListUISchemasExecute(r ApiListUISchemasRequest) (*A
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -716,6 +734,7 @@ func (a *UISchemaAPIService) ListUISchemasExecute(r ApiListUISchemasRequest) (*A
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -770,6 +789,11 @@ func (r ApiReplaceUISchemasRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ReplaceUISchemasExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into UISchemasResponseObject.
+func (r ApiReplaceUISchemasRequest) ExecuteTyped() (UISchemasResponseObject, *APIResponse, error) {
+	return decodeResponse[UISchemasResponseObject](r.Execute())
+}
+
 /*
 ReplaceUISchemas Replace a UI Schema
 
@@ -809,7 +833,7 @@ func (a *UISchemaAPIService) ReplaceUISchemasExecute(r ApiReplaceUISchemasReques
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UISchemaAPIService.ReplaceUISchemas")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/meta/uischemas/{id}"
@@ -860,7 +884,7 @@ func (a *UISchemaAPIService) ReplaceUISchemasExecute(r ApiReplaceUISchemasReques
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -875,6 +899,7 @@ func (a *UISchemaAPIService) ReplaceUISchemasExecute(r ApiReplaceUISchemasReques
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -651,6 +651,11 @@ func (r ApiActivateUserRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ActivateUserExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into UserActivationToken.
+func (r ApiActivateUserRequest) ExecuteTyped() (UserActivationToken, *APIResponse, error) {
+	return decodeResponse[UserActivationToken](r.Execute())
+}
+
 /*
 ActivateUser Activate a User
 
@@ -702,7 +707,7 @@ func (a *UserAPIService) ActivateUserExecute(r ApiActivateUserRequest) (*APIResp
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserAPIService.ActivateUser")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/lifecycle/activate"
@@ -751,7 +756,7 @@ func (a *UserAPIService) ActivateUserExecute(r ApiActivateUserRequest) (*APIResp
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -766,6 +771,7 @@ func (a *UserAPIService) ActivateUserExecute(r ApiActivateUserRequest) (*APIResp
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -838,6 +844,11 @@ func (r ApiChangePasswordRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ChangePasswordExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into UserCredentials.
+func (r ApiChangePasswordRequest) ExecuteTyped() (UserCredentials, *APIResponse, error) {
+	return decodeResponse[UserCredentials](r.Execute())
+}
+
 /*
 ChangePassword Change Password
 
@@ -877,7 +888,7 @@ func (a *UserAPIService) ChangePasswordExecute(r ApiChangePasswordRequest) (*API
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserAPIService.ChangePassword")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/credentials/change_password"
@@ -931,7 +942,7 @@ func (a *UserAPIService) ChangePasswordExecute(r ApiChangePasswordRequest) (*API
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -946,6 +957,7 @@ func (a *UserAPIService) ChangePasswordExecute(r ApiChangePasswordRequest) (*API
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1024,6 +1036,11 @@ func (r ApiChangeRecoveryQuestionRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ChangeRecoveryQuestionExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into UserCredentials.
+func (r ApiChangeRecoveryQuestionRequest) ExecuteTyped() (UserCredentials, *APIResponse, error) {
+	return decodeResponse[UserCredentials](r.Execute())
+}
+
 /*
 ChangeRecoveryQuestion Change Recovery Question
 
@@ -1063,7 +1080,7 @@ func (a *UserAPIService) ChangeRecoveryQuestionExecute(r ApiChangeRecoveryQuesti
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserAPIService.ChangeRecoveryQuestion")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/credentials/change_recovery_question"
@@ -1114,7 +1131,7 @@ func (a *UserAPIService) ChangeRecoveryQuestionExecute(r ApiChangeRecoveryQuesti
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1129,6 +1146,7 @@ func (a *UserAPIService) ChangeRecoveryQuestionExecute(r ApiChangeRecoveryQuesti
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1227,6 +1245,11 @@ func (r ApiCreateUserRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.CreateUserExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into User.
+func (r ApiCreateUserRequest) ExecuteTyped() (User, *APIResponse, error) {
+	return decodeResponse[User](r.Execute())
+}
+
 /*
 CreateUser Create a User
 
@@ -1269,7 +1292,7 @@ func (a *UserAPIService) CreateUserExecute(r ApiCreateUserRequest) (*APIResponse
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserAPIService.CreateUser")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users"
@@ -1328,7 +1351,7 @@ func (a *UserAPIService) CreateUserExecute(r ApiCreateUserRequest) (*APIResponse
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1343,6 +1366,7 @@ func (a *UserAPIService) CreateUserExecute(r ApiCreateUserRequest) (*APIResponse
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1447,7 +1471,7 @@ func (a *UserAPIService) DeactivateUserExecute(r ApiDeactivateUserRequest) (*API
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserAPIService.DeactivateUser")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/lifecycle/deactivate"
@@ -1498,7 +1522,7 @@ func (a *UserAPIService) DeactivateUserExecute(r ApiDeactivateUserRequest) (*API
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1513,6 +1537,7 @@ func (a *UserAPIService) DeactivateUserExecute(r ApiDeactivateUserRequest) (*API
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1614,7 +1639,7 @@ func (a *UserAPIService) DeleteLinkedObjectForUserExecute(r ApiDeleteLinkedObjec
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserAPIService.DeleteLinkedObjectForUser")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/linkedObjects/{relationshipName}"
@@ -1663,7 +1688,7 @@ func (a *UserAPIService) DeleteLinkedObjectForUserExecute(r ApiDeleteLinkedObjec
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1678,6 +1703,7 @@ func (a *UserAPIService) DeleteLinkedObjectForUserExecute(r ApiDeleteLinkedObjec
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1782,7 +1808,7 @@ func (a *UserAPIService) DeleteUserExecute(r ApiDeleteUserRequest) (*APIResponse
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserAPIService.DeleteUser")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}"
@@ -1833,7 +1859,7 @@ func (a *UserAPIService) DeleteUserExecute(r ApiDeleteUserRequest) (*APIResponse
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1848,6 +1874,7 @@ func (a *UserAPIService) DeleteUserExecute(r ApiDeleteUserRequest) (*APIResponse
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1920,6 +1947,11 @@ func (r ApiExpirePasswordRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ExpirePasswordExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into User.
+func (r ApiExpirePasswordRequest) ExecuteTyped() (User, *APIResponse, error) {
+	return decodeResponse[User](r.Execute())
+}
+
 /*
 ExpirePassword Expire Password
 
@@ -1959,7 +1991,7 @@ func (a *UserAPIService) ExpirePasswordExecute(r ApiExpirePasswordRequest) (*API
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserAPIService.ExpirePassword")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/lifecycle/expire_password"
@@ -2007,7 +2039,7 @@ func (a *UserAPIService) ExpirePasswordExecute(r ApiExpirePasswordRequest) (*API
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2022,6 +2054,7 @@ func (a *UserAPIService) ExpirePasswordExecute(r ApiExpirePasswordRequest) (*API
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2089,6 +2122,11 @@ func (r ApiExpirePasswordAndGetTemporaryPasswordRequest) Execute() (*APIResponse
 	return r.ApiService.ExpirePasswordAndGetTemporaryPasswordExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into TempPassword.
+func (r ApiExpirePasswordAndGetTemporaryPasswordRequest) ExecuteTyped() (TempPassword, *APIResponse, error) {
+	return decodeResponse[TempPassword](r.Execute())
+}
+
 /*
 ExpirePasswordAndGetTemporaryPassword Expire Password and Set Temporary Password
 
@@ -2128,7 +2166,7 @@ func (a *UserAPIService) ExpirePasswordAndGetTemporaryPasswordExecute(r ApiExpir
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserAPIService.ExpirePasswordAndGetTemporaryPassword")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/lifecycle/expire_password_with_temp_password"
@@ -2179,7 +2217,7 @@ func (a *UserAPIService) ExpirePasswordAndGetTemporaryPasswordExecute(r ApiExpir
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2194,6 +2232,7 @@ func (a *UserAPIService) ExpirePasswordAndGetTemporaryPasswordExecute(r ApiExpir
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2260,6 +2299,11 @@ func (r ApiForgotPasswordRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ForgotPasswordExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ForgotPasswordResponse.
+func (r ApiForgotPasswordRequest) ExecuteTyped() (ForgotPasswordResponse, *APIResponse, error) {
+	return decodeResponse[ForgotPasswordResponse](r.Execute())
+}
+
 /*
 ForgotPassword Initiate Forgot Password
 
@@ -2299,7 +2343,7 @@ func (a *UserAPIService) ForgotPasswordExecute(r ApiForgotPasswordRequest) (*API
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserAPIService.ForgotPassword")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/credentials/forgot_password"
@@ -2350,7 +2394,7 @@ func (a *UserAPIService) ForgotPasswordExecute(r ApiForgotPasswordRequest) (*API
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2365,6 +2409,7 @@ func (a *UserAPIService) ForgotPasswordExecute(r ApiForgotPasswordRequest) (*API
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2437,6 +2482,11 @@ func (r ApiForgotPasswordSetNewPasswordRequest) Execute() (*APIResponse, error)
 	return r.ApiService.ForgotPasswordSetNewPasswordExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into UserCredentials.
+func (r ApiForgotPasswordSetNewPasswordRequest) ExecuteTyped() (UserCredentials, *APIResponse, error) {
+	return decodeResponse[UserCredentials](r.Execute())
+}
+
 /*
 ForgotPasswordSetNewPassword Reset Password with Recovery Question
 
@@ -2476,7 +2526,7 @@ func (a *UserAPIService) ForgotPasswordSetNewPasswordExecute(r ApiForgotPassword
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserAPIService.ForgotPasswordSetNewPassword")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/credentials/forgot_password_recovery_question"
@@ -2530,7 +2580,7 @@ func (a *UserAPIService) ForgotPasswordSetNewPasswordExecute(r ApiForgotPassword
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2545,6 +2595,7 @@ func (a *UserAPIService) ForgotPasswordSetNewPasswordExecute(r ApiForgotPassword
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -2630,6 +2681,11 @@ func (r ApiGenerateResetPasswordTokenRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GenerateResetPasswordTokenExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ResetPasswordToken.
+func (r ApiGenerateResetPasswordTokenRequest) ExecuteTyped() (ResetPasswordToken, *APIResponse, error) {
+	return decodeResponse[ResetPasswordToken](r.Execute())
+}
+
 /*
 GenerateResetPasswordToken Generate a Reset Password Token
 
@@ -2669,7 +2725,7 @@ func (a *UserAPIService) GenerateResetPasswordTokenExecute(r ApiGenerateResetPas
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserAPIService.GenerateResetPasswordToken")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/lifecycle/reset_password"
@@ -2721,7 +2777,7 @@ func (a *UserAPIService) GenerateResetPasswordTokenExecute(r ApiGenerateResetPas
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2736,6 +2792,7 @@ func (a *UserAPIService) GenerateResetPasswordTokenExecute(r ApiGenerateResetPas
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2816,6 +2873,11 @@ func (r ApiGetRefreshTokenForUserAndClientRequest) Execute() (*APIResponse, erro
 	return r.ApiService.GetRefreshTokenForUserAndClientExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into OAuth2RefreshToken.
+func (r ApiGetRefreshTokenForUserAndClientRequest) ExecuteTyped() (OAuth2RefreshToken, *APIResponse, error) {
+	return decodeResponse[OAuth2RefreshToken](r.Execute())
+}
+
 /*
 GetRefreshTokenForUserAndClient Retrieve a Refresh Token for a Client
 
@@ -2859,7 +2921,7 @@ func (a *UserAPIService) GetRefreshTokenForUserAndClientExecute(r ApiGetRefreshT
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserAPIService.GetRefreshTokenForUserAndClient")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/clients/{clientId}/tokens/{tokenId}"
@@ -2918,7 +2980,7 @@ func (a *UserAPIService) GetRefreshTokenForUserAndClientExecute(r ApiGetRefreshT
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2933,6 +2995,7 @@ func (a *UserAPIService) GetRefreshTokenForUserAndClientExecute(r ApiGetRefreshT
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -3000,6 +3063,11 @@ func (r ApiGetUserRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetUserExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into UserGetSingleton.
+func (r ApiGetUserRequest) ExecuteTyped() (UserGetSingleton, *APIResponse, error) {
+	return decodeResponse[UserGetSingleton](r.Execute())
+}
+
 /*
 GetUser Retrieve a User
 
@@ -3039,7 +3107,7 @@ func (a *UserAPIService) GetUserExecute(r ApiGetUserRequest) (*APIResponse, erro
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserAPIService.GetUser")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}"
@@ -3090,7 +3158,7 @@ func (a *UserAPIService) GetUserExecute(r ApiGetUserRequest) (*APIResponse, erro
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -3105,6 +3173,7 @@ func (a *UserAPIService) GetUserExecute(r ApiGetUserRequest) (*APIResponse, erro
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -3172,6 +3241,11 @@ func (r ApiGetUserGrantRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetUserGrantExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into OAuth2ScopeConsentGrant.
+func (r ApiGetUserGrantRequest) ExecuteTyped() (OAuth2ScopeConsentGrant, *APIResponse, error) {
+	return decodeResponse[OAuth2ScopeConsentGrant](r.Execute())
+}
+
 /*
 GetUserGrant Retrieve a User Grant
 
@@ -3213,7 +3287,7 @@ func (a *UserAPIService) GetUserGrantExecute(r ApiGetUserGrantRequest) (*APIResp
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserAPIService.GetUserGrant")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/grants/{grantId}"
@@ -3265,7 +3339,7 @@ func (a *UserAPIService) GetUserGrantExecute(r ApiGetUserGrantRequest) (*APIResp
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -3280,6 +3354,7 @@ func (a *UserAPIService) GetUserGrantExecute(r ApiGetUserGrantRequest) (*APIResp
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -3340,6 +3415,11 @@ func (r ApiListAppLinksRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListAppLinksExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []AppLink.
+func (r ApiListAppLinksRequest) ExecuteTyped() ([]AppLink, *APIResponse, error) {
+	return decodeResponse[[]AppLink](r.Execute())
+}
+
 /*
 ListAppLinks List all Assigned Application Links
 
@@ -3379,7 +3459,7 @@ func (a *UserAPIService) ListAppLinksExecute(r ApiListAppLinksRequest) (*APIResp
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserAPIService.ListAppLinks")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/appLinks"
@@ -3427,7 +3507,7 @@ func (a *UserAPIService) ListAppLinksExecute(r ApiListAppLinksRequest) (*APIResp
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -3442,6 +3522,7 @@ func (a *UserAPIService) ListAppLinksExecute(r ApiListAppLinksRequest) (*APIResp
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -3521,6 +3602,11 @@ func (r ApiListGrantsForUserAndClientRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListGrantsForUserAndClientExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []OAuth2ScopeConsentGrant.
+func (r ApiListGrantsForUserAndClientRequest) ExecuteTyped() ([]OAuth2ScopeConsentGrant, *APIResponse, error) {
+	return decodeResponse[[]OAuth2ScopeConsentGrant](r.Execute())
+}
+
 /*
 ListGrantsForUserAndClient List all Grants for a Client
 
@@ -3562,7 +3648,7 @@ func (a *UserAPIService) ListGrantsForUserAndClientExecute(r ApiListGrantsForUse
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserAPIService.ListGrantsForUserAndClient")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/clients/{clientId}/grants"
@@ -3620,7 +3706,7 @@ func (a *UserAPIService) ListGrantsForUserAndClientExecute(r ApiListGrantsForUse
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -3635,6 +3721,7 @@ func (a *UserAPIService) ListGrantsForUserAndClientExecute(r ApiListGrantsForUse
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -3708,6 +3795,11 @@ func (r ApiListLinkedObjectsForUserRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListLinkedObjectsForUserExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []map[string]interface{}.
+func (r ApiListLinkedObjectsForUserRequest) ExecuteTyped() ([]map[string]interface{}, *APIResponse, error) {
+	return decodeResponse[[]map[string]interface{}](r.Execute())
+}
+
 /*
 ListLinkedObjectsForUser List all Linked Objects
 
@@ -3749,7 +3841,7 @@ func (a *UserAPIService) ListLinkedObjectsForUserExecute(r ApiListLinkedObjectsF
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserAPIService.ListLinkedObjectsForUser")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/linkedObjects/{relationshipName}"
@@ -3804,7 +3896,7 @@ func (a *UserAPIService) ListLinkedObjectsForUserExecute(r ApiListLinkedObjectsF
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -3819,6 +3911,7 @@ func (a *UserAPIService) ListLinkedObjectsForUserExecute(r ApiListLinkedObjectsF
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -3898,6 +3991,11 @@ func (r ApiListRefreshTokensForUserAndClientRequest) Execute() (*APIResponse, er
 	return r.ApiService.ListRefreshTokensForUserAndClientExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []OAuth2RefreshToken.
+func (r ApiListRefreshTokensForUserAndClientRequest) ExecuteTyped() ([]OAuth2RefreshToken, *APIResponse, error) {
+	return decodeResponse[[]OAuth2RefreshToken](r.Execute())
+}
+
 /*
 ListRefreshTokensForUserAndClient List all Refresh Tokens for a Client
 
@@ -3939,7 +4037,7 @@ func (a *UserAPIService) ListRefreshTokensForUserAndClientExecute(r ApiListRefre
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserAPIService.ListRefreshTokensForUserAndClient")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/clients/{clientId}/tokens"
@@ -3997,7 +4095,7 @@ func (a *UserAPIService) ListRefreshTokensForUserAndClientExecute(r ApiListRefre
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -4012,6 +4110,7 @@ func (a *UserAPIService) ListRefreshTokensForUserAndClientExecute(r ApiListRefre
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -4072,6 +4171,11 @@ func (r ApiListUserBlocksRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListUserBlocksExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []UserBlock.
+func (r ApiListUserBlocksRequest) ExecuteTyped() ([]UserBlock, *APIResponse, error) {
+	return decodeResponse[[]UserBlock](r.Execute())
+}
+
 /*
 ListUserBlocks List all User Blocks
 
@@ -4111,7 +4215,7 @@ func (a *UserAPIService) ListUserBlocksExecute(r ApiListUserBlocksRequest) (*API
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserAPIService.ListUserBlocks")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/blocks"
@@ -4159,7 +4263,7 @@ func (a *UserAPIService) ListUserBlocksExecute(r ApiListUserBlocksRequest) (*API
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -4174,6 +4278,7 @@ func (a *UserAPIService) ListUserBlocksExecute(r ApiListUserBlocksRequest) (*API
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -4234,6 +4339,11 @@ func (r ApiListUserClientsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListUserClientsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []OAuth2Client.
+func (r ApiListUserClientsRequest) ExecuteTyped() ([]OAuth2Client, *APIResponse, error) {
+	return decodeResponse[[]OAuth2Client](r.Execute())
+}
+
 /*
 ListUserClients List all Clients
 
@@ -4273,7 +4383,7 @@ func (a *UserAPIService) ListUserClientsExecute(r ApiListUserClientsRequest) (*A
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserAPIService.ListUserClients")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/clients"
@@ -4321,7 +4431,7 @@ func (a *UserAPIService) ListUserClientsExecute(r ApiListUserClientsRequest) (*A
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -4336,6 +4446,7 @@ func (a *UserAPIService) ListUserClientsExecute(r ApiListUserClientsRequest) (*A
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -4420,6 +4531,11 @@ func (r ApiListUserGrantsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListUserGrantsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []OAuth2ScopeConsentGrant.
+func (r ApiListUserGrantsRequest) ExecuteTyped() ([]OAuth2ScopeConsentGrant, *APIResponse, error) {
+	return decodeResponse[[]OAuth2ScopeConsentGrant](r.Execute())
+}
+
 /*
 ListUserGrants List all User Grants
 
@@ -4459,7 +4575,7 @@ func (a *UserAPIService) ListUserGrantsExecute(r ApiListUserGrantsRequest) (*API
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserAPIService.ListUserGrants")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/grants"
@@ -4519,7 +4635,7 @@ func (a *UserAPIService) ListUserGrantsExecute(r ApiListUserGrantsRequest) (*API
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -4534,6 +4650,7 @@ func (a *UserAPIService) ListUserGrantsExecute(r ApiListUserGrantsRequest) (*API
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -4608,6 +4725,11 @@ func (r ApiListUserGroupsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListUserGroupsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []Group.
+func (r ApiListUserGroupsRequest) ExecuteTyped() ([]Group, *APIResponse, error) {
+	return decodeResponse[[]Group](r.Execute())
+}
+
 /*
 ListUserGroups List all Groups
 
@@ -4647,7 +4769,7 @@ func (a *UserAPIService) ListUserGroupsExecute(r ApiListUserGroupsRequest) (*API
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserAPIService.ListUserGroups")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/groups"
@@ -4701,7 +4823,7 @@ func (a *UserAPIService) ListUserGroupsExecute(r ApiListUserGroupsRequest) (*API
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -4716,6 +4838,7 @@ func (a *UserAPIService) ListUserGroupsExecute(r ApiListUserGroupsRequest) (*API
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -4776,6 +4899,11 @@ func (r ApiListUserIdentityProvidersRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListUserIdentityProvidersExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []IdentityProvider.
+func (r ApiListUserIdentityProvidersRequest) ExecuteTyped() ([]IdentityProvider, *APIResponse, error) {
+	return decodeResponse[[]IdentityProvider](r.Execute())
+}
+
 /*
 ListUserIdentityProviders List all Identity Providers
 
@@ -4815,7 +4943,7 @@ func (a *UserAPIService) ListUserIdentityProvidersExecute(r ApiListUserIdentityP
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserAPIService.ListUserIdentityProviders")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/idps"
@@ -4863,7 +4991,7 @@ func (a *UserAPIService) ListUserIdentityProvidersExecute(r ApiListUserIdentityP
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -4878,6 +5006,7 @@ func (a *UserAPIService) ListUserIdentityProvidersExecute(r ApiListUserIdentityP
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -4985,6 +5114,11 @@ func (r ApiListUsersRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListUsersExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []User.
+func (r ApiListUsersRequest) ExecuteTyped() ([]User, *APIResponse, error) {
+	return decodeResponse[[]User](r.Execute())
+}
+
 /*
 ListUsers List all Users
 
@@ -5022,7 +5156,7 @@ func (a *UserAPIService) ListUsersExecute(r ApiListUsersRequest) (*APIResponse,
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserAPIService.ListUsers")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users"
@@ -5090,7 +5224,7 @@ func (a *UserAPIService) ListUsersExecute(r ApiListUsersRequest) (*APIResponse,
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -5105,6 +5239,7 @@ func (a *UserAPIService) ListUsersExecute(r ApiListUsersRequest) (*APIResponse,
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -5160,6 +5295,11 @@ func (r ApiReactivateUserRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ReactivateUserExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into UserActivationToken.
+func (r ApiReactivateUserRequest) ExecuteTyped() (UserActivationToken, *APIResponse, error) {
+	return decodeResponse[UserActivationToken](r.Execute())
+}
+
 /*
 ReactivateUser Reactivate a User
 
@@ -5199,7 +5339,7 @@ func (a *UserAPIService) ReactivateUserExecute(r ApiReactivateUserRequest) (*API
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserAPIService.ReactivateUser")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/lifecycle/reactivate"
@@ -5250,7 +5390,7 @@ func (a *UserAPIService) ReactivateUserExecute(r ApiReactivateUserRequest) (*API
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -5265,6 +5405,7 @@ func (a *UserAPIService) ReactivateUserExecute(r ApiReactivateUserRequest) (*API
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -5337,6 +5478,11 @@ func (r ApiReplaceUserRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ReplaceUserExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into User.
+func (r ApiReplaceUserRequest) ExecuteTyped() (User, *APIResponse, error) {
+	return decodeResponse[User](r.Execute())
+}
+
 /*
 ReplaceUser Replace a User
 
@@ -5376,7 +5522,7 @@ func (a *UserAPIService) ReplaceUserExecute(r ApiReplaceUserRequest) (*APIRespon
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserAPIService.ReplaceUser")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}"
@@ -5430,7 +5576,7 @@ func (a *UserAPIService) ReplaceUserExecute(r ApiReplaceUserRequest) (*APIRespon
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -5445,6 +5591,7 @@ func (a *UserAPIService) ReplaceUserExecute(r ApiReplaceUserRequest) (*APIRespon
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -5562,7 +5709,7 @@ func (a *UserAPIService) ResetFactorsExecute(r ApiResetFactorsRequest) (*APIResp
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserAPIService.ResetFactors")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/lifecycle/reset_factors"
@@ -5613,7 +5760,7 @@ func (a *UserAPIService) ResetFactorsExecute(r ApiResetFactorsRequest) (*APIResp
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -5628,6 +5775,7 @@ func (a *UserAPIService) ResetFactorsExecute(r ApiResetFactorsRequest) (*APIResp
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -5729,7 +5877,7 @@ func (a *UserAPIService) RevokeGrantsForUserAndClientExecute(r ApiRevokeGrantsFo
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserAPIService.RevokeGrantsForUserAndClient")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/clients/{clientId}/grants"
@@ -5778,7 +5926,7 @@ func (a *UserAPIService) RevokeGrantsForUserAndClientExecute(r ApiRevokeGrantsFo
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -5793,6 +5941,7 @@ func (a *UserAPIService) RevokeGrantsForUserAndClientExecute(r ApiRevokeGrantsFo
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -5897,7 +6046,7 @@ func (a *UserAPIService) RevokeTokenForUserAndClientExecute(r ApiRevokeTokenForU
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserAPIService.RevokeTokenForUserAndClient")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/clients/{clientId}/tokens/{tokenId}"
@@ -5947,7 +6096,7 @@ func (a *UserAPIService) RevokeTokenForUserAndClientExecute(r ApiRevokeTokenForU
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -5962,6 +6111,7 @@ func (a *UserAPIService) RevokeTokenForUserAndClientExecute(r ApiRevokeTokenForU
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -6063,7 +6213,7 @@ func (a *UserAPIService) RevokeTokensForUserAndClientExecute(r ApiRevokeTokensFo
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserAPIService.RevokeTokensForUserAndClient")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/clients/{clientId}/tokens"
@@ -6112,7 +6262,7 @@ func (a *UserAPIService) RevokeTokensForUserAndClientExecute(r ApiRevokeTokensFo
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -6127,6 +6277,7 @@ func (a *UserAPIService) RevokeTokensForUserAndClientExecute(r ApiRevokeTokensFo
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -6228,7 +6379,7 @@ func (a *UserAPIService) RevokeUserGrantExecute(r ApiRevokeUserGrantRequest) (*A
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserAPIService.RevokeUserGrant")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/grants/{grantId}"
@@ -6277,7 +6428,7 @@ func (a *UserAPIService) RevokeUserGrantExecute(r ApiRevokeUserGrantRequest) (*A
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -6292,6 +6443,7 @@ func (a *UserAPIService) RevokeUserGrantExecute(r ApiRevokeUserGrantRequest) (*A
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -6390,7 +6542,7 @@ func (a *UserAPIService) RevokeUserGrantsExecute(r ApiRevokeUserGrantsRequest) (
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserAPIService.RevokeUserGrants")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/grants"
@@ -6438,7 +6590,7 @@ func (a *UserAPIService) RevokeUserGrantsExecute(r ApiRevokeUserGrantsRequest) (
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -6453,6 +6605,7 @@ func (a *UserAPIService) RevokeUserGrantsExecute(r ApiRevokeUserGrantsRequest) (
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -6558,7 +6711,7 @@ func (a *UserAPIService) RevokeUserSessionsExecute(r ApiRevokeUserSessionsReques
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserAPIService.RevokeUserSessions")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/sessions"
@@ -6609,7 +6762,7 @@ func (a *UserAPIService) RevokeUserSessionsExecute(r ApiRevokeUserSessionsReques
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -6624,6 +6777,7 @@ func (a *UserAPIService) RevokeUserSessionsExecute(r ApiRevokeUserSessionsReques
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -6728,7 +6882,7 @@ func (a *UserAPIService) SetLinkedObjectForUserExecute(r ApiSetLinkedObjectForUs
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserAPIService.SetLinkedObjectForUser")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/linkedObjects/{primaryRelationshipName}/{primaryUserId}"
@@ -6764,7 +6918,7 @@ func (a *UserAPIService) SetLinkedObjectForUserExecute(r ApiSetLinkedObjectForUs
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -6779,6 +6933,7 @@ func (a *UserAPIService) SetLinkedObjectForUserExecute(r ApiSetLinkedObjectForUs
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -6877,7 +7032,7 @@ func (a *UserAPIService) SuspendUserExecute(r ApiSuspendUserRequest) (*APIRespon
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserAPIService.SuspendUser")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/lifecycle/suspend"
@@ -6925,7 +7080,7 @@ func (a *UserAPIService) SuspendUserExecute(r ApiSuspendUserRequest) (*APIRespon
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -6940,6 +7095,7 @@ func (a *UserAPIService) SuspendUserExecute(r ApiSuspendUserRequest) (*APIRespon
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -7038,7 +7194,7 @@ func (a *UserAPIService) UnlockUserExecute(r ApiUnlockUserRequest) (*APIResponse
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserAPIService.UnlockUser")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/lifecycle/unlock"
@@ -7086,7 +7242,7 @@ func (a *UserAPIService) UnlockUserExecute(r ApiUnlockUserRequest) (*APIResponse
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -7101,6 +7257,7 @@ func (a *UserAPIService) UnlockUserExecute(r ApiUnlockUserRequest) (*APIResponse
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -7199,7 +7356,7 @@ func (a *UserAPIService) UnsuspendUserExecute(r ApiUnsuspendUserRequest) (*APIRe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserAPIService.UnsuspendUser")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/lifecycle/unsuspend"
@@ -7247,7 +7404,7 @@ func (a *UserAPIService) UnsuspendUserExecute(r ApiUnsuspendUserRequest) (*APIRe
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -7262,6 +7419,7 @@ func (a *UserAPIService) UnsuspendUserExecute(r ApiUnsuspendUserRequest) (*APIRe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -7334,6 +7492,11 @@ func (r ApiUpdateUserRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.UpdateUserExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into User.
+func (r ApiUpdateUserRequest) ExecuteTyped() (User, *APIResponse, error) {
+	return decodeResponse[User](r.Execute())
+}
+
 /*
 UpdateUser Update a User
 
@@ -7373,7 +7536,7 @@ func (a *UserAPIService) UpdateUserExecute(r ApiUpdateUserRequest) (*APIResponse
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserAPIService.UpdateUser")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}"
@@ -7427,7 +7590,7 @@ func (a *UserAPIService) UpdateUserExecute(r ApiUpdateUserRequest) (*APIResponse
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -7442,6 +7605,7 @@ func (a *UserAPIService) UpdateUserExecute(r ApiUpdateUserRequest) (*APIResponse
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
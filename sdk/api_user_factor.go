@@ -218,6 +218,11 @@ func (r ApiActivateFactorRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ActivateFactorExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ListFactors200ResponseInner.
+func (r ApiActivateFactorRequest) ExecuteTyped() (ListFactors200ResponseInner, *APIResponse, error) {
+	return decodeResponse[ListFactors200ResponseInner](r.Execute())
+}
+
 /*
 ActivateFactor Activate a Factor
 
@@ -262,7 +267,7 @@ func (a *UserFactorAPIService) ActivateFactorExecute(r ApiActivateFactorRequest)
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserFactorAPIService.ActivateFactor")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/factors/{factorId}/lifecycle/activate"
@@ -314,7 +319,7 @@ func (a *UserFactorAPIService) ActivateFactorExecute(r ApiActivateFactorRequest)
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -329,6 +334,7 @@ func (a *UserFactorAPIService) ActivateFactorExecute(r ApiActivateFactorRequest)
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -436,6 +442,11 @@ func (r ApiEnrollFactorRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.EnrollFactorExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ListFactors200ResponseInner.
+func (r ApiEnrollFactorRequest) ExecuteTyped() (ListFactors200ResponseInner, *APIResponse, error) {
+	return decodeResponse[ListFactors200ResponseInner](r.Execute())
+}
+
 /*
 EnrollFactor Enroll a Factor
 
@@ -475,7 +486,7 @@ func (a *UserFactorAPIService) EnrollFactorExecute(r ApiEnrollFactorRequest) (*A
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserFactorAPIService.EnrollFactor")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/factors"
@@ -538,7 +549,7 @@ func (a *UserFactorAPIService) EnrollFactorExecute(r ApiEnrollFactorRequest) (*A
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -553,6 +564,7 @@ func (a *UserFactorAPIService) EnrollFactorExecute(r ApiEnrollFactorRequest) (*A
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -626,6 +638,11 @@ func (r ApiGetFactorRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetFactorExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ListFactors200ResponseInner.
+func (r ApiGetFactorRequest) ExecuteTyped() (ListFactors200ResponseInner, *APIResponse, error) {
+	return decodeResponse[ListFactors200ResponseInner](r.Execute())
+}
+
 /*
 GetFactor Retrieve a Factor
 
@@ -667,7 +684,7 @@ func (a *UserFactorAPIService) GetFactorExecute(r ApiGetFactorRequest) (*APIResp
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserFactorAPIService.GetFactor")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/factors/{factorId}"
@@ -716,7 +733,7 @@ func (a *UserFactorAPIService) GetFactorExecute(r ApiGetFactorRequest) (*APIResp
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -731,6 +748,7 @@ func (a *UserFactorAPIService) GetFactorExecute(r ApiGetFactorRequest) (*APIResp
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -793,6 +811,11 @@ func (r ApiGetFactorTransactionStatusRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetFactorTransactionStatusExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into UserFactorVerifyResponse.
+func (r ApiGetFactorTransactionStatusRequest) ExecuteTyped() (UserFactorVerifyResponse, *APIResponse, error) {
+	return decodeResponse[UserFactorVerifyResponse](r.Execute())
+}
+
 /*
 GetFactorTransactionStatus Retrieve a Factor transaction status
 
@@ -836,7 +859,7 @@ func (a *UserFactorAPIService) GetFactorTransactionStatusExecute(r ApiGetFactorT
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserFactorAPIService.GetFactorTransactionStatus")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/factors/{factorId}/transactions/{transactionId}"
@@ -886,7 +909,7 @@ func (a *UserFactorAPIService) GetFactorTransactionStatusExecute(r ApiGetFactorT
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -901,6 +924,7 @@ func (a *UserFactorAPIService) GetFactorTransactionStatusExecute(r ApiGetFactorT
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -961,6 +985,11 @@ func (r ApiListFactorsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListFactorsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []ListFactors200ResponseInner.
+func (r ApiListFactorsRequest) ExecuteTyped() ([]ListFactors200ResponseInner, *APIResponse, error) {
+	return decodeResponse[[]ListFactors200ResponseInner](r.Execute())
+}
+
 /*
 ListFactors List all enrolled Factors
 
@@ -1000,7 +1029,7 @@ func (a *UserFactorAPIService) ListFactorsExecute(r ApiListFactorsRequest) (*API
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserFactorAPIService.ListFactors")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/factors"
@@ -1048,7 +1077,7 @@ func (a *UserFactorAPIService) ListFactorsExecute(r ApiListFactorsRequest) (*API
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1063,6 +1092,7 @@ func (a *UserFactorAPIService) ListFactorsExecute(r ApiListFactorsRequest) (*API
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1123,6 +1153,11 @@ func (r ApiListSupportedFactorsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListSupportedFactorsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []ListFactors200ResponseInner.
+func (r ApiListSupportedFactorsRequest) ExecuteTyped() ([]ListFactors200ResponseInner, *APIResponse, error) {
+	return decodeResponse[[]ListFactors200ResponseInner](r.Execute())
+}
+
 /*
 ListSupportedFactors List all supported Factors
 
@@ -1162,7 +1197,7 @@ func (a *UserFactorAPIService) ListSupportedFactorsExecute(r ApiListSupportedFac
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserFactorAPIService.ListSupportedFactors")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/factors/catalog"
@@ -1210,7 +1245,7 @@ func (a *UserFactorAPIService) ListSupportedFactorsExecute(r ApiListSupportedFac
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1225,6 +1260,7 @@ func (a *UserFactorAPIService) ListSupportedFactorsExecute(r ApiListSupportedFac
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1285,6 +1321,11 @@ func (r ApiListSupportedSecurityQuestionsRequest) Execute() (*APIResponse, error
 	return r.ApiService.ListSupportedSecurityQuestionsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []UserFactorSecurityQuestionProfile.
+func (r ApiListSupportedSecurityQuestionsRequest) ExecuteTyped() ([]UserFactorSecurityQuestionProfile, *APIResponse, error) {
+	return decodeResponse[[]UserFactorSecurityQuestionProfile](r.Execute())
+}
+
 /*
 ListSupportedSecurityQuestions List all supported Security Questions
 
@@ -1324,7 +1365,7 @@ func (a *UserFactorAPIService) ListSupportedSecurityQuestionsExecute(r ApiListSu
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserFactorAPIService.ListSupportedSecurityQuestions")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/factors/questions"
@@ -1372,7 +1413,7 @@ func (a *UserFactorAPIService) ListSupportedSecurityQuestionsExecute(r ApiListSu
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1387,6 +1428,7 @@ func (a *UserFactorAPIService) ListSupportedSecurityQuestionsExecute(r ApiListSu
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1461,6 +1503,11 @@ func (r ApiResendEnrollFactorRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ResendEnrollFactorExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into ListFactors200ResponseInner.
+func (r ApiResendEnrollFactorRequest) ExecuteTyped() (ListFactors200ResponseInner, *APIResponse, error) {
+	return decodeResponse[ListFactors200ResponseInner](r.Execute())
+}
+
 /*
 ResendEnrollFactor Resend a Factor enrollment
 
@@ -1506,7 +1553,7 @@ func (a *UserFactorAPIService) ResendEnrollFactorExecute(r ApiResendEnrollFactor
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserFactorAPIService.ResendEnrollFactor")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/factors/{factorId}/resend"
@@ -1561,7 +1608,7 @@ func (a *UserFactorAPIService) ResendEnrollFactorExecute(r ApiResendEnrollFactor
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1576,6 +1623,7 @@ func (a *UserFactorAPIService) ResendEnrollFactorExecute(r ApiResendEnrollFactor
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1698,7 +1746,7 @@ func (a *UserFactorAPIService) UnenrollFactorExecute(r ApiUnenrollFactorRequest)
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserFactorAPIService.UnenrollFactor")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/factors/{factorId}"
@@ -1750,7 +1798,7 @@ func (a *UserFactorAPIService) UnenrollFactorExecute(r ApiUnenrollFactorRequest)
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1765,6 +1813,7 @@ func (a *UserFactorAPIService) UnenrollFactorExecute(r ApiUnenrollFactorRequest)
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1867,6 +1916,11 @@ func (r ApiVerifyFactorRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.VerifyFactorExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into UserFactorVerifyResponse.
+func (r ApiVerifyFactorRequest) ExecuteTyped() (UserFactorVerifyResponse, *APIResponse, error) {
+	return decodeResponse[UserFactorVerifyResponse](r.Execute())
+}
+
 /*
 VerifyFactor Verify a Factor
 
@@ -1910,7 +1964,7 @@ func (a *UserFactorAPIService) VerifyFactorExecute(r ApiVerifyFactorRequest) (*A
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserFactorAPIService.VerifyFactor")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/factors/{factorId}/verify"
@@ -1977,7 +2031,7 @@ func (a *UserFactorAPIService) VerifyFactorExecute(r ApiVerifyFactorRequest) (*A
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1992,6 +2046,7 @@ func (a *UserFactorAPIService) VerifyFactorExecute(r ApiVerifyFactorRequest) (*A
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
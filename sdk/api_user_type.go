@@ -140,6 +140,11 @@ func (r ApiCreateUserTypeRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.CreateUserTypeExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into UserType.
+func (r ApiCreateUserTypeRequest) ExecuteTyped() (UserType, *APIResponse, error) {
+	return decodeResponse[UserType](r.Execute())
+}
+
 /*
 CreateUserType Create a User Type
 
@@ -178,7 +183,7 @@ func (a *UserTypeAPIService) CreateUserTypeExecute(r ApiCreateUserTypeRequest) (
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserTypeAPIService.CreateUserType")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/meta/types/user"
@@ -228,7 +233,7 @@ func (a *UserTypeAPIService) CreateUserTypeExecute(r ApiCreateUserTypeRequest) (
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -243,6 +248,7 @@ func (a *UserTypeAPIService) CreateUserTypeExecute(r ApiCreateUserTypeRequest) (
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -342,7 +348,7 @@ func (a *UserTypeAPIService) DeleteUserTypeExecute(r ApiDeleteUserTypeRequest) (
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserTypeAPIService.DeleteUserType")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/meta/types/user/{typeId}"
@@ -390,7 +396,7 @@ func (a *UserTypeAPIService) DeleteUserTypeExecute(r ApiDeleteUserTypeRequest) (
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -405,6 +411,7 @@ func (a *UserTypeAPIService) DeleteUserTypeExecute(r ApiDeleteUserTypeRequest) (
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -465,6 +472,11 @@ func (r ApiGetUserTypeRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetUserTypeExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into UserType.
+func (r ApiGetUserTypeRequest) ExecuteTyped() (UserType, *APIResponse, error) {
+	return decodeResponse[UserType](r.Execute())
+}
+
 /*
 GetUserType Retrieve a User Type
 
@@ -504,7 +516,7 @@ func (a *UserTypeAPIService) GetUserTypeExecute(r ApiGetUserTypeRequest) (*APIRe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserTypeAPIService.GetUserType")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/meta/types/user/{typeId}"
@@ -552,7 +564,7 @@ func (a *UserTypeAPIService) GetUserTypeExecute(r ApiGetUserTypeRequest) (*APIRe
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -567,6 +579,7 @@ func (a *UserTypeAPIService) GetUserTypeExecute(r ApiGetUserTypeRequest) (*APIRe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -626,6 +639,11 @@ func (r ApiListUserTypesRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListUserTypesExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []UserType.
+func (r ApiListUserTypesRequest) ExecuteTyped() ([]UserType, *APIResponse, error) {
+	return decodeResponse[[]UserType](r.Execute())
+}
+
 /*
 ListUserTypes List all User Types
 
@@ -663,7 +681,7 @@ func (a *UserTypeAPIService) ListUserTypesExecute(r ApiListUserTypesRequest) (*A
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserTypeAPIService.ListUserTypes")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/meta/types/user"
@@ -710,7 +728,7 @@ func (a *UserTypeAPIService) ListUserTypesExecute(r ApiListUserTypesRequest) (*A
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -725,6 +743,7 @@ func (a *UserTypeAPIService) ListUserTypesExecute(r ApiListUserTypesRequest) (*A
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -779,6 +798,11 @@ func (r ApiReplaceUserTypeRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ReplaceUserTypeExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into UserType.
+func (r ApiReplaceUserTypeRequest) ExecuteTyped() (UserType, *APIResponse, error) {
+	return decodeResponse[UserType](r.Execute())
+}
+
 /*
 ReplaceUserType Replace a User Type
 
@@ -819,7 +843,7 @@ func (a *UserTypeAPIService) ReplaceUserTypeExecute(r ApiReplaceUserTypeRequest)
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserTypeAPIService.ReplaceUserType")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/meta/types/user/{typeId}"
@@ -870,7 +894,7 @@ func (a *UserTypeAPIService) ReplaceUserTypeExecute(r ApiReplaceUserTypeRequest)
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -885,6 +909,7 @@ func (a *UserTypeAPIService) ReplaceUserTypeExecute(r ApiReplaceUserTypeRequest)
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -963,6 +988,11 @@ func (r ApiUpdateUserTypeRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.UpdateUserTypeExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into UserType.
+func (r ApiUpdateUserTypeRequest) ExecuteTyped() (UserType, *APIResponse, error) {
+	return decodeResponse[UserType](r.Execute())
+}
+
 /*
 UpdateUserType Update a User Type
 
@@ -1003,7 +1033,7 @@ func (a *UserTypeAPIService) UpdateUserTypeExecute(r ApiUpdateUserTypeRequest) (
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserTypeAPIService.UpdateUserType")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/meta/types/user/{typeId}"
@@ -1054,7 +1084,7 @@ func (a *UserTypeAPIService) UpdateUserTypeExecute(r ApiUpdateUserTypeRequest) (
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1069,6 +1099,7 @@ func (a *UserTypeAPIService) UpdateUserTypeExecute(r ApiUpdateUserTypeRequest) (
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
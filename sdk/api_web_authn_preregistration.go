@@ -121,6 +121,11 @@ func (r ApiActivatePreregistrationEnrollmentRequest) Execute() (*APIResponse, er
 	return r.ApiService.ActivatePreregistrationEnrollmentExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into EnrollmentActivationResponse.
+func (r ApiActivatePreregistrationEnrollmentRequest) ExecuteTyped() (EnrollmentActivationResponse, *APIResponse, error) {
+	return decodeResponse[EnrollmentActivationResponse](r.Execute())
+}
+
 /*
 ActivatePreregistrationEnrollment Activate a Preregistered WebAuthn Factor
 
@@ -158,7 +163,7 @@ func (a *WebAuthnPreregistrationAPIService) ActivatePreregistrationEnrollmentExe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "WebAuthnPreregistrationAPIService.ActivatePreregistrationEnrollment")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/webauthn-registration/api/v1/activate"
@@ -208,7 +213,7 @@ func (a *WebAuthnPreregistrationAPIService) ActivatePreregistrationEnrollmentExe
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -223,6 +228,7 @@ func (a *WebAuthnPreregistrationAPIService) ActivatePreregistrationEnrollmentExe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -336,7 +342,7 @@ func (a *WebAuthnPreregistrationAPIService) DeleteWebAuthnPreregistrationFactorE
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "WebAuthnPreregistrationAPIService.DeleteWebAuthnPreregistrationFactor")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/webauthn-registration/api/v1/users/{userId}/enrollments/{authenticatorEnrollmentId}"
@@ -385,7 +391,7 @@ func (a *WebAuthnPreregistrationAPIService) DeleteWebAuthnPreregistrationFactorE
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -400,6 +406,7 @@ func (a *WebAuthnPreregistrationAPIService) DeleteWebAuthnPreregistrationFactorE
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -466,6 +473,11 @@ func (r ApiEnrollPreregistrationEnrollmentRequest) Execute() (*APIResponse, erro
 	return r.ApiService.EnrollPreregistrationEnrollmentExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into EnrollmentInitializationResponse.
+func (r ApiEnrollPreregistrationEnrollmentRequest) ExecuteTyped() (EnrollmentInitializationResponse, *APIResponse, error) {
+	return decodeResponse[EnrollmentInitializationResponse](r.Execute())
+}
+
 /*
 EnrollPreregistrationEnrollment Enroll a Preregistered WebAuthn Factor
 
@@ -503,7 +515,7 @@ func (a *WebAuthnPreregistrationAPIService) EnrollPreregistrationEnrollmentExecu
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "WebAuthnPreregistrationAPIService.EnrollPreregistrationEnrollment")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/webauthn-registration/api/v1/enroll"
@@ -553,7 +565,7 @@ func (a *WebAuthnPreregistrationAPIService) EnrollPreregistrationEnrollmentExecu
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -568,6 +580,7 @@ func (a *WebAuthnPreregistrationAPIService) EnrollPreregistrationEnrollmentExecu
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -682,7 +695,7 @@ func (a *WebAuthnPreregistrationAPIService) GenerateFulfillmentRequestExecute(r
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "WebAuthnPreregistrationAPIService.GenerateFulfillmentRequest")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/webauthn-registration/api/v1/initiate-fulfillment-request"
@@ -732,7 +745,7 @@ func (a *WebAuthnPreregistrationAPIService) GenerateFulfillmentRequestExecute(r
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -747,6 +760,7 @@ func (a *WebAuthnPreregistrationAPIService) GenerateFulfillmentRequestExecute(r
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -807,6 +821,11 @@ func (r ApiListWebAuthnPreregistrationFactorsRequest) Execute() (*APIResponse, e
 	return r.ApiService.ListWebAuthnPreregistrationFactorsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []WebAuthnPreregistrationFactor.
+func (r ApiListWebAuthnPreregistrationFactorsRequest) ExecuteTyped() ([]WebAuthnPreregistrationFactor, *APIResponse, error) {
+	return decodeResponse[[]WebAuthnPreregistrationFactor](r.Execute())
+}
+
 /*
 ListWebAuthnPreregistrationFactors List all WebAuthn Preregistration Factors
 
@@ -846,7 +865,7 @@ func (a *WebAuthnPreregistrationAPIService) ListWebAuthnPreregistrationFactorsEx
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "WebAuthnPreregistrationAPIService.ListWebAuthnPreregistrationFactors")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/webauthn-registration/api/v1/users/{userId}/enrollments"
@@ -894,7 +913,7 @@ func (a *WebAuthnPreregistrationAPIService) ListWebAuthnPreregistrationFactorsEx
 	localVarHTTPResponse, err = a.client.Do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -909,6 +928,7 @@ func (a *WebAuthnPreregistrationAPIService) ListWebAuthnPreregistrationFactorsEx
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -176,6 +176,11 @@ func (r ApiCreateSubmissionRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.CreateSubmissionExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into SubmissionResponse.
+func (r ApiCreateSubmissionRequest) ExecuteTyped() (SubmissionResponse, *APIResponse, error) {
+	return decodeResponse[SubmissionResponse](r.Execute())
+}
+
 /*
 CreateSubmission Create an OIN Integration
 
@@ -216,7 +221,7 @@ func (a *YourOinIntegrationsAPIService) CreateSubmissionExecute(r ApiCreateSubmi
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "YourOinIntegrationsAPIService.CreateSubmission")
 	if err != nil {
 		// TODU
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/integrations/api/v1/submissions"
@@ -269,7 +274,7 @@ func (a *YourOinIntegrationsAPIService) CreateSubmissionExecute(r ApiCreateSubmi
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
 		// TODU
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -285,6 +290,7 @@ func (a *YourOinIntegrationsAPIService) CreateSubmissionExecute(r ApiCreateSubmi
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -368,6 +374,11 @@ func (r ApiGetSubmissionByOperationIdRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetSubmissionByOperationIdExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into SubmissionResponse.
+func (r ApiGetSubmissionByOperationIdRequest) ExecuteTyped() (SubmissionResponse, *APIResponse, error) {
+	return decodeResponse[SubmissionResponse](r.Execute())
+}
+
 /*
 GetSubmissionByOperationId Retrieve an OIN Integration
 
@@ -410,7 +421,7 @@ func (a *YourOinIntegrationsAPIService) GetSubmissionByOperationIdExecute(r ApiG
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "YourOinIntegrationsAPIService.GetSubmissionByOperationId")
 	if err != nil {
 		// TODU
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/integrations/api/v1/submissions/{submissionId}"
@@ -461,7 +472,7 @@ func (a *YourOinIntegrationsAPIService) GetSubmissionByOperationIdExecute(r ApiG
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
 		// TODU
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -477,6 +488,7 @@ func (a *YourOinIntegrationsAPIService) GetSubmissionByOperationIdExecute(r ApiG
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -560,6 +572,11 @@ func (r ApiGetSubmissionTestInfoRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.GetSubmissionTestInfoExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into TestInfo.
+func (r ApiGetSubmissionTestInfoRequest) ExecuteTyped() (TestInfo, *APIResponse, error) {
+	return decodeResponse[TestInfo](r.Execute())
+}
+
 /*
 GetSubmissionTestInfo Retrieve an OIN Integration Testing Information
 
@@ -602,7 +619,7 @@ func (a *YourOinIntegrationsAPIService) GetSubmissionTestInfoExecute(r ApiGetSub
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "YourOinIntegrationsAPIService.GetSubmissionTestInfo")
 	if err != nil {
 		// TODU
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/integrations/api/v1/submissions/{submissionId}/testing"
@@ -653,7 +670,7 @@ func (a *YourOinIntegrationsAPIService) GetSubmissionTestInfoExecute(r ApiGetSub
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
 		// TODU
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -669,6 +686,7 @@ func (a *YourOinIntegrationsAPIService) GetSubmissionTestInfoExecute(r ApiGetSub
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -765,6 +783,11 @@ func (r ApiListSubmissionsRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ListSubmissionsExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into []SubmissionResponse.
+func (r ApiListSubmissionsRequest) ExecuteTyped() ([]SubmissionResponse, *APIResponse, error) {
+	return decodeResponse[[]SubmissionResponse](r.Execute())
+}
+
 /*
 ListSubmissions List all OIN Integrations
 
@@ -805,7 +828,7 @@ func (a *YourOinIntegrationsAPIService) ListSubmissionsExecute(r ApiListSubmissi
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "YourOinIntegrationsAPIService.ListSubmissions")
 	if err != nil {
 		// TODU
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/integrations/api/v1/submissions"
@@ -861,7 +884,7 @@ func (a *YourOinIntegrationsAPIService) ListSubmissionsExecute(r ApiListSubmissi
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
 		// TODU
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -877,6 +900,7 @@ func (a *YourOinIntegrationsAPIService) ListSubmissionsExecute(r ApiListSubmissi
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -966,6 +990,11 @@ func (r ApiReplaceSubmissionRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.ReplaceSubmissionExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into SubmissionResponse.
+func (r ApiReplaceSubmissionRequest) ExecuteTyped() (SubmissionResponse, *APIResponse, error) {
+	return decodeResponse[SubmissionResponse](r.Execute())
+}
+
 /*
 ReplaceSubmission Replace an OIN Integration
 
@@ -1008,7 +1037,7 @@ func (a *YourOinIntegrationsAPIService) ReplaceSubmissionExecute(r ApiReplaceSub
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "YourOinIntegrationsAPIService.ReplaceSubmission")
 	if err != nil {
 		// TODU
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/integrations/api/v1/submissions/{submissionId}"
@@ -1062,7 +1091,7 @@ func (a *YourOinIntegrationsAPIService) ReplaceSubmissionExecute(r ApiReplaceSub
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
 		// TODU
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1078,6 +1107,7 @@ func (a *YourOinIntegrationsAPIService) ReplaceSubmissionExecute(r ApiReplaceSub
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1201,7 +1231,7 @@ func (a *YourOinIntegrationsAPIService) SubmitSubmissionExecute(r ApiSubmitSubmi
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "YourOinIntegrationsAPIService.SubmitSubmission")
 	if err != nil {
 		// TODU
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/integrations/api/v1/submissions/{submissionId}/submit"
@@ -1252,7 +1282,7 @@ func (a *YourOinIntegrationsAPIService) SubmitSubmissionExecute(r ApiSubmitSubmi
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
 		// TODU
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1268,6 +1298,7 @@ func (a *YourOinIntegrationsAPIService) SubmitSubmissionExecute(r ApiSubmitSubmi
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1394,7 +1425,7 @@ func (a *YourOinIntegrationsAPIService) UploadSubmissionLogoExecute(r ApiUploadS
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "YourOinIntegrationsAPIService.UploadSubmissionLogo")
 	if err != nil {
 		// TODU
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/integrations/api/v1/submissions/logo"
@@ -1461,7 +1492,7 @@ func (a *YourOinIntegrationsAPIService) UploadSubmissionLogoExecute(r ApiUploadS
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
 		// TODU
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1477,6 +1508,7 @@ func (a *YourOinIntegrationsAPIService) UploadSubmissionLogoExecute(r ApiUploadS
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1566,6 +1598,11 @@ func (r ApiUpsertSubmissionTestInfoRequest) Execute() (*APIResponse, error) {
 	return r.ApiService.UpsertSubmissionTestInfoExecute(r)
 }
 
+// ExecuteTyped executes the request and decodes the response into TestInfo.
+func (r ApiUpsertSubmissionTestInfoRequest) ExecuteTyped() (TestInfo, *APIResponse, error) {
+	return decodeResponse[TestInfo](r.Execute())
+}
+
 /*
 UpsertSubmissionTestInfo Upsert an OIN Integration Testing Information
 
@@ -1608,7 +1645,7 @@ func (a *YourOinIntegrationsAPIService) UpsertSubmissionTestInfoExecute(r ApiUps
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "YourOinIntegrationsAPIService.UpsertSubmissionTestInfo")
 	if err != nil {
 		// TODU
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarPath := localBasePath + "/integrations/api/v1/submissions/{submissionId}/testing"
@@ -1662,7 +1699,7 @@ func (a *YourOinIntegrationsAPIService) UpsertSubmissionTestInfoExecute(r ApiUps
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
 		// TODU
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), cause: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1678,6 +1715,7 @@ func (a *YourOinIntegrationsAPIService) UpsertSubmissionTestInfoExecute(r ApiUps
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			cause: newOktaError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
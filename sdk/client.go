@@ -14,7 +14,6 @@ package sdk
 import (
 	"bytes"
 	"context"
-	"crypto/ecdsa"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/sha256"
@@ -38,6 +37,8 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 	"unicode/utf8"
 
@@ -65,11 +66,18 @@ const (
 // APIClient manages communication with the Okta Admin Management API v5.1.0
 // In most cases there should be only one, shared, APIClient.
 type APIClient struct {
-	cfg        *Configuration
-	common     service // Reuse a single struct instead of allocating one for each service on the heap.
-	cache      Cache
-	tokenCache *goCache.Cache
-	freshcache bool
+	cfg            *Configuration
+	common         service // Reuse a single struct instead of allocating one for each service on the heap.
+	cache          Cache
+	cachePrincipal string
+	tokenCache     *goCache.Cache
+	tokenLock      sync.RWMutex
+	doer           Doer
+	caller         Doer
+	secrets        *secretResolver
+	rateGovernor   *rateGovernor
+	telemetry      *telemetry
+	refreshNext    atomic.Bool
 
 	// API Services
 
@@ -259,121 +267,95 @@ func (a *BearerAuth) Authorize(method, URL string) error {
 }
 
 type PrivateKeyAuth struct {
-	tokenCache       *goCache.Cache
-	httpClient       *http.Client
-	privateKeySigner jose.Signer
-	privateKey       string
-	privateKeyId     string
-	clientId         string
-	orgURL           string
-	userAgent        string
-	scopes           []string
-	maxRetries       int32
-	maxBackoff       int64
-	req              *http.Request
+	tokenCache           *goCache.Cache
+	tokenLock            *sync.RWMutex
+	httpClient           Doer
+	privateKeySigner     jose.Signer
+	privateKey           string
+	privateKeyFile       string
+	privateKeyId         string
+	privateKeyAlgorithm  string
+	privateKeyPassphrase PassphraseFunc
+	clientId             string
+	orgURL               string
+	userAgent            string
+	scopes               []string
+	maxRetries           int32
+	maxBackoff           int64
+	req                  *http.Request
 }
 
 type PrivateKeyAuthConfig struct {
-	TokenCache       *goCache.Cache
-	HttpClient       *http.Client
-	PrivateKeySigner jose.Signer
-	PrivateKey       string
-	PrivateKeyId     string
-	ClientId         string
-	OrgURL           string
-	UserAgent        string
-	Scopes           []string
-	MaxRetries       int32
-	MaxBackoff       int64
-	Req              *http.Request
+	TokenCache           *goCache.Cache
+	TokenLock            *sync.RWMutex
+	HttpClient           Doer
+	PrivateKeySigner     jose.Signer
+	PrivateKey           string
+	PrivateKeyFile       string
+	PrivateKeyId         string
+	PrivateKeyAlgorithm  string
+	PrivateKeyPassphrase PassphraseFunc
+	ClientId             string
+	OrgURL               string
+	UserAgent            string
+	Scopes               []string
+	MaxRetries           int32
+	MaxBackoff           int64
+	Req                  *http.Request
 }
 
 func NewPrivateKeyAuth(config PrivateKeyAuthConfig) *PrivateKeyAuth {
 	return &PrivateKeyAuth{
-		tokenCache:       config.TokenCache,
-		httpClient:       config.HttpClient,
-		privateKeySigner: config.PrivateKeySigner,
-		privateKey:       config.PrivateKey,
-		privateKeyId:     config.PrivateKeyId,
-		clientId:         config.ClientId,
-		orgURL:           config.OrgURL,
-		userAgent:        config.UserAgent,
-		scopes:           config.Scopes,
-		maxRetries:       config.MaxRetries,
-		maxBackoff:       config.MaxBackoff,
-		req:              config.Req,
+		tokenCache:           config.TokenCache,
+		tokenLock:            config.TokenLock,
+		httpClient:           config.HttpClient,
+		privateKeySigner:     config.PrivateKeySigner,
+		privateKey:           config.PrivateKey,
+		privateKeyFile:       config.PrivateKeyFile,
+		privateKeyId:         config.PrivateKeyId,
+		privateKeyAlgorithm:  config.PrivateKeyAlgorithm,
+		privateKeyPassphrase: config.PrivateKeyPassphrase,
+		clientId:             config.ClientId,
+		orgURL:               config.OrgURL,
+		userAgent:            config.UserAgent,
+		scopes:               config.Scopes,
+		maxRetries:           config.MaxRetries,
+		maxBackoff:           config.MaxBackoff,
+		req:                  config.Req,
 	}
 }
 
 func (a *PrivateKeyAuth) Authorize(method, URL string) error {
-	accessToken, hasToken := a.tokenCache.Get(AccessTokenCacheKey)
-	if hasToken && accessToken != "" {
-		accessTokenWithTokenType := accessToken.(string)
-		a.req.Header.Add("Authorization", accessTokenWithTokenType)
-		nonce, hasNonce := a.tokenCache.Get(DpopAccessTokenNonce)
-		if hasNonce && nonce != "" {
-			privateKey, ok := a.tokenCache.Get(DpopAccessTokenPrivateKey)
-			if ok && privateKey != nil {
-				res := strings.Split(accessTokenWithTokenType, " ")
-				if len(res) != 2 {
-					return errors.New("Unidentified access token")
-				}
-				dpopJWT, err := generateDpopJWT(privateKey.(*rsa.PrivateKey), method, URL, nonce.(string), res[1])
+	return authorizeWithAccessToken(a.tokenCache, a.tokenLock, a.req, method, URL, func() (*RequestAccessToken, string, *rsa.PrivateKey, error) {
+		if a.privateKeySigner == nil {
+			privateKey := a.privateKey
+			if privateKey == "" && a.privateKeyFile != "" {
+				content, err := os.ReadFile(a.privateKeyFile)
 				if err != nil {
-					return err
+					return nil, "", nil, fmt.Errorf("failed to read private key file: %w", err)
 				}
-				a.req.Header.Set("Dpop", dpopJWT)
-				a.req.Header.Set("x-okta-user-agent-extended", "isDPoP:true")
-			} else {
-				return errors.New("Using Dpop but signing key not found")
+				privateKey = string(content)
 			}
-		}
-	} else {
-		if a.privateKeySigner == nil {
 			var err error
-			a.privateKeySigner, err = createKeySigner(a.privateKey, a.privateKeyId)
+			a.privateKeySigner, err = createKeySigner(privateKey, a.privateKeyId, a.privateKeyAlgorithm, a.privateKeyPassphrase)
 			if err != nil {
-				return err
+				return nil, "", nil, err
 			}
 		}
 
 		clientAssertion, err := createClientAssertion(a.orgURL, a.clientId, a.privateKeySigner)
 		if err != nil {
-			return err
-		}
-
-		accessToken, nonce, privateKey, err := getAccessTokenForPrivateKey(a.httpClient, a.orgURL, clientAssertion, a.userAgent, a.scopes, a.maxRetries, a.maxBackoff, a.clientId, a.privateKeySigner)
-		if err != nil {
-			return err
-		}
-
-		if accessToken == nil {
-			return errors.New("Empty access token")
-		}
-
-		a.req.Header.Set("Authorization", fmt.Sprintf("%v %v", accessToken.TokenType, accessToken.AccessToken))
-		if accessToken.TokenType == "DPoP" {
-			dpopJWT, err := generateDpopJWT(privateKey, method, URL, nonce, accessToken.AccessToken)
-			if err != nil {
-				return err
-			}
-			a.req.Header.Set("Dpop", dpopJWT)
-			a.req.Header.Set("x-okta-user-agent-extended", "isDPoP:true")
+			return nil, "", nil, err
 		}
 
-		// Trim a couple of seconds off calculated expiry so cache expiry
-		// occures before Okta server side expiry.
-		expiration := accessToken.ExpiresIn - 2
-		a.tokenCache.Set(AccessTokenCacheKey, fmt.Sprintf("%v %v", accessToken.TokenType, accessToken.AccessToken), time.Second*time.Duration(expiration))
-		a.tokenCache.Set(DpopAccessTokenNonce, nonce, time.Second*time.Duration(expiration))
-		a.tokenCache.Set(DpopAccessTokenPrivateKey, privateKey, time.Second*time.Duration(expiration))
-	}
-	return nil
+		return getAccessTokenForPrivateKey(a.httpClient, a.orgURL, clientAssertion, a.userAgent, a.scopes, a.maxRetries, a.maxBackoff, a.clientId, a.privateKeySigner)
+	})
 }
 
 type JWTAuth struct {
 	tokenCache      *goCache.Cache
-	httpClient      *http.Client
+	tokenLock       *sync.RWMutex
+	httpClient      Doer
 	orgURL          string
 	userAgent       string
 	scopes          []string
@@ -385,7 +367,8 @@ type JWTAuth struct {
 
 type JWTAuthConfig struct {
 	TokenCache      *goCache.Cache
-	HttpClient      *http.Client
+	TokenLock       *sync.RWMutex
+	HttpClient      Doer
 	OrgURL          string
 	UserAgent       string
 	Scopes          []string
@@ -398,6 +381,7 @@ type JWTAuthConfig struct {
 func NewJWTAuth(config JWTAuthConfig) *JWTAuth {
 	return &JWTAuth{
 		tokenCache:      config.TokenCache,
+		tokenLock:       config.TokenLock,
 		httpClient:      config.HttpClient,
 		orgURL:          config.OrgURL,
 		userAgent:       config.UserAgent,
@@ -410,98 +394,87 @@ func NewJWTAuth(config JWTAuthConfig) *JWTAuth {
 }
 
 func (a *JWTAuth) Authorize(method, URL string) error {
-	accessToken, hasToken := a.tokenCache.Get(AccessTokenCacheKey)
-	if hasToken && accessToken != "" {
-		accessTokenWithTokenType := accessToken.(string)
-		a.req.Header.Add("Authorization", accessTokenWithTokenType)
-		nonce, hasNonce := a.tokenCache.Get(DpopAccessTokenNonce)
-		if hasNonce && nonce != "" {
-			privateKey, ok := a.tokenCache.Get(DpopAccessTokenPrivateKey)
-			if ok && privateKey != nil {
-				res := strings.Split(accessTokenWithTokenType, " ")
-				if len(res) != 2 {
-					return errors.New("Unidentified access token")
-				}
-				dpopJWT, err := generateDpopJWT(privateKey.(*rsa.PrivateKey), method, URL, nonce.(string), res[1])
-				if err != nil {
-					return err
-				}
-				a.req.Header.Set("Dpop", dpopJWT)
-				a.req.Header.Set("x-okta-user-agent-extended", "isDPoP:true")
-			} else {
-				return errors.New("Using Dpop but signing key not found")
-			}
-		}
-	} else {
-		accessToken, nonce, privateKey, err := getAccessTokenForPrivateKey(a.httpClient, a.orgURL, a.clientAssertion, a.userAgent, a.scopes, a.maxRetries, a.maxBackoff, "", nil)
-		if err != nil {
-			return err
-		}
-
-		if accessToken == nil {
-			return errors.New("Empty access token")
-		}
-
-		a.req.Header.Set("Authorization", fmt.Sprintf("%v %v", accessToken.TokenType, accessToken.AccessToken))
-		if accessToken.TokenType == "DPoP" {
-			dpopJWT, err := generateDpopJWT(privateKey, method, URL, nonce, accessToken.AccessToken)
-			if err != nil {
-				return err
-			}
-			a.req.Header.Set("Dpop", dpopJWT)
-			a.req.Header.Set("x-okta-user-agent-extended", "isDPoP:true")
-		}
-
-		// Trim a couple of seconds off calculated expiry so cache expiry
-		// occures before Okta server side expiry.
-		expiration := accessToken.ExpiresIn - 2
-		a.tokenCache.Set(AccessTokenCacheKey, fmt.Sprintf("%v %v", accessToken.TokenType, accessToken.AccessToken), time.Second*time.Duration(expiration))
-		a.tokenCache.Set(DpopAccessTokenNonce, nonce, time.Second*time.Duration(expiration))
-		a.tokenCache.Set(DpopAccessTokenPrivateKey, privateKey, time.Second*time.Duration(expiration))
-	}
-	return nil
+	return authorizeWithAccessToken(a.tokenCache, a.tokenLock, a.req, method, URL, func() (*RequestAccessToken, string, *rsa.PrivateKey, error) {
+		return getAccessTokenForPrivateKey(a.httpClient, a.orgURL, a.clientAssertion, a.userAgent, a.scopes, a.maxRetries, a.maxBackoff, "", nil)
+	})
 }
 
-func createKeySigner(privateKey, privateKeyID string) (jose.Signer, error) {
-	var signerOptions *jose.SignerOptions
-	if privateKeyID != "" {
-		signerOptions = (&jose.SignerOptions{}).WithHeader("kid", privateKeyID)
+// authorizeWithAccessToken sets the access token cached in tokenCache on req,
+// calling fetch for a new one when none is cached. Holding tokenLock, the
+// callers sharing tokenCache wait for a single fetch instead of each minting
+// a token.
+func authorizeWithAccessToken(tokenCache *goCache.Cache, tokenLock *sync.RWMutex, req *http.Request, method, URL string, fetch func() (*RequestAccessToken, string, *rsa.PrivateKey, error)) error {
+	if tokenLock == nil {
+		tokenLock = &sync.RWMutex{}
+	}
+	tokenLock.RLock()
+	ok, err := authorizeWithCachedAccessToken(tokenCache, req, method, URL)
+	tokenLock.RUnlock()
+	if ok || err != nil {
+		return err
 	}
 
-	priv := []byte(strings.ReplaceAll(privateKey, `\n`, "\n"))
+	tokenLock.Lock()
+	defer tokenLock.Unlock()
+	// another caller may have fetched the token meanwhile
+	if ok, err := authorizeWithCachedAccessToken(tokenCache, req, method, URL); ok || err != nil {
+		return err
+	}
+	accessToken, nonce, privateKey, err := fetch()
+	if err != nil {
+		return err
+	}
 
-	privPem, _ := pem.Decode(priv)
-	if privPem == nil {
-		return nil, errors.New("invalid private key")
+	if accessToken == nil {
+		return errors.New("Empty access token")
 	}
-	if privPem.Type == "RSA PRIVATE KEY" {
-		parsedKey, err := x509.ParsePKCS1PrivateKey(privPem.Bytes)
+
+	req.Header.Set("Authorization", fmt.Sprintf("%v %v", accessToken.TokenType, accessToken.AccessToken))
+	if accessToken.TokenType == "DPoP" {
+		dpopJWT, err := generateDpopJWT(privateKey, method, URL, nonce, accessToken.AccessToken)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		return jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: parsedKey}, signerOptions)
+		req.Header.Set("Dpop", dpopJWT)
+		req.Header.Set("x-okta-user-agent-extended", "isDPoP:true")
 	}
-	if privPem.Type == "PRIVATE KEY" {
-		parsedKey, err := x509.ParsePKCS8PrivateKey(privPem.Bytes)
-		if err != nil {
-			return nil, err
+
+	// Trim a couple of seconds off calculated expiry so cache expiry
+	// occures before Okta server side expiry.
+	expiration := accessToken.ExpiresIn - 2
+	tokenCache.Set(AccessTokenCacheKey, fmt.Sprintf("%v %v", accessToken.TokenType, accessToken.AccessToken), time.Second*time.Duration(expiration))
+	tokenCache.Set(DpopAccessTokenNonce, nonce, time.Second*time.Duration(expiration))
+	tokenCache.Set(DpopAccessTokenPrivateKey, privateKey, time.Second*time.Duration(expiration))
+	return nil
+}
+
+// authorizeWithCachedAccessToken sets the access token cached in tokenCache
+// on req, it reports false when there is none.
+func authorizeWithCachedAccessToken(tokenCache *goCache.Cache, req *http.Request, method, URL string) (bool, error) {
+	accessToken, hasToken := tokenCache.Get(AccessTokenCacheKey)
+	if !hasToken || accessToken == "" {
+		return false, nil
+	}
+	accessTokenWithTokenType := accessToken.(string)
+	req.Header.Set("Authorization", accessTokenWithTokenType)
+	nonce, hasNonce := tokenCache.Get(DpopAccessTokenNonce)
+	if hasNonce && nonce != "" {
+		privateKey, ok := tokenCache.Get(DpopAccessTokenPrivateKey)
+		if !ok || privateKey == nil {
+			return false, errors.New("Using Dpop but signing key not found")
 		}
-		var alg jose.SignatureAlgorithm
-		switch parsedKey.(type) {
-		case *rsa.PrivateKey:
-			alg = jose.RS256
-		case *ecdsa.PrivateKey:
-			alg = jose.ES256 // TODO handle ES384 or ES512 ?
-		default:
-			// TODO are either of these also valid?
-			// ed25519.PrivateKey:
-			// *ecdh.PrivateKey
-			return nil, fmt.Errorf("private key %q is unknown pkcs#8 format type", privPem.Type)
+		res := strings.Split(accessTokenWithTokenType, " ")
+		if len(res) != 2 {
+			return false, errors.New("Unidentified access token")
 		}
-		return jose.NewSigner(jose.SigningKey{Algorithm: alg, Key: parsedKey}, signerOptions)
+		dpopJWT, err := generateDpopJWT(privateKey.(*rsa.PrivateKey), method, URL, nonce.(string), res[1])
+		if err != nil {
+			return false, err
+		}
+		req.Header.Set("Dpop", dpopJWT)
+		req.Header.Set("x-okta-user-agent-extended", "isDPoP:true")
 	}
-
-	return nil, fmt.Errorf("private key %q is not pkcs#1 or pkcs#8 format", privPem.Type)
+	return true, nil
 }
 
 func createClientAssertion(orgURL, clientID string, privateKeySinger jose.Signer) (clientAssertion string, err error) {
@@ -517,7 +490,7 @@ func createClientAssertion(orgURL, clientID string, privateKeySinger jose.Signer
 	return jwtBuilder.CompactSerialize()
 }
 
-func getAccessTokenForPrivateKey(httpClient *http.Client, orgURL, clientAssertion, userAgent string, scopes []string, maxRetries int32, maxBackoff int64, clientID string, signer jose.Signer) (*RequestAccessToken, string, *rsa.PrivateKey, error) {
+func getAccessTokenForPrivateKey(httpClient Doer, orgURL, clientAssertion, userAgent string, scopes []string, maxRetries int32, maxBackoff int64, clientID string, signer jose.Signer) (*RequestAccessToken, string, *rsa.PrivateKey, error) {
 	query := url.Values{}
 	tokenRequestURL := orgURL + "/oauth2/v1/token"
 
@@ -573,7 +546,7 @@ func getAccessTokenForPrivateKey(httpClient *http.Client, orgURL, clientAssertio
 	return accessToken, "", nil, nil
 }
 
-func getAccessTokenForDpopPrivateKey(tokenRequest *http.Request, httpClient *http.Client, orgURL, nonce string, maxRetries int32, maxBackoff int64, clientAssertion string, scopes string, clientID string, signer jose.Signer) (*RequestAccessToken, string, *rsa.PrivateKey, error) {
+func getAccessTokenForDpopPrivateKey(tokenRequest *http.Request, httpClient Doer, orgURL, nonce string, maxRetries int32, maxBackoff int64, clientAssertion string, scopes string, clientID string, signer jose.Signer) (*RequestAccessToken, string, *rsa.PrivateKey, error) {
 	privateKey, err := generatePrivateKey(2048)
 	if err != nil {
 		return nil, "", nil, err
@@ -633,17 +606,9 @@ func getAccessTokenForDpopPrivateKey(tokenRequest *http.Request, httpClient *htt
 // NewAPIClient creates a new API client. Requires a userAgent string describing your application.
 // optionally a custom http.Client to allow for advanced features such as caching.
 func NewAPIClient(cfg *Configuration) *APIClient {
+	secrets := newSecretResolver(time.Duration(cfg.Okta.Client.SecretTimeout) * time.Second)
 	if cfg.HTTPClient == nil {
-		cfg.HTTPClient = http.DefaultClient
-	}
-
-	if cfg.Okta.Client.Proxy.Host != "" {
-		var proxyURL url.URL
-		proxyURL.Host = fmt.Sprintf("%v:%v", cfg.Okta.Client.Proxy.Host, cfg.Okta.Client.Proxy.Port)
-		up := url.UserPassword(cfg.Okta.Client.Proxy.Username, cfg.Okta.Client.Proxy.Password)
-		proxyURL.User = up
-		transport := http.Transport{Proxy: http.ProxyURL(&proxyURL)}
-		cfg.HTTPClient = &http.Client{Transport: &transport}
+		cfg.HTTPClient = newHTTPClient(cfg, secrets)
 	}
 
 	var oktaCache Cache
@@ -661,7 +626,15 @@ func NewAPIClient(cfg *Configuration) *APIClient {
 	c := &APIClient{}
 	c.cfg = cfg
 	c.cache = oktaCache
+	c.cachePrincipal = cachePrincipal(cfg)
 	c.tokenCache = goCache.New(5*time.Minute, 10*time.Minute)
+	c.secrets = secrets
+	c.rateGovernor = newRateGovernor(cfg.Okta.Client.RateLimit.Headroom)
+	c.telemetry = newTelemetry(cfg)
+	c.doer = chainMiddlewares(cfg.HTTPClient, cfg.Middlewares)
+	c.caller = chainMiddlewares(DoerFunc(func(req *http.Request) (*http.Response, error) {
+		return c.send(req.Context(), req)
+	}), cfg.CallMiddlewares)
 	c.common.client = c
 
 	// API Services
@@ -838,7 +811,7 @@ func (c *APIClient) callAPI(request *http.Request) (*http.Response, error) {
 		log.Printf("\n%s\n", string(dump))
 	}
 
-	resp, err := c.cfg.HTTPClient.Do(request)
+	resp, err := c.doer.Do(request)
 	if err != nil {
 		return resp, err
 	}
@@ -873,8 +846,8 @@ func (c *APIClient) PrepareRequest(
 	headerParams map[string]string,
 	queryParams url.Values,
 	formParams url.Values,
-	formFiles []formFile) (localVarRequest *http.Request, err error) {
-
+	formFiles []formFile,
+) (localVarRequest *http.Request, err error) {
 	var body *bytes.Buffer
 
 	// Detect postBody type and post.
@@ -1024,51 +997,118 @@ func (c *APIClient) PrepareRequest(
 	}
 
 	// This will override the auth in context
+	auth, err := c.authorization(localVarRequest)
+	if err != nil {
+		return nil, err
+	}
+	err = auth.Authorize(method, urlWithoutQuery.String())
+	if err != nil {
+		return nil, err
+	}
+
+	for header, value := range c.cfg.DefaultHeader {
+		localVarRequest.Header.Add(header, value)
+	}
+	return localVarRequest, nil
+}
+
+// authorization returns the Authorization for the configured authorization mode, bound to req.
+func (c *APIClient) authorization(req *http.Request) (Authorization, error) {
 	var auth Authorization
 	switch c.cfg.Okta.Client.AuthorizationMode {
 	case "SSWS":
-		auth = NewSSWSAuth(c.cfg.Okta.Client.Token, localVarRequest)
+		token, err := c.secrets.resolve("token", c.cfg.Okta.Client.Token)
+		if err != nil {
+			return nil, err
+		}
+		auth = NewSSWSAuth(token, req)
 	case "Bearer":
-		auth = NewBearerAuth(c.cfg.Okta.Client.Token, localVarRequest)
+		token, err := c.secrets.resolve("token", c.cfg.Okta.Client.Token)
+		if err != nil {
+			return nil, err
+		}
+		auth = NewBearerAuth(token, req)
 	case "PrivateKey":
+		var privateKey string
+		if c.cfg.PrivateKeySigner == nil {
+			var err error
+			if privateKey, err = c.secrets.resolve("privateKey", c.cfg.Okta.Client.PrivateKey); err != nil {
+				return nil, err
+			}
+		}
 		auth = NewPrivateKeyAuth(PrivateKeyAuthConfig{
-			TokenCache:       c.tokenCache,
-			HttpClient:       c.cfg.HTTPClient,
-			PrivateKeySigner: c.cfg.PrivateKeySigner,
-			PrivateKey:       c.cfg.Okta.Client.PrivateKey,
-			PrivateKeyId:     c.cfg.Okta.Client.PrivateKeyId,
-			ClientId:         c.cfg.Okta.Client.ClientId,
-			OrgURL:           c.cfg.Okta.Client.OrgUrl,
-			UserAgent:        NewUserAgent(c.cfg).String(),
-			Scopes:           c.cfg.Okta.Client.Scopes,
-			MaxRetries:       c.cfg.Okta.Client.RateLimit.MaxRetries,
-			MaxBackoff:       c.cfg.Okta.Client.RateLimit.MaxBackoff,
-			Req:              localVarRequest,
+			TokenCache:           c.tokenCache,
+			TokenLock:            &c.tokenLock,
+			HttpClient:           c.telemetry.tokenDoer(req.Context(), c.doer),
+			PrivateKeySigner:     c.cfg.PrivateKeySigner,
+			PrivateKey:           privateKey,
+			PrivateKeyFile:       c.cfg.Okta.Client.PrivateKeyFile,
+			PrivateKeyId:         c.cfg.Okta.Client.PrivateKeyId,
+			PrivateKeyAlgorithm:  c.cfg.Okta.Client.PrivateKeyAlgorithm,
+			PrivateKeyPassphrase: c.privateKeyPassphrase(),
+			ClientId:             c.cfg.Okta.Client.ClientId,
+			OrgURL:               c.cfg.Okta.Client.OrgUrl,
+			UserAgent:            NewUserAgent(c.cfg).String(),
+			Scopes:               c.cfg.Okta.Client.Scopes,
+			MaxRetries:           c.cfg.Okta.Client.RateLimit.MaxRetries,
+			MaxBackoff:           c.cfg.Okta.Client.RateLimit.MaxBackoff,
+			Req:                  req,
 		})
 	case "JWT":
+		clientAssertion, err := c.secrets.resolve("clientAssertion", c.cfg.Okta.Client.ClientAssertion)
+		if err != nil {
+			return nil, err
+		}
 		auth = NewJWTAuth(JWTAuthConfig{
 			TokenCache:      c.tokenCache,
-			HttpClient:      c.cfg.HTTPClient,
+			TokenLock:       &c.tokenLock,
+			HttpClient:      c.telemetry.tokenDoer(req.Context(), c.doer),
 			OrgURL:          c.cfg.Okta.Client.OrgUrl,
 			UserAgent:       NewUserAgent(c.cfg).String(),
 			Scopes:          c.cfg.Okta.Client.Scopes,
-			ClientAssertion: c.cfg.Okta.Client.ClientAssertion,
+			ClientAssertion: clientAssertion,
 			MaxRetries:      c.cfg.Okta.Client.RateLimit.MaxRetries,
 			MaxBackoff:      c.cfg.Okta.Client.RateLimit.MaxBackoff,
-			Req:             localVarRequest,
+			Req:             req,
 		})
 	default:
 		return nil, fmt.Errorf("unknown authorization mode %v", c.cfg.Okta.Client.AuthorizationMode)
 	}
-	err = auth.Authorize(method, urlWithoutQuery.String())
-	if err != nil {
-		return nil, err
+	return auth, nil
+}
+
+// privateKeyPassphrase returns the configured passphrase of an encrypted
+// private key, or the callback when there is none.
+func (c *APIClient) privateKeyPassphrase() PassphraseFunc {
+	if c.cfg.Okta.Client.PrivateKeyPassphrase == "" {
+		return c.cfg.PrivateKeyPassphraseFunc
+	}
+	return func() ([]byte, error) {
+		passphrase, err := c.secrets.resolve("privateKeyPassphrase", c.cfg.Okta.Client.PrivateKeyPassphrase)
+		return []byte(passphrase), err
 	}
+}
 
-	for header, value := range c.cfg.DefaultHeader {
-		localVarRequest.Header.Add(header, value)
+// AccessToken returns the token type and credential the client authorizes
+// requests with. In PrivateKey and JWT modes a new access token is requested
+// from the org when none is cached.
+func (c *APIClient) AccessToken() (tokenType string, token string, err error) {
+	req, err := http.NewRequest(http.MethodGet, c.cfg.Okta.Client.OrgUrl, nil)
+	if err != nil {
+		return "", "", err
 	}
-	return localVarRequest, nil
+	auth, err := c.authorization(req)
+	if err != nil {
+		return "", "", err
+	}
+	if err = auth.Authorize(req.Method, req.URL.String()); err != nil {
+		return "", "", err
+	}
+	res := strings.SplitN(req.Header.Get("Authorization"), " ", 2)
+	if len(res) != 2 {
+		return "", "", errors.New("Unidentified access token")
+	}
+	return res[0], res[1], nil
 }
 
 func (c *APIClient) decode(v interface{}, b []byte, contentType string) (err error) {
@@ -1114,33 +1154,92 @@ func (c *APIClient) decode(v interface{}, b []byte, contentType string) (err err
 	return errors.New("undefined response type")
 }
 
+// RefreshNext makes the next GET request of the client bypass the cache.
+//
+// Deprecated: the next request is whichever request of the client comes
+// first, set ContextSkipCache on the context of the request instead.
 func (c *APIClient) RefreshNext() *APIClient {
-	c.freshcache = true
+	c.refreshNext.Store(true)
 	return c
 }
 
+// Do sends req through the cache and the retries, in a span of the tracer
+// provider of the configuration.
 func (c *APIClient) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
-	cacheKey := CreateCacheKey(req)
+	ctx, rt := c.telemetry.start(ctx, req)
+	resp, err := c.do(ctx, req.WithContext(ctx))
+	rt.end(ctx, resp, err)
+	return resp, err
+}
+
+func (c *APIClient) do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	if dryRun, err := c.dryRun(req); dryRun {
+		return nil, err
+	}
+	return c.caller.Do(req)
+}
+
+// send answers req from the cache or the org, retrying it as configured.
+func (c *APIClient) send(ctx context.Context, req *http.Request) (*http.Response, error) {
 	if req.Method != http.MethodGet {
-		c.cache.Delete(cacheKey)
+		resp, err := c.doWithRetries(ctx, req)
+		c.invalidateCache(req)
+		return resp, err
 	}
-	inCache := c.cache.Has(cacheKey)
-	if c.freshcache {
-		c.cache.Delete(cacheKey)
-		inCache = false
-		c.freshcache = false
+	cacheKey := c.cacheKey(req)
+	cached := c.cache.Get(cacheKey)
+	if skip, ok := ctx.Value(ContextSkipCache).(bool); ok && skip || c.refreshNext.CompareAndSwap(true, false) {
+		// the fresh response replaces the cached one, other callers keep
+		// being served from the cache meanwhile
+		cached = nil
 	}
-	if !inCache {
-		resp, err := c.doWithRetries(ctx, req)
-		if err != nil {
-			return nil, err
-		}
-		if resp.StatusCode >= 200 && resp.StatusCode <= 299 && req.Method == http.MethodGet {
-			c.cache.Set(cacheKey, resp)
+	if cached != nil && cacheFresh(cached) {
+		cached.Request = req
+		return cached, nil
+	}
+	if cached != nil && cached.Header.Get("ETag") != "" {
+		req.Header.Set("If-None-Match", cached.Header.Get("ETag"))
+	}
+	resp, err := c.doWithRetries(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		resp.Body.Close()
+		resp = revalidated(cached, resp)
+	}
+	if resp.StatusCode >= 200 && resp.StatusCode <= 299 && cacheable(resp) {
+		c.cache.Set(cacheKey, resp)
+	}
+	return resp, nil
+}
+
+// cacheKey is the key of the response to req in the cache, which is the
+// URL followed by the principal of the client.
+func (c *APIClient) cacheKey(req *http.Request) string {
+	return CreateCacheKey(req) + " " + c.cachePrincipal
+}
+
+// invalidateCache drops the cached responses of the resource collection
+// written to by req, for every principal: a PUT /api/v1/groups/{groupId}
+// may change the group as well as the lists of groups. The collection ends
+// at a path segment, /api/v1/groups leaves /api/v1/groupsettings alone.
+//
+// Writes reflected in other collections are not tracked, adding a user to a
+// group with PUT /api/v1/groups/{groupId}/users/{userId} leaves the cached
+// /api/v1/users/{userId}/groups stale until it expires or is refreshed with
+// ContextSkipCache.
+func (c *APIClient) invalidateCache(req *http.Request) {
+	if cache, ok := c.cache.(PrefixDeleter); ok {
+		collection := req.URL.Scheme + "://" + req.URL.Host + resourceCollection(req.URL.Path)
+		// the keys of the collection continue with its items, its query or
+		// the principal
+		for _, boundary := range []string{"/", "?", " "} {
+			cache.DeletePrefix(collection + boundary)
 		}
-		return resp, err
+		return
 	}
-	return c.cache.Get(cacheKey), nil
+	c.cache.Delete(c.cacheKey(req))
 }
 
 func (c *APIClient) doWithRetries(ctx context.Context, req *http.Request) (*http.Response, error) {
@@ -1158,41 +1257,76 @@ func (c *APIClient) doWithRetries(ctx context.Context, req *http.Request) (*http
 		resp *http.Response
 		err  error
 	)
+	// 429 responses are retried for any method since Okta did not process
+	// the request, other transient failures only for idempotent requests.
+	idempotent := isIdempotent(ctx, req)
+	var rateLimitRetries, transientRetries int32
 	bOff := &OktaBackoff{
 		Ctx:        ctx,
-		MaxRetries: c.cfg.Okta.Client.RateLimit.MaxRetries,
+		MaxRetries: c.cfg.Okta.Client.RateLimit.MaxRetries + c.cfg.Okta.Client.Retry.MaxRetries,
 	}
 	operation := func() error {
 		// Always rewind the request body when non-nil.
 		if bodyReader != nil {
 			req.Body = bodyReader()
 		}
-		resp, err = c.callAPI(req)
-		if errors.Is(err, io.EOF) {
-			// retry on EOF errors, which might be caused by network connectivity issues
-			return fmt.Errorf("network error: %w", err)
-		} else if err != nil {
-			// this is error is considered to be permanent and should not be retried
+		if err = c.rateGovernor.wait(ctx, req); err != nil {
 			return backoff.Permanent(err)
 		}
-		if !tooManyRequests(resp) {
+		resp, err = c.callAPI(req)
+		c.rateGovernor.update(req, resp)
+		switch {
+		case err != nil:
+			if !idempotent || !isTransientError(ctx, err) || transientRetries >= c.cfg.Okta.Client.Retry.MaxRetries {
+				// this is error is considered to be permanent and should not be retried
+				return backoff.Permanent(err)
+			}
+			transientRetries++
+			bOff.BackoffDuration = retryBackoff(c.cfg, transientRetries, nil)
+			err = fmt.Errorf("network error: %w", err)
+		case tooManyRequests(resp):
+			c.telemetry.tooManyRequests(ctx, req)
+			if rateLimitRetries >= c.cfg.Okta.Client.RateLimit.MaxRetries {
+				// the caller gets the error object of the org, with the
+				// time to wait before retrying
+				body, readErr := io.ReadAll(resp.Body)
+				resp.Body.Close()
+				if readErr != nil {
+					return backoff.Permanent(readErr)
+				}
+				return backoff.Permanent(newOktaError(resp, body))
+			}
+			if err = tryDrainBody(resp.Body); err != nil {
+				return err
+			}
+			var backoffDuration int64
+			// err is the outcome of the attempt, it must not be shadowed
+			if backoffDuration, err = Get429BackoffTime(resp); err != nil {
+				return err
+			}
+			if c.cfg.Okta.Client.RateLimit.MaxBackoff < backoffDuration {
+				backoffDuration = c.cfg.Okta.Client.RateLimit.MaxBackoff
+			}
+			rateLimitRetries++
+			bOff.BackoffDuration = time.Second * time.Duration(backoffDuration)
+			err = errors.New("too many requests")
+		case idempotent && retryStatusCode(c.cfg, resp.StatusCode) && transientRetries < c.cfg.Okta.Client.Retry.MaxRetries:
+			if err = tryDrainBody(resp.Body); err != nil {
+				return err
+			}
+			transientRetries++
+			bOff.BackoffDuration = retryBackoff(c.cfg, transientRetries, resp)
+			err = fmt.Errorf("server error: %v", resp.Status)
+		default:
 			return nil
 		}
-		if err = tryDrainBody(resp.Body); err != nil {
-			return err
-		}
-		backoffDuration, err := Get429BackoffTime(resp)
-		if err != nil {
-			return err
-		}
-		if c.cfg.Okta.Client.RateLimit.MaxBackoff < backoffDuration {
-			backoffDuration = c.cfg.Okta.Client.RateLimit.MaxBackoff
-		}
-		bOff.BackoffDuration = time.Second * time.Duration(backoffDuration)
 		bOff.RetryCount++
-		req.Header.Add("X-Okta-Retry-For", resp.Header.Get("X-Okta-Request-Id"))
-		req.Header.Add("X-Okta-Retry-Count", fmt.Sprint(bOff.RetryCount))
-		return errors.New("too many requests")
+		c.telemetry.retry(ctx, bOff.RetryCount, err)
+		if resp != nil {
+			req.Header.Set("X-Okta-Retry-For", resp.Header.Get("X-Okta-Request-Id"))
+		}
+		req.Header.Set("X-Okta-Retry-Count", fmt.Sprint(bOff.RetryCount))
+		return err
 	}
 	err = backoff.Retry(operation, bOff)
 	return resp, err
@@ -1336,10 +1470,13 @@ func strlen(s string) int {
 }
 
 // GenericOpenAPIError Provides access to the body, error and model on returned errors.
+// It wraps the *OktaError of responses with an error status, or the error
+// of the request, for errors.As and errors.Is.
 type GenericOpenAPIError struct {
 	body  []byte
 	error string
 	model interface{}
+	cause error
 }
 
 // Error returns non-empty string if there was an error.
@@ -1357,6 +1494,11 @@ func (e GenericOpenAPIError) Model() interface{} {
 	return e.model
 }
 
+// Unwrap returns the *OktaError or the error of the request.
+func (e GenericOpenAPIError) Unwrap() error {
+	return e.cause
+}
+
 // Okta Backoff
 type OktaBackoff struct {
 	RetryCount, MaxRetries int32
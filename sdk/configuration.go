@@ -13,6 +13,7 @@ package sdk
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -28,6 +29,8 @@ import (
 
 	"github.com/go-jose/go-jose/v3"
 	"github.com/kelseyhightower/envconfig"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
 	"gopkg.in/yaml.v3"
 )
 
@@ -68,6 +71,12 @@ var (
 
 	// ContextOperationServerVariables overrides a server configuration variables using operation specific values.
 	ContextOperationServerVariables = contextKey("serverOperationVariables")
+
+	// ContextRetryNonIdempotent takes a bool allowing POST and DELETE requests to be retried after transient failures.
+	ContextRetryNonIdempotent = contextKey("retryNonIdempotent")
+
+	// ContextSkipCache takes a bool bypassing the response cache, the response is fetched from the org and cached again.
+	ContextSkipCache = contextKey("skipCache")
 )
 
 // BasicAuth provides basic http authentication to a request passed via context using ContextBasicAuth
@@ -108,43 +117,77 @@ type Configuration struct {
 	Debug            bool              `json:"debug,omitempty"`
 	Servers          ServerConfigurations
 	OperationServers map[string]ServerConfigurations
-	HTTPClient       *http.Client
+	HTTPClient       *http.Client `ignored:"true"`
 	UserAgentExtra   string
 	Context          context.Context
 	Okta             struct {
 		Client struct {
 			Cache struct {
-				Enabled    bool  `yaml:"enabled" envconfig:"OKTA_CLIENT_CACHE_ENABLED"`
-				DefaultTtl int32 `yaml:"defaultTtl" envconfig:"OKTA_CLIENT_CACHE_DEFAULT_TTL"`
-				DefaultTti int32 `yaml:"defaultTti" envconfig:"OKTA_CLIENT_CACHE_DEFAULT_TTI"`
+				Enabled    bool   `yaml:"enabled" envconfig:"OKTA_CLIENT_CACHE_ENABLED"`
+				DefaultTtl int32  `yaml:"defaultTtl" envconfig:"OKTA_CLIENT_CACHE_DEFAULT_TTL"`
+				DefaultTti int32  `yaml:"defaultTti" envconfig:"OKTA_CLIENT_CACHE_DEFAULT_TTI"`
+				Dir        string `yaml:"dir" envconfig:"OKTA_CLIENT_CACHE_DIR"`
 			} `yaml:"cache"`
 			Proxy struct {
 				Port     int32  `yaml:"port" envconfig:"OKTA_CLIENT_PROXY_PORT"`
 				Host     string `yaml:"host" envconfig:"OKTA_CLIENT_PROXY_HOST"`
 				Username string `yaml:"username" envconfig:"OKTA_CLIENT_PROXY_USERNAME"`
 				Password string `yaml:"password" envconfig:"OKTA_CLIENT_PROXY_PASSWORD"`
+				Scheme   string `yaml:"scheme" envconfig:"OKTA_CLIENT_PROXY_SCHEME"`
 			} `yaml:"proxy"`
 			ConnectionTimeout int64 `yaml:"connectionTimeout" envconfig:"OKTA_CLIENT_CONNECTION_TIMEOUT"`
 			RequestTimeout    int64 `yaml:"requestTimeout" envconfig:"OKTA_CLIENT_REQUEST_TIMEOUT"`
 			RateLimit         struct {
 				MaxRetries int32 `yaml:"maxRetries" envconfig:"OKTA_CLIENT_RATE_LIMIT_MAX_RETRIES"`
 				MaxBackoff int64 `yaml:"maxBackoff" envconfig:"OKTA_CLIENT_RATE_LIMIT_MAX_BACKOFF"`
+				Headroom   int32 `yaml:"headroom" envconfig:"OKTA_CLIENT_RATE_LIMIT_HEADROOM"`
 			} `yaml:"rateLimit"`
-			OrgUrl            string   `yaml:"orgUrl" envconfig:"OKTA_CLIENT_ORGURL"`
-			Token             string   `yaml:"token" envconfig:"OKTA_CLIENT_TOKEN"`
-			AuthorizationMode string   `yaml:"authorizationMode" envconfig:"OKTA_CLIENT_AUTHORIZATIONMODE"`
-			ClientId          string   `yaml:"clientId" envconfig:"OKTA_CLIENT_CLIENTID"`
-			ClientAssertion   string   `yaml:"clientAssertion" envconfig:"OKTA_CLIENT_CLIENTASSERTION"`
-			Scopes            []string `yaml:"scopes" envconfig:"OKTA_CLIENT_SCOPES"`
-			PrivateKey        string   `yaml:"privateKey" envconfig:"OKTA_CLIENT_PRIVATEKEY"`
-			PrivateKeyId      string   `yaml:"privateKeyId" envconfig:"OKTA_CLIENT_PRIVATEKEYID"`
+			Retry struct {
+				MaxRetries  int32 `yaml:"maxRetries" envconfig:"OKTA_CLIENT_RETRY_MAX_RETRIES"`
+				MinBackoff  int64 `yaml:"minBackoff" envconfig:"OKTA_CLIENT_RETRY_MIN_BACKOFF"`
+				MaxBackoff  int64 `yaml:"maxBackoff" envconfig:"OKTA_CLIENT_RETRY_MAX_BACKOFF"`
+				StatusCodes []int `yaml:"statusCodes" envconfig:"OKTA_CLIENT_RETRY_STATUS_CODES"`
+			} `yaml:"retry"`
+			OrgUrl               string   `yaml:"orgUrl" envconfig:"OKTA_CLIENT_ORGURL"`
+			Token                string   `yaml:"token" envconfig:"OKTA_CLIENT_TOKEN"`
+			AuthorizationMode    string   `yaml:"authorizationMode" envconfig:"OKTA_CLIENT_AUTHORIZATIONMODE"`
+			ClientId             string   `yaml:"clientId" envconfig:"OKTA_CLIENT_CLIENTID"`
+			ClientAssertion      string   `yaml:"clientAssertion" envconfig:"OKTA_CLIENT_CLIENTASSERTION"`
+			Scopes               []string `yaml:"scopes" envconfig:"OKTA_CLIENT_SCOPES"`
+			PrivateKey           string   `yaml:"privateKey" envconfig:"OKTA_CLIENT_PRIVATEKEY"`
+			PrivateKeyId         string   `yaml:"privateKeyId" envconfig:"OKTA_CLIENT_PRIVATEKEYID"`
+			PrivateKeyFile       string   `yaml:"privateKeyFile" envconfig:"OKTA_CLIENT_PRIVATEKEYFILE"`
+			PrivateKeyAlgorithm  string   `yaml:"privateKeyAlgorithm" envconfig:"OKTA_CLIENT_PRIVATEKEYALGORITHM"`
+			PrivateKeyPassphrase string   `yaml:"privateKeyPassphrase" envconfig:"OKTA_CLIENT_PRIVATEKEYPASSPHRASE"`
+			SecretTimeout        int64    `yaml:"secretTimeout" envconfig:"OKTA_CLIENT_SECRET_TIMEOUT"`
+			CABundle             string   `yaml:"caBundle" envconfig:"OKTA_CLIENT_CA_BUNDLE"`
+			ClientCertificate    string   `yaml:"clientCertificate" envconfig:"OKTA_CLIENT_CLIENT_CERTIFICATE"`
+			ClientCertificateKey string   `yaml:"clientCertificateKey" envconfig:"OKTA_CLIENT_CLIENT_CERTIFICATE_KEY"`
 		} `yaml:"client"`
 		Testing struct {
 			DisableHttpsCheck bool `yaml:"disableHttpsCheck" envconfig:"OKTA_TESTING_DISABLE_HTTPS_CHECK"`
 		} `yaml:"testing"`
 	} `yaml:"okta"`
 	PrivateKeySigner jose.Signer
-	CacheManager     Cache
+	// PrivateKeyPassphraseFunc is called for the passphrase of an encrypted
+	// private key when none is configured.
+	PrivateKeyPassphraseFunc PassphraseFunc
+	CacheManager             Cache
+	// Middlewares wrap the HTTPClient, the first one sees the requests first.
+	Middlewares []Middleware
+	// CallMiddlewares wrap the calls of APIClient.Do that are sent, once per
+	// call whatever the retries, the first one sees the requests first.
+	CallMiddlewares []Middleware
+	// DryRun is given the requests changing the org instead of sending them.
+	DryRun DryRunFunc
+	// TracerProvider and MeterProvider trace and measure the requests, the
+	// global providers of OpenTelemetry are used when they are nil.
+	TracerProvider trace.TracerProvider
+	MeterProvider  metric.MeterProvider
+	tlsConfig      *tls.Config
+	// setterErr is the first error of the ConfigSetters, returned by
+	// NewConfiguration.
+	setterErr error
 }
 
 // NewConfiguration returns a new Configuration object
@@ -158,7 +201,7 @@ func NewConfiguration(conf ...ConfigSetter) (*Configuration, error) {
 				URL:         "https://{yourOktaDomain}",
 				Description: "No description provided",
 				Variables: map[string]ServerVariable{
-					"yourOktaDomain": ServerVariable{
+					"yourOktaDomain": {
 						Description:  "The domain of your organization. This can be a provided subdomain of an official okta domain (okta.com, oktapreview.com, etc) or one of your configured custom domains.",
 						DefaultValue: "subdomain.okta.com",
 					},
@@ -170,6 +213,10 @@ func NewConfiguration(conf ...ConfigSetter) (*Configuration, error) {
 
 	cfg.Okta.Testing.DisableHttpsCheck = false
 	cfg.Okta.Client.AuthorizationMode = "SSWS"
+	cfg.Okta.Client.Retry.MaxRetries = DefaultRetryMaxRetries
+	cfg.Okta.Client.Retry.MinBackoff = DefaultRetryMinBackoff
+	cfg.Okta.Client.Retry.MaxBackoff = DefaultRetryMaxBackoff
+	cfg.Okta.Client.Retry.StatusCodes = DefaultRetryStatusCodes
 
 	cfg = readConfigFromSystem(*cfg)
 	cfg = readConfigFromApplication(*cfg)
@@ -178,20 +225,41 @@ func NewConfiguration(conf ...ConfigSetter) (*Configuration, error) {
 	for _, confSetter := range conf {
 		confSetter(cfg)
 	}
+	if cfg.setterErr != nil {
+		return nil, cfg.setterErr
+	}
 
 	purl, err := url.Parse(cfg.Okta.Client.OrgUrl)
 	if err != nil {
 		return nil, err
 	}
-	cfg.Host = purl.Hostname()
+	cfg.Host = purl.Host
 	cfg.Scheme = purl.Scheme
+	if err = checkOrgURL(cfg, purl); err != nil {
+		return nil, err
+	}
+	if _, err = proxyURL(cfg); err != nil {
+		return nil, err
+	}
+	if cfg.tlsConfig, err = loadTLSConfig(cfg); err != nil {
+		return nil, err
+	}
+	if cfg.Okta.Client.Cache.Enabled && cfg.Okta.Client.Cache.Dir != "" && cfg.CacheManager == nil {
+		if cfg.CacheManager, err = NewFileCache(cfg.Okta.Client.Cache.Dir, cfg.Okta.Client.Cache.DefaultTtl); err != nil {
+			return nil, err
+		}
+	}
 
 	if cfg.UserAgentExtra != "" {
 		cfg.UserAgent = fmt.Sprintf("%s %s", cfg.UserAgent, cfg.UserAgentExtra)
 	}
 
+	base := cfg.Context
+	if base == nil {
+		base = context.Background()
+	}
 	ctx := context.WithValue(
-		context.Background(),
+		base,
 		ContextAPIKeys,
 		map[string]APIKey{
 			"API_Token": {
@@ -368,6 +436,14 @@ func (c *Configuration) ServerURLWithContext(ctx context.Context, endpoint strin
 
 type ConfigSetter func(*Configuration)
 
+// WithContext sets the context the client is bound to, cancelling it stops
+// the requests made with Configuration.Context, like APIResponse.Next.
+func WithContext(ctx context.Context) ConfigSetter {
+	return func(c *Configuration) {
+		c.Context = ctx
+	}
+}
+
 func WithCache(cache bool) ConfigSetter {
 	return func(c *Configuration) {
 		c.Okta.Client.Cache.Enabled = cache
@@ -380,6 +456,13 @@ func WithCacheManager(cacheManager Cache) ConfigSetter {
 	}
 }
 
+// WithCacheDir keeps the cached responses in files of dir, see FileCache.
+func WithCacheDir(dir string) ConfigSetter {
+	return func(c *Configuration) {
+		c.Okta.Client.Cache.Dir = dir
+	}
+}
+
 func WithCacheTtl(i int32) ConfigSetter {
 	return func(c *Configuration) {
 		c.Okta.Client.Cache.DefaultTtl = i
@@ -398,6 +481,40 @@ func WithHttpClientPtr(httpClient *http.Client) ConfigSetter {
 	}
 }
 
+// WithMiddleware appends middlewares wrapping the HTTP client, for example
+// to log, sign or inject faults into requests.
+func WithMiddleware(middlewares ...Middleware) ConfigSetter {
+	return func(c *Configuration) {
+		c.Middlewares = append(c.Middlewares, middlewares...)
+	}
+}
+
+// WithCallMiddleware appends middlewares wrapping the calls of APIClient.Do,
+// which see a request once however many times it is attempted, and whether
+// it is answered from the cache, for example to audit the requests. The
+// requests given to DryRun instead are not seen.
+func WithCallMiddleware(middlewares ...Middleware) ConfigSetter {
+	return func(c *Configuration) {
+		c.CallMiddlewares = append(c.CallMiddlewares, middlewares...)
+	}
+}
+
+// WithTracerProvider traces the requests of the client, and those for its
+// access tokens, with a span each in tracerProvider.
+func WithTracerProvider(tracerProvider trace.TracerProvider) ConfigSetter {
+	return func(c *Configuration) {
+		c.TracerProvider = tracerProvider
+	}
+}
+
+// WithMeterProvider records the duration of the requests of the client and
+// counts its 429 responses with meterProvider.
+func WithMeterProvider(meterProvider metric.MeterProvider) ConfigSetter {
+	return func(c *Configuration) {
+		c.MeterProvider = meterProvider
+	}
+}
+
 func WithConnectionTimeout(i int64) ConfigSetter {
 	return func(c *Configuration) {
 		c.Okta.Client.ConnectionTimeout = i
@@ -428,6 +545,28 @@ func WithProxyPassword(pass string) ConfigSetter {
 	}
 }
 
+// WithProxyScheme sets the proxy scheme: http (the default), https, socks5 or socks5h.
+func WithProxyScheme(scheme string) ConfigSetter {
+	return func(c *Configuration) {
+		c.Okta.Client.Proxy.Scheme = scheme
+	}
+}
+
+// WithCABundle adds the PEM certificates in the file to the system roots, e.g. for a TLS intercepting proxy.
+func WithCABundle(path string) ConfigSetter {
+	return func(c *Configuration) {
+		c.Okta.Client.CABundle = path
+	}
+}
+
+// WithClientCertificate sets the PEM certificate and key files presented for mutual TLS.
+func WithClientCertificate(certificate, key string) ConfigSetter {
+	return func(c *Configuration) {
+		c.Okta.Client.ClientCertificate = certificate
+		c.Okta.Client.ClientCertificateKey = key
+	}
+}
+
 func WithOrgUrl(rawUrl string) ConfigSetter {
 	return func(c *Configuration) {
 		c.Okta.Client.OrgUrl = rawUrl
@@ -452,6 +591,35 @@ func WithTestingDisableHttpsCheck(httpsCheck bool) ConfigSetter {
 	}
 }
 
+// WithRateLimitHeadroom holds requests to an endpoint once its remaining rate limit falls to the given percentage of the limit.
+func WithRateLimitHeadroom(headroom int32) ConfigSetter {
+	return func(c *Configuration) {
+		c.Okta.Client.RateLimit.Headroom = headroom
+	}
+}
+
+// WithRetryMaxRetries sets how often requests failing with a retryable status, a connection reset or a timeout are retried.
+func WithRetryMaxRetries(maxRetries int32) ConfigSetter {
+	return func(c *Configuration) {
+		c.Okta.Client.Retry.MaxRetries = maxRetries
+	}
+}
+
+// WithRetryBackoff sets the bounds, in seconds, of the exponential backoff between retries.
+func WithRetryBackoff(minBackoff, maxBackoff int64) ConfigSetter {
+	return func(c *Configuration) {
+		c.Okta.Client.Retry.MinBackoff = minBackoff
+		c.Okta.Client.Retry.MaxBackoff = maxBackoff
+	}
+}
+
+// WithRetryStatusCodes sets the response status codes retried, 502, 503 and 504 by default.
+func WithRetryStatusCodes(statusCodes ...int) ConfigSetter {
+	return func(c *Configuration) {
+		c.Okta.Client.Retry.StatusCodes = statusCodes
+	}
+}
+
 func WithRequestTimeout(requestTimeout int64) ConfigSetter {
 	return func(c *Configuration) {
 		c.Okta.Client.RequestTimeout = requestTimeout
@@ -515,6 +683,49 @@ func WithPrivateKeySigner(signer jose.Signer) ConfigSetter {
 	}
 }
 
+// WithPrivateKeyFile sets the path of a PEM or JWK private key read when the client first authorizes.
+func WithPrivateKeyFile(privateKeyFile string) ConfigSetter {
+	return func(c *Configuration) {
+		c.Okta.Client.PrivateKeyFile = privateKeyFile
+	}
+}
+
+// WithPrivateKeyAlgorithm overrides the JWS algorithm of the client assertion, e.g. PS256 for an RSA key.
+func WithPrivateKeyAlgorithm(algorithm string) ConfigSetter {
+	return func(c *Configuration) {
+		c.Okta.Client.PrivateKeyAlgorithm = algorithm
+	}
+}
+
+// WithPrivateKeyPassphraseFunc sets the callback asked for the passphrase of an encrypted private key.
+func WithPrivateKeyPassphraseFunc(passphrase PassphraseFunc) ConfigSetter {
+	return func(c *Configuration) {
+		c.PrivateKeyPassphraseFunc = passphrase
+	}
+}
+
+// WithSecretTimeout bounds, in seconds, the commands run for exec: secret references.
+func WithSecretTimeout(timeout int64) ConfigSetter {
+	return func(c *Configuration) {
+		c.Okta.Client.SecretTimeout = timeout
+	}
+}
+
+// WithConfigFile reads an additional okta.yaml style configuration file.
+// Values set in the file override the ones read from the default locations and the environment.
+func WithConfigFile(location string) ConfigSetter {
+	return func(c *Configuration) {
+		conf, err := readConfigFromFile(location, *c)
+		if err != nil {
+			if c.setterErr == nil {
+				c.setterErr = fmt.Errorf("failed to read configuration file %v: %w", location, err)
+			}
+			return
+		}
+		*c = *conf
+	}
+}
+
 func fileExists(filename string) bool {
 	info, err := os.Stat(filename)
 	if err != nil {
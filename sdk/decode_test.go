@@ -0,0 +1,62 @@
+package sdk
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecuteTyped(t *testing.T) {
+	bodies := map[string]string{
+		"/api/v1/groups": `[{"id":"00g1","profile":{"name":"Engineering"}},{"id":"00g2","profile":{"name":"Sales"}}]`,
+		"/api/v1/apps": `[{"id":"0oa1","name":"bookmark","label":"Wiki","signOnMode":"BOOKMARK","settings":{"app":{"url":"https://wiki.example.com"}}},` +
+			`{"id":"0oa2","name":"template_basic_auth","label":"Intranet","signOnMode":"BASIC_AUTH"}]`,
+		"/api/v1/apps/0oa1": `{"id":"0oa1","name":"bookmark","label":"Wiki","signOnMode":"BOOKMARK"}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := bodies[r.URL.Path]
+		if !ok {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			io.WriteString(w, `{"errorCode":"E0000007","errorSummary":"Not found: Resource not found"}`)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, body)
+	}))
+	defer server.Close()
+	client, err := NewTestClient(server.URL, "token")
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	groups, resp, err := client.GroupAPI.ListGroups(ctx).ExecuteTyped()
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Len(t, groups, 2)
+	require.Equal(t, "Sales", groups[1].Profile.GetName())
+
+	// the body stays readable for callers decoding it themselves
+	again, err := Decode[[]Group](resp)
+	require.NoError(t, err)
+	require.Equal(t, groups, again)
+
+	apps, _, err := client.ApplicationAPI.ListApplications(ctx).ExecuteTyped()
+	require.NoError(t, err)
+	require.Len(t, apps, 2)
+	require.NotNil(t, apps[0].BookmarkApplication)
+	require.Equal(t, "Wiki", apps[0].BookmarkApplication.GetLabel())
+	require.Nil(t, apps[0].BasicAuthApplication)
+	require.NotNil(t, apps[1].BasicAuthApplication)
+
+	app, _, err := client.ApplicationAPI.GetApplication(ctx, "0oa1").ExecuteTyped()
+	require.NoError(t, err)
+	require.IsType(t, &BookmarkApplication{}, app.GetActualInstance())
+
+	_, resp, err = client.GroupAPI.GetGroup(ctx, "00g3").ExecuteTyped()
+	require.Error(t, err)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
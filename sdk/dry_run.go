@@ -0,0 +1,44 @@
+package sdk
+
+import (
+	"errors"
+	"net/http"
+)
+
+// ErrDryRun is the error of the requests a client configured WithDryRun did
+// not send.
+var ErrDryRun = errors.New("dry run, the request was not sent")
+
+// DryRunFunc is given the requests that would change the org, prepared and
+// authorized like they would be sent. An error it returns is the error of
+// the request instead of ErrDryRun.
+type DryRunFunc func(req *http.Request) error
+
+// WithDryRun hands the POST, PUT, PATCH and DELETE requests of the client to
+// dryRun instead of sending them, they fail with ErrDryRun. GET requests,
+// and those for access tokens, are still sent.
+func WithDryRun(dryRun DryRunFunc) ConfigSetter {
+	return func(c *Configuration) {
+		c.DryRun = dryRun
+	}
+}
+
+// dryRun hands req to the DryRun function of the configuration, if any, and
+// reports whether it did.
+func (c *APIClient) dryRun(req *http.Request) (bool, error) {
+	if c.cfg.DryRun == nil || safeMethod(req.Method) {
+		return false, nil
+	}
+	if err := c.cfg.DryRun(req); err != nil {
+		return true, err
+	}
+	return true, ErrDryRun
+}
+
+func safeMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,132 @@
+package sdk
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Error codes of the org, see
+// https://developer.okta.com/docs/reference/error-codes/.
+const (
+	ErrorCodeValidation  = "E0000001"
+	ErrorCodeNotFound    = "E0000007"
+	ErrorCodeRateLimited = "E0000047"
+)
+
+// OktaError is the error object the org answers a request with when it
+// fails, like
+//
+//	{"errorCode": "E0000007", "errorSummary": "Not found: Resource not found: 00u1 (User)", ...}
+//
+// The errors of the API operations wrap it, errors.As finds it:
+//
+//	var oktaErr *sdk.OktaError
+//	if errors.As(err, &oktaErr) && oktaErr.ErrorCode == "E0000038" {
+//		...
+//	}
+type OktaError struct {
+	// StatusCode is the HTTP status of the response, like 404.
+	StatusCode int
+	// Status is the HTTP status line of the response, like "404 Not Found".
+	Status       string
+	ErrorCode    string
+	ErrorSummary string
+	ErrorLink    string
+	ErrorId      string
+	// ErrorCauses are the summaries of the causes, like the fields failing
+	// the validation.
+	ErrorCauses []string
+	// RequestId is the X-Okta-Request-Id of the response, which Okta
+	// support asks for.
+	RequestId string
+	// RetryAfter is how long to wait before sending a request the org
+	// rejected with 429 again, 0 for other statuses.
+	RetryAfter time.Duration
+}
+
+func (e *OktaError) Error() string {
+	msg := e.Status
+	if e.ErrorSummary != "" {
+		msg += ": " + e.ErrorSummary
+	}
+	if len(e.ErrorCauses) > 0 {
+		msg += " (" + strings.Join(e.ErrorCauses, "; ") + ")"
+	}
+	if e.ErrorCode != "" {
+		msg += fmt.Sprintf(" [%v]", e.ErrorCode)
+	}
+	return msg
+}
+
+// newOktaError returns the error of a response with an error status, whose
+// body may not be an error object, like the HTML of a proxy.
+func newOktaError(resp *http.Response, body []byte) *OktaError {
+	e := &OktaError{
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		RequestId:  resp.Header.Get("X-Okta-Request-Id"),
+	}
+	if e.Status == "" {
+		e.Status = fmt.Sprintf("%d %v", resp.StatusCode, http.StatusText(resp.StatusCode))
+	}
+	var v Error
+	if json.Unmarshal(body, &v) == nil {
+		e.ErrorCode = v.GetErrorCode()
+		e.ErrorSummary = v.GetErrorSummary()
+		e.ErrorLink = v.GetErrorLink()
+		e.ErrorId = v.GetErrorId()
+		for _, cause := range v.ErrorCauses {
+			e.ErrorCauses = append(e.ErrorCauses, cause.GetErrorSummary())
+		}
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		e.RetryAfter = retryAfter(resp)
+	}
+	return e
+}
+
+// retryAfter returns the wait the Retry-After header asks for, or else the
+// time left until the X-Rate-Limit-Reset of the rate limit.
+func retryAfter(resp *http.Response) time.Duration {
+	if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	seconds, err := Get429BackoffTime(resp)
+	if err != nil {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// AsOktaError returns the OktaError in the chain of err, if any.
+func AsOktaError(err error) (*OktaError, bool) {
+	var oktaErr *OktaError
+	ok := errors.As(err, &oktaErr)
+	return oktaErr, ok
+}
+
+// IsNotFound reports whether err is the org answering 404, the resource or
+// the endpoint does not exist.
+func IsNotFound(err error) bool {
+	oktaErr, ok := AsOktaError(err)
+	return ok && oktaErr.StatusCode == http.StatusNotFound
+}
+
+// IsRateLimited reports whether err is the org answering 429 once the
+// retries were exhausted. RetryAfter tells when to try again.
+func IsRateLimited(err error) bool {
+	oktaErr, ok := AsOktaError(err)
+	return ok && (oktaErr.StatusCode == http.StatusTooManyRequests || oktaErr.ErrorCode == ErrorCodeRateLimited)
+}
+
+// IsValidationError reports whether err is the org rejecting the request
+// body or parameters, the ErrorCauses name the invalid fields.
+func IsValidationError(err error) bool {
+	oktaErr, ok := AsOktaError(err)
+	return ok && oktaErr.ErrorCode == ErrorCodeValidation
+}
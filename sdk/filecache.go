@@ -0,0 +1,149 @@
+package sdk
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httputil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// FileCache is a Cache keeping every entry in a file of a directory, so the
+// cached responses outlive the process, like the invocations of the CLI.
+// Entries are replaced atomically, several processes can share the
+// directory. The responses hold the data of the org, the directory and its
+// files are only accessible to their owner.
+type FileCache struct {
+	dir string
+	ttl time.Duration
+}
+
+type fileCacheEntry struct {
+	Key      string    `json:"key"`
+	Expires  time.Time `json:"expires,omitempty"`
+	Response []byte    `json:"response,omitempty"`
+	Value    string    `json:"value,omitempty"`
+}
+
+// NewFileCache returns a FileCache in dir, which is created when missing.
+// Entries expire after ttl seconds, never when ttl is 0.
+func NewFileCache(dir string, ttl int32) (*FileCache, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	return &FileCache{dir: dir, ttl: time.Duration(ttl) * time.Second}, nil
+}
+
+func (c *FileCache) Get(key string) *http.Response {
+	entry, ok := c.read(c.path(key))
+	if !ok || entry.Key != key || entry.Response == nil {
+		return nil
+	}
+	resp, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(entry.Response)), nil)
+	if err != nil {
+		return nil
+	}
+	return resp
+}
+
+func (c *FileCache) Set(key string, value *http.Response) {
+	cacheableResponse, err := httputil.DumpResponse(value, true)
+	if err != nil {
+		return
+	}
+	c.write(fileCacheEntry{Key: key, Response: cacheableResponse})
+}
+
+func (c *FileCache) GetString(key string) string {
+	entry, ok := c.read(c.path(key))
+	if !ok || entry.Key != key {
+		return ""
+	}
+	return entry.Value
+}
+
+func (c *FileCache) SetString(key string, value string) {
+	c.write(fileCacheEntry{Key: key, Value: value})
+}
+
+func (c *FileCache) Delete(key string) {
+	os.Remove(c.path(key))
+}
+
+func (c *FileCache) DeletePrefix(prefix string) {
+	for _, path := range c.entries() {
+		if entry, ok := c.read(path); ok && strings.HasPrefix(entry.Key, prefix) {
+			os.Remove(path)
+		}
+	}
+}
+
+func (c *FileCache) Clear() {
+	for _, path := range c.entries() {
+		os.Remove(path)
+	}
+}
+
+func (c *FileCache) Has(key string) bool {
+	entry, ok := c.read(c.path(key))
+	return ok && entry.Key == key
+}
+
+func (c *FileCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+func (c *FileCache) entries() []string {
+	paths, _ := filepath.Glob(filepath.Join(c.dir, "*.json"))
+	return paths
+}
+
+// read returns the entry of a file, removing it once expired.
+func (c *FileCache) read(path string) (*fileCacheEntry, bool) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	var entry fileCacheEntry
+	if err := json.Unmarshal(b, &entry); err != nil {
+		return nil, false
+	}
+	if !entry.Expires.IsZero() && !time.Now().Before(entry.Expires) {
+		os.Remove(path)
+		return nil, false
+	}
+	return &entry, true
+}
+
+// write replaces the file of an entry through a rename, readers never see a
+// partly written entry.
+func (c *FileCache) write(entry fileCacheEntry) {
+	if c.ttl > 0 {
+		entry.Expires = time.Now().Add(c.ttl)
+	}
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	f, err := os.CreateTemp(c.dir, ".entry-*")
+	if err != nil {
+		return
+	}
+	_, err = f.Write(b)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), c.path(entry.Key))
+	}
+	if err != nil {
+		os.Remove(f.Name())
+	}
+}
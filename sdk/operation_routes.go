@@ -0,0 +1,614 @@
+// Code generated by cmdTools from template.yaml. DO NOT EDIT.
+
+package sdk
+
+type operationRoute struct {
+	method      string
+	path        string
+	operationID string
+}
+
+// operationRoutes are the operations of the Management API declared in
+// template.yaml, in its order.
+var operationRoutes = []operationRoute{
+	{method: "GET", path: "/.well-known/app-authenticator-configuration", operationID: "getWellKnownAppAuthenticatorConfiguration"},
+	{method: "GET", path: "/.well-known/okta-organization", operationID: "getWellknownOrgMetadata"},
+	{method: "GET", path: "/api/v1/agentPools", operationID: "listAgentPools"},
+	{method: "GET", path: "/api/v1/agentPools/{poolId}/updates", operationID: "listAgentPoolsUpdates"},
+	{method: "POST", path: "/api/v1/agentPools/{poolId}/updates", operationID: "createAgentPoolsUpdate"},
+	{method: "GET", path: "/api/v1/agentPools/{poolId}/updates/settings", operationID: "getAgentPoolsUpdateSettings"},
+	{method: "POST", path: "/api/v1/agentPools/{poolId}/updates/settings", operationID: "updateAgentPoolsUpdateSettings"},
+	{method: "GET", path: "/api/v1/agentPools/{poolId}/updates/{updateId}", operationID: "getAgentPoolsUpdateInstance"},
+	{method: "POST", path: "/api/v1/agentPools/{poolId}/updates/{updateId}", operationID: "updateAgentPoolsUpdate"},
+	{method: "DELETE", path: "/api/v1/agentPools/{poolId}/updates/{updateId}", operationID: "deleteAgentPoolsUpdate"},
+	{method: "POST", path: "/api/v1/agentPools/{poolId}/updates/{updateId}/activate", operationID: "activateAgentPoolsUpdate"},
+	{method: "POST", path: "/api/v1/agentPools/{poolId}/updates/{updateId}/deactivate", operationID: "deactivateAgentPoolsUpdate"},
+	{method: "POST", path: "/api/v1/agentPools/{poolId}/updates/{updateId}/pause", operationID: "pauseAgentPoolsUpdate"},
+	{method: "POST", path: "/api/v1/agentPools/{poolId}/updates/{updateId}/resume", operationID: "resumeAgentPoolsUpdate"},
+	{method: "POST", path: "/api/v1/agentPools/{poolId}/updates/{updateId}/retry", operationID: "retryAgentPoolsUpdate"},
+	{method: "POST", path: "/api/v1/agentPools/{poolId}/updates/{updateId}/stop", operationID: "stopAgentPoolsUpdate"},
+	{method: "GET", path: "/api/v1/api-tokens", operationID: "listApiTokens"},
+	{method: "DELETE", path: "/api/v1/api-tokens/current", operationID: "revokeCurrentApiToken"},
+	{method: "GET", path: "/api/v1/api-tokens/{apiTokenId}", operationID: "getApiToken"},
+	{method: "DELETE", path: "/api/v1/api-tokens/{apiTokenId}", operationID: "revokeApiToken"},
+	{method: "GET", path: "/api/v1/apps", operationID: "listApplications"},
+	{method: "POST", path: "/api/v1/apps", operationID: "createApplication"},
+	{method: "GET", path: "/api/v1/apps/{appId}", operationID: "getApplication"},
+	{method: "PUT", path: "/api/v1/apps/{appId}", operationID: "replaceApplication"},
+	{method: "DELETE", path: "/api/v1/apps/{appId}", operationID: "deleteApplication"},
+	{method: "GET", path: "/api/v1/apps/{appId}/connections/default", operationID: "getDefaultProvisioningConnectionForApplication"},
+	{method: "POST", path: "/api/v1/apps/{appId}/connections/default", operationID: "updateDefaultProvisioningConnectionForApplication"},
+	{method: "POST", path: "/api/v1/apps/{appId}/connections/default/lifecycle/activate", operationID: "activateDefaultProvisioningConnectionForApplication"},
+	{method: "POST", path: "/api/v1/apps/{appId}/connections/default/lifecycle/deactivate", operationID: "deactivateDefaultProvisioningConnectionForApplication"},
+	{method: "GET", path: "/api/v1/apps/{appId}/credentials/csrs", operationID: "listCsrsForApplication"},
+	{method: "POST", path: "/api/v1/apps/{appId}/credentials/csrs", operationID: "generateCsrForApplication"},
+	{method: "GET", path: "/api/v1/apps/{appId}/credentials/csrs/{csrId}", operationID: "getCsrForApplication"},
+	{method: "DELETE", path: "/api/v1/apps/{appId}/credentials/csrs/{csrId}", operationID: "revokeCsrFromApplication"},
+	{method: "POST", path: "/api/v1/apps/{appId}/credentials/csrs/{csrId}/lifecycle/publish", operationID: "publishCsrFromApplication"},
+	{method: "GET", path: "/api/v1/apps/{appId}/credentials/keys", operationID: "listApplicationKeys"},
+	{method: "POST", path: "/api/v1/apps/{appId}/credentials/keys/generate", operationID: "generateApplicationKey"},
+	{method: "GET", path: "/api/v1/apps/{appId}/credentials/keys/{keyId}", operationID: "getApplicationKey"},
+	{method: "POST", path: "/api/v1/apps/{appId}/credentials/keys/{keyId}/clone", operationID: "cloneApplicationKey"},
+	{method: "GET", path: "/api/v1/apps/{appId}/features", operationID: "listFeaturesForApplication"},
+	{method: "GET", path: "/api/v1/apps/{appId}/features/{featureName}", operationID: "getFeatureForApplication"},
+	{method: "PUT", path: "/api/v1/apps/{appId}/features/{featureName}", operationID: "updateFeatureForApplication"},
+	{method: "GET", path: "/api/v1/apps/{appId}/grants", operationID: "listScopeConsentGrants"},
+	{method: "POST", path: "/api/v1/apps/{appId}/grants", operationID: "grantConsentToScope"},
+	{method: "GET", path: "/api/v1/apps/{appId}/grants/{grantId}", operationID: "getScopeConsentGrant"},
+	{method: "DELETE", path: "/api/v1/apps/{appId}/grants/{grantId}", operationID: "revokeScopeConsentGrant"},
+	{method: "GET", path: "/api/v1/apps/{appId}/groups", operationID: "listApplicationGroupAssignments"},
+	{method: "GET", path: "/api/v1/apps/{appId}/groups/{groupId}", operationID: "getApplicationGroupAssignment"},
+	{method: "PUT", path: "/api/v1/apps/{appId}/groups/{groupId}", operationID: "assignGroupToApplication"},
+	{method: "DELETE", path: "/api/v1/apps/{appId}/groups/{groupId}", operationID: "unassignApplicationFromGroup"},
+	{method: "POST", path: "/api/v1/apps/{appId}/lifecycle/activate", operationID: "activateApplication"},
+	{method: "POST", path: "/api/v1/apps/{appId}/lifecycle/deactivate", operationID: "deactivateApplication"},
+	{method: "POST", path: "/api/v1/apps/{appId}/logo", operationID: "uploadApplicationLogo"},
+	{method: "PUT", path: "/api/v1/apps/{appId}/policies/{policyId}", operationID: "assignApplicationPolicy"},
+	{method: "GET", path: "/api/v1/apps/{appId}/sso/saml/metadata", operationID: "previewSAMLmetadataForApplication"},
+	{method: "GET", path: "/api/v1/apps/{appId}/tokens", operationID: "listOAuth2TokensForApplication"},
+	{method: "DELETE", path: "/api/v1/apps/{appId}/tokens", operationID: "revokeOAuth2TokensForApplication"},
+	{method: "GET", path: "/api/v1/apps/{appId}/tokens/{tokenId}", operationID: "getOAuth2TokenForApplication"},
+	{method: "DELETE", path: "/api/v1/apps/{appId}/tokens/{tokenId}", operationID: "revokeOAuth2TokenForApplication"},
+	{method: "GET", path: "/api/v1/apps/{appId}/users", operationID: "listApplicationUsers"},
+	{method: "POST", path: "/api/v1/apps/{appId}/users", operationID: "assignUserToApplication"},
+	{method: "GET", path: "/api/v1/apps/{appId}/users/{userId}", operationID: "getApplicationUser"},
+	{method: "POST", path: "/api/v1/apps/{appId}/users/{userId}", operationID: "updateApplicationUser"},
+	{method: "DELETE", path: "/api/v1/apps/{appId}/users/{userId}", operationID: "unassignUserFromApplication"},
+	{method: "POST", path: "/api/v1/apps/{appName}/{appId}/oauth2/callback", operationID: "verifyProvisioningConnectionForApplication"},
+	{method: "GET", path: "/api/v1/authenticators", operationID: "listAuthenticators"},
+	{method: "POST", path: "/api/v1/authenticators", operationID: "createAuthenticator"},
+	{method: "GET", path: "/api/v1/authenticators/{authenticatorId}", operationID: "getAuthenticator"},
+	{method: "PUT", path: "/api/v1/authenticators/{authenticatorId}", operationID: "replaceAuthenticator"},
+	{method: "POST", path: "/api/v1/authenticators/{authenticatorId}/lifecycle/activate", operationID: "activateAuthenticator"},
+	{method: "POST", path: "/api/v1/authenticators/{authenticatorId}/lifecycle/deactivate", operationID: "deactivateAuthenticator"},
+	{method: "GET", path: "/api/v1/authenticators/{authenticatorId}/methods", operationID: "listAuthenticatorMethods"},
+	{method: "GET", path: "/api/v1/authenticators/{authenticatorId}/methods/{methodType}", operationID: "getAuthenticatorMethod"},
+	{method: "PUT", path: "/api/v1/authenticators/{authenticatorId}/methods/{methodType}", operationID: "replaceAuthenticatorMethod"},
+	{method: "POST", path: "/api/v1/authenticators/{authenticatorId}/methods/{methodType}/lifecycle/activate", operationID: "activateAuthenticatorMethod"},
+	{method: "POST", path: "/api/v1/authenticators/{authenticatorId}/methods/{methodType}/lifecycle/deactivate", operationID: "deactivateAuthenticatorMethod"},
+	{method: "GET", path: "/api/v1/authorizationServers", operationID: "listAuthorizationServers"},
+	{method: "POST", path: "/api/v1/authorizationServers", operationID: "createAuthorizationServer"},
+	{method: "GET", path: "/api/v1/authorizationServers/{authServerId}", operationID: "getAuthorizationServer"},
+	{method: "PUT", path: "/api/v1/authorizationServers/{authServerId}", operationID: "replaceAuthorizationServer"},
+	{method: "DELETE", path: "/api/v1/authorizationServers/{authServerId}", operationID: "deleteAuthorizationServer"},
+	{method: "GET", path: "/api/v1/authorizationServers/{authServerId}/associatedServers", operationID: "listAssociatedServersByTrustedType"},
+	{method: "POST", path: "/api/v1/authorizationServers/{authServerId}/associatedServers", operationID: "createAssociatedServers"},
+	{method: "DELETE", path: "/api/v1/authorizationServers/{authServerId}/associatedServers/{associatedServerId}", operationID: "deleteAssociatedServer"},
+	{method: "GET", path: "/api/v1/authorizationServers/{authServerId}/claims", operationID: "listOAuth2Claims"},
+	{method: "POST", path: "/api/v1/authorizationServers/{authServerId}/claims", operationID: "createOAuth2Claim"},
+	{method: "GET", path: "/api/v1/authorizationServers/{authServerId}/claims/{claimId}", operationID: "getOAuth2Claim"},
+	{method: "PUT", path: "/api/v1/authorizationServers/{authServerId}/claims/{claimId}", operationID: "replaceOAuth2Claim"},
+	{method: "DELETE", path: "/api/v1/authorizationServers/{authServerId}/claims/{claimId}", operationID: "deleteOAuth2Claim"},
+	{method: "GET", path: "/api/v1/authorizationServers/{authServerId}/clients", operationID: "listOAuth2ClientsForAuthorizationServer"},
+	{method: "GET", path: "/api/v1/authorizationServers/{authServerId}/clients/{clientId}/tokens", operationID: "listRefreshTokensForAuthorizationServerAndClient"},
+	{method: "DELETE", path: "/api/v1/authorizationServers/{authServerId}/clients/{clientId}/tokens", operationID: "revokeRefreshTokensForAuthorizationServerAndClient"},
+	{method: "GET", path: "/api/v1/authorizationServers/{authServerId}/clients/{clientId}/tokens/{tokenId}", operationID: "getRefreshTokenForAuthorizationServerAndClient"},
+	{method: "DELETE", path: "/api/v1/authorizationServers/{authServerId}/clients/{clientId}/tokens/{tokenId}", operationID: "revokeRefreshTokenForAuthorizationServerAndClient"},
+	{method: "GET", path: "/api/v1/authorizationServers/{authServerId}/credentials/keys", operationID: "listAuthorizationServerKeys"},
+	{method: "POST", path: "/api/v1/authorizationServers/{authServerId}/credentials/lifecycle/keyRotate", operationID: "rotateAuthorizationServerKeys"},
+	{method: "POST", path: "/api/v1/authorizationServers/{authServerId}/lifecycle/activate", operationID: "activateAuthorizationServer"},
+	{method: "POST", path: "/api/v1/authorizationServers/{authServerId}/lifecycle/deactivate", operationID: "deactivateAuthorizationServer"},
+	{method: "GET", path: "/api/v1/authorizationServers/{authServerId}/policies", operationID: "listAuthorizationServerPolicies"},
+	{method: "POST", path: "/api/v1/authorizationServers/{authServerId}/policies", operationID: "createAuthorizationServerPolicy"},
+	{method: "GET", path: "/api/v1/authorizationServers/{authServerId}/policies/{policyId}", operationID: "getAuthorizationServerPolicy"},
+	{method: "PUT", path: "/api/v1/authorizationServers/{authServerId}/policies/{policyId}", operationID: "replaceAuthorizationServerPolicy"},
+	{method: "DELETE", path: "/api/v1/authorizationServers/{authServerId}/policies/{policyId}", operationID: "deleteAuthorizationServerPolicy"},
+	{method: "POST", path: "/api/v1/authorizationServers/{authServerId}/policies/{policyId}/lifecycle/activate", operationID: "activateAuthorizationServerPolicy"},
+	{method: "POST", path: "/api/v1/authorizationServers/{authServerId}/policies/{policyId}/lifecycle/deactivate", operationID: "deactivateAuthorizationServerPolicy"},
+	{method: "GET", path: "/api/v1/authorizationServers/{authServerId}/policies/{policyId}/rules", operationID: "listAuthorizationServerPolicyRules"},
+	{method: "POST", path: "/api/v1/authorizationServers/{authServerId}/policies/{policyId}/rules", operationID: "createAuthorizationServerPolicyRule"},
+	{method: "GET", path: "/api/v1/authorizationServers/{authServerId}/policies/{policyId}/rules/{ruleId}", operationID: "getAuthorizationServerPolicyRule"},
+	{method: "PUT", path: "/api/v1/authorizationServers/{authServerId}/policies/{policyId}/rules/{ruleId}", operationID: "replaceAuthorizationServerPolicyRule"},
+	{method: "DELETE", path: "/api/v1/authorizationServers/{authServerId}/policies/{policyId}/rules/{ruleId}", operationID: "deleteAuthorizationServerPolicyRule"},
+	{method: "POST", path: "/api/v1/authorizationServers/{authServerId}/policies/{policyId}/rules/{ruleId}/lifecycle/activate", operationID: "activateAuthorizationServerPolicyRule"},
+	{method: "POST", path: "/api/v1/authorizationServers/{authServerId}/policies/{policyId}/rules/{ruleId}/lifecycle/deactivate", operationID: "deactivateAuthorizationServerPolicyRule"},
+	{method: "GET", path: "/api/v1/authorizationServers/{authServerId}/scopes", operationID: "listOAuth2Scopes"},
+	{method: "POST", path: "/api/v1/authorizationServers/{authServerId}/scopes", operationID: "createOAuth2Scope"},
+	{method: "GET", path: "/api/v1/authorizationServers/{authServerId}/scopes/{scopeId}", operationID: "getOAuth2Scope"},
+	{method: "PUT", path: "/api/v1/authorizationServers/{authServerId}/scopes/{scopeId}", operationID: "replaceOAuth2Scope"},
+	{method: "DELETE", path: "/api/v1/authorizationServers/{authServerId}/scopes/{scopeId}", operationID: "deleteOAuth2Scope"},
+	{method: "GET", path: "/api/v1/behaviors", operationID: "listBehaviorDetectionRules"},
+	{method: "POST", path: "/api/v1/behaviors", operationID: "createBehaviorDetectionRule"},
+	{method: "GET", path: "/api/v1/behaviors/{behaviorId}", operationID: "getBehaviorDetectionRule"},
+	{method: "PUT", path: "/api/v1/behaviors/{behaviorId}", operationID: "replaceBehaviorDetectionRule"},
+	{method: "DELETE", path: "/api/v1/behaviors/{behaviorId}", operationID: "deleteBehaviorDetectionRule"},
+	{method: "POST", path: "/api/v1/behaviors/{behaviorId}/lifecycle/activate", operationID: "activateBehaviorDetectionRule"},
+	{method: "POST", path: "/api/v1/behaviors/{behaviorId}/lifecycle/deactivate", operationID: "deactivateBehaviorDetectionRule"},
+	{method: "GET", path: "/api/v1/brands", operationID: "listBrands"},
+	{method: "POST", path: "/api/v1/brands", operationID: "createBrand"},
+	{method: "GET", path: "/api/v1/brands/{brandId}", operationID: "getBrand"},
+	{method: "PUT", path: "/api/v1/brands/{brandId}", operationID: "replaceBrand"},
+	{method: "DELETE", path: "/api/v1/brands/{brandId}", operationID: "deleteBrand"},
+	{method: "GET", path: "/api/v1/brands/{brandId}/domains", operationID: "listBrandDomains"},
+	{method: "GET", path: "/api/v1/brands/{brandId}/pages/error", operationID: "getErrorPage"},
+	{method: "GET", path: "/api/v1/brands/{brandId}/pages/error/customized", operationID: "getCustomizedErrorPage"},
+	{method: "PUT", path: "/api/v1/brands/{brandId}/pages/error/customized", operationID: "replaceCustomizedErrorPage"},
+	{method: "DELETE", path: "/api/v1/brands/{brandId}/pages/error/customized", operationID: "deleteCustomizedErrorPage"},
+	{method: "GET", path: "/api/v1/brands/{brandId}/pages/error/default", operationID: "getDefaultErrorPage"},
+	{method: "GET", path: "/api/v1/brands/{brandId}/pages/error/preview", operationID: "getPreviewErrorPage"},
+	{method: "PUT", path: "/api/v1/brands/{brandId}/pages/error/preview", operationID: "replacePreviewErrorPage"},
+	{method: "DELETE", path: "/api/v1/brands/{brandId}/pages/error/preview", operationID: "deletePreviewErrorPage"},
+	{method: "GET", path: "/api/v1/brands/{brandId}/pages/sign-in", operationID: "getSignInPage"},
+	{method: "GET", path: "/api/v1/brands/{brandId}/pages/sign-in/customized", operationID: "getCustomizedSignInPage"},
+	{method: "PUT", path: "/api/v1/brands/{brandId}/pages/sign-in/customized", operationID: "replaceCustomizedSignInPage"},
+	{method: "DELETE", path: "/api/v1/brands/{brandId}/pages/sign-in/customized", operationID: "deleteCustomizedSignInPage"},
+	{method: "GET", path: "/api/v1/brands/{brandId}/pages/sign-in/default", operationID: "getDefaultSignInPage"},
+	{method: "GET", path: "/api/v1/brands/{brandId}/pages/sign-in/preview", operationID: "getPreviewSignInPage"},
+	{method: "PUT", path: "/api/v1/brands/{brandId}/pages/sign-in/preview", operationID: "replacePreviewSignInPage"},
+	{method: "DELETE", path: "/api/v1/brands/{brandId}/pages/sign-in/preview", operationID: "deletePreviewSignInPage"},
+	{method: "GET", path: "/api/v1/brands/{brandId}/pages/sign-in/widget-versions", operationID: "listAllSignInWidgetVersions"},
+	{method: "GET", path: "/api/v1/brands/{brandId}/pages/sign-out/customized", operationID: "getSignOutPageSettings"},
+	{method: "PUT", path: "/api/v1/brands/{brandId}/pages/sign-out/customized", operationID: "replaceSignOutPageSettings"},
+	{method: "GET", path: "/api/v1/brands/{brandId}/templates/email", operationID: "listEmailTemplates"},
+	{method: "GET", path: "/api/v1/brands/{brandId}/templates/email/{templateName}", operationID: "getEmailTemplate"},
+	{method: "GET", path: "/api/v1/brands/{brandId}/templates/email/{templateName}/customizations", operationID: "listEmailCustomizations"},
+	{method: "POST", path: "/api/v1/brands/{brandId}/templates/email/{templateName}/customizations", operationID: "createEmailCustomization"},
+	{method: "DELETE", path: "/api/v1/brands/{brandId}/templates/email/{templateName}/customizations", operationID: "deleteAllCustomizations"},
+	{method: "GET", path: "/api/v1/brands/{brandId}/templates/email/{templateName}/customizations/{customizationId}", operationID: "getEmailCustomization"},
+	{method: "PUT", path: "/api/v1/brands/{brandId}/templates/email/{templateName}/customizations/{customizationId}", operationID: "replaceEmailCustomization"},
+	{method: "DELETE", path: "/api/v1/brands/{brandId}/templates/email/{templateName}/customizations/{customizationId}", operationID: "deleteEmailCustomization"},
+	{method: "GET", path: "/api/v1/brands/{brandId}/templates/email/{templateName}/customizations/{customizationId}/preview", operationID: "getCustomizationPreview"},
+	{method: "GET", path: "/api/v1/brands/{brandId}/templates/email/{templateName}/default-content", operationID: "getEmailDefaultContent"},
+	{method: "GET", path: "/api/v1/brands/{brandId}/templates/email/{templateName}/default-content/preview", operationID: "getEmailDefaultPreview"},
+	{method: "GET", path: "/api/v1/brands/{brandId}/templates/email/{templateName}/settings", operationID: "getEmailSettings"},
+	{method: "PUT", path: "/api/v1/brands/{brandId}/templates/email/{templateName}/settings", operationID: "replaceEmailSettings"},
+	{method: "POST", path: "/api/v1/brands/{brandId}/templates/email/{templateName}/test", operationID: "sendTestEmail"},
+	{method: "GET", path: "/api/v1/brands/{brandId}/themes", operationID: "listBrandThemes"},
+	{method: "GET", path: "/api/v1/brands/{brandId}/themes/{themeId}", operationID: "getBrandTheme"},
+	{method: "PUT", path: "/api/v1/brands/{brandId}/themes/{themeId}", operationID: "replaceBrandTheme"},
+	{method: "POST", path: "/api/v1/brands/{brandId}/themes/{themeId}/background-image", operationID: "uploadBrandThemeBackgroundImage"},
+	{method: "DELETE", path: "/api/v1/brands/{brandId}/themes/{themeId}/background-image", operationID: "deleteBrandThemeBackgroundImage"},
+	{method: "POST", path: "/api/v1/brands/{brandId}/themes/{themeId}/favicon", operationID: "uploadBrandThemeFavicon"},
+	{method: "DELETE", path: "/api/v1/brands/{brandId}/themes/{themeId}/favicon", operationID: "deleteBrandThemeFavicon"},
+	{method: "POST", path: "/api/v1/brands/{brandId}/themes/{themeId}/logo", operationID: "uploadBrandThemeLogo"},
+	{method: "DELETE", path: "/api/v1/brands/{brandId}/themes/{themeId}/logo", operationID: "deleteBrandThemeLogo"},
+	{method: "GET", path: "/api/v1/captchas", operationID: "listCaptchaInstances"},
+	{method: "POST", path: "/api/v1/captchas", operationID: "createCaptchaInstance"},
+	{method: "GET", path: "/api/v1/captchas/{captchaId}", operationID: "getCaptchaInstance"},
+	{method: "POST", path: "/api/v1/captchas/{captchaId}", operationID: "updateCaptchaInstance"},
+	{method: "PUT", path: "/api/v1/captchas/{captchaId}", operationID: "replaceCaptchaInstance"},
+	{method: "DELETE", path: "/api/v1/captchas/{captchaId}", operationID: "deleteCaptchaInstance"},
+	{method: "GET", path: "/api/v1/device-assurances", operationID: "listDeviceAssurancePolicies"},
+	{method: "POST", path: "/api/v1/device-assurances", operationID: "createDeviceAssurancePolicy"},
+	{method: "GET", path: "/api/v1/device-assurances/{deviceAssuranceId}", operationID: "getDeviceAssurancePolicy"},
+	{method: "PUT", path: "/api/v1/device-assurances/{deviceAssuranceId}", operationID: "replaceDeviceAssurancePolicy"},
+	{method: "DELETE", path: "/api/v1/device-assurances/{deviceAssuranceId}", operationID: "deleteDeviceAssurancePolicy"},
+	{method: "GET", path: "/api/v1/devices", operationID: "listDevices"},
+	{method: "GET", path: "/api/v1/devices/{deviceId}", operationID: "getDevice"},
+	{method: "DELETE", path: "/api/v1/devices/{deviceId}", operationID: "deleteDevice"},
+	{method: "POST", path: "/api/v1/devices/{deviceId}/lifecycle/activate", operationID: "activateDevice"},
+	{method: "POST", path: "/api/v1/devices/{deviceId}/lifecycle/deactivate", operationID: "deactivateDevice"},
+	{method: "POST", path: "/api/v1/devices/{deviceId}/lifecycle/suspend", operationID: "suspendDevice"},
+	{method: "POST", path: "/api/v1/devices/{deviceId}/lifecycle/unsuspend", operationID: "unsuspendDevice"},
+	{method: "GET", path: "/api/v1/devices/{deviceId}/users", operationID: "listDeviceUsers"},
+	{method: "GET", path: "/api/v1/domains", operationID: "listCustomDomains"},
+	{method: "POST", path: "/api/v1/domains", operationID: "createCustomDomain"},
+	{method: "GET", path: "/api/v1/domains/{domainId}", operationID: "getCustomDomain"},
+	{method: "PUT", path: "/api/v1/domains/{domainId}", operationID: "replaceCustomDomain"},
+	{method: "DELETE", path: "/api/v1/domains/{domainId}", operationID: "deleteCustomDomain"},
+	{method: "PUT", path: "/api/v1/domains/{domainId}/certificate", operationID: "upsertCertificate"},
+	{method: "POST", path: "/api/v1/domains/{domainId}/verify", operationID: "verifyDomain"},
+	{method: "GET", path: "/api/v1/email-domains", operationID: "listEmailDomains"},
+	{method: "POST", path: "/api/v1/email-domains", operationID: "createEmailDomain"},
+	{method: "GET", path: "/api/v1/email-domains/{emailDomainId}", operationID: "getEmailDomain"},
+	{method: "PUT", path: "/api/v1/email-domains/{emailDomainId}", operationID: "replaceEmailDomain"},
+	{method: "DELETE", path: "/api/v1/email-domains/{emailDomainId}", operationID: "deleteEmailDomain"},
+	{method: "POST", path: "/api/v1/email-domains/{emailDomainId}/verify", operationID: "verifyEmailDomain"},
+	{method: "GET", path: "/api/v1/email-servers", operationID: "listEmailServers"},
+	{method: "POST", path: "/api/v1/email-servers", operationID: "createEmailServer"},
+	{method: "GET", path: "/api/v1/email-servers/{emailServerId}", operationID: "getEmailServer"},
+	{method: "PATCH", path: "/api/v1/email-servers/{emailServerId}", operationID: "updateEmailServer"},
+	{method: "DELETE", path: "/api/v1/email-servers/{emailServerId}", operationID: "deleteEmailServer"},
+	{method: "POST", path: "/api/v1/email-servers/{emailServerId}/test", operationID: "testEmailServer"},
+	{method: "GET", path: "/api/v1/eventHooks", operationID: "listEventHooks"},
+	{method: "POST", path: "/api/v1/eventHooks", operationID: "createEventHook"},
+	{method: "GET", path: "/api/v1/eventHooks/{eventHookId}", operationID: "getEventHook"},
+	{method: "PUT", path: "/api/v1/eventHooks/{eventHookId}", operationID: "replaceEventHook"},
+	{method: "DELETE", path: "/api/v1/eventHooks/{eventHookId}", operationID: "deleteEventHook"},
+	{method: "POST", path: "/api/v1/eventHooks/{eventHookId}/lifecycle/activate", operationID: "activateEventHook"},
+	{method: "POST", path: "/api/v1/eventHooks/{eventHookId}/lifecycle/deactivate", operationID: "deactivateEventHook"},
+	{method: "POST", path: "/api/v1/eventHooks/{eventHookId}/lifecycle/verify", operationID: "verifyEventHook"},
+	{method: "GET", path: "/api/v1/features", operationID: "listFeatures"},
+	{method: "GET", path: "/api/v1/features/{featureId}", operationID: "getFeature"},
+	{method: "GET", path: "/api/v1/features/{featureId}/dependencies", operationID: "listFeatureDependencies"},
+	{method: "GET", path: "/api/v1/features/{featureId}/dependents", operationID: "listFeatureDependents"},
+	{method: "POST", path: "/api/v1/features/{featureId}/{lifecycle}", operationID: "updateFeatureLifecycle"},
+	{method: "GET", path: "/api/v1/first-party-app-settings/{appName}", operationID: "getFirstPartyAppSettings"},
+	{method: "PUT", path: "/api/v1/first-party-app-settings/{appName}", operationID: "replaceFirstPartyAppSettings"},
+	{method: "GET", path: "/api/v1/groups", operationID: "listGroups"},
+	{method: "POST", path: "/api/v1/groups", operationID: "createGroup"},
+	{method: "GET", path: "/api/v1/groups/rules", operationID: "listGroupRules"},
+	{method: "POST", path: "/api/v1/groups/rules", operationID: "createGroupRule"},
+	{method: "GET", path: "/api/v1/groups/rules/{groupRuleId}", operationID: "getGroupRule"},
+	{method: "PUT", path: "/api/v1/groups/rules/{groupRuleId}", operationID: "replaceGroupRule"},
+	{method: "DELETE", path: "/api/v1/groups/rules/{groupRuleId}", operationID: "deleteGroupRule"},
+	{method: "POST", path: "/api/v1/groups/rules/{groupRuleId}/lifecycle/activate", operationID: "activateGroupRule"},
+	{method: "POST", path: "/api/v1/groups/rules/{groupRuleId}/lifecycle/deactivate", operationID: "deactivateGroupRule"},
+	{method: "GET", path: "/api/v1/groups/{groupId}", operationID: "getGroup"},
+	{method: "PUT", path: "/api/v1/groups/{groupId}", operationID: "replaceGroup"},
+	{method: "DELETE", path: "/api/v1/groups/{groupId}", operationID: "deleteGroup"},
+	{method: "GET", path: "/api/v1/groups/{groupId}/apps", operationID: "listAssignedApplicationsForGroup"},
+	{method: "GET", path: "/api/v1/groups/{groupId}/owners", operationID: "listGroupOwners"},
+	{method: "POST", path: "/api/v1/groups/{groupId}/owners", operationID: "assignGroupOwner"},
+	{method: "DELETE", path: "/api/v1/groups/{groupId}/owners/{ownerId}", operationID: "deleteGroupOwner"},
+	{method: "GET", path: "/api/v1/groups/{groupId}/roles", operationID: "listGroupAssignedRoles"},
+	{method: "POST", path: "/api/v1/groups/{groupId}/roles", operationID: "assignRoleToGroup"},
+	{method: "GET", path: "/api/v1/groups/{groupId}/roles/{roleId}", operationID: "getGroupAssignedRole"},
+	{method: "DELETE", path: "/api/v1/groups/{groupId}/roles/{roleId}", operationID: "unassignRoleFromGroup"},
+	{method: "GET", path: "/api/v1/groups/{groupId}/roles/{roleId}/targets/catalog/apps", operationID: "listApplicationTargetsForApplicationAdministratorRoleForGroup"},
+	{method: "PUT", path: "/api/v1/groups/{groupId}/roles/{roleId}/targets/catalog/apps/{appName}", operationID: "assignAppTargetToAdminRoleForGroup"},
+	{method: "DELETE", path: "/api/v1/groups/{groupId}/roles/{roleId}/targets/catalog/apps/{appName}", operationID: "unassignAppTargetToAdminRoleForGroup"},
+	{method: "PUT", path: "/api/v1/groups/{groupId}/roles/{roleId}/targets/catalog/apps/{appName}/{appId}", operationID: "assignAppInstanceTargetToAppAdminRoleForGroup"},
+	{method: "DELETE", path: "/api/v1/groups/{groupId}/roles/{roleId}/targets/catalog/apps/{appName}/{appId}", operationID: "unassignAppInstanceTargetToAppAdminRoleForGroup"},
+	{method: "GET", path: "/api/v1/groups/{groupId}/roles/{roleId}/targets/groups", operationID: "listGroupTargetsForGroupRole"},
+	{method: "PUT", path: "/api/v1/groups/{groupId}/roles/{roleId}/targets/groups/{targetGroupId}", operationID: "assignGroupTargetToGroupAdminRole"},
+	{method: "DELETE", path: "/api/v1/groups/{groupId}/roles/{roleId}/targets/groups/{targetGroupId}", operationID: "unassignGroupTargetFromGroupAdminRole"},
+	{method: "GET", path: "/api/v1/groups/{groupId}/users", operationID: "listGroupUsers"},
+	{method: "PUT", path: "/api/v1/groups/{groupId}/users/{userId}", operationID: "assignUserToGroup"},
+	{method: "DELETE", path: "/api/v1/groups/{groupId}/users/{userId}", operationID: "unassignUserFromGroup"},
+	{method: "GET", path: "/api/v1/hook-keys", operationID: "listHookKeys"},
+	{method: "POST", path: "/api/v1/hook-keys", operationID: "createHookKey"},
+	{method: "GET", path: "/api/v1/hook-keys/public/{publicKeyId}", operationID: "getPublicKey"},
+	{method: "GET", path: "/api/v1/hook-keys/{hookKeyId}", operationID: "getHookKey"},
+	{method: "PUT", path: "/api/v1/hook-keys/{hookKeyId}", operationID: "replaceHookKey"},
+	{method: "DELETE", path: "/api/v1/hook-keys/{hookKeyId}", operationID: "deleteHookKey"},
+	{method: "GET", path: "/api/v1/iam/assignees/users", operationID: "listUsersWithRoleAssignments"},
+	{method: "GET", path: "/api/v1/iam/resource-sets", operationID: "listResourceSets"},
+	{method: "POST", path: "/api/v1/iam/resource-sets", operationID: "createResourceSet"},
+	{method: "GET", path: "/api/v1/iam/resource-sets/{resourceSetId}", operationID: "getResourceSet"},
+	{method: "PUT", path: "/api/v1/iam/resource-sets/{resourceSetId}", operationID: "replaceResourceSet"},
+	{method: "DELETE", path: "/api/v1/iam/resource-sets/{resourceSetId}", operationID: "deleteResourceSet"},
+	{method: "GET", path: "/api/v1/iam/resource-sets/{resourceSetId}/bindings", operationID: "listBindings"},
+	{method: "POST", path: "/api/v1/iam/resource-sets/{resourceSetId}/bindings", operationID: "createResourceSetBinding"},
+	{method: "GET", path: "/api/v1/iam/resource-sets/{resourceSetId}/bindings/{roleIdOrLabel}", operationID: "getBinding"},
+	{method: "DELETE", path: "/api/v1/iam/resource-sets/{resourceSetId}/bindings/{roleIdOrLabel}", operationID: "deleteBinding"},
+	{method: "GET", path: "/api/v1/iam/resource-sets/{resourceSetId}/bindings/{roleIdOrLabel}/members", operationID: "listMembersOfBinding"},
+	{method: "PATCH", path: "/api/v1/iam/resource-sets/{resourceSetId}/bindings/{roleIdOrLabel}/members", operationID: "addMembersToBinding"},
+	{method: "GET", path: "/api/v1/iam/resource-sets/{resourceSetId}/bindings/{roleIdOrLabel}/members/{memberId}", operationID: "getMemberOfBinding"},
+	{method: "DELETE", path: "/api/v1/iam/resource-sets/{resourceSetId}/bindings/{roleIdOrLabel}/members/{memberId}", operationID: "unassignMemberFromBinding"},
+	{method: "GET", path: "/api/v1/iam/resource-sets/{resourceSetId}/resources", operationID: "listResourceSetResources"},
+	{method: "PATCH", path: "/api/v1/iam/resource-sets/{resourceSetId}/resources", operationID: "addResourceSetResource"},
+	{method: "DELETE", path: "/api/v1/iam/resource-sets/{resourceSetId}/resources/{resourceId}", operationID: "deleteResourceSetResource"},
+	{method: "GET", path: "/api/v1/iam/roles", operationID: "listRoles"},
+	{method: "POST", path: "/api/v1/iam/roles", operationID: "createRole"},
+	{method: "GET", path: "/api/v1/iam/roles/{roleIdOrLabel}", operationID: "getRole"},
+	{method: "PUT", path: "/api/v1/iam/roles/{roleIdOrLabel}", operationID: "replaceRole"},
+	{method: "DELETE", path: "/api/v1/iam/roles/{roleIdOrLabel}", operationID: "deleteRole"},
+	{method: "GET", path: "/api/v1/iam/roles/{roleIdOrLabel}/permissions", operationID: "listRolePermissions"},
+	{method: "GET", path: "/api/v1/iam/roles/{roleIdOrLabel}/permissions/{permissionType}", operationID: "getRolePermission"},
+	{method: "POST", path: "/api/v1/iam/roles/{roleIdOrLabel}/permissions/{permissionType}", operationID: "createRolePermission"},
+	{method: "PUT", path: "/api/v1/iam/roles/{roleIdOrLabel}/permissions/{permissionType}", operationID: "replaceRolePermission"},
+	{method: "DELETE", path: "/api/v1/iam/roles/{roleIdOrLabel}/permissions/{permissionType}", operationID: "deleteRolePermission"},
+	{method: "GET", path: "/api/v1/identity-sources/{identitySourceId}/sessions", operationID: "listIdentitySourceSessions"},
+	{method: "POST", path: "/api/v1/identity-sources/{identitySourceId}/sessions", operationID: "createIdentitySourceSession"},
+	{method: "GET", path: "/api/v1/identity-sources/{identitySourceId}/sessions/{sessionId}", operationID: "getIdentitySourceSession"},
+	{method: "DELETE", path: "/api/v1/identity-sources/{identitySourceId}/sessions/{sessionId}", operationID: "deleteIdentitySourceSession"},
+	{method: "POST", path: "/api/v1/identity-sources/{identitySourceId}/sessions/{sessionId}/bulk-delete", operationID: "uploadIdentitySourceDataForDelete"},
+	{method: "POST", path: "/api/v1/identity-sources/{identitySourceId}/sessions/{sessionId}/bulk-upsert", operationID: "uploadIdentitySourceDataForUpsert"},
+	{method: "POST", path: "/api/v1/identity-sources/{identitySourceId}/sessions/{sessionId}/start-import", operationID: "startImportFromIdentitySource"},
+	{method: "GET", path: "/api/v1/idps", operationID: "listIdentityProviders"},
+	{method: "POST", path: "/api/v1/idps", operationID: "createIdentityProvider"},
+	{method: "GET", path: "/api/v1/idps/credentials/keys", operationID: "listIdentityProviderKeys"},
+	{method: "POST", path: "/api/v1/idps/credentials/keys", operationID: "createIdentityProviderKey"},
+	{method: "GET", path: "/api/v1/idps/credentials/keys/{idpKeyId}", operationID: "getIdentityProviderKey"},
+	{method: "DELETE", path: "/api/v1/idps/credentials/keys/{idpKeyId}", operationID: "deleteIdentityProviderKey"},
+	{method: "GET", path: "/api/v1/idps/{idpId}", operationID: "getIdentityProvider"},
+	{method: "PUT", path: "/api/v1/idps/{idpId}", operationID: "replaceIdentityProvider"},
+	{method: "DELETE", path: "/api/v1/idps/{idpId}", operationID: "deleteIdentityProvider"},
+	{method: "GET", path: "/api/v1/idps/{idpId}/credentials/csrs", operationID: "listCsrsForIdentityProvider"},
+	{method: "POST", path: "/api/v1/idps/{idpId}/credentials/csrs", operationID: "generateCsrForIdentityProvider"},
+	{method: "GET", path: "/api/v1/idps/{idpId}/credentials/csrs/{idpCsrId}", operationID: "getCsrForIdentityProvider"},
+	{method: "DELETE", path: "/api/v1/idps/{idpId}/credentials/csrs/{idpCsrId}", operationID: "revokeCsrForIdentityProvider"},
+	{method: "POST", path: "/api/v1/idps/{idpId}/credentials/csrs/{idpCsrId}/lifecycle/publish", operationID: "publishCsrForIdentityProvider"},
+	{method: "GET", path: "/api/v1/idps/{idpId}/credentials/keys", operationID: "listIdentityProviderSigningKeys"},
+	{method: "POST", path: "/api/v1/idps/{idpId}/credentials/keys/generate", operationID: "generateIdentityProviderSigningKey"},
+	{method: "GET", path: "/api/v1/idps/{idpId}/credentials/keys/{idpKeyId}", operationID: "getIdentityProviderSigningKey"},
+	{method: "POST", path: "/api/v1/idps/{idpId}/credentials/keys/{idpKeyId}/clone", operationID: "cloneIdentityProviderKey"},
+	{method: "POST", path: "/api/v1/idps/{idpId}/lifecycle/activate", operationID: "activateIdentityProvider"},
+	{method: "POST", path: "/api/v1/idps/{idpId}/lifecycle/deactivate", operationID: "deactivateIdentityProvider"},
+	{method: "GET", path: "/api/v1/idps/{idpId}/users", operationID: "listIdentityProviderApplicationUsers"},
+	{method: "GET", path: "/api/v1/idps/{idpId}/users/{userId}", operationID: "getIdentityProviderApplicationUser"},
+	{method: "POST", path: "/api/v1/idps/{idpId}/users/{userId}", operationID: "linkUserToIdentityProvider"},
+	{method: "DELETE", path: "/api/v1/idps/{idpId}/users/{userId}", operationID: "unlinkUserFromIdentityProvider"},
+	{method: "GET", path: "/api/v1/idps/{idpId}/users/{userId}/credentials/tokens", operationID: "listSocialAuthTokens"},
+	{method: "GET", path: "/api/v1/inlineHooks", operationID: "listInlineHooks"},
+	{method: "POST", path: "/api/v1/inlineHooks", operationID: "createInlineHook"},
+	{method: "GET", path: "/api/v1/inlineHooks/{inlineHookId}", operationID: "getInlineHook"},
+	{method: "PUT", path: "/api/v1/inlineHooks/{inlineHookId}", operationID: "replaceInlineHook"},
+	{method: "DELETE", path: "/api/v1/inlineHooks/{inlineHookId}", operationID: "deleteInlineHook"},
+	{method: "POST", path: "/api/v1/inlineHooks/{inlineHookId}/execute", operationID: "executeInlineHook"},
+	{method: "POST", path: "/api/v1/inlineHooks/{inlineHookId}/lifecycle/activate", operationID: "activateInlineHook"},
+	{method: "POST", path: "/api/v1/inlineHooks/{inlineHookId}/lifecycle/deactivate", operationID: "deactivateInlineHook"},
+	{method: "GET", path: "/api/v1/logStreams", operationID: "listLogStreams"},
+	{method: "POST", path: "/api/v1/logStreams", operationID: "createLogStream"},
+	{method: "GET", path: "/api/v1/logStreams/{logStreamId}", operationID: "getLogStream"},
+	{method: "PUT", path: "/api/v1/logStreams/{logStreamId}", operationID: "replaceLogStream"},
+	{method: "DELETE", path: "/api/v1/logStreams/{logStreamId}", operationID: "deleteLogStream"},
+	{method: "POST", path: "/api/v1/logStreams/{logStreamId}/lifecycle/activate", operationID: "activateLogStream"},
+	{method: "POST", path: "/api/v1/logStreams/{logStreamId}/lifecycle/deactivate", operationID: "deactivateLogStream"},
+	{method: "GET", path: "/api/v1/logs", operationID: "listLogEvents"},
+	{method: "GET", path: "/api/v1/mappings", operationID: "listProfileMappings"},
+	{method: "GET", path: "/api/v1/mappings/{mappingId}", operationID: "getProfileMapping"},
+	{method: "POST", path: "/api/v1/mappings/{mappingId}", operationID: "updateProfileMapping"},
+	{method: "GET", path: "/api/v1/meta/schemas/apps/{appId}/default", operationID: "getApplicationUserSchema"},
+	{method: "POST", path: "/api/v1/meta/schemas/apps/{appId}/default", operationID: "updateApplicationUserProfile"},
+	{method: "GET", path: "/api/v1/meta/schemas/group/default", operationID: "getGroupSchema"},
+	{method: "POST", path: "/api/v1/meta/schemas/group/default", operationID: "updateGroupSchema"},
+	{method: "GET", path: "/api/v1/meta/schemas/logStream", operationID: "listLogStreamSchemas"},
+	{method: "GET", path: "/api/v1/meta/schemas/logStream/{logStreamType}", operationID: "getLogStreamSchema"},
+	{method: "GET", path: "/api/v1/meta/schemas/user/linkedObjects", operationID: "listLinkedObjectDefinitions"},
+	{method: "POST", path: "/api/v1/meta/schemas/user/linkedObjects", operationID: "createLinkedObjectDefinition"},
+	{method: "GET", path: "/api/v1/meta/schemas/user/linkedObjects/{linkedObjectName}", operationID: "getLinkedObjectDefinition"},
+	{method: "DELETE", path: "/api/v1/meta/schemas/user/linkedObjects/{linkedObjectName}", operationID: "deleteLinkedObjectDefinition"},
+	{method: "GET", path: "/api/v1/meta/schemas/user/{schemaId}", operationID: "getUserSchema"},
+	{method: "POST", path: "/api/v1/meta/schemas/user/{schemaId}", operationID: "updateUserProfile"},
+	{method: "GET", path: "/api/v1/meta/types/user", operationID: "listUserTypes"},
+	{method: "POST", path: "/api/v1/meta/types/user", operationID: "createUserType"},
+	{method: "GET", path: "/api/v1/meta/types/user/{typeId}", operationID: "getUserType"},
+	{method: "POST", path: "/api/v1/meta/types/user/{typeId}", operationID: "updateUserType"},
+	{method: "PUT", path: "/api/v1/meta/types/user/{typeId}", operationID: "replaceUserType"},
+	{method: "DELETE", path: "/api/v1/meta/types/user/{typeId}", operationID: "deleteUserType"},
+	{method: "GET", path: "/api/v1/meta/uischemas", operationID: "listUISchemas"},
+	{method: "POST", path: "/api/v1/meta/uischemas", operationID: "createUISchema"},
+	{method: "GET", path: "/api/v1/meta/uischemas/{id}", operationID: "getUISchema"},
+	{method: "PUT", path: "/api/v1/meta/uischemas/{id}", operationID: "replaceUISchemas"},
+	{method: "DELETE", path: "/api/v1/meta/uischemas/{id}", operationID: "deleteUISchemas"},
+	{method: "GET", path: "/api/v1/org", operationID: "getOrgSettings"},
+	{method: "POST", path: "/api/v1/org", operationID: "updateOrgSettings"},
+	{method: "PUT", path: "/api/v1/org", operationID: "replaceOrgSettings"},
+	{method: "GET", path: "/api/v1/org/captcha", operationID: "getOrgCaptchaSettings"},
+	{method: "PUT", path: "/api/v1/org/captcha", operationID: "replacesOrgCaptchaSettings"},
+	{method: "DELETE", path: "/api/v1/org/captcha", operationID: "deleteOrgCaptchaSettings"},
+	{method: "GET", path: "/api/v1/org/contacts", operationID: "getOrgContactTypes"},
+	{method: "GET", path: "/api/v1/org/contacts/{contactType}", operationID: "getOrgContactUser"},
+	{method: "PUT", path: "/api/v1/org/contacts/{contactType}", operationID: "replaceOrgContactUser"},
+	{method: "POST", path: "/api/v1/org/email/bounces/remove-list", operationID: "bulkRemoveEmailAddressBounces"},
+	{method: "POST", path: "/api/v1/org/logo", operationID: "uploadOrgLogo"},
+	{method: "GET", path: "/api/v1/org/orgSettings/thirdPartyAdminSetting", operationID: "getThirdPartyAdminSetting"},
+	{method: "POST", path: "/api/v1/org/orgSettings/thirdPartyAdminSetting", operationID: "updateThirdPartyAdminSetting"},
+	{method: "GET", path: "/api/v1/org/preferences", operationID: "getOrgPreferences"},
+	{method: "POST", path: "/api/v1/org/preferences/hideEndUserFooter", operationID: "updateOrgHideOktaUIFooter"},
+	{method: "POST", path: "/api/v1/org/preferences/showEndUserFooter", operationID: "updateOrgShowOktaUIFooter"},
+	{method: "GET", path: "/api/v1/org/privacy/oktaCommunication", operationID: "getOktaCommunicationSettings"},
+	{method: "POST", path: "/api/v1/org/privacy/oktaCommunication/optIn", operationID: "optInUsersToOktaCommunicationEmails"},
+	{method: "POST", path: "/api/v1/org/privacy/oktaCommunication/optOut", operationID: "optOutUsersFromOktaCommunicationEmails"},
+	{method: "GET", path: "/api/v1/org/privacy/oktaSupport", operationID: "getOrgOktaSupportSettings"},
+	{method: "POST", path: "/api/v1/org/privacy/oktaSupport/extend", operationID: "extendOktaSupport"},
+	{method: "POST", path: "/api/v1/org/privacy/oktaSupport/grant", operationID: "grantOktaSupport"},
+	{method: "POST", path: "/api/v1/org/privacy/oktaSupport/revoke", operationID: "revokeOktaSupport"},
+	{method: "GET", path: "/api/v1/org/settings/clientPrivilegesSetting", operationID: "getClientPrivilegesSetting"},
+	{method: "PUT", path: "/api/v1/org/settings/clientPrivilegesSetting", operationID: "assignClientPrivilegesSetting"},
+	{method: "GET", path: "/api/v1/policies", operationID: "listPolicies"},
+	{method: "POST", path: "/api/v1/policies", operationID: "createPolicy"},
+	{method: "POST", path: "/api/v1/policies/simulate", operationID: "createPolicySimulation"},
+	{method: "GET", path: "/api/v1/policies/{policyId}", operationID: "getPolicy"},
+	{method: "PUT", path: "/api/v1/policies/{policyId}", operationID: "replacePolicy"},
+	{method: "DELETE", path: "/api/v1/policies/{policyId}", operationID: "deletePolicy"},
+	{method: "GET", path: "/api/v1/policies/{policyId}/app", operationID: "listPolicyApps"},
+	{method: "POST", path: "/api/v1/policies/{policyId}/clone", operationID: "clonePolicy"},
+	{method: "POST", path: "/api/v1/policies/{policyId}/lifecycle/activate", operationID: "activatePolicy"},
+	{method: "POST", path: "/api/v1/policies/{policyId}/lifecycle/deactivate", operationID: "deactivatePolicy"},
+	{method: "GET", path: "/api/v1/policies/{policyId}/mappings", operationID: "listPolicyMappings"},
+	{method: "POST", path: "/api/v1/policies/{policyId}/mappings", operationID: "mapResourceToPolicy"},
+	{method: "GET", path: "/api/v1/policies/{policyId}/mappings/{mappingId}", operationID: "getPolicyMapping"},
+	{method: "DELETE", path: "/api/v1/policies/{policyId}/mappings/{mappingId}", operationID: "deletePolicyResourceMapping"},
+	{method: "GET", path: "/api/v1/policies/{policyId}/rules", operationID: "listPolicyRules"},
+	{method: "POST", path: "/api/v1/policies/{policyId}/rules", operationID: "createPolicyRule"},
+	{method: "GET", path: "/api/v1/policies/{policyId}/rules/{ruleId}", operationID: "getPolicyRule"},
+	{method: "PUT", path: "/api/v1/policies/{policyId}/rules/{ruleId}", operationID: "replacePolicyRule"},
+	{method: "DELETE", path: "/api/v1/policies/{policyId}/rules/{ruleId}", operationID: "deletePolicyRule"},
+	{method: "POST", path: "/api/v1/policies/{policyId}/rules/{ruleId}/lifecycle/activate", operationID: "activatePolicyRule"},
+	{method: "POST", path: "/api/v1/policies/{policyId}/rules/{ruleId}/lifecycle/deactivate", operationID: "deactivatePolicyRule"},
+	{method: "GET", path: "/api/v1/principal-rate-limits", operationID: "listPrincipalRateLimitEntities"},
+	{method: "POST", path: "/api/v1/principal-rate-limits", operationID: "createPrincipalRateLimitEntity"},
+	{method: "GET", path: "/api/v1/principal-rate-limits/{principalRateLimitId}", operationID: "getPrincipalRateLimitEntity"},
+	{method: "PUT", path: "/api/v1/principal-rate-limits/{principalRateLimitId}", operationID: "replacePrincipalRateLimitEntity"},
+	{method: "GET", path: "/api/v1/push-providers", operationID: "listPushProviders"},
+	{method: "POST", path: "/api/v1/push-providers", operationID: "createPushProvider"},
+	{method: "GET", path: "/api/v1/push-providers/{pushProviderId}", operationID: "getPushProvider"},
+	{method: "PUT", path: "/api/v1/push-providers/{pushProviderId}", operationID: "replacePushProvider"},
+	{method: "DELETE", path: "/api/v1/push-providers/{pushProviderId}", operationID: "deletePushProvider"},
+	{method: "GET", path: "/api/v1/rate-limit-settings/admin-notifications", operationID: "getRateLimitSettingsAdminNotifications"},
+	{method: "PUT", path: "/api/v1/rate-limit-settings/admin-notifications", operationID: "replaceRateLimitSettingsAdminNotifications"},
+	{method: "GET", path: "/api/v1/rate-limit-settings/per-client", operationID: "getRateLimitSettingsPerClient"},
+	{method: "PUT", path: "/api/v1/rate-limit-settings/per-client", operationID: "replaceRateLimitSettingsPerClient"},
+	{method: "GET", path: "/api/v1/rate-limit-settings/warning-threshold", operationID: "getRateLimitSettingsWarningThreshold"},
+	{method: "PUT", path: "/api/v1/rate-limit-settings/warning-threshold", operationID: "replaceRateLimitSettingsWarningThreshold"},
+	{method: "GET", path: "/api/v1/realm-assignments", operationID: "listRealmAssignments"},
+	{method: "POST", path: "/api/v1/realm-assignments", operationID: "createRealmAssignment"},
+	{method: "GET", path: "/api/v1/realm-assignments/operations", operationID: "listRealmAssignmentOperations"},
+	{method: "POST", path: "/api/v1/realm-assignments/operations", operationID: "executeRealmAssignment"},
+	{method: "GET", path: "/api/v1/realm-assignments/{assignmentId}", operationID: "getRealmAssignment"},
+	{method: "PUT", path: "/api/v1/realm-assignments/{assignmentId}", operationID: "replaceRealmAssignment"},
+	{method: "DELETE", path: "/api/v1/realm-assignments/{assignmentId}", operationID: "deleteRealmAssignment"},
+	{method: "POST", path: "/api/v1/realm-assignments/{assignmentId}/lifecycle/activate", operationID: "activateRealmAssignment"},
+	{method: "POST", path: "/api/v1/realm-assignments/{assignmentId}/lifecycle/deactivate", operationID: "deactivateRealmAssignment"},
+	{method: "GET", path: "/api/v1/realms", operationID: "listRealms"},
+	{method: "POST", path: "/api/v1/realms", operationID: "createRealm"},
+	{method: "GET", path: "/api/v1/realms/{realmId}", operationID: "getRealm"},
+	{method: "PUT", path: "/api/v1/realms/{realmId}", operationID: "replaceRealm"},
+	{method: "DELETE", path: "/api/v1/realms/{realmId}", operationID: "deleteRealm"},
+	{method: "POST", path: "/api/v1/risk/events/ip", operationID: "sendRiskEvents"},
+	{method: "GET", path: "/api/v1/risk/providers", operationID: "listRiskProviders"},
+	{method: "POST", path: "/api/v1/risk/providers", operationID: "createRiskProvider"},
+	{method: "GET", path: "/api/v1/risk/providers/{riskProviderId}", operationID: "getRiskProvider"},
+	{method: "PUT", path: "/api/v1/risk/providers/{riskProviderId}", operationID: "replaceRiskProvider"},
+	{method: "DELETE", path: "/api/v1/risk/providers/{riskProviderId}", operationID: "deleteRiskProvider"},
+	{method: "GET", path: "/api/v1/roles/{roleRef}/subscriptions", operationID: "listSubscriptionsRole"},
+	{method: "GET", path: "/api/v1/roles/{roleRef}/subscriptions/{notificationType}", operationID: "getSubscriptionsNotificationTypeRole"},
+	{method: "POST", path: "/api/v1/roles/{roleRef}/subscriptions/{notificationType}/subscribe", operationID: "subscribeByNotificationTypeRole"},
+	{method: "POST", path: "/api/v1/roles/{roleRef}/subscriptions/{notificationType}/unsubscribe", operationID: "unsubscribeByNotificationTypeRole"},
+	{method: "GET", path: "/api/v1/security-events-providers", operationID: "listSecurityEventsProviderInstances"},
+	{method: "POST", path: "/api/v1/security-events-providers", operationID: "createSecurityEventsProviderInstance"},
+	{method: "GET", path: "/api/v1/security-events-providers/{securityEventProviderId}", operationID: "getSecurityEventsProviderInstance"},
+	{method: "PUT", path: "/api/v1/security-events-providers/{securityEventProviderId}", operationID: "replaceSecurityEventsProviderInstance"},
+	{method: "DELETE", path: "/api/v1/security-events-providers/{securityEventProviderId}", operationID: "deleteSecurityEventsProviderInstance"},
+	{method: "POST", path: "/api/v1/security-events-providers/{securityEventProviderId}/lifecycle/activate", operationID: "activateSecurityEventsProviderInstance"},
+	{method: "POST", path: "/api/v1/security-events-providers/{securityEventProviderId}/lifecycle/deactivate", operationID: "deactivateSecurityEventsProviderInstance"},
+	{method: "POST", path: "/api/v1/sessions", operationID: "createSession"},
+	{method: "GET", path: "/api/v1/sessions/me", operationID: "getCurrentSession"},
+	{method: "DELETE", path: "/api/v1/sessions/me", operationID: "closeCurrentSession"},
+	{method: "POST", path: "/api/v1/sessions/me/lifecycle/refresh", operationID: "refreshCurrentSession"},
+	{method: "GET", path: "/api/v1/sessions/{sessionId}", operationID: "getSession"},
+	{method: "DELETE", path: "/api/v1/sessions/{sessionId}", operationID: "revokeSession"},
+	{method: "POST", path: "/api/v1/sessions/{sessionId}/lifecycle/refresh", operationID: "refreshSession"},
+	{method: "GET", path: "/api/v1/templates/sms", operationID: "listSmsTemplates"},
+	{method: "POST", path: "/api/v1/templates/sms", operationID: "createSmsTemplate"},
+	{method: "GET", path: "/api/v1/templates/sms/{templateId}", operationID: "getSmsTemplate"},
+	{method: "POST", path: "/api/v1/templates/sms/{templateId}", operationID: "updateSmsTemplate"},
+	{method: "PUT", path: "/api/v1/templates/sms/{templateId}", operationID: "replaceSmsTemplate"},
+	{method: "DELETE", path: "/api/v1/templates/sms/{templateId}", operationID: "deleteSmsTemplate"},
+	{method: "GET", path: "/api/v1/threats/configuration", operationID: "getCurrentConfiguration"},
+	{method: "POST", path: "/api/v1/threats/configuration", operationID: "updateConfiguration"},
+	{method: "GET", path: "/api/v1/trustedOrigins", operationID: "listTrustedOrigins"},
+	{method: "POST", path: "/api/v1/trustedOrigins", operationID: "createTrustedOrigin"},
+	{method: "GET", path: "/api/v1/trustedOrigins/{trustedOriginId}", operationID: "getTrustedOrigin"},
+	{method: "PUT", path: "/api/v1/trustedOrigins/{trustedOriginId}", operationID: "replaceTrustedOrigin"},
+	{method: "DELETE", path: "/api/v1/trustedOrigins/{trustedOriginId}", operationID: "deleteTrustedOrigin"},
+	{method: "POST", path: "/api/v1/trustedOrigins/{trustedOriginId}/lifecycle/activate", operationID: "activateTrustedOrigin"},
+	{method: "POST", path: "/api/v1/trustedOrigins/{trustedOriginId}/lifecycle/deactivate", operationID: "deactivateTrustedOrigin"},
+	{method: "GET", path: "/api/v1/users", operationID: "listUsers"},
+	{method: "POST", path: "/api/v1/users", operationID: "createUser"},
+	{method: "GET", path: "/api/v1/users/{userId}", operationID: "getUser"},
+	{method: "POST", path: "/api/v1/users/{userId}", operationID: "updateUser"},
+	{method: "PUT", path: "/api/v1/users/{userId}", operationID: "replaceUser"},
+	{method: "DELETE", path: "/api/v1/users/{userId}", operationID: "deleteUser"},
+	{method: "GET", path: "/api/v1/users/{userId}/appLinks", operationID: "listAppLinks"},
+	{method: "GET", path: "/api/v1/users/{userId}/blocks", operationID: "listUserBlocks"},
+	{method: "GET", path: "/api/v1/users/{userId}/clients", operationID: "listUserClients"},
+	{method: "GET", path: "/api/v1/users/{userId}/clients/{clientId}/grants", operationID: "listGrantsForUserAndClient"},
+	{method: "DELETE", path: "/api/v1/users/{userId}/clients/{clientId}/grants", operationID: "revokeGrantsForUserAndClient"},
+	{method: "GET", path: "/api/v1/users/{userId}/clients/{clientId}/tokens", operationID: "listRefreshTokensForUserAndClient"},
+	{method: "DELETE", path: "/api/v1/users/{userId}/clients/{clientId}/tokens", operationID: "revokeTokensForUserAndClient"},
+	{method: "GET", path: "/api/v1/users/{userId}/clients/{clientId}/tokens/{tokenId}", operationID: "getRefreshTokenForUserAndClient"},
+	{method: "DELETE", path: "/api/v1/users/{userId}/clients/{clientId}/tokens/{tokenId}", operationID: "revokeTokenForUserAndClient"},
+	{method: "POST", path: "/api/v1/users/{userId}/credentials/change_password", operationID: "changePassword"},
+	{method: "POST", path: "/api/v1/users/{userId}/credentials/change_recovery_question", operationID: "changeRecoveryQuestion"},
+	{method: "POST", path: "/api/v1/users/{userId}/credentials/forgot_password", operationID: "forgotPassword"},
+	{method: "POST", path: "/api/v1/users/{userId}/credentials/forgot_password_recovery_question", operationID: "forgotPasswordSetNewPassword"},
+	{method: "GET", path: "/api/v1/users/{userId}/factors", operationID: "listFactors"},
+	{method: "POST", path: "/api/v1/users/{userId}/factors", operationID: "enrollFactor"},
+	{method: "GET", path: "/api/v1/users/{userId}/factors/catalog", operationID: "listSupportedFactors"},
+	{method: "GET", path: "/api/v1/users/{userId}/factors/questions", operationID: "listSupportedSecurityQuestions"},
+	{method: "GET", path: "/api/v1/users/{userId}/factors/{factorId}", operationID: "getFactor"},
+	{method: "DELETE", path: "/api/v1/users/{userId}/factors/{factorId}", operationID: "unenrollFactor"},
+	{method: "POST", path: "/api/v1/users/{userId}/factors/{factorId}/lifecycle/activate", operationID: "activateFactor"},
+	{method: "POST", path: "/api/v1/users/{userId}/factors/{factorId}/resend", operationID: "resendEnrollFactor"},
+	{method: "GET", path: "/api/v1/users/{userId}/factors/{factorId}/transactions/{transactionId}", operationID: "getFactorTransactionStatus"},
+	{method: "POST", path: "/api/v1/users/{userId}/factors/{factorId}/verify", operationID: "verifyFactor"},
+	{method: "GET", path: "/api/v1/users/{userId}/grants", operationID: "listUserGrants"},
+	{method: "DELETE", path: "/api/v1/users/{userId}/grants", operationID: "revokeUserGrants"},
+	{method: "GET", path: "/api/v1/users/{userId}/grants/{grantId}", operationID: "getUserGrant"},
+	{method: "DELETE", path: "/api/v1/users/{userId}/grants/{grantId}", operationID: "revokeUserGrant"},
+	{method: "GET", path: "/api/v1/users/{userId}/groups", operationID: "listUserGroups"},
+	{method: "GET", path: "/api/v1/users/{userId}/idps", operationID: "listUserIdentityProviders"},
+	{method: "POST", path: "/api/v1/users/{userId}/lifecycle/activate", operationID: "activateUser"},
+	{method: "POST", path: "/api/v1/users/{userId}/lifecycle/deactivate", operationID: "deactivateUser"},
+	{method: "POST", path: "/api/v1/users/{userId}/lifecycle/expire_password", operationID: "expirePassword"},
+	{method: "POST", path: "/api/v1/users/{userId}/lifecycle/expire_password_with_temp_password", operationID: "expirePasswordAndGetTemporaryPassword"},
+	{method: "POST", path: "/api/v1/users/{userId}/lifecycle/reactivate", operationID: "reactivateUser"},
+	{method: "POST", path: "/api/v1/users/{userId}/lifecycle/reset_factors", operationID: "resetFactors"},
+	{method: "POST", path: "/api/v1/users/{userId}/lifecycle/reset_password", operationID: "generateResetPasswordToken"},
+	{method: "POST", path: "/api/v1/users/{userId}/lifecycle/suspend", operationID: "suspendUser"},
+	{method: "POST", path: "/api/v1/users/{userId}/lifecycle/unlock", operationID: "unlockUser"},
+	{method: "POST", path: "/api/v1/users/{userId}/lifecycle/unsuspend", operationID: "unsuspendUser"},
+	{method: "PUT", path: "/api/v1/users/{userId}/linkedObjects/{primaryRelationshipName}/{primaryUserId}", operationID: "setLinkedObjectForUser"},
+	{method: "GET", path: "/api/v1/users/{userId}/linkedObjects/{relationshipName}", operationID: "listLinkedObjectsForUser"},
+	{method: "DELETE", path: "/api/v1/users/{userId}/linkedObjects/{relationshipName}", operationID: "deleteLinkedObjectForUser"},
+	{method: "GET", path: "/api/v1/users/{userId}/roles", operationID: "listAssignedRolesForUser"},
+	{method: "POST", path: "/api/v1/users/{userId}/roles", operationID: "assignRoleToUser"},
+	{method: "GET", path: "/api/v1/users/{userId}/roles/{roleId}", operationID: "getUserAssignedRole"},
+	{method: "DELETE", path: "/api/v1/users/{userId}/roles/{roleId}", operationID: "unassignRoleFromUser"},
+	{method: "GET", path: "/api/v1/users/{userId}/roles/{roleId}/targets/catalog/apps", operationID: "listApplicationTargetsForApplicationAdministratorRoleForUser"},
+	{method: "PUT", path: "/api/v1/users/{userId}/roles/{roleId}/targets/catalog/apps", operationID: "assignAllAppsAsTargetToRoleForUser"},
+	{method: "PUT", path: "/api/v1/users/{userId}/roles/{roleId}/targets/catalog/apps/{appName}", operationID: "assignAppTargetToAdminRoleForUser"},
+	{method: "DELETE", path: "/api/v1/users/{userId}/roles/{roleId}/targets/catalog/apps/{appName}", operationID: "unassignAppTargetFromAppAdminRoleForUser"},
+	{method: "PUT", path: "/api/v1/users/{userId}/roles/{roleId}/targets/catalog/apps/{appName}/{appId}", operationID: "assignAppInstanceTargetToAppAdminRoleForUser"},
+	{method: "DELETE", path: "/api/v1/users/{userId}/roles/{roleId}/targets/catalog/apps/{appName}/{appId}", operationID: "unassignAppInstanceTargetFromAdminRoleForUser"},
+	{method: "GET", path: "/api/v1/users/{userId}/roles/{roleId}/targets/groups", operationID: "listGroupTargetsForRole"},
+	{method: "PUT", path: "/api/v1/users/{userId}/roles/{roleId}/targets/groups/{groupId}", operationID: "assignGroupTargetToUserRole"},
+	{method: "DELETE", path: "/api/v1/users/{userId}/roles/{roleId}/targets/groups/{groupId}", operationID: "unassignGroupTargetFromUserAdminRole"},
+	{method: "DELETE", path: "/api/v1/users/{userId}/sessions", operationID: "revokeUserSessions"},
+	{method: "GET", path: "/api/v1/users/{userId}/subscriptions", operationID: "listSubscriptionsUser"},
+	{method: "GET", path: "/api/v1/users/{userId}/subscriptions/{notificationType}", operationID: "getSubscriptionsNotificationTypeUser"},
+	{method: "POST", path: "/api/v1/users/{userId}/subscriptions/{notificationType}/subscribe", operationID: "subscribeByNotificationTypeUser"},
+	{method: "POST", path: "/api/v1/users/{userId}/subscriptions/{notificationType}/unsubscribe", operationID: "unsubscribeByNotificationTypeUser"},
+	{method: "GET", path: "/api/v1/zones", operationID: "listNetworkZones"},
+	{method: "POST", path: "/api/v1/zones", operationID: "createNetworkZone"},
+	{method: "GET", path: "/api/v1/zones/{zoneId}", operationID: "getNetworkZone"},
+	{method: "PUT", path: "/api/v1/zones/{zoneId}", operationID: "replaceNetworkZone"},
+	{method: "DELETE", path: "/api/v1/zones/{zoneId}", operationID: "deleteNetworkZone"},
+	{method: "POST", path: "/api/v1/zones/{zoneId}/lifecycle/activate", operationID: "activateNetworkZone"},
+	{method: "POST", path: "/api/v1/zones/{zoneId}/lifecycle/deactivate", operationID: "deactivateNetworkZone"},
+	{method: "GET", path: "/attack-protection/api/v1/authenticator-settings", operationID: "getAuthenticatorSettings"},
+	{method: "PUT", path: "/attack-protection/api/v1/authenticator-settings", operationID: "replaceAuthenticatorSettings"},
+	{method: "GET", path: "/attack-protection/api/v1/user-lockout-settings", operationID: "getUserLockoutSettings"},
+	{method: "PUT", path: "/attack-protection/api/v1/user-lockout-settings", operationID: "replaceUserLockoutSettings"},
+	{method: "GET", path: "/integrations/api/v1/api-services", operationID: "listApiServiceIntegrationInstances"},
+	{method: "POST", path: "/integrations/api/v1/api-services", operationID: "createApiServiceIntegrationInstance"},
+	{method: "GET", path: "/integrations/api/v1/api-services/{apiServiceId}", operationID: "getApiServiceIntegrationInstance"},
+	{method: "DELETE", path: "/integrations/api/v1/api-services/{apiServiceId}", operationID: "deleteApiServiceIntegrationInstance"},
+	{method: "GET", path: "/integrations/api/v1/api-services/{apiServiceId}/credentials/secrets", operationID: "listApiServiceIntegrationInstanceSecrets"},
+	{method: "POST", path: "/integrations/api/v1/api-services/{apiServiceId}/credentials/secrets", operationID: "createApiServiceIntegrationInstanceSecret"},
+	{method: "DELETE", path: "/integrations/api/v1/api-services/{apiServiceId}/credentials/secrets/{secretId}", operationID: "deleteApiServiceIntegrationInstanceSecret"},
+	{method: "POST", path: "/integrations/api/v1/api-services/{apiServiceId}/credentials/secrets/{secretId}/lifecycle/activate", operationID: "activateApiServiceIntegrationInstanceSecret"},
+	{method: "POST", path: "/integrations/api/v1/api-services/{apiServiceId}/credentials/secrets/{secretId}/lifecycle/deactivate", operationID: "deactivateApiServiceIntegrationInstanceSecret"},
+	{method: "POST", path: "/security/api/v1/security-events", operationID: "publishSecurityEventTokens"},
+	{method: "POST", path: "/webauthn-registration/api/v1/activate", operationID: "activatePreregistrationEnrollment"},
+	{method: "POST", path: "/webauthn-registration/api/v1/enroll", operationID: "enrollPreregistrationEnrollment"},
+	{method: "POST", path: "/webauthn-registration/api/v1/initiate-fulfillment-request", operationID: "generateFulfillmentRequest"},
+	{method: "GET", path: "/webauthn-registration/api/v1/users/{userId}/enrollments", operationID: "listWebAuthnPreregistrationFactors"},
+	{method: "DELETE", path: "/webauthn-registration/api/v1/users/{userId}/enrollments/{authenticatorEnrollmentId}", operationID: "deleteWebAuthnPreregistrationFactor"},
+}
+
+// OperationOf returns the operationId and the path template of the operation
+// a request with method to path is for, like deleteGroup and
+// /api/v1/groups/{groupId} for DELETE /api/v1/groups/00g1.
+func OperationOf(method, path string) (operationID string, route string, ok bool) {
+	operation, ok := findOperation(method, path)
+	return operation.operationID, operation.path, ok
+}
+
+// OperationRoute returns the method and the path template of the operation
+// with operationID, like POST and /api/v1/apps/{appId}/lifecycle/activate
+// for activateApplication.
+func OperationRoute(operationID string) (method string, route string, ok bool) {
+	for _, operation := range operationRoutes {
+		if operation.operationID == operationID {
+			return operation.method, operation.path, true
+		}
+	}
+	return "", "", false
+}
+
+// findOperation returns the operation of the spec matching method and path,
+// the first one in the order of the spec, like listGroups for
+// GET /api/v1/groups.
+func findOperation(method, path string) (operationRoute, bool) {
+	for _, route := range operationRoutes {
+		if route.method == method && matchRoute(route.path, path) {
+			return route, true
+		}
+	}
+	return operationRoute{}, false
+}
@@ -0,0 +1,30 @@
+package sdk
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindOperation(t *testing.T) {
+	route, ok := findOperation(http.MethodDelete, "/api/v1/api-tokens/current")
+	require.True(t, ok)
+	require.Equal(t, "revokeCurrentApiToken", route.operationID)
+	route, ok = findOperation(http.MethodDelete, "/api/v1/api-tokens/00T1")
+	require.True(t, ok)
+	require.Equal(t, "/api/v1/api-tokens/{apiTokenId}", route.path)
+	_, ok = findOperation(http.MethodGet, "/api/v1/unknown")
+	require.False(t, ok)
+
+	operationID, path, ok := OperationOf(http.MethodPost, "/api/v1/apps/0oa1/lifecycle/deactivate")
+	require.True(t, ok)
+	require.Equal(t, "deactivateApplication", operationID)
+	require.Equal(t, "/api/v1/apps/{appId}/lifecycle/deactivate", path)
+	method, path, ok := OperationRoute("activateApplication")
+	require.True(t, ok)
+	require.Equal(t, http.MethodPost, method)
+	require.Equal(t, "/api/v1/apps/{appId}/lifecycle/activate", path)
+	_, _, ok = OperationRoute("unknown")
+	require.False(t, ok)
+}
@@ -0,0 +1,154 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"iter"
+	"net/http"
+	"strings"
+)
+
+// Pageable is the request builder of a list operation, like
+// ApiListGroupsRequest.
+type Pageable interface {
+	Execute() (*APIResponse, error)
+}
+
+// PageFunc is called with the response of every page before its items are
+// yielded. Returning an error stops the iteration with that error.
+type PageFunc func(resp *APIResponse) error
+
+// Pages executes req and lazily follows the links to the next pages, from
+// the Link header or the body's _links.next or nextPage depending on the
+// operation, yielding the items of every page decoded into a []T. The first
+// page is fetched with the context req was built with, usually ctx, the
+// following ones with ctx. The iteration stops at the first error, which is yielded.
+func Pages[T any](ctx context.Context, req Pageable, onPage ...PageFunc) iter.Seq2[[]T, error] {
+	return func(yield func([]T, error) bool) {
+		if err := ctx.Err(); err != nil {
+			yield(nil, err)
+			return
+		}
+		resp, err := req.Execute()
+		for {
+			if err == nil {
+				err = checkPage(resp)
+			}
+			for _, f := range onPage {
+				if err != nil {
+					break
+				}
+				err = f(resp)
+			}
+			var page []T
+			if err == nil {
+				page, err = decodePage[T](resp)
+			}
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if !yield(page, nil) || !resp.HasNextPage() {
+				return
+			}
+			if err = ctx.Err(); err != nil {
+				yield(nil, err)
+				return
+			}
+			resp, err = resp.NextWithContext(ctx, nil)
+		}
+	}
+}
+
+// All is like Pages but yields the items one by one:
+//
+//	for group, err := range sdk.All[sdk.Group](ctx, client.GroupAPI.ListGroups(ctx)) {
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(group.Profile.GetName())
+//	}
+func All[T any](ctx context.Context, req Pageable, onPage ...PageFunc) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for page, err := range Pages[T](ctx, req, onPage...) {
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range page {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// decodePage decodes the items of a page, which are the body or, for
+// operations paginated in the body, one of its properties.
+func decodePage[T any](resp *APIResponse) ([]T, error) {
+	pg, ok := resp.pg.(*PaginationInBody)
+	if !ok {
+		return Decode[[]T](resp)
+	}
+	body, err := Decode[map[string]json.RawMessage](resp)
+	if err != nil {
+		return nil, err
+	}
+	var items []T
+	if raw, ok := body[pg.Items()]; ok {
+		err = json.Unmarshal(raw, &items)
+	}
+	return items, err
+}
+
+type bodyPaginatedRoute struct {
+	path  string
+	items string
+}
+
+// bodyPaginationItems returns the property holding the items of a response
+// to one of the bodyPaginatedRoutes.
+func bodyPaginationItems(r *http.Response) (string, bool) {
+	if r == nil || r.Request == nil || r.Request.URL == nil || r.Request.Method != http.MethodGet {
+		return "", false
+	}
+	for _, route := range bodyPaginatedRoutes {
+		if matchRoute(route.path, r.Request.URL.Path) {
+			return route.items, true
+		}
+	}
+	return "", false
+}
+
+// matchRoute reports whether path matches a route of the spec, like
+// /api/v1/iam/resource-sets/{resourceSetId}/resources.
+func matchRoute(route, path string) bool {
+	routeSegments := strings.Split(strings.Trim(route, "/"), "/")
+	pathSegments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(routeSegments) != len(pathSegments) {
+		return false
+	}
+	for i, segment := range routeSegments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			if pathSegments[i] == "" {
+				return false
+			}
+		} else if segment != pathSegments[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// checkPage returns the error of a page that was not answered with a 2xx
+// status.
+func checkPage(resp *APIResponse) error {
+	if resp.StatusCode < 300 {
+		return nil
+	}
+	body, _ := io.ReadAll(resp.Body)
+	return &GenericOpenAPIError{body: body, error: resp.Status, cause: newOktaError(resp.Response, body)}
+}
@@ -0,0 +1,172 @@
+package sdk
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/lestrrat-go/jwx/jwk"
+	"github.com/youmark/pkcs8"
+)
+
+// PassphraseFunc returns the passphrase of an encrypted private key. It is
+// only called when the key turns out to be encrypted.
+type PassphraseFunc func() ([]byte, error)
+
+// createKeySigner builds the signer of the client assertion from a PEM
+// (PKCS#1, SEC1 or PKCS#8, optionally encrypted) or JWK encoded private key.
+// The algorithm defaults to RS256 for RSA keys and follows the curve for EC
+// keys, a JWK's kid and alg are used unless privateKeyID or algorithm are set.
+func createKeySigner(privateKey, privateKeyID, algorithm string, passphrase PassphraseFunc) (jose.Signer, error) {
+	var key interface{}
+	var err error
+	if trimmed := strings.TrimSpace(privateKey); strings.HasPrefix(trimmed, "{") {
+		var jwkID, jwkAlgorithm string
+		key, jwkID, jwkAlgorithm, err = parseJWKPrivateKey([]byte(trimmed), privateKeyID)
+		if privateKeyID == "" {
+			privateKeyID = jwkID
+		}
+		if algorithm == "" {
+			algorithm = jwkAlgorithm
+		}
+	} else {
+		key, err = parsePEMPrivateKey([]byte(strings.ReplaceAll(privateKey, `\n`, "\n")), passphrase)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	alg, err := signingAlgorithm(key, algorithm)
+	if err != nil {
+		return nil, err
+	}
+	var signerOptions *jose.SignerOptions
+	if privateKeyID != "" {
+		signerOptions = (&jose.SignerOptions{}).WithHeader("kid", privateKeyID)
+	}
+	return jose.NewSigner(jose.SigningKey{Algorithm: alg, Key: key}, signerOptions)
+}
+
+func parsePEMPrivateKey(priv []byte, passphrase PassphraseFunc) (interface{}, error) {
+	privPem, _ := pem.Decode(priv)
+	if privPem == nil {
+		return nil, errors.New("invalid private key")
+	}
+	der := privPem.Bytes
+	// legacy "Proc-Type: 4,ENCRYPTED" keys, as written by openssl 1.x
+	if x509.IsEncryptedPEMBlock(privPem) {
+		password, err := readPassphrase(passphrase)
+		if err != nil {
+			return nil, err
+		}
+		if der, err = x509.DecryptPEMBlock(privPem, password); err != nil {
+			return nil, fmt.Errorf("failed to decrypt private key: %w", err)
+		}
+	}
+
+	switch privPem.Type {
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(der)
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(der)
+	case "PRIVATE KEY":
+		return x509.ParsePKCS8PrivateKey(der)
+	case "ENCRYPTED PRIVATE KEY":
+		password, err := readPassphrase(passphrase)
+		if err != nil {
+			return nil, err
+		}
+		key, err := pkcs8.ParsePKCS8PrivateKey(der, password)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt private key: %w", err)
+		}
+		return key, nil
+	}
+	return nil, fmt.Errorf("private key %q is not pkcs#1, sec1 or pkcs#8 format", privPem.Type)
+}
+
+func readPassphrase(passphrase PassphraseFunc) ([]byte, error) {
+	if passphrase == nil {
+		return nil, errors.New("the private key is encrypted, set privateKeyPassphrase")
+	}
+	password, err := passphrase()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the private key passphrase: %w", err)
+	}
+	return password, nil
+}
+
+// parseJWKPrivateKey reads a private JWK, or the key matching privateKeyID
+// from a JWKS, and returns it with its kid and alg.
+func parseJWKPrivateKey(src []byte, privateKeyID string) (interface{}, string, string, error) {
+	set, err := jwk.Parse(src)
+	if err != nil {
+		return nil, "", "", fmt.Errorf("invalid private JWK: %w", err)
+	}
+	var key jwk.Key
+	var ok bool
+	switch {
+	case privateKeyID != "":
+		key, ok = set.LookupKeyID(privateKeyID)
+		if !ok && set.Len() == 1 {
+			key, ok = set.Get(0)
+		}
+		if !ok {
+			return nil, "", "", fmt.Errorf("no JWK with kid %q", privateKeyID)
+		}
+	case set.Len() == 1:
+		key, _ = set.Get(0)
+	default:
+		return nil, "", "", fmt.Errorf("the JWKS holds %v keys, set privateKeyId to pick one", set.Len())
+	}
+
+	var raw interface{}
+	if err = key.Raw(&raw); err != nil {
+		return nil, "", "", err
+	}
+	switch raw.(type) {
+	case *rsa.PublicKey, *ecdsa.PublicKey, ed25519.PublicKey:
+		return nil, "", "", errors.New("the JWK holds a public key, not a private key")
+	}
+	return raw, key.KeyID(), key.Algorithm(), nil
+}
+
+// signingAlgorithm checks algorithm against the key, or picks the default
+// one for it when algorithm is empty.
+func signingAlgorithm(key interface{}, algorithm string) (jose.SignatureAlgorithm, error) {
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		switch alg := jose.SignatureAlgorithm(algorithm); alg {
+		case "":
+			return jose.RS256, nil
+		case jose.RS256, jose.RS384, jose.RS512, jose.PS256, jose.PS384, jose.PS512:
+			return alg, nil
+		}
+		return "", fmt.Errorf("algorithm %v can't be used with an RSA private key", algorithm)
+	case *ecdsa.PrivateKey:
+		var alg jose.SignatureAlgorithm
+		switch k.Curve.Params().Name {
+		case "P-256":
+			alg = jose.ES256
+		case "P-384":
+			alg = jose.ES384
+		case "P-521":
+			alg = jose.ES512
+		default:
+			return "", fmt.Errorf("unsupported EC curve %v", k.Curve.Params().Name)
+		}
+		if algorithm != "" && jose.SignatureAlgorithm(algorithm) != alg {
+			return "", fmt.Errorf("algorithm %v can't be used with a %v private key, use %v", algorithm, k.Curve.Params().Name, alg)
+		}
+		return alg, nil
+	case ed25519.PrivateKey, *ed25519.PrivateKey:
+		return "", errors.New("Ed25519 private keys are not supported, Okta does not accept EdDSA signed client assertions")
+	}
+	return "", fmt.Errorf("unsupported private key type %T", key)
+}
@@ -0,0 +1,73 @@
+package sdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// credentialHeaders are the headers RedactHeader redacts.
+var credentialHeaders = []string{"Authorization", "DPoP", "Cookie"}
+
+// RedactHeader returns a copy of header with the credentials redacted, the
+// scheme of the Authorization header is kept, like "SSWS REDACTED".
+func RedactHeader(header http.Header) http.Header {
+	header = header.Clone()
+	for _, name := range credentialHeaders {
+		values := header.Values(name)
+		for i, value := range values {
+			scheme, _, ok := strings.Cut(value, " ")
+			if name == "Authorization" && ok {
+				values[i] = scheme + " " + redacted
+				continue
+			}
+			values[i] = redacted
+		}
+	}
+	return header
+}
+
+// RedactBody returns a copy of the JSON or form body of a request with the
+// values of the fields holding credentials, like password or client_secret,
+// redacted. Other bodies are returned as they are.
+func RedactBody(body []byte) []byte {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var v interface{}
+	if err := decoder.Decode(&v); err == nil && !decoder.More() {
+		if b, err := json.Marshal(redactJSON(v)); err == nil {
+			return b
+		}
+	}
+	if form, err := url.ParseQuery(string(body)); err == nil && strings.Contains(string(body), "=") {
+		for key, values := range form {
+			if secretFields[key] {
+				for i := range values {
+					values[i] = redacted
+				}
+			}
+		}
+		return []byte(form.Encode())
+	}
+	return body
+}
+
+func redactJSON(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if secretFields[key] {
+				v[key] = redacted
+			} else {
+				v[key] = redactJSON(value)
+			}
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redactJSON(value)
+		}
+	}
+	return v
+}
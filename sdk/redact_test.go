@@ -0,0 +1,29 @@
+package sdk
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactHeader(t *testing.T) {
+	header := http.Header{}
+	header.Set("Authorization", "DPoP eyJhbGciOi")
+	header.Set("DPoP", "eyJ0eXAiOi")
+	header.Set("Accept", "application/json")
+
+	redactedHeader := RedactHeader(header)
+	require.Equal(t, "DPoP REDACTED", redactedHeader.Get("Authorization"))
+	require.Equal(t, "REDACTED", redactedHeader.Get("DPoP"))
+	require.Equal(t, "application/json", redactedHeader.Get("Accept"))
+	require.Equal(t, "DPoP eyJhbGciOi", header.Get("Authorization"), "the header is not changed")
+}
+
+func TestRedactBody(t *testing.T) {
+	body := RedactBody([]byte(`{"profile": {"login": "a@example.com"}, "credentials": {"password": {"value": "s3cret"}}, "items": [{"client_secret": "x", "n": 12345678901234567890}]}`))
+	require.JSONEq(t, `{"profile": {"login": "a@example.com"}, "credentials": {"password": "REDACTED"}, "items": [{"client_secret": "REDACTED", "n": 12345678901234567890}]}`, string(body))
+
+	require.Equal(t, "client_secret=REDACTED&grant_type=client_credentials", string(RedactBody([]byte("grant_type=client_credentials&client_secret=x"))))
+	require.Equal(t, "not json", string(RedactBody([]byte("not json"))))
+}
@@ -13,6 +13,7 @@ package sdk
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"encoding/xml"
 	"errors"
@@ -32,8 +33,11 @@ type APIResponse struct {
 
 func newAPIResponse(r *http.Response, cli *APIClient, v interface{}) *APIResponse {
 	var pg Pagination
-	// switch v
-	pg = newPaginationInHeader(r)
+	if items, ok := bodyPaginationItems(r); ok {
+		pg = newPaginationInBody(r, items)
+	} else {
+		pg = newPaginationInHeader(r)
+	}
 	response := &APIResponse{Response: r, cli: cli, pg: pg}
 	return response
 }
@@ -50,7 +54,7 @@ func buildResponse(resp *http.Response, cli *APIClient, v interface{}) (*APIResp
 	copy(copyBodyBytes, bodyBytes)
 	_ = resp.Body.Close()                                    // close it to avoid memory leaks
 	resp.Body = ioutil.NopCloser(bytes.NewBuffer(bodyBytes)) // restore the original response body
-	if len(copyBodyBytes) == 0 {
+	if v == nil || len(copyBodyBytes) == 0 {
 		return response, nil
 	}
 	switch {
@@ -78,6 +82,7 @@ func (c *APIClient) checkResponseForError(resp *http.Response) error {
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: resp.Status,
+			cause: newOktaError(resp, localVarBody),
 		}
 		if resp.StatusCode == 403 {
 			var v Error
@@ -109,6 +114,7 @@ func (c *APIClient) checkResponseForError(resp *http.Response) error {
 			newErr.model = v
 			return newErr
 		}
+		return newErr
 	}
 	return nil
 }
@@ -117,11 +123,20 @@ func (res *APIResponse) Next(v interface{}) (*APIResponse, error) {
 	if res.cli == nil {
 		return nil, errors.New("no initial response provided from previous request")
 	}
-	req, err := res.cli.PrepareRequest(res.cli.cfg.Context, res.NextPage(), http.MethodGet, nil, map[string]string{"Accept": "application/json"}, nil, nil, nil)
+	return res.NextWithContext(res.cli.cfg.Context, v)
+}
+
+// NextWithContext fetches the next page with ctx and decodes it into v,
+// a nil v leaves the body to the caller.
+func (res *APIResponse) NextWithContext(ctx context.Context, v interface{}) (*APIResponse, error) {
+	if res.cli == nil {
+		return nil, errors.New("no initial response provided from previous request")
+	}
+	req, err := res.cli.PrepareRequest(ctx, res.NextPage(), http.MethodGet, nil, map[string]string{"Accept": "application/json"}, nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := res.cli.Do(res.cli.cfg.Context, req)
+	resp, err := res.cli.Do(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -207,4 +222,82 @@ func (pg *PaginationInHeader) NextPage() (next string) {
 	return
 }
 
-type PaginationInBody struct{}
+// PaginationInBody reads the links of operations answering an object that
+// holds the items in a property and links the next page in _links.next, or
+// in a nextPage field holding either the link or the cursor of the page.
+type PaginationInBody struct {
+	self  string
+	next  string
+	items string
+}
+
+func newPaginationInBody(r *http.Response, items string) *PaginationInBody {
+	pg := &PaginationInBody{items: items}
+	if r == nil || r.Body == nil {
+		return pg
+	}
+	body, _ := ioutil.ReadAll(r.Body)
+	r.Body.Close()
+	r.Body = ioutil.NopCloser(bytes.NewBuffer(body))
+	var page struct {
+		Links map[string]struct {
+			Href string `json:"href"`
+		} `json:"_links"`
+		NextPage string `json:"nextPage"`
+	}
+	if err := json.Unmarshal(body, &page); err != nil {
+		return pg
+	}
+	pg.self = relativeLink(page.Links["self"].Href)
+	pg.next = relativeLink(page.Links["next"].Href)
+	if pg.next == "" && page.NextPage != "" {
+		pg.next = nextPageLink(r.Request, page.NextPage)
+	}
+	return pg
+}
+
+// nextPageLink returns the link to the page of a nextPage field, which is
+// either a link or a cursor sent back as the after parameter of req.
+func nextPageLink(req *http.Request, nextPage string) string {
+	if strings.HasPrefix(nextPage, "/") || strings.Contains(nextPage, "://") {
+		return relativeLink(nextPage)
+	}
+	if req == nil || req.URL == nil {
+		return ""
+	}
+	next := *req.URL
+	query := next.Query()
+	query.Set("after", nextPage)
+	next.RawQuery = query.Encode()
+	next.Scheme = ""
+	next.Host = ""
+	return next.String()
+}
+
+func (pg *PaginationInBody) Self() string {
+	return pg.self
+}
+
+func (pg *PaginationInBody) NextPage() string {
+	return pg.next
+}
+
+// Items returns the property of the body holding the items of the page.
+func (pg *PaginationInBody) Items() string {
+	return pg.items
+}
+
+// relativeLink strips the scheme and host of an href, like the links of the
+// Link header.
+func relativeLink(href string) string {
+	if href == "" {
+		return ""
+	}
+	rawURL, err := url.Parse(href)
+	if err != nil {
+		return ""
+	}
+	rawURL.Scheme = ""
+	rawURL.Host = ""
+	return rawURL.String()
+}
@@ -0,0 +1,104 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// Defaults of the retry policy for transient failures, 429 responses are
+// governed by okta.client.rateLimit instead.
+const (
+	DefaultRetryMaxRetries = 3
+	DefaultRetryMinBackoff = 1
+	DefaultRetryMaxBackoff = 30
+)
+
+// DefaultRetryStatusCodes are the responses of Okta's edge worth retrying.
+var DefaultRetryStatusCodes = []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout}
+
+// idempotentPostSuffixes are POST operations that can be sent twice with the
+// same outcome, like lifecycle transitions. Activations are left out since
+// activating a user twice can send the activation email twice.
+var idempotentPostSuffixes = []string{
+	"/lifecycle/deactivate",
+	"/lifecycle/suspend",
+	"/lifecycle/unsuspend",
+	"/lifecycle/unlock",
+}
+
+// isIdempotent reports whether req may be retried after a failure that
+// might have happened after the server received it. DELETE is not, a second
+// DELETE of a deactivated user deletes it for good.
+func isIdempotent(ctx context.Context, req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut:
+		return true
+	}
+	if ctx != nil {
+		if optIn, ok := ctx.Value(ContextRetryNonIdempotent).(bool); ok && optIn {
+			return true
+		}
+	}
+	for _, suffix := range idempotentPostSuffixes {
+		if strings.HasSuffix(req.URL.Path, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// isTransientError reports whether err is a connection reset, an
+// unexpected EOF or a timeout rather than a cancellation by the caller.
+func isTransientError(ctx context.Context, err error) bool {
+	if ctx != nil && ctx.Err() != nil {
+		return false
+	}
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+func retryStatusCode(cfg *Configuration, statusCode int) bool {
+	for _, code := range cfg.Okta.Client.Retry.StatusCodes {
+		if code == statusCode {
+			return true
+		}
+	}
+	return false
+}
+
+// retryBackoff returns the exponential backoff with jitter before the
+// given retry, between half and all of minBackoff*2^(retry-1) capped at
+// maxBackoff. A Retry-After header of resp takes precedence.
+func retryBackoff(cfg *Configuration, retry int32, resp *http.Response) time.Duration {
+	maxBackoff := time.Duration(cfg.Okta.Client.Retry.MaxBackoff) * time.Second
+	if resp != nil {
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			if d := time.Duration(seconds) * time.Second; d < maxBackoff {
+				return d
+			}
+			return maxBackoff
+		}
+	}
+	d := time.Duration(cfg.Okta.Client.Retry.MinBackoff) * time.Second
+	for i := int32(1); i < retry && d < maxBackoff; i++ {
+		d *= 2
+	}
+	if d > maxBackoff {
+		d = maxBackoff
+	}
+	if d <= 0 {
+		return 0
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
@@ -0,0 +1,103 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTelemetry(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		now := time.Now()
+		w.Header().Set("Date", now.UTC().Format(http.TimeFormat))
+		w.Header().Set("X-Rate-Limit-Reset", strconv.FormatInt(now.Unix(), 10))
+		w.Header().Set("X-Okta-Request-Id", "req-"+strconv.Itoa(int(atomic.AddInt32(&calls, 1))))
+		if calls == 1 {
+			w.Header().Set("X-Rate-Limit-Remaining", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("X-Rate-Limit-Remaining", "99")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	spans := tracetest.NewSpanRecorder()
+	metrics := sdkmetric.NewManualReader()
+	client, err := NewTestClient(server.URL, "token", WithRateLimitMaxRetries(1), WithRateLimitMaxBackOff(0),
+		WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))),
+		WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(metrics))))
+	require.NoError(t, err)
+
+	_, err = client.GroupAPI.ListGroups(context.Background()).Execute()
+	require.NoError(t, err)
+
+	ended := spans.Ended()
+	require.Len(t, ended, 1)
+	span := ended[0]
+	require.Equal(t, "GET /api/v1/groups", span.Name())
+	attrs := attribute.NewSet(span.Attributes()...)
+	for key, want := range map[attribute.Key]attribute.Value{
+		"okta.operation.id":         attribute.StringValue("listGroups"),
+		"http.request.method":       attribute.StringValue("GET"),
+		"http.route":                attribute.StringValue("/api/v1/groups"),
+		"http.response.status_code": attribute.IntValue(200),
+		"http.request.resend_count": attribute.IntValue(1),
+		"okta.rate_limit.remaining": attribute.IntValue(99),
+		"okta.request.id":           attribute.StringValue("req-2"),
+	} {
+		got, ok := attrs.Value(key)
+		require.True(t, ok, key)
+		require.Equal(t, want, got, key)
+	}
+	require.Len(t, span.Events(), 1)
+
+	var data metricdata.ResourceMetrics
+	require.NoError(t, metrics.Collect(context.Background(), &data))
+	require.Len(t, data.ScopeMetrics, 1)
+	byName := map[string]metricdata.Aggregation{}
+	for _, m := range data.ScopeMetrics[0].Metrics {
+		byName[m.Name] = m.Data
+	}
+	rateLimited := byName["okta.client.rate_limited"].(metricdata.Sum[int64])
+	require.Len(t, rateLimited.DataPoints, 1)
+	require.Equal(t, int64(1), rateLimited.DataPoints[0].Value)
+	duration := byName["okta.client.request.duration"].(metricdata.Histogram[float64])
+	require.Len(t, duration.DataPoints, 1)
+	require.Equal(t, uint64(1), duration.DataPoints[0].Count)
+}
+
+func TestTelemetryTokenRequests(t *testing.T) {
+	spans := tracetest.NewSpanRecorder()
+	cfg, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"),
+		WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))))
+	require.NoError(t, err)
+	client := NewAPIClient(cfg)
+
+	doer := client.telemetry.tokenDoer(context.Background(), DoerFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusUnauthorized, Status: "401 Unauthorized", Header: http.Header{}, Request: req}, nil
+	}))
+	req, err := http.NewRequest(http.MethodPost, "https://example.okta.com/oauth2/v1/token", nil)
+	require.NoError(t, err)
+	_, err = doer.Do(req)
+	require.NoError(t, err)
+
+	ended := spans.Ended()
+	require.Len(t, ended, 1)
+	require.Equal(t, "POST /oauth2/v1/token", ended[0].Name())
+	require.Equal(t, codes.Error, ended[0].Status().Code)
+}
@@ -62,6 +62,18 @@ func contain(searchPhrase string, searchList []string) (res bool) {
 	return
 }
 
+// NewTestClient returns a client of the test org at orgURL, authorized with
+// token over plain HTTP and without cache, configured further by setters.
+func NewTestClient(orgURL, token string, setters ...ConfigSetter) (*APIClient, error) {
+	cfg, err := NewConfiguration(append([]ConfigSetter{
+		WithOrgUrl(orgURL), WithToken(token), WithTestingDisableHttpsCheck(true), WithCache(false),
+	}, setters...)...)
+	if err != nil {
+		return nil, err
+	}
+	return NewAPIClient(cfg), nil
+}
+
 type TestFactory struct{}
 
 var testFactory TestFactory
@@ -0,0 +1,109 @@
+package sdk
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+)
+
+// checkOrgURL rejects org URLs that don't use https unless
+// okta.testing.disableHttpsCheck is set.
+func checkOrgURL(cfg *Configuration, orgURL *url.URL) error {
+	if cfg.Okta.Client.OrgUrl == "" || orgURL.Scheme == "https" || cfg.Okta.Testing.DisableHttpsCheck {
+		return nil
+	}
+	return fmt.Errorf("orgUrl %v must use https, set okta.testing.disableHttpsCheck to allow %v", cfg.Okta.Client.OrgUrl, orgURL.Scheme)
+}
+
+// loadTLSConfig reads the CA bundle and client certificate configured for
+// the connection to the org.
+func loadTLSConfig(cfg *Configuration) (*tls.Config, error) {
+	client := cfg.Okta.Client
+	if client.CABundle == "" && client.ClientCertificate == "" && client.ClientCertificateKey == "" {
+		return nil, nil
+	}
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if client.CABundle != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		bundle, err := os.ReadFile(client.CABundle)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read caBundle: %w", err)
+		}
+		if !pool.AppendCertsFromPEM(bundle) {
+			return nil, fmt.Errorf("caBundle %v holds no PEM certificates", client.CABundle)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if client.ClientCertificate != "" || client.ClientCertificateKey != "" {
+		if client.ClientCertificate == "" || client.ClientCertificateKey == "" {
+			return nil, errors.New("clientCertificate and clientCertificateKey must be set together")
+		}
+		cert, err := tls.LoadX509KeyPair(client.ClientCertificate, client.ClientCertificateKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+// proxyURL returns the configured proxy without credentials, nil when no
+// proxy host is configured.
+func proxyURL(cfg *Configuration) (*url.URL, error) {
+	proxy := cfg.Okta.Client.Proxy
+	if proxy.Host == "" {
+		return nil, nil
+	}
+	scheme := proxy.Scheme
+	switch scheme {
+	case "":
+		scheme = "http"
+	case "http", "https", "socks5", "socks5h":
+	default:
+		return nil, fmt.Errorf("unsupported proxy scheme %v, expected http, https, socks5 or socks5h", scheme)
+	}
+	host := proxy.Host
+	if proxy.Port != 0 {
+		host = net.JoinHostPort(proxy.Host, strconv.Itoa(int(proxy.Port)))
+	}
+	return &url.URL{Scheme: scheme, Host: host}, nil
+}
+
+// newHTTPClient builds the client used when none is configured: the dial
+// and TLS handshake honor connectionTimeout, the configured proxy is used
+// before the HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables.
+func newHTTPClient(cfg *Configuration, secrets *secretResolver) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if cfg.Okta.Client.ConnectionTimeout > 0 {
+		timeout := time.Duration(cfg.Okta.Client.ConnectionTimeout) * time.Second
+		transport.DialContext = (&net.Dialer{Timeout: timeout, KeepAlive: 30 * time.Second}).DialContext
+		transport.TLSHandshakeTimeout = timeout
+	}
+	transport.TLSClientConfig = cfg.tlsConfig
+	// validated by NewConfiguration
+	proxy, _ := proxyURL(cfg)
+	if proxy != nil {
+		transport.Proxy = func(*http.Request) (*url.URL, error) {
+			withUser := *proxy
+			if cfg.Okta.Client.Proxy.Username != "" {
+				password, err := secrets.resolve("proxy password", cfg.Okta.Client.Proxy.Password)
+				if err != nil {
+					return nil, err
+				}
+				withUser.User = url.UserPassword(cfg.Okta.Client.Proxy.Username, password)
+			}
+			return &withUser, nil
+		}
+	}
+	return &http.Client{Transport: transport}
+}
@@ -0,0 +1,115 @@
+package sdk
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writePEM(t *testing.T, blockType string, der []byte) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "file.pem")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600))
+	return path
+}
+
+// selfSigned returns a DER certificate and PKCS#8 key signed by themselves.
+func selfSigned(t *testing.T, template *x509.Certificate) ([]byte, []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template.SerialNumber = big.NewInt(1)
+	template.NotBefore = time.Now().Add(-time.Hour)
+	template.NotAfter = time.Now().Add(time.Hour)
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	return certDER, keyDER
+}
+
+func TestHttpsCheck(t *testing.T) {
+	_, err := NewConfiguration(WithOrgUrl("http://example.okta.com"), WithToken("token"))
+	require.ErrorContains(t, err, "must use https")
+
+	cfg, err := NewConfiguration(WithOrgUrl("http://localhost:8080"), WithToken("token"), WithTestingDisableHttpsCheck(true))
+	require.NoError(t, err)
+	require.Equal(t, "localhost:8080", cfg.Host)
+}
+
+func TestProxyScheme(t *testing.T) {
+	_, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithProxyHost("proxy"), WithProxyScheme("ftp"))
+	require.ErrorContains(t, err, "unsupported proxy scheme")
+
+	cfg, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithProxyHost("proxy"), WithProxyPort(1080), WithProxyScheme("socks5"))
+	require.NoError(t, err)
+	proxy, err := proxyURL(cfg)
+	require.NoError(t, err)
+	require.Equal(t, "socks5://proxy:1080", proxy.String())
+}
+
+func TestCABundleAndClientCertificate(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) == 0 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	serverDER, serverKeyDER := selfSigned(t, &x509.Certificate{
+		Subject:               pkix.Name{CommonName: "127.0.0.1"},
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	})
+	serverKey, err := x509.ParsePKCS8PrivateKey(serverKeyDER)
+	require.NoError(t, err)
+	server.TLS = &tls.Config{
+		ClientAuth:   tls.RequestClientCert,
+		Certificates: []tls.Certificate{{Certificate: [][]byte{serverDER}, PrivateKey: serverKey}},
+	}
+	server.StartTLS()
+	defer server.Close()
+	caBundle := writePEM(t, "CERTIFICATE", serverDER)
+
+	// the test server's certificate is not trusted by default
+	cfg, err := NewConfiguration(WithOrgUrl(server.URL), WithToken("token"))
+	require.NoError(t, err)
+	_, err = NewAPIClient(cfg).GetConfig().HTTPClient.Get(server.URL)
+	require.Error(t, err)
+
+	cfg, err = NewConfiguration(WithOrgUrl(server.URL), WithToken("token"), WithCABundle(caBundle))
+	require.NoError(t, err)
+	resp, err := NewAPIClient(cfg).GetConfig().HTTPClient.Get(server.URL)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	certDER, keyDER := selfSigned(t, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "okta-cli-client"},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+
+	_, err = NewConfiguration(WithOrgUrl(server.URL), WithClientCertificate(writePEM(t, "CERTIFICATE", certDER), ""))
+	require.Error(t, err)
+	cfg, err = NewConfiguration(WithOrgUrl(server.URL), WithToken("token"), WithCABundle(caBundle),
+		WithClientCertificate(writePEM(t, "CERTIFICATE", certDER), writePEM(t, "PRIVATE KEY", keyDER)))
+	require.NoError(t, err)
+	resp, err = NewAPIClient(cfg).GetConfig().HTTPClient.Get(server.URL)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+}